@@ -29,6 +29,8 @@ type ChatMessage struct {
 	SenderId       string                 `protobuf:"bytes,2,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
 	Content        string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
 	SentAt         *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	MessageId      int64                  `protobuf:"varint,5,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Status         string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -91,7 +93,21 @@ func (x *ChatMessage) GetSentAt() *timestamp.Timestamp {
 	return nil
 }
 
-// Send a new message to a conversaiton
+func (x *ChatMessage) GetMessageId() int64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *ChatMessage) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// Send a new message to a conversation
 type SendMessageRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
@@ -209,6 +225,7 @@ type GetChatHistoryRequest struct {
 	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
 	Limit          int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	Offset         int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	UserId         string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -264,11 +281,20 @@ func (x *GetChatHistoryRequest) GetOffset() int32 {
 	return 0
 }
 
+func (x *GetChatHistoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// When end_to_end_encrypted is set, message content is client ciphertext the server cannot read
 type GetChatHistoryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Messages      []*ChatMessage         `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Messages          []*ChatMessage         `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	EndToEndEncrypted bool                   `protobuf:"varint,2,opt,name=end_to_end_encrypted,json=endToEndEncrypted,proto3" json:"end_to_end_encrypted,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *GetChatHistoryResponse) Reset() {
@@ -308,33 +334,1927 @@ func (x *GetChatHistoryResponse) GetMessages() []*ChatMessage {
 	return nil
 }
 
-var File_api_v1_chat_proto protoreflect.FileDescriptor
+func (x *GetChatHistoryResponse) GetEndToEndEncrypted() bool {
+	if x != nil {
+		return x.EndToEndEncrypted
+	}
+	return false
+}
 
-const file_api_v1_chat_proto_rawDesc = "" +
-	"\n" +
-	"\x11api/v1/chat.proto\x12\x06api.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa2\x01\n" +
-	"\vChatMessage\x12'\n" +
-	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1b\n" +
-	"\tsender_id\x18\x02 \x01(\tR\bsenderId\x12\x18\n" +
-	"\acontent\x18\x03 \x01(\tR\acontent\x123\n" +
-	"\asent_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06sentAt\"t\n" +
-	"\x12SendMessageRequest\x12'\n" +
-	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1b\n" +
-	"\tsender_id\x18\x02 \x01(\tR\bsenderId\x12\x18\n" +
-	"\acontent\x18\x03 \x01(\tR\acontent\"^\n" +
-	"\x13SendMessageResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12-\n" +
-	"\amessage\x18\x02 \x01(\v2\x13.api.v1.ChatMessageR\amessage\"n\n" +
-	"\x15GetChatHistoryRequest\x12'\n" +
-	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\"I\n" +
-	"\x16GetChatHistoryResponse\x12/\n" +
-	"\bmessages\x18\x01 \x03(\v2\x13.api.v1.ChatMessageR\bmessages2\xe7\x01\n" +
-	"\vChatService\x12>\n" +
-	"\x0eStreamMessages\x12\x13.api.v1.ChatMessage\x1a\x13.api.v1.ChatMessage(\x010\x01\x12G\n" +
-	"\fSendMessages\x12\x1a.api.v1.SendMessageRequest\x1a\x1b.api.v1.SendMessageResponse\x12O\n" +
-	"\x0eGetChatHistory\x12\x1d.api.v1.GetChatHistoryRequest\x1a\x1e.api.v1.GetChatHistoryResponseB\x0fZ\r./api/v1/chatb\x06proto3"
+// Register an automated bot account owned by a user
+type RegisterBotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	WebhookUrl    string                 `protobuf:"bytes,3,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterBotRequest) Reset() {
+	*x = RegisterBotRequest{}
+	mi := &file_api_v1_chat_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterBotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterBotRequest) ProtoMessage() {}
+
+func (x *RegisterBotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterBotRequest.ProtoReflect.Descriptor instead.
+func (*RegisterBotRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RegisterBotRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *RegisterBotRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegisterBotRequest) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+// The api token and webhook secret are only returned once
+type RegisterBotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BotId         int64                  `protobuf:"varint,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	ApiToken      string                 `protobuf:"bytes,2,opt,name=api_token,json=apiToken,proto3" json:"api_token,omitempty"`
+	WebhookSecret string                 `protobuf:"bytes,3,opt,name=webhook_secret,json=webhookSecret,proto3" json:"webhook_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterBotResponse) Reset() {
+	*x = RegisterBotResponse{}
+	mi := &file_api_v1_chat_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterBotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterBotResponse) ProtoMessage() {}
+
+func (x *RegisterBotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterBotResponse.ProtoReflect.Descriptor instead.
+func (*RegisterBotResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RegisterBotResponse) GetBotId() int64 {
+	if x != nil {
+		return x.BotId
+	}
+	return 0
+}
+
+func (x *RegisterBotResponse) GetApiToken() string {
+	if x != nil {
+		return x.ApiToken
+	}
+	return ""
+}
+
+func (x *RegisterBotResponse) GetWebhookSecret() string {
+	if x != nil {
+		return x.WebhookSecret
+	}
+	return ""
+}
+
+type AddBotToConversationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BotId          int64                  `protobuf:"varint,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	AddedBy        string                 `protobuf:"bytes,3,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddBotToConversationRequest) Reset() {
+	*x = AddBotToConversationRequest{}
+	mi := &file_api_v1_chat_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBotToConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBotToConversationRequest) ProtoMessage() {}
+
+func (x *AddBotToConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBotToConversationRequest.ProtoReflect.Descriptor instead.
+func (*AddBotToConversationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AddBotToConversationRequest) GetBotId() int64 {
+	if x != nil {
+		return x.BotId
+	}
+	return 0
+}
+
+func (x *AddBotToConversationRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *AddBotToConversationRequest) GetAddedBy() string {
+	if x != nil {
+		return x.AddedBy
+	}
+	return ""
+}
+
+type AddBotToConversationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBotToConversationResponse) Reset() {
+	*x = AddBotToConversationResponse{}
+	mi := &file_api_v1_chat_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBotToConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBotToConversationResponse) ProtoMessage() {}
+
+func (x *AddBotToConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBotToConversationResponse.ProtoReflect.Descriptor instead.
+func (*AddBotToConversationResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AddBotToConversationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type EditMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     int64                  `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	EditorId      string                 `protobuf:"bytes,2,opt,name=editor_id,json=editorId,proto3" json:"editor_id,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditMessageRequest) Reset() {
+	*x = EditMessageRequest{}
+	mi := &file_api_v1_chat_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditMessageRequest) ProtoMessage() {}
+
+func (x *EditMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditMessageRequest.ProtoReflect.Descriptor instead.
+func (*EditMessageRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EditMessageRequest) GetMessageId() int64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *EditMessageRequest) GetEditorId() string {
+	if x != nil {
+		return x.EditorId
+	}
+	return ""
+}
+
+func (x *EditMessageRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type DeleteMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     int64                  `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	RequesterId   string                 `protobuf:"bytes,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMessageRequest) Reset() {
+	*x = DeleteMessageRequest{}
+	mi := &file_api_v1_chat_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMessageRequest) ProtoMessage() {}
+
+func (x *DeleteMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMessageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMessageRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteMessageRequest) GetMessageId() int64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *DeleteMessageRequest) GetRequesterId() string {
+	if x != nil {
+		return x.RequesterId
+	}
+	return ""
+}
+
+type MessageActionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       *ChatMessage           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageActionResponse) Reset() {
+	*x = MessageActionResponse{}
+	mi := &file_api_v1_chat_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageActionResponse) ProtoMessage() {}
+
+func (x *MessageActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageActionResponse.ProtoReflect.Descriptor instead.
+func (*MessageActionResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MessageActionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MessageActionResponse) GetMessage() *ChatMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// Read receipt for every message in the conversation up to and including up_to_message_id
+type MarkAsReadRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UpToMessageId  int64                  `protobuf:"varint,3,opt,name=up_to_message_id,json=upToMessageId,proto3" json:"up_to_message_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MarkAsReadRequest) Reset() {
+	*x = MarkAsReadRequest{}
+	mi := &file_api_v1_chat_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAsReadRequest) ProtoMessage() {}
+
+func (x *MarkAsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkAsReadRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MarkAsReadRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *MarkAsReadRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MarkAsReadRequest) GetUpToMessageId() int64 {
+	if x != nil {
+		return x.UpToMessageId
+	}
+	return 0
+}
+
+// Start a conversation of the creator and member_ids, only members can send into it
+type CreateConversationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreatorId     string                 `protobuf:"bytes,1,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	MemberIds     []string               `protobuf:"bytes,2,rep,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateConversationRequest) Reset() {
+	*x = CreateConversationRequest{}
+	mi := &file_api_v1_chat_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConversationRequest) ProtoMessage() {}
+
+func (x *CreateConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateConversationRequest.ProtoReflect.Descriptor instead.
+func (*CreateConversationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateConversationRequest) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *CreateConversationRequest) GetMemberIds() []string {
+	if x != nil {
+		return x.MemberIds
+	}
+	return nil
+}
+
+type CreateConversationResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateConversationResponse) Reset() {
+	*x = CreateConversationResponse{}
+	mi := &file_api_v1_chat_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConversationResponse) ProtoMessage() {}
+
+func (x *CreateConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateConversationResponse.ProtoReflect.Descriptor instead.
+func (*CreateConversationResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateConversationResponse) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+type ConversationMemberRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ActorId        string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConversationMemberRequest) Reset() {
+	*x = ConversationMemberRequest{}
+	mi := &file_api_v1_chat_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationMemberRequest) ProtoMessage() {}
+
+func (x *ConversationMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationMemberRequest.ProtoReflect.Descriptor instead.
+func (*ConversationMemberRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ConversationMemberRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ConversationMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ConversationMemberRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type ChatStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatStatusResponse) Reset() {
+	*x = ChatStatusResponse{}
+	mi := &file_api_v1_chat_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatStatusResponse) ProtoMessage() {}
+
+func (x *ChatStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatStatusResponse.ProtoReflect.Descriptor instead.
+func (*ChatStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ChatStatusResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// An unset since_cursor resumes from the cursor last stored for the device, 0 syncs from the beginning
+type SyncChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DeviceToken   string                 `protobuf:"bytes,2,opt,name=device_token,json=deviceToken,proto3" json:"device_token,omitempty"`
+	SinceCursor   *int64                 `protobuf:"varint,3,opt,name=since_cursor,json=sinceCursor,proto3,oneof" json:"since_cursor,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncChangesRequest) Reset() {
+	*x = SyncChangesRequest{}
+	mi := &file_api_v1_chat_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncChangesRequest) ProtoMessage() {}
+
+func (x *SyncChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncChangesRequest.ProtoReflect.Descriptor instead.
+func (*SyncChangesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SyncChangesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SyncChangesRequest) GetDeviceToken() string {
+	if x != nil {
+		return x.DeviceToken
+	}
+	return ""
+}
+
+func (x *SyncChangesRequest) GetSinceCursor() int64 {
+	if x != nil && x.SinceCursor != nil {
+		return *x.SinceCursor
+	}
+	return 0
+}
+
+func (x *SyncChangesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// content is the current content of the message, an edited or deleted message never shows its old text
+type SyncChange struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Cursor         int64                  `protobuf:"varint,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Kind           string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	ConversationId string                 `protobuf:"bytes,3,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	MessageId      int64                  `protobuf:"varint,4,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ActorId        string                 `protobuf:"bytes,5,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	SubjectId      string                 `protobuf:"bytes,6,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	Content        string                 `protobuf:"bytes,7,opt,name=content,proto3" json:"content,omitempty"`
+	OccurredAt     *timestamp.Timestamp   `protobuf:"bytes,8,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SyncChange) Reset() {
+	*x = SyncChange{}
+	mi := &file_api_v1_chat_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncChange) ProtoMessage() {}
+
+func (x *SyncChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncChange.ProtoReflect.Descriptor instead.
+func (*SyncChange) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SyncChange) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *SyncChange) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SyncChange) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *SyncChange) GetMessageId() int64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *SyncChange) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *SyncChange) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *SyncChange) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *SyncChange) GetOccurredAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type SyncChangesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*SyncChange          `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	NextCursor    int64                  `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncChangesResponse) Reset() {
+	*x = SyncChangesResponse{}
+	mi := &file_api_v1_chat_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncChangesResponse) ProtoMessage() {}
+
+func (x *SyncChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncChangesResponse.ProtoReflect.Descriptor instead.
+func (*SyncChangesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SyncChangesResponse) GetChanges() []*SyncChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *SyncChangesResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *SyncChangesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// A muted_until that is unset or in the past unmutes the conversation
+type MuteConversationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MutedUntil     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=muted_until,json=mutedUntil,proto3" json:"muted_until,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MuteConversationRequest) Reset() {
+	*x = MuteConversationRequest{}
+	mi := &file_api_v1_chat_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteConversationRequest) ProtoMessage() {}
+
+func (x *MuteConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteConversationRequest.ProtoReflect.Descriptor instead.
+func (*MuteConversationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MuteConversationRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *MuteConversationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MuteConversationRequest) GetMutedUntil() *timestamp.Timestamp {
+	if x != nil {
+		return x.MutedUntil
+	}
+	return nil
+}
+
+type ConversationFlagRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Enabled        bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConversationFlagRequest) Reset() {
+	*x = ConversationFlagRequest{}
+	mi := &file_api_v1_chat_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationFlagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationFlagRequest) ProtoMessage() {}
+
+func (x *ConversationFlagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationFlagRequest.ProtoReflect.Descriptor instead.
+func (*ConversationFlagRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ConversationFlagRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ConversationFlagRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ConversationFlagRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type ListConversationsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,2,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListConversationsRequest) Reset() {
+	*x = ListConversationsRequest{}
+	mi := &file_api_v1_chat_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConversationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConversationsRequest) ProtoMessage() {}
+
+func (x *ListConversationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConversationsRequest.ProtoReflect.Descriptor instead.
+func (*ListConversationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListConversationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListConversationsRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type ConversationSummary struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId    string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	LastMessage       *ChatMessage           `protobuf:"bytes,2,opt,name=last_message,json=lastMessage,proto3" json:"last_message,omitempty"`
+	MutedUntil        *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=muted_until,json=mutedUntil,proto3" json:"muted_until,omitempty"`
+	Archived          bool                   `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
+	Pinned            bool                   `protobuf:"varint,5,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	EndToEndEncrypted bool                   `protobuf:"varint,6,opt,name=end_to_end_encrypted,json=endToEndEncrypted,proto3" json:"end_to_end_encrypted,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ConversationSummary) Reset() {
+	*x = ConversationSummary{}
+	mi := &file_api_v1_chat_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationSummary) ProtoMessage() {}
+
+func (x *ConversationSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationSummary.ProtoReflect.Descriptor instead.
+func (*ConversationSummary) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ConversationSummary) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ConversationSummary) GetLastMessage() *ChatMessage {
+	if x != nil {
+		return x.LastMessage
+	}
+	return nil
+}
+
+func (x *ConversationSummary) GetMutedUntil() *timestamp.Timestamp {
+	if x != nil {
+		return x.MutedUntil
+	}
+	return nil
+}
+
+func (x *ConversationSummary) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *ConversationSummary) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *ConversationSummary) GetEndToEndEncrypted() bool {
+	if x != nil {
+		return x.EndToEndEncrypted
+	}
+	return false
+}
+
+// Pinned conversations come first, then the rest by latest activity
+type ListConversationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversations []*ConversationSummary `protobuf:"bytes,1,rep,name=conversations,proto3" json:"conversations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConversationsResponse) Reset() {
+	*x = ListConversationsResponse{}
+	mi := &file_api_v1_chat_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConversationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConversationsResponse) ProtoMessage() {}
+
+func (x *ListConversationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConversationsResponse.ProtoReflect.Descriptor instead.
+func (*ListConversationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListConversationsResponse) GetConversations() []*ConversationSummary {
+	if x != nil {
+		return x.Conversations
+	}
+	return nil
+}
+
+type OneTimePrekey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         uint32                 `protobuf:"varint,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	PublicKey     []byte                 `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OneTimePrekey) Reset() {
+	*x = OneTimePrekey{}
+	mi := &file_api_v1_chat_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OneTimePrekey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OneTimePrekey) ProtoMessage() {}
+
+func (x *OneTimePrekey) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OneTimePrekey.ProtoReflect.Descriptor instead.
+func (*OneTimePrekey) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *OneTimePrekey) GetKeyId() uint32 {
+	if x != nil {
+		return x.KeyId
+	}
+	return 0
+}
+
+func (x *OneTimePrekey) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+// Publish the public identity key, signed prekey and a batch of one-time prekeys of a device
+type PublishKeysRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	UserId                string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DeviceToken           string                 `protobuf:"bytes,2,opt,name=device_token,json=deviceToken,proto3" json:"device_token,omitempty"`
+	IdentityKey           []byte                 `protobuf:"bytes,3,opt,name=identity_key,json=identityKey,proto3" json:"identity_key,omitempty"`
+	SignedPrekeyId        uint32                 `protobuf:"varint,4,opt,name=signed_prekey_id,json=signedPrekeyId,proto3" json:"signed_prekey_id,omitempty"`
+	SignedPrekey          []byte                 `protobuf:"bytes,5,opt,name=signed_prekey,json=signedPrekey,proto3" json:"signed_prekey,omitempty"`
+	SignedPrekeySignature []byte                 `protobuf:"bytes,6,opt,name=signed_prekey_signature,json=signedPrekeySignature,proto3" json:"signed_prekey_signature,omitempty"`
+	OneTimePrekeys        []*OneTimePrekey       `protobuf:"bytes,7,rep,name=one_time_prekeys,json=oneTimePrekeys,proto3" json:"one_time_prekeys,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *PublishKeysRequest) Reset() {
+	*x = PublishKeysRequest{}
+	mi := &file_api_v1_chat_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishKeysRequest) ProtoMessage() {}
+
+func (x *PublishKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishKeysRequest.ProtoReflect.Descriptor instead.
+func (*PublishKeysRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PublishKeysRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PublishKeysRequest) GetDeviceToken() string {
+	if x != nil {
+		return x.DeviceToken
+	}
+	return ""
+}
+
+func (x *PublishKeysRequest) GetIdentityKey() []byte {
+	if x != nil {
+		return x.IdentityKey
+	}
+	return nil
+}
+
+func (x *PublishKeysRequest) GetSignedPrekeyId() uint32 {
+	if x != nil {
+		return x.SignedPrekeyId
+	}
+	return 0
+}
+
+func (x *PublishKeysRequest) GetSignedPrekey() []byte {
+	if x != nil {
+		return x.SignedPrekey
+	}
+	return nil
+}
+
+func (x *PublishKeysRequest) GetSignedPrekeySignature() []byte {
+	if x != nil {
+		return x.SignedPrekeySignature
+	}
+	return nil
+}
+
+func (x *PublishKeysRequest) GetOneTimePrekeys() []*OneTimePrekey {
+	if x != nil {
+		return x.OneTimePrekeys
+	}
+	return nil
+}
+
+// device_id is the public handle of the device in the key directory, the device token is never exposed
+type PublishKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishKeysResponse) Reset() {
+	*x = PublishKeysResponse{}
+	mi := &file_api_v1_chat_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishKeysResponse) ProtoMessage() {}
+
+func (x *PublishKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishKeysResponse.ProtoReflect.Descriptor instead.
+func (*PublishKeysResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PublishKeysResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PublishKeysResponse) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+type GetKeyBundlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequesterId   string                 `protobuf:"bytes,1,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKeyBundlesRequest) Reset() {
+	*x = GetKeyBundlesRequest{}
+	mi := &file_api_v1_chat_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKeyBundlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKeyBundlesRequest) ProtoMessage() {}
+
+func (x *GetKeyBundlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKeyBundlesRequest.ProtoReflect.Descriptor instead.
+func (*GetKeyBundlesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetKeyBundlesRequest) GetRequesterId() string {
+	if x != nil {
+		return x.RequesterId
+	}
+	return ""
+}
+
+func (x *GetKeyBundlesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// one_time_prekey is consumed when handed out and is unset once the device runs out
+type KeyBundle struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId              string                 `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	IdentityKey           []byte                 `protobuf:"bytes,2,opt,name=identity_key,json=identityKey,proto3" json:"identity_key,omitempty"`
+	SignedPrekeyId        uint32                 `protobuf:"varint,3,opt,name=signed_prekey_id,json=signedPrekeyId,proto3" json:"signed_prekey_id,omitempty"`
+	SignedPrekey          []byte                 `protobuf:"bytes,4,opt,name=signed_prekey,json=signedPrekey,proto3" json:"signed_prekey,omitempty"`
+	SignedPrekeySignature []byte                 `protobuf:"bytes,5,opt,name=signed_prekey_signature,json=signedPrekeySignature,proto3" json:"signed_prekey_signature,omitempty"`
+	OneTimePrekey         *OneTimePrekey         `protobuf:"bytes,6,opt,name=one_time_prekey,json=oneTimePrekey,proto3" json:"one_time_prekey,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *KeyBundle) Reset() {
+	*x = KeyBundle{}
+	mi := &file_api_v1_chat_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyBundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyBundle) ProtoMessage() {}
+
+func (x *KeyBundle) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyBundle.ProtoReflect.Descriptor instead.
+func (*KeyBundle) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *KeyBundle) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *KeyBundle) GetIdentityKey() []byte {
+	if x != nil {
+		return x.IdentityKey
+	}
+	return nil
+}
+
+func (x *KeyBundle) GetSignedPrekeyId() uint32 {
+	if x != nil {
+		return x.SignedPrekeyId
+	}
+	return 0
+}
+
+func (x *KeyBundle) GetSignedPrekey() []byte {
+	if x != nil {
+		return x.SignedPrekey
+	}
+	return nil
+}
+
+func (x *KeyBundle) GetSignedPrekeySignature() []byte {
+	if x != nil {
+		return x.SignedPrekeySignature
+	}
+	return nil
+}
+
+func (x *KeyBundle) GetOneTimePrekey() *OneTimePrekey {
+	if x != nil {
+		return x.OneTimePrekey
+	}
+	return nil
+}
+
+type GetKeyBundlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Bundles       []*KeyBundle           `protobuf:"bytes,2,rep,name=bundles,proto3" json:"bundles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKeyBundlesResponse) Reset() {
+	*x = GetKeyBundlesResponse{}
+	mi := &file_api_v1_chat_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKeyBundlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKeyBundlesResponse) ProtoMessage() {}
+
+func (x *GetKeyBundlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKeyBundlesResponse.ProtoReflect.Descriptor instead.
+func (*GetKeyBundlesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetKeyBundlesResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetKeyBundlesResponse) GetBundles() []*KeyBundle {
+	if x != nil {
+		return x.Bundles
+	}
+	return nil
+}
+
+// Turn on end-to-end encryption for a 1:1 conversation, this cannot be undone
+type EnableE2ERequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EnableE2ERequest) Reset() {
+	*x = EnableE2ERequest{}
+	mi := &file_api_v1_chat_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnableE2ERequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableE2ERequest) ProtoMessage() {}
+
+func (x *EnableE2ERequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableE2ERequest.ProtoReflect.Descriptor instead.
+func (*EnableE2ERequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *EnableE2ERequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *EnableE2ERequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type SearchMessagesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Query          string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Limit          int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	UserId         string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchMessagesRequest) Reset() {
+	*x = SearchMessagesRequest{}
+	mi := &file_api_v1_chat_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMessagesRequest) ProtoMessage() {}
+
+func (x *SearchMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMessagesRequest.ProtoReflect.Descriptor instead.
+func (*SearchMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SearchMessagesRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchMessagesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type SearchMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*ChatMessage         `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMessagesResponse) Reset() {
+	*x = SearchMessagesResponse{}
+	mi := &file_api_v1_chat_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMessagesResponse) ProtoMessage() {}
+
+func (x *SearchMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_chat_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMessagesResponse.ProtoReflect.Descriptor instead.
+func (*SearchMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_chat_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SearchMessagesResponse) GetMessages() []*ChatMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+var File_api_v1_chat_proto protoreflect.FileDescriptor
+
+const file_api_v1_chat_proto_rawDesc = "" +
+	"\n" +
+	"\x11api/v1/chat.proto\x12\x06api.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd9\x01\n" +
+	"\vChatMessage\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1b\n" +
+	"\tsender_id\x18\x02 \x01(\tR\bsenderId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x123\n" +
+	"\asent_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06sentAt\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x05 \x01(\x03R\tmessageId\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\"t\n" +
+	"\x12SendMessageRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1b\n" +
+	"\tsender_id\x18\x02 \x01(\tR\bsenderId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\"^\n" +
+	"\x13SendMessageResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12-\n" +
+	"\amessage\x18\x02 \x01(\v2\x13.api.v1.ChatMessageR\amessage\"\x87\x01\n" +
+	"\x15GetChatHistoryRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\"z\n" +
+	"\x16GetChatHistoryResponse\x12/\n" +
+	"\bmessages\x18\x01 \x03(\v2\x13.api.v1.ChatMessageR\bmessages\x12/\n" +
+	"\x14end_to_end_encrypted\x18\x02 \x01(\bR\x11endToEndEncrypted\"d\n" +
+	"\x12RegisterBotRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\tR\aownerId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vwebhook_url\x18\x03 \x01(\tR\n" +
+	"webhookUrl\"p\n" +
+	"\x13RegisterBotResponse\x12\x15\n" +
+	"\x06bot_id\x18\x01 \x01(\x03R\x05botId\x12\x1b\n" +
+	"\tapi_token\x18\x02 \x01(\tR\bapiToken\x12%\n" +
+	"\x0ewebhook_secret\x18\x03 \x01(\tR\rwebhookSecret\"x\n" +
+	"\x1bAddBotToConversationRequest\x12\x15\n" +
+	"\x06bot_id\x18\x01 \x01(\x03R\x05botId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x19\n" +
+	"\badded_by\x18\x03 \x01(\tR\aaddedBy\"8\n" +
+	"\x1cAddBotToConversationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"j\n" +
+	"\x12EditMessageRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\x03R\tmessageId\x12\x1b\n" +
+	"\teditor_id\x18\x02 \x01(\tR\beditorId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\"X\n" +
+	"\x14DeleteMessageRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\x03R\tmessageId\x12!\n" +
+	"\frequester_id\x18\x02 \x01(\tR\vrequesterId\"`\n" +
+	"\x15MessageActionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12-\n" +
+	"\amessage\x18\x02 \x01(\v2\x13.api.v1.ChatMessageR\amessage\"~\n" +
+	"\x11MarkAsReadRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12'\n" +
+	"\x10up_to_message_id\x18\x03 \x01(\x03R\rupToMessageId\"Y\n" +
+	"\x19CreateConversationRequest\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x01 \x01(\tR\tcreatorId\x12\x1d\n" +
+	"\n" +
+	"member_ids\x18\x02 \x03(\tR\tmemberIds\"E\n" +
+	"\x1aCreateConversationResponse\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\"x\n" +
+	"\x19ConversationMemberRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\".\n" +
+	"\x12ChatStatusResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x9f\x01\n" +
+	"\x12SyncChangesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\fdevice_token\x18\x02 \x01(\tR\vdeviceToken\x12&\n" +
+	"\fsince_cursor\x18\x03 \x01(\x03H\x00R\vsinceCursor\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limitB\x0f\n" +
+	"\r_since_cursor\"\x91\x02\n" +
+	"\n" +
+	"SyncChange\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\x03R\x06cursor\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12'\n" +
+	"\x0fconversation_id\x18\x03 \x01(\tR\x0econversationId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x04 \x01(\x03R\tmessageId\x12\x19\n" +
+	"\bactor_id\x18\x05 \x01(\tR\aactorId\x12\x1d\n" +
+	"\n" +
+	"subject_id\x18\x06 \x01(\tR\tsubjectId\x12\x18\n" +
+	"\acontent\x18\a \x01(\tR\acontent\x12;\n" +
+	"\voccurred_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\x7f\n" +
+	"\x13SyncChangesResponse\x12,\n" +
+	"\achanges\x18\x01 \x03(\v2\x12.api.v1.SyncChangeR\achanges\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\x03R\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"\x98\x01\n" +
+	"\x17MuteConversationRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12;\n" +
+	"\vmuted_until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"mutedUntil\"u\n" +
+	"\x17ConversationFlagRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\"^\n" +
+	"\x18ListConversationsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12)\n" +
+	"\x10include_archived\x18\x02 \x01(\bR\x0fincludeArchived\"\x98\x02\n" +
+	"\x13ConversationSummary\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x126\n" +
+	"\flast_message\x18\x02 \x01(\v2\x13.api.v1.ChatMessageR\vlastMessage\x12;\n" +
+	"\vmuted_until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"mutedUntil\x12\x1a\n" +
+	"\barchived\x18\x04 \x01(\bR\barchived\x12\x16\n" +
+	"\x06pinned\x18\x05 \x01(\bR\x06pinned\x12/\n" +
+	"\x14end_to_end_encrypted\x18\x06 \x01(\bR\x11endToEndEncrypted\"^\n" +
+	"\x19ListConversationsResponse\x12A\n" +
+	"\rconversations\x18\x01 \x03(\v2\x1b.api.v1.ConversationSummaryR\rconversations\"E\n" +
+	"\rOneTimePrekey\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\rR\x05keyId\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\fR\tpublicKey\"\xbb\x02\n" +
+	"\x12PublishKeysRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\fdevice_token\x18\x02 \x01(\tR\vdeviceToken\x12!\n" +
+	"\fidentity_key\x18\x03 \x01(\fR\videntityKey\x12(\n" +
+	"\x10signed_prekey_id\x18\x04 \x01(\rR\x0esignedPrekeyId\x12#\n" +
+	"\rsigned_prekey\x18\x05 \x01(\fR\fsignedPrekey\x126\n" +
+	"\x17signed_prekey_signature\x18\x06 \x01(\fR\x15signedPrekeySignature\x12?\n" +
+	"\x10one_time_prekeys\x18\a \x03(\v2\x15.api.v1.OneTimePrekeyR\x0eoneTimePrekeys\"L\n" +
+	"\x13PublishKeysResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\bdeviceId\"R\n" +
+	"\x14GetKeyBundlesRequest\x12!\n" +
+	"\frequester_id\x18\x01 \x01(\tR\vrequesterId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x91\x02\n" +
+	"\tKeyBundle\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\bdeviceId\x12!\n" +
+	"\fidentity_key\x18\x02 \x01(\fR\videntityKey\x12(\n" +
+	"\x10signed_prekey_id\x18\x03 \x01(\rR\x0esignedPrekeyId\x12#\n" +
+	"\rsigned_prekey\x18\x04 \x01(\fR\fsignedPrekey\x126\n" +
+	"\x17signed_prekey_signature\x18\x05 \x01(\fR\x15signedPrekeySignature\x12=\n" +
+	"\x0fone_time_prekey\x18\x06 \x01(\v2\x15.api.v1.OneTimePrekeyR\roneTimePrekey\"]\n" +
+	"\x15GetKeyBundlesResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\abundles\x18\x02 \x03(\v2\x11.api.v1.KeyBundleR\abundles\"T\n" +
+	"\x10EnableE2ERequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x85\x01\n" +
+	"\x15SearchMessagesRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\"I\n" +
+	"\x16SearchMessagesResponse\x12/\n" +
+	"\bmessages\x18\x01 \x03(\v2\x13.api.v1.ChatMessageR\bmessages2\xc0\f\n" +
+	"\vChatService\x12>\n" +
+	"\x0eStreamMessages\x12\x13.api.v1.ChatMessage\x1a\x13.api.v1.ChatMessage(\x010\x01\x12G\n" +
+	"\fSendMessages\x12\x1a.api.v1.SendMessageRequest\x1a\x1b.api.v1.SendMessageResponse\x12O\n" +
+	"\x0eGetChatHistory\x12\x1d.api.v1.GetChatHistoryRequest\x1a\x1e.api.v1.GetChatHistoryResponse\x12F\n" +
+	"\vRegisterBot\x12\x1a.api.v1.RegisterBotRequest\x1a\x1b.api.v1.RegisterBotResponse\x12a\n" +
+	"\x14AddBotToConversation\x12#.api.v1.AddBotToConversationRequest\x1a$.api.v1.AddBotToConversationResponse\x12H\n" +
+	"\vEditMessage\x12\x1a.api.v1.EditMessageRequest\x1a\x1d.api.v1.MessageActionResponse\x12L\n" +
+	"\rDeleteMessage\x12\x1c.api.v1.DeleteMessageRequest\x1a\x1d.api.v1.MessageActionResponse\x12C\n" +
+	"\n" +
+	"MarkAsRead\x12\x19.api.v1.MarkAsReadRequest\x1a\x1a.api.v1.ChatStatusResponse\x12[\n" +
+	"\x12CreateConversation\x12!.api.v1.CreateConversationRequest\x1a\".api.v1.CreateConversationResponse\x12V\n" +
+	"\x15AddConversationMember\x12!.api.v1.ConversationMemberRequest\x1a\x1a.api.v1.ChatStatusResponse\x12Y\n" +
+	"\x18RemoveConversationMember\x12!.api.v1.ConversationMemberRequest\x1a\x1a.api.v1.ChatStatusResponse\x12F\n" +
+	"\vSyncChanges\x12\x1a.api.v1.SyncChangesRequest\x1a\x1b.api.v1.SyncChangesResponse\x12O\n" +
+	"\x10MuteConversation\x12\x1f.api.v1.MuteConversationRequest\x1a\x1a.api.v1.ChatStatusResponse\x12R\n" +
+	"\x13ArchiveConversation\x12\x1f.api.v1.ConversationFlagRequest\x1a\x1a.api.v1.ChatStatusResponse\x12N\n" +
+	"\x0fPinConversation\x12\x1f.api.v1.ConversationFlagRequest\x1a\x1a.api.v1.ChatStatusResponse\x12X\n" +
+	"\x11ListConversations\x12 .api.v1.ListConversationsRequest\x1a!.api.v1.ListConversationsResponse\x12F\n" +
+	"\vPublishKeys\x12\x1a.api.v1.PublishKeysRequest\x1a\x1b.api.v1.PublishKeysResponse\x12L\n" +
+	"\rGetKeyBundles\x12\x1c.api.v1.GetKeyBundlesRequest\x1a\x1d.api.v1.GetKeyBundlesResponse\x12A\n" +
+	"\tEnableE2E\x12\x18.api.v1.EnableE2ERequest\x1a\x1a.api.v1.ChatStatusResponse\x12O\n" +
+	"\x0eSearchMessages\x12\x1d.api.v1.SearchMessagesRequest\x1a\x1e.api.v1.SearchMessagesResponseB\x0fZ\r./api/v1/chatb\x06proto3"
 
 var (
 	file_api_v1_chat_proto_rawDescOnce sync.Once
@@ -348,30 +2268,104 @@ func file_api_v1_chat_proto_rawDescGZIP() []byte {
 	return file_api_v1_chat_proto_rawDescData
 }
 
-var file_api_v1_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_api_v1_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_api_v1_chat_proto_goTypes = []any{
-	(*ChatMessage)(nil),            // 0: api.v1.ChatMessage
-	(*SendMessageRequest)(nil),     // 1: api.v1.SendMessageRequest
-	(*SendMessageResponse)(nil),    // 2: api.v1.SendMessageResponse
-	(*GetChatHistoryRequest)(nil),  // 3: api.v1.GetChatHistoryRequest
-	(*GetChatHistoryResponse)(nil), // 4: api.v1.GetChatHistoryResponse
-	(*timestamp.Timestamp)(nil),    // 5: google.protobuf.Timestamp
+	(*ChatMessage)(nil),                  // 0: api.v1.ChatMessage
+	(*SendMessageRequest)(nil),           // 1: api.v1.SendMessageRequest
+	(*SendMessageResponse)(nil),          // 2: api.v1.SendMessageResponse
+	(*GetChatHistoryRequest)(nil),        // 3: api.v1.GetChatHistoryRequest
+	(*GetChatHistoryResponse)(nil),       // 4: api.v1.GetChatHistoryResponse
+	(*RegisterBotRequest)(nil),           // 5: api.v1.RegisterBotRequest
+	(*RegisterBotResponse)(nil),          // 6: api.v1.RegisterBotResponse
+	(*AddBotToConversationRequest)(nil),  // 7: api.v1.AddBotToConversationRequest
+	(*AddBotToConversationResponse)(nil), // 8: api.v1.AddBotToConversationResponse
+	(*EditMessageRequest)(nil),           // 9: api.v1.EditMessageRequest
+	(*DeleteMessageRequest)(nil),         // 10: api.v1.DeleteMessageRequest
+	(*MessageActionResponse)(nil),        // 11: api.v1.MessageActionResponse
+	(*MarkAsReadRequest)(nil),            // 12: api.v1.MarkAsReadRequest
+	(*CreateConversationRequest)(nil),    // 13: api.v1.CreateConversationRequest
+	(*CreateConversationResponse)(nil),   // 14: api.v1.CreateConversationResponse
+	(*ConversationMemberRequest)(nil),    // 15: api.v1.ConversationMemberRequest
+	(*ChatStatusResponse)(nil),           // 16: api.v1.ChatStatusResponse
+	(*SyncChangesRequest)(nil),           // 17: api.v1.SyncChangesRequest
+	(*SyncChange)(nil),                   // 18: api.v1.SyncChange
+	(*SyncChangesResponse)(nil),          // 19: api.v1.SyncChangesResponse
+	(*MuteConversationRequest)(nil),      // 20: api.v1.MuteConversationRequest
+	(*ConversationFlagRequest)(nil),      // 21: api.v1.ConversationFlagRequest
+	(*ListConversationsRequest)(nil),     // 22: api.v1.ListConversationsRequest
+	(*ConversationSummary)(nil),          // 23: api.v1.ConversationSummary
+	(*ListConversationsResponse)(nil),    // 24: api.v1.ListConversationsResponse
+	(*OneTimePrekey)(nil),                // 25: api.v1.OneTimePrekey
+	(*PublishKeysRequest)(nil),           // 26: api.v1.PublishKeysRequest
+	(*PublishKeysResponse)(nil),          // 27: api.v1.PublishKeysResponse
+	(*GetKeyBundlesRequest)(nil),         // 28: api.v1.GetKeyBundlesRequest
+	(*KeyBundle)(nil),                    // 29: api.v1.KeyBundle
+	(*GetKeyBundlesResponse)(nil),        // 30: api.v1.GetKeyBundlesResponse
+	(*EnableE2ERequest)(nil),             // 31: api.v1.EnableE2ERequest
+	(*SearchMessagesRequest)(nil),        // 32: api.v1.SearchMessagesRequest
+	(*SearchMessagesResponse)(nil),       // 33: api.v1.SearchMessagesResponse
+	(*timestamp.Timestamp)(nil),          // 34: google.protobuf.Timestamp
 }
 var file_api_v1_chat_proto_depIdxs = []int32{
-	5, // 0: api.v1.ChatMessage.sent_at:type_name -> google.protobuf.Timestamp
-	0, // 1: api.v1.SendMessageResponse.message:type_name -> api.v1.ChatMessage
-	0, // 2: api.v1.GetChatHistoryResponse.messages:type_name -> api.v1.ChatMessage
-	0, // 3: api.v1.ChatService.StreamMessages:input_type -> api.v1.ChatMessage
-	1, // 4: api.v1.ChatService.SendMessages:input_type -> api.v1.SendMessageRequest
-	3, // 5: api.v1.ChatService.GetChatHistory:input_type -> api.v1.GetChatHistoryRequest
-	0, // 6: api.v1.ChatService.StreamMessages:output_type -> api.v1.ChatMessage
-	2, // 7: api.v1.ChatService.SendMessages:output_type -> api.v1.SendMessageResponse
-	4, // 8: api.v1.ChatService.GetChatHistory:output_type -> api.v1.GetChatHistoryResponse
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	34, // 0: api.v1.ChatMessage.sent_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: api.v1.SendMessageResponse.message:type_name -> api.v1.ChatMessage
+	0,  // 2: api.v1.GetChatHistoryResponse.messages:type_name -> api.v1.ChatMessage
+	0,  // 3: api.v1.MessageActionResponse.message:type_name -> api.v1.ChatMessage
+	34, // 4: api.v1.SyncChange.occurred_at:type_name -> google.protobuf.Timestamp
+	18, // 5: api.v1.SyncChangesResponse.changes:type_name -> api.v1.SyncChange
+	34, // 6: api.v1.MuteConversationRequest.muted_until:type_name -> google.protobuf.Timestamp
+	0,  // 7: api.v1.ConversationSummary.last_message:type_name -> api.v1.ChatMessage
+	34, // 8: api.v1.ConversationSummary.muted_until:type_name -> google.protobuf.Timestamp
+	23, // 9: api.v1.ListConversationsResponse.conversations:type_name -> api.v1.ConversationSummary
+	25, // 10: api.v1.PublishKeysRequest.one_time_prekeys:type_name -> api.v1.OneTimePrekey
+	25, // 11: api.v1.KeyBundle.one_time_prekey:type_name -> api.v1.OneTimePrekey
+	29, // 12: api.v1.GetKeyBundlesResponse.bundles:type_name -> api.v1.KeyBundle
+	0,  // 13: api.v1.SearchMessagesResponse.messages:type_name -> api.v1.ChatMessage
+	0,  // 14: api.v1.ChatService.StreamMessages:input_type -> api.v1.ChatMessage
+	1,  // 15: api.v1.ChatService.SendMessages:input_type -> api.v1.SendMessageRequest
+	3,  // 16: api.v1.ChatService.GetChatHistory:input_type -> api.v1.GetChatHistoryRequest
+	5,  // 17: api.v1.ChatService.RegisterBot:input_type -> api.v1.RegisterBotRequest
+	7,  // 18: api.v1.ChatService.AddBotToConversation:input_type -> api.v1.AddBotToConversationRequest
+	9,  // 19: api.v1.ChatService.EditMessage:input_type -> api.v1.EditMessageRequest
+	10, // 20: api.v1.ChatService.DeleteMessage:input_type -> api.v1.DeleteMessageRequest
+	12, // 21: api.v1.ChatService.MarkAsRead:input_type -> api.v1.MarkAsReadRequest
+	13, // 22: api.v1.ChatService.CreateConversation:input_type -> api.v1.CreateConversationRequest
+	15, // 23: api.v1.ChatService.AddConversationMember:input_type -> api.v1.ConversationMemberRequest
+	15, // 24: api.v1.ChatService.RemoveConversationMember:input_type -> api.v1.ConversationMemberRequest
+	17, // 25: api.v1.ChatService.SyncChanges:input_type -> api.v1.SyncChangesRequest
+	20, // 26: api.v1.ChatService.MuteConversation:input_type -> api.v1.MuteConversationRequest
+	21, // 27: api.v1.ChatService.ArchiveConversation:input_type -> api.v1.ConversationFlagRequest
+	21, // 28: api.v1.ChatService.PinConversation:input_type -> api.v1.ConversationFlagRequest
+	22, // 29: api.v1.ChatService.ListConversations:input_type -> api.v1.ListConversationsRequest
+	26, // 30: api.v1.ChatService.PublishKeys:input_type -> api.v1.PublishKeysRequest
+	28, // 31: api.v1.ChatService.GetKeyBundles:input_type -> api.v1.GetKeyBundlesRequest
+	31, // 32: api.v1.ChatService.EnableE2E:input_type -> api.v1.EnableE2ERequest
+	32, // 33: api.v1.ChatService.SearchMessages:input_type -> api.v1.SearchMessagesRequest
+	0,  // 34: api.v1.ChatService.StreamMessages:output_type -> api.v1.ChatMessage
+	2,  // 35: api.v1.ChatService.SendMessages:output_type -> api.v1.SendMessageResponse
+	4,  // 36: api.v1.ChatService.GetChatHistory:output_type -> api.v1.GetChatHistoryResponse
+	6,  // 37: api.v1.ChatService.RegisterBot:output_type -> api.v1.RegisterBotResponse
+	8,  // 38: api.v1.ChatService.AddBotToConversation:output_type -> api.v1.AddBotToConversationResponse
+	11, // 39: api.v1.ChatService.EditMessage:output_type -> api.v1.MessageActionResponse
+	11, // 40: api.v1.ChatService.DeleteMessage:output_type -> api.v1.MessageActionResponse
+	16, // 41: api.v1.ChatService.MarkAsRead:output_type -> api.v1.ChatStatusResponse
+	14, // 42: api.v1.ChatService.CreateConversation:output_type -> api.v1.CreateConversationResponse
+	16, // 43: api.v1.ChatService.AddConversationMember:output_type -> api.v1.ChatStatusResponse
+	16, // 44: api.v1.ChatService.RemoveConversationMember:output_type -> api.v1.ChatStatusResponse
+	19, // 45: api.v1.ChatService.SyncChanges:output_type -> api.v1.SyncChangesResponse
+	16, // 46: api.v1.ChatService.MuteConversation:output_type -> api.v1.ChatStatusResponse
+	16, // 47: api.v1.ChatService.ArchiveConversation:output_type -> api.v1.ChatStatusResponse
+	16, // 48: api.v1.ChatService.PinConversation:output_type -> api.v1.ChatStatusResponse
+	24, // 49: api.v1.ChatService.ListConversations:output_type -> api.v1.ListConversationsResponse
+	27, // 50: api.v1.ChatService.PublishKeys:output_type -> api.v1.PublishKeysResponse
+	30, // 51: api.v1.ChatService.GetKeyBundles:output_type -> api.v1.GetKeyBundlesResponse
+	16, // 52: api.v1.ChatService.EnableE2E:output_type -> api.v1.ChatStatusResponse
+	33, // 53: api.v1.ChatService.SearchMessages:output_type -> api.v1.SearchMessagesResponse
+	34, // [34:54] is the sub-list for method output_type
+	14, // [14:34] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_chat_proto_init() }
@@ -379,13 +2373,14 @@ func file_api_v1_chat_proto_init() {
 	if File_api_v1_chat_proto != nil {
 		return
 	}
+	file_api_v1_chat_proto_msgTypes[17].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_chat_proto_rawDesc), len(file_api_v1_chat_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,9 +19,26 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ChatService_StreamMessages_FullMethodName = "/api.v1.ChatService/StreamMessages"
-	ChatService_SendMessages_FullMethodName   = "/api.v1.ChatService/SendMessages"
-	ChatService_GetChatHistory_FullMethodName = "/api.v1.ChatService/GetChatHistory"
+	ChatService_StreamMessages_FullMethodName           = "/api.v1.ChatService/StreamMessages"
+	ChatService_SendMessages_FullMethodName             = "/api.v1.ChatService/SendMessages"
+	ChatService_GetChatHistory_FullMethodName           = "/api.v1.ChatService/GetChatHistory"
+	ChatService_RegisterBot_FullMethodName              = "/api.v1.ChatService/RegisterBot"
+	ChatService_AddBotToConversation_FullMethodName     = "/api.v1.ChatService/AddBotToConversation"
+	ChatService_EditMessage_FullMethodName              = "/api.v1.ChatService/EditMessage"
+	ChatService_DeleteMessage_FullMethodName            = "/api.v1.ChatService/DeleteMessage"
+	ChatService_MarkAsRead_FullMethodName               = "/api.v1.ChatService/MarkAsRead"
+	ChatService_CreateConversation_FullMethodName       = "/api.v1.ChatService/CreateConversation"
+	ChatService_AddConversationMember_FullMethodName    = "/api.v1.ChatService/AddConversationMember"
+	ChatService_RemoveConversationMember_FullMethodName = "/api.v1.ChatService/RemoveConversationMember"
+	ChatService_SyncChanges_FullMethodName              = "/api.v1.ChatService/SyncChanges"
+	ChatService_MuteConversation_FullMethodName         = "/api.v1.ChatService/MuteConversation"
+	ChatService_ArchiveConversation_FullMethodName      = "/api.v1.ChatService/ArchiveConversation"
+	ChatService_PinConversation_FullMethodName          = "/api.v1.ChatService/PinConversation"
+	ChatService_ListConversations_FullMethodName        = "/api.v1.ChatService/ListConversations"
+	ChatService_PublishKeys_FullMethodName              = "/api.v1.ChatService/PublishKeys"
+	ChatService_GetKeyBundles_FullMethodName            = "/api.v1.ChatService/GetKeyBundles"
+	ChatService_EnableE2E_FullMethodName                = "/api.v1.ChatService/EnableE2E"
+	ChatService_SearchMessages_FullMethodName           = "/api.v1.ChatService/SearchMessages"
 )
 
 // ChatServiceClient is the client API for ChatService service.
@@ -31,6 +48,23 @@ type ChatServiceClient interface {
 	StreamMessages(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ChatMessage, ChatMessage], error)
 	SendMessages(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*SendMessageResponse, error)
 	GetChatHistory(ctx context.Context, in *GetChatHistoryRequest, opts ...grpc.CallOption) (*GetChatHistoryResponse, error)
+	RegisterBot(ctx context.Context, in *RegisterBotRequest, opts ...grpc.CallOption) (*RegisterBotResponse, error)
+	AddBotToConversation(ctx context.Context, in *AddBotToConversationRequest, opts ...grpc.CallOption) (*AddBotToConversationResponse, error)
+	EditMessage(ctx context.Context, in *EditMessageRequest, opts ...grpc.CallOption) (*MessageActionResponse, error)
+	DeleteMessage(ctx context.Context, in *DeleteMessageRequest, opts ...grpc.CallOption) (*MessageActionResponse, error)
+	MarkAsRead(ctx context.Context, in *MarkAsReadRequest, opts ...grpc.CallOption) (*ChatStatusResponse, error)
+	CreateConversation(ctx context.Context, in *CreateConversationRequest, opts ...grpc.CallOption) (*CreateConversationResponse, error)
+	AddConversationMember(ctx context.Context, in *ConversationMemberRequest, opts ...grpc.CallOption) (*ChatStatusResponse, error)
+	RemoveConversationMember(ctx context.Context, in *ConversationMemberRequest, opts ...grpc.CallOption) (*ChatStatusResponse, error)
+	SyncChanges(ctx context.Context, in *SyncChangesRequest, opts ...grpc.CallOption) (*SyncChangesResponse, error)
+	MuteConversation(ctx context.Context, in *MuteConversationRequest, opts ...grpc.CallOption) (*ChatStatusResponse, error)
+	ArchiveConversation(ctx context.Context, in *ConversationFlagRequest, opts ...grpc.CallOption) (*ChatStatusResponse, error)
+	PinConversation(ctx context.Context, in *ConversationFlagRequest, opts ...grpc.CallOption) (*ChatStatusResponse, error)
+	ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...grpc.CallOption) (*ListConversationsResponse, error)
+	PublishKeys(ctx context.Context, in *PublishKeysRequest, opts ...grpc.CallOption) (*PublishKeysResponse, error)
+	GetKeyBundles(ctx context.Context, in *GetKeyBundlesRequest, opts ...grpc.CallOption) (*GetKeyBundlesResponse, error)
+	EnableE2E(ctx context.Context, in *EnableE2ERequest, opts ...grpc.CallOption) (*ChatStatusResponse, error)
+	SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...grpc.CallOption) (*SearchMessagesResponse, error)
 }
 
 type chatServiceClient struct {
@@ -74,6 +108,176 @@ func (c *chatServiceClient) GetChatHistory(ctx context.Context, in *GetChatHisto
 	return out, nil
 }
 
+func (c *chatServiceClient) RegisterBot(ctx context.Context, in *RegisterBotRequest, opts ...grpc.CallOption) (*RegisterBotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterBotResponse)
+	err := c.cc.Invoke(ctx, ChatService_RegisterBot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) AddBotToConversation(ctx context.Context, in *AddBotToConversationRequest, opts ...grpc.CallOption) (*AddBotToConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddBotToConversationResponse)
+	err := c.cc.Invoke(ctx, ChatService_AddBotToConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) EditMessage(ctx context.Context, in *EditMessageRequest, opts ...grpc.CallOption) (*MessageActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageActionResponse)
+	err := c.cc.Invoke(ctx, ChatService_EditMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) DeleteMessage(ctx context.Context, in *DeleteMessageRequest, opts ...grpc.CallOption) (*MessageActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageActionResponse)
+	err := c.cc.Invoke(ctx, ChatService_DeleteMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) MarkAsRead(ctx context.Context, in *MarkAsReadRequest, opts ...grpc.CallOption) (*ChatStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatStatusResponse)
+	err := c.cc.Invoke(ctx, ChatService_MarkAsRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) CreateConversation(ctx context.Context, in *CreateConversationRequest, opts ...grpc.CallOption) (*CreateConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateConversationResponse)
+	err := c.cc.Invoke(ctx, ChatService_CreateConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) AddConversationMember(ctx context.Context, in *ConversationMemberRequest, opts ...grpc.CallOption) (*ChatStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatStatusResponse)
+	err := c.cc.Invoke(ctx, ChatService_AddConversationMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) RemoveConversationMember(ctx context.Context, in *ConversationMemberRequest, opts ...grpc.CallOption) (*ChatStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatStatusResponse)
+	err := c.cc.Invoke(ctx, ChatService_RemoveConversationMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) SyncChanges(ctx context.Context, in *SyncChangesRequest, opts ...grpc.CallOption) (*SyncChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncChangesResponse)
+	err := c.cc.Invoke(ctx, ChatService_SyncChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) MuteConversation(ctx context.Context, in *MuteConversationRequest, opts ...grpc.CallOption) (*ChatStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatStatusResponse)
+	err := c.cc.Invoke(ctx, ChatService_MuteConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ArchiveConversation(ctx context.Context, in *ConversationFlagRequest, opts ...grpc.CallOption) (*ChatStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatStatusResponse)
+	err := c.cc.Invoke(ctx, ChatService_ArchiveConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) PinConversation(ctx context.Context, in *ConversationFlagRequest, opts ...grpc.CallOption) (*ChatStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatStatusResponse)
+	err := c.cc.Invoke(ctx, ChatService_PinConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...grpc.CallOption) (*ListConversationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConversationsResponse)
+	err := c.cc.Invoke(ctx, ChatService_ListConversations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) PublishKeys(ctx context.Context, in *PublishKeysRequest, opts ...grpc.CallOption) (*PublishKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishKeysResponse)
+	err := c.cc.Invoke(ctx, ChatService_PublishKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetKeyBundles(ctx context.Context, in *GetKeyBundlesRequest, opts ...grpc.CallOption) (*GetKeyBundlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetKeyBundlesResponse)
+	err := c.cc.Invoke(ctx, ChatService_GetKeyBundles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) EnableE2E(ctx context.Context, in *EnableE2ERequest, opts ...grpc.CallOption) (*ChatStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatStatusResponse)
+	err := c.cc.Invoke(ctx, ChatService_EnableE2E_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...grpc.CallOption) (*SearchMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchMessagesResponse)
+	err := c.cc.Invoke(ctx, ChatService_SearchMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChatServiceServer is the server API for ChatService service.
 // All implementations must embed UnimplementedChatServiceServer
 // for forward compatibility.
@@ -81,6 +285,23 @@ type ChatServiceServer interface {
 	StreamMessages(grpc.BidiStreamingServer[ChatMessage, ChatMessage]) error
 	SendMessages(context.Context, *SendMessageRequest) (*SendMessageResponse, error)
 	GetChatHistory(context.Context, *GetChatHistoryRequest) (*GetChatHistoryResponse, error)
+	RegisterBot(context.Context, *RegisterBotRequest) (*RegisterBotResponse, error)
+	AddBotToConversation(context.Context, *AddBotToConversationRequest) (*AddBotToConversationResponse, error)
+	EditMessage(context.Context, *EditMessageRequest) (*MessageActionResponse, error)
+	DeleteMessage(context.Context, *DeleteMessageRequest) (*MessageActionResponse, error)
+	MarkAsRead(context.Context, *MarkAsReadRequest) (*ChatStatusResponse, error)
+	CreateConversation(context.Context, *CreateConversationRequest) (*CreateConversationResponse, error)
+	AddConversationMember(context.Context, *ConversationMemberRequest) (*ChatStatusResponse, error)
+	RemoveConversationMember(context.Context, *ConversationMemberRequest) (*ChatStatusResponse, error)
+	SyncChanges(context.Context, *SyncChangesRequest) (*SyncChangesResponse, error)
+	MuteConversation(context.Context, *MuteConversationRequest) (*ChatStatusResponse, error)
+	ArchiveConversation(context.Context, *ConversationFlagRequest) (*ChatStatusResponse, error)
+	PinConversation(context.Context, *ConversationFlagRequest) (*ChatStatusResponse, error)
+	ListConversations(context.Context, *ListConversationsRequest) (*ListConversationsResponse, error)
+	PublishKeys(context.Context, *PublishKeysRequest) (*PublishKeysResponse, error)
+	GetKeyBundles(context.Context, *GetKeyBundlesRequest) (*GetKeyBundlesResponse, error)
+	EnableE2E(context.Context, *EnableE2ERequest) (*ChatStatusResponse, error)
+	SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error)
 	mustEmbedUnimplementedChatServiceServer()
 }
 
@@ -100,6 +321,57 @@ func (UnimplementedChatServiceServer) SendMessages(context.Context, *SendMessage
 func (UnimplementedChatServiceServer) GetChatHistory(context.Context, *GetChatHistoryRequest) (*GetChatHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChatHistory not implemented")
 }
+func (UnimplementedChatServiceServer) RegisterBot(context.Context, *RegisterBotRequest) (*RegisterBotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterBot not implemented")
+}
+func (UnimplementedChatServiceServer) AddBotToConversation(context.Context, *AddBotToConversationRequest) (*AddBotToConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBotToConversation not implemented")
+}
+func (UnimplementedChatServiceServer) EditMessage(context.Context, *EditMessageRequest) (*MessageActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditMessage not implemented")
+}
+func (UnimplementedChatServiceServer) DeleteMessage(context.Context, *DeleteMessageRequest) (*MessageActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMessage not implemented")
+}
+func (UnimplementedChatServiceServer) MarkAsRead(context.Context, *MarkAsReadRequest) (*ChatStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAsRead not implemented")
+}
+func (UnimplementedChatServiceServer) CreateConversation(context.Context, *CreateConversationRequest) (*CreateConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateConversation not implemented")
+}
+func (UnimplementedChatServiceServer) AddConversationMember(context.Context, *ConversationMemberRequest) (*ChatStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddConversationMember not implemented")
+}
+func (UnimplementedChatServiceServer) RemoveConversationMember(context.Context, *ConversationMemberRequest) (*ChatStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveConversationMember not implemented")
+}
+func (UnimplementedChatServiceServer) SyncChanges(context.Context, *SyncChangesRequest) (*SyncChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncChanges not implemented")
+}
+func (UnimplementedChatServiceServer) MuteConversation(context.Context, *MuteConversationRequest) (*ChatStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteConversation not implemented")
+}
+func (UnimplementedChatServiceServer) ArchiveConversation(context.Context, *ConversationFlagRequest) (*ChatStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveConversation not implemented")
+}
+func (UnimplementedChatServiceServer) PinConversation(context.Context, *ConversationFlagRequest) (*ChatStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinConversation not implemented")
+}
+func (UnimplementedChatServiceServer) ListConversations(context.Context, *ListConversationsRequest) (*ListConversationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConversations not implemented")
+}
+func (UnimplementedChatServiceServer) PublishKeys(context.Context, *PublishKeysRequest) (*PublishKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishKeys not implemented")
+}
+func (UnimplementedChatServiceServer) GetKeyBundles(context.Context, *GetKeyBundlesRequest) (*GetKeyBundlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKeyBundles not implemented")
+}
+func (UnimplementedChatServiceServer) EnableE2E(context.Context, *EnableE2ERequest) (*ChatStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableE2E not implemented")
+}
+func (UnimplementedChatServiceServer) SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMessages not implemented")
+}
 func (UnimplementedChatServiceServer) mustEmbedUnimplementedChatServiceServer() {}
 func (UnimplementedChatServiceServer) testEmbeddedByValue()                     {}
 
@@ -164,6 +436,312 @@ func _ChatService_GetChatHistory_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChatService_RegisterBot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterBotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).RegisterBot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_RegisterBot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).RegisterBot(ctx, req.(*RegisterBotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_AddBotToConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBotToConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).AddBotToConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_AddBotToConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).AddBotToConversation(ctx, req.(*AddBotToConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_EditMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).EditMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_EditMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).EditMessage(ctx, req.(*EditMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_DeleteMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).DeleteMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_DeleteMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).DeleteMessage(ctx, req.(*DeleteMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_MarkAsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).MarkAsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_MarkAsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).MarkAsRead(ctx, req.(*MarkAsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_CreateConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).CreateConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_CreateConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).CreateConversation(ctx, req.(*CreateConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_AddConversationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConversationMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).AddConversationMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_AddConversationMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).AddConversationMember(ctx, req.(*ConversationMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_RemoveConversationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConversationMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).RemoveConversationMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_RemoveConversationMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).RemoveConversationMember(ctx, req.(*ConversationMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_SyncChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SyncChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_SyncChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SyncChanges(ctx, req.(*SyncChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_MuteConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).MuteConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_MuteConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).MuteConversation(ctx, req.(*MuteConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ArchiveConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConversationFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ArchiveConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ArchiveConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ArchiveConversation(ctx, req.(*ConversationFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_PinConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConversationFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).PinConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_PinConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).PinConversation(ctx, req.(*ConversationFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ListConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConversationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ListConversations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ListConversations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ListConversations(ctx, req.(*ListConversationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_PublishKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).PublishKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_PublishKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).PublishKeys(ctx, req.(*PublishKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetKeyBundles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKeyBundlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetKeyBundles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetKeyBundles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetKeyBundles(ctx, req.(*GetKeyBundlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_EnableE2E_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableE2ERequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).EnableE2E(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_EnableE2E_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).EnableE2E(ctx, req.(*EnableE2ERequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_SearchMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SearchMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_SearchMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SearchMessages(ctx, req.(*SearchMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ChatService_ServiceDesc is the grpc.ServiceDesc for ChatService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -179,6 +757,74 @@ var ChatService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetChatHistory",
 			Handler:    _ChatService_GetChatHistory_Handler,
 		},
+		{
+			MethodName: "RegisterBot",
+			Handler:    _ChatService_RegisterBot_Handler,
+		},
+		{
+			MethodName: "AddBotToConversation",
+			Handler:    _ChatService_AddBotToConversation_Handler,
+		},
+		{
+			MethodName: "EditMessage",
+			Handler:    _ChatService_EditMessage_Handler,
+		},
+		{
+			MethodName: "DeleteMessage",
+			Handler:    _ChatService_DeleteMessage_Handler,
+		},
+		{
+			MethodName: "MarkAsRead",
+			Handler:    _ChatService_MarkAsRead_Handler,
+		},
+		{
+			MethodName: "CreateConversation",
+			Handler:    _ChatService_CreateConversation_Handler,
+		},
+		{
+			MethodName: "AddConversationMember",
+			Handler:    _ChatService_AddConversationMember_Handler,
+		},
+		{
+			MethodName: "RemoveConversationMember",
+			Handler:    _ChatService_RemoveConversationMember_Handler,
+		},
+		{
+			MethodName: "SyncChanges",
+			Handler:    _ChatService_SyncChanges_Handler,
+		},
+		{
+			MethodName: "MuteConversation",
+			Handler:    _ChatService_MuteConversation_Handler,
+		},
+		{
+			MethodName: "ArchiveConversation",
+			Handler:    _ChatService_ArchiveConversation_Handler,
+		},
+		{
+			MethodName: "PinConversation",
+			Handler:    _ChatService_PinConversation_Handler,
+		},
+		{
+			MethodName: "ListConversations",
+			Handler:    _ChatService_ListConversations_Handler,
+		},
+		{
+			MethodName: "PublishKeys",
+			Handler:    _ChatService_PublishKeys_Handler,
+		},
+		{
+			MethodName: "GetKeyBundles",
+			Handler:    _ChatService_GetKeyBundles_Handler,
+		},
+		{
+			MethodName: "EnableE2E",
+			Handler:    _ChatService_EnableE2E_Handler,
+		},
+		{
+			MethodName: "SearchMessages",
+			Handler:    _ChatService_SearchMessages_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
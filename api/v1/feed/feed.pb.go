@@ -66,9 +66,11 @@ func (x *UserID) GetUserId() int64 {
 	return 0
 }
 
+// viewer_id is optional, GetContent uses it to report the viewer's own reaction
 type ContentID struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ContentId     int64                  `protobuf:"varint,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	ViewerId      int64                  `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -110,29 +112,4063 @@ func (x *ContentID) GetContentId() int64 {
 	return 0
 }
 
+func (x *ContentID) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+// cursor is the next_cursor of the previous page, empty for the first page.
+// ranking is "chronological" (default) or "ranked". A ranked timeline ranks the newest contents of the
+// last 3 days as a whole, at most 500, and ends there. Its cursors only continue ranked timelines.
+type GetTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Ranking       string                 `protobuf:"bytes,4,opt,name=ranking,proto3" json:"ranking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTimelineRequest) Reset() {
+	*x = GetTimelineRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimelineRequest) ProtoMessage() {}
+
+func (x *GetTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetTimelineRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetTimelineRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetTimelineRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetTimelineRequest) GetRanking() string {
+	if x != nil {
+		return x.Ranking
+	}
+	return ""
+}
+
 type GetUserContentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequesterId   int64                  `protobuf:"varint,1,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
-	TargetUserId  int64                  `protobuf:"varint,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	RequesterId   int64                  `protobuf:"varint,1,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	TargetUserId  int64                  `protobuf:"varint,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserContentRequest) Reset() {
+	*x = GetUserContentRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserContentRequest) ProtoMessage() {}
+
+func (x *GetUserContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserContentRequest.ProtoReflect.Descriptor instead.
+func (*GetUserContentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetUserContentRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *GetUserContentRequest) GetTargetUserId() int64 {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return 0
+}
+
+type UpdateContentPrivacyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentId     int64                  `protobuf:"varint,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	RequesterId   int64                  `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	Privacy       string                 `protobuf:"bytes,3,opt,name=privacy,proto3" json:"privacy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateContentPrivacyRequest) Reset() {
+	*x = UpdateContentPrivacyRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateContentPrivacyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateContentPrivacyRequest) ProtoMessage() {}
+
+func (x *UpdateContentPrivacyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateContentPrivacyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateContentPrivacyRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateContentPrivacyRequest) GetContentId() int64 {
+	if x != nil {
+		return x.ContentId
+	}
+	return 0
+}
+
+func (x *UpdateContentPrivacyRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *UpdateContentPrivacyRequest) GetPrivacy() string {
+	if x != nil {
+		return x.Privacy
+	}
+	return ""
+}
+
+// unset fields are left as they are, text is a post's text or a reel's caption
+type UpdateContentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ContentId      int64                  `protobuf:"varint,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	EditorId       int64                  `protobuf:"varint,2,opt,name=editor_id,json=editorId,proto3" json:"editor_id,omitempty"`
+	Text           *string                `protobuf:"bytes,3,opt,name=text,proto3,oneof" json:"text,omitempty"`
+	Privacy        *string                `protobuf:"bytes,4,opt,name=privacy,proto3,oneof" json:"privacy,omitempty"`
+	AudienceListId *int64                 `protobuf:"varint,5,opt,name=audience_list_id,json=audienceListId,proto3,oneof" json:"audience_list_id,omitempty"` // the list of list privacy
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateContentRequest) Reset() {
+	*x = UpdateContentRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateContentRequest) ProtoMessage() {}
+
+func (x *UpdateContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateContentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateContentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateContentRequest) GetContentId() int64 {
+	if x != nil {
+		return x.ContentId
+	}
+	return 0
+}
+
+func (x *UpdateContentRequest) GetEditorId() int64 {
+	if x != nil {
+		return x.EditorId
+	}
+	return 0
+}
+
+func (x *UpdateContentRequest) GetText() string {
+	if x != nil && x.Text != nil {
+		return *x.Text
+	}
+	return ""
+}
+
+func (x *UpdateContentRequest) GetPrivacy() string {
+	if x != nil && x.Privacy != nil {
+		return *x.Privacy
+	}
+	return ""
+}
+
+func (x *UpdateContentRequest) GetAudienceListId() int64 {
+	if x != nil && x.AudienceListId != nil {
+		return *x.AudienceListId
+	}
+	return 0
+}
+
+type ContentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       *TimelineContent       `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContentResponse) Reset() {
+	*x = ContentResponse{}
+	mi := &file_api_v1_feed_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentResponse) ProtoMessage() {}
+
+func (x *ContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentResponse.ProtoReflect.Descriptor instead.
+func (*ContentResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ContentResponse) GetContent() *TimelineContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type ListRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentId     int64                  `protobuf:"varint,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	ViewerId      int64                  `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRevisionsRequest) Reset() {
+	*x = ListRevisionsRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRevisionsRequest) ProtoMessage() {}
+
+func (x *ListRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListRevisionsRequest) GetContentId() int64 {
+	if x != nil {
+		return x.ContentId
+	}
+	return 0
+}
+
+func (x *ListRevisionsRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+func (x *ListRevisionsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListRevisionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// a version of the content as it was until replaced_at
+type Revision struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RevisionId     int64                  `protobuf:"varint,1,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+	Text           string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Privacy        string                 `protobuf:"bytes,3,opt,name=privacy,proto3" json:"privacy,omitempty"`
+	ReplacedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=replaced_at,json=replacedAt,proto3" json:"replaced_at,omitempty"`
+	AudienceListId int64                  `protobuf:"varint,5,opt,name=audience_list_id,json=audienceListId,proto3" json:"audience_list_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Revision) Reset() {
+	*x = Revision{}
+	mi := &file_api_v1_feed_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Revision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Revision) ProtoMessage() {}
+
+func (x *Revision) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Revision.ProtoReflect.Descriptor instead.
+func (*Revision) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Revision) GetRevisionId() int64 {
+	if x != nil {
+		return x.RevisionId
+	}
+	return 0
+}
+
+func (x *Revision) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Revision) GetPrivacy() string {
+	if x != nil {
+		return x.Privacy
+	}
+	return ""
+}
+
+func (x *Revision) GetReplacedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReplacedAt
+	}
+	return nil
+}
+
+func (x *Revision) GetAudienceListId() int64 {
+	if x != nil {
+		return x.AudienceListId
+	}
+	return 0
+}
+
+// oldest first, versions whose privacy hides them from the viewer are left out so a page can come back short
+type RevisionList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*Revision            `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevisionList) Reset() {
+	*x = RevisionList{}
+	mi := &file_api_v1_feed_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevisionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevisionList) ProtoMessage() {}
+
+func (x *RevisionList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevisionList.ProtoReflect.Descriptor instead.
+func (*RevisionList) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RevisionList) GetRevisions() []*Revision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *RevisionList) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// Sent once a friend request is accepted so both home timelines get each other's recent posts
+type FriendshipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FriendId      int64                  `protobuf:"varint,2,opt,name=friend_id,json=friendId,proto3" json:"friend_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendshipRequest) Reset() {
+	*x = FriendshipRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendshipRequest) ProtoMessage() {}
+
+func (x *FriendshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendshipRequest.ProtoReflect.Descriptor instead.
+func (*FriendshipRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FriendshipRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *FriendshipRequest) GetFriendId() int64 {
+	if x != nil {
+		return x.FriendId
+	}
+	return 0
+}
+
+// attachments make a carousel of up to 10 images and videos in order, they replace media_data, media_type and media_name.
+// media_ref_id uses a file sent earlier through UploadMedia instead
+type CreatePostRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId       int64                  `protobuf:"varint,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Text           string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	MediaData      []byte                 `protobuf:"bytes,3,opt,name=media_data,json=mediaData,proto3" json:"media_data,omitempty"`
+	MediaType      string                 `protobuf:"bytes,4,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
+	MediaName      string                 `protobuf:"bytes,5,opt,name=media_name,json=mediaName,proto3" json:"media_name,omitempty"`
+	Privacy        string                 `protobuf:"bytes,6,opt,name=privacy,proto3" json:"privacy,omitempty"`
+	Attachments    []*MediaAttachment     `protobuf:"bytes,7,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	MediaRefId     int64                  `protobuf:"varint,8,opt,name=media_ref_id,json=mediaRefId,proto3" json:"media_ref_id,omitempty"`
+	AudienceListId int64                  `protobuf:"varint,9,opt,name=audience_list_id,json=audienceListId,proto3" json:"audience_list_id,omitempty"` // required when privacy is list
+	PublishAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`                  // optional, keeps the post hidden until then
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreatePostRequest) Reset() {
+	*x = CreatePostRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostRequest) ProtoMessage() {}
+
+func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
+func (*CreatePostRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreatePostRequest) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *CreatePostRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetMediaData() []byte {
+	if x != nil {
+		return x.MediaData
+	}
+	return nil
+}
+
+func (x *CreatePostRequest) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetMediaName() string {
+	if x != nil {
+		return x.MediaName
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetPrivacy() string {
+	if x != nil {
+		return x.Privacy
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetAttachments() []*MediaAttachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *CreatePostRequest) GetMediaRefId() int64 {
+	if x != nil {
+		return x.MediaRefId
+	}
+	return 0
+}
+
+func (x *CreatePostRequest) GetAudienceListId() int64 {
+	if x != nil {
+		return x.AudienceListId
+	}
+	return 0
+}
+
+func (x *CreatePostRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+type MediaAttachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // image or video
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MediaAttachment) Reset() {
+	*x = MediaAttachment{}
+	mi := &file_api_v1_feed_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MediaAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaAttachment) ProtoMessage() {}
+
+func (x *MediaAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaAttachment.ProtoReflect.Descriptor instead.
+func (*MediaAttachment) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MediaAttachment) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *MediaAttachment) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *MediaAttachment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// media_ref_id uses a video sent earlier through UploadMedia instead of media_data
+type CreateReelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId      int64                  `protobuf:"varint,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Caption       string                 `protobuf:"bytes,2,opt,name=caption,proto3" json:"caption,omitempty"`
+	MediaData     []byte                 `protobuf:"bytes,3,opt,name=media_data,json=mediaData,proto3" json:"media_data,omitempty"`
+	MediaName     string                 `protobuf:"bytes,4,opt,name=media_name,json=mediaName,proto3" json:"media_name,omitempty"`
+	DurationSecs  int32                  `protobuf:"varint,5,opt,name=duration_secs,json=durationSecs,proto3" json:"duration_secs,omitempty"`
+	Privacy       string                 `protobuf:"bytes,6,opt,name=privacy,proto3" json:"privacy,omitempty"`
+	MediaRefId    int64                  `protobuf:"varint,7,opt,name=media_ref_id,json=mediaRefId,proto3" json:"media_ref_id,omitempty"`
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"` // optional, keeps the reel hidden until then
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReelRequest) Reset() {
+	*x = CreateReelRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReelRequest) ProtoMessage() {}
+
+func (x *CreateReelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReelRequest.ProtoReflect.Descriptor instead.
+func (*CreateReelRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateReelRequest) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *CreateReelRequest) GetCaption() string {
+	if x != nil {
+		return x.Caption
+	}
+	return ""
+}
+
+func (x *CreateReelRequest) GetMediaData() []byte {
+	if x != nil {
+		return x.MediaData
+	}
+	return nil
+}
+
+func (x *CreateReelRequest) GetMediaName() string {
+	if x != nil {
+		return x.MediaName
+	}
+	return ""
+}
+
+func (x *CreateReelRequest) GetDurationSecs() int32 {
+	if x != nil {
+		return x.DurationSecs
+	}
+	return 0
+}
+
+func (x *CreateReelRequest) GetPrivacy() string {
+	if x != nil {
+		return x.Privacy
+	}
+	return ""
+}
+
+func (x *CreateReelRequest) GetMediaRefId() int64 {
+	if x != nil {
+		return x.MediaRefId
+	}
+	return 0
+}
+
+func (x *CreateReelRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+// media_ref_id uses a file sent earlier through UploadMedia instead of media_data
+type CreateStoryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId       int64                  `protobuf:"varint,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	MediaType      string                 `protobuf:"bytes,2,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
+	MediaName      string                 `protobuf:"bytes,3,opt,name=media_name,json=mediaName,proto3" json:"media_name,omitempty"`
+	MediaData      []byte                 `protobuf:"bytes,4,opt,name=media_data,json=mediaData,proto3" json:"media_data,omitempty"`
+	DurationSecs   int32                  `protobuf:"varint,5,opt,name=duration_secs,json=durationSecs,proto3" json:"duration_secs,omitempty"`
+	Privacy        string                 `protobuf:"bytes,6,opt,name=privacy,proto3" json:"privacy,omitempty"`
+	MediaRefId     int64                  `protobuf:"varint,7,opt,name=media_ref_id,json=mediaRefId,proto3" json:"media_ref_id,omitempty"`
+	AudienceListId int64                  `protobuf:"varint,8,opt,name=audience_list_id,json=audienceListId,proto3" json:"audience_list_id,omitempty"` // required when privacy is list
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateStoryRequest) Reset() {
+	*x = CreateStoryRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateStoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStoryRequest) ProtoMessage() {}
+
+func (x *CreateStoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateStoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateStoryRequest) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *CreateStoryRequest) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+func (x *CreateStoryRequest) GetMediaName() string {
+	if x != nil {
+		return x.MediaName
+	}
+	return ""
+}
+
+func (x *CreateStoryRequest) GetMediaData() []byte {
+	if x != nil {
+		return x.MediaData
+	}
+	return nil
+}
+
+func (x *CreateStoryRequest) GetDurationSecs() int32 {
+	if x != nil {
+		return x.DurationSecs
+	}
+	return 0
+}
+
+func (x *CreateStoryRequest) GetPrivacy() string {
+	if x != nil {
+		return x.Privacy
+	}
+	return ""
+}
+
+func (x *CreateStoryRequest) GetMediaRefId() int64 {
+	if x != nil {
+		return x.MediaRefId
+	}
+	return 0
+}
+
+func (x *CreateStoryRequest) GetAudienceListId() int64 {
+	if x != nil {
+		return x.AudienceListId
+	}
+	return 0
+}
+
+// UploadMedia takes the metadata from the first chunk, every chunk carries the next part of the file
+type UploadChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploaderId    int64                  `protobuf:"varint,1,opt,name=uploader_id,json=uploaderId,proto3" json:"uploader_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	MediaType     string                 `protobuf:"bytes,3,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"` // image or video
+	Data          []byte                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadChunk) Reset() {
+	*x = UploadChunk{}
+	mi := &file_api_v1_feed_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadChunk) ProtoMessage() {}
+
+func (x *UploadChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadChunk.ProtoReflect.Descriptor instead.
+func (*UploadChunk) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UploadChunk) GetUploaderId() int64 {
+	if x != nil {
+		return x.UploaderId
+	}
+	return 0
+}
+
+func (x *UploadChunk) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *UploadChunk) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+func (x *UploadChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UploadMediaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MediaRefId    int64                  `protobuf:"varint,1,opt,name=media_ref_id,json=mediaRefId,proto3" json:"media_ref_id,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	MediaUrl      string                 `protobuf:"bytes,3,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadMediaResponse) Reset() {
+	*x = UploadMediaResponse{}
+	mi := &file_api_v1_feed_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadMediaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadMediaResponse) ProtoMessage() {}
+
+func (x *UploadMediaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadMediaResponse.ProtoReflect.Descriptor instead.
+func (*UploadMediaResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UploadMediaResponse) GetMediaRefId() int64 {
+	if x != nil {
+		return x.MediaRefId
+	}
+	return 0
+}
+
+func (x *UploadMediaResponse) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *UploadMediaResponse) GetMediaUrl() string {
+	if x != nil {
+		return x.MediaUrl
+	}
+	return ""
+}
+
+type ReactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContentId     int64                  `protobuf:"varint,2,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // like, love, laugh, etc.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactionRequest) Reset() {
+	*x = ReactionRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionRequest) ProtoMessage() {}
+
+func (x *ReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionRequest.ProtoReflect.Descriptor instead.
+func (*ReactionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReactionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReactionRequest) GetContentId() int64 {
+	if x != nil {
+		return x.ContentId
+	}
+	return 0
+}
+
+func (x *ReactionRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type DeleteReactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContentId     int64                  `protobuf:"varint,2,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReactionRequest) Reset() {
+	*x = DeleteReactionRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReactionRequest) ProtoMessage() {}
+
+func (x *DeleteReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReactionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReactionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteReactionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteReactionRequest) GetContentId() int64 {
+	if x != nil {
+		return x.ContentId
+	}
+	return 0
+}
+
+type Reaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContentId     int64                  `protobuf:"varint,3,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reaction) Reset() {
+	*x = Reaction{}
+	mi := &file_api_v1_feed_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reaction) ProtoMessage() {}
+
+func (x *Reaction) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reaction.ProtoReflect.Descriptor instead.
+func (*Reaction) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Reaction) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Reaction) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Reaction) GetContentId() int64 {
+	if x != nil {
+		return x.ContentId
+	}
+	return 0
+}
+
+func (x *Reaction) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Reaction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ReactionList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reactions     []*Reaction            `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactionList) Reset() {
+	*x = ReactionList{}
+	mi := &file_api_v1_feed_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionList) ProtoMessage() {}
+
+func (x *ReactionList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionList.ProtoReflect.Descriptor instead.
+func (*ReactionList) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReactionList) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+// counts is keyed by reaction type, viewer_reaction is empty when the viewer has not reacted
+type ReactionSummary struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Counts         map[string]int64       `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Total          int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	ViewerReaction string                 `protobuf:"bytes,3,opt,name=viewer_reaction,json=viewerReaction,proto3" json:"viewer_reaction,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReactionSummary) Reset() {
+	*x = ReactionSummary{}
+	mi := &file_api_v1_feed_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionSummary) ProtoMessage() {}
+
+func (x *ReactionSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionSummary.ProtoReflect.Descriptor instead.
+func (*ReactionSummary) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReactionSummary) GetCounts() map[string]int64 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *ReactionSummary) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ReactionSummary) GetViewerReaction() string {
+	if x != nil {
+		return x.ViewerReaction
+	}
+	return ""
+}
+
+// type filters the reactors when set
+type ListReactorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentId     int64                  `protobuf:"varint,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	ViewerId      int64                  `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Cursor        string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReactorsRequest) Reset() {
+	*x = ListReactorsRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReactorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReactorsRequest) ProtoMessage() {}
+
+func (x *ListReactorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReactorsRequest.ProtoReflect.Descriptor instead.
+func (*ListReactorsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListReactorsRequest) GetContentId() int64 {
+	if x != nil {
+		return x.ContentId
+	}
+	return 0
+}
+
+func (x *ListReactorsRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+func (x *ListReactorsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListReactorsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListReactorsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type Reactor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Handle        string                 `protobuf:"bytes,2,opt,name=handle,proto3" json:"handle,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	ReactedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=reacted_at,json=reactedAt,proto3" json:"reacted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reactor) Reset() {
+	*x = Reactor{}
+	mi := &file_api_v1_feed_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reactor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reactor) ProtoMessage() {}
+
+func (x *Reactor) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reactor.ProtoReflect.Descriptor instead.
+func (*Reactor) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Reactor) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Reactor) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *Reactor) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Reactor) GetReactedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReactedAt
+	}
+	return nil
+}
+
+type ReactorList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reactors      []*Reactor             `protobuf:"bytes,1,rep,name=reactors,proto3" json:"reactors,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactorList) Reset() {
+	*x = ReactorList{}
+	mi := &file_api_v1_feed_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactorList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactorList) ProtoMessage() {}
+
+func (x *ReactorList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactorList.ProtoReflect.Descriptor instead.
+func (*ReactorList) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReactorList) GetReactors() []*Reactor {
+	if x != nil {
+		return x.Reactors
+	}
+	return nil
+}
+
+func (x *ReactorList) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type TimelineContent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentId     int64                  `protobuf:"varint,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	AuthorId      int64                  `protobuf:"varint,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	MediaUrl      string                 `protobuf:"bytes,5,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
+	Privacy       string                 `protobuf:"bytes,6,opt,name=privacy,proto3" json:"privacy,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CommentCount  int64                  `protobuf:"varint,8,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	Reactions     *ReactionSummary       `protobuf:"bytes,9,opt,name=reactions,proto3" json:"reactions,omitempty"`
+	Seen          bool                   `protobuf:"varint,10,opt,name=seen,proto3" json:"seen,omitempty"` // stories only, whether the viewer has watched it
+	Mentions      []*Mention             `protobuf:"bytes,11,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	ShareCount    int64                  `protobuf:"varint,12,opt,name=share_count,json=shareCount,proto3" json:"share_count,omitempty"`
+	Shared        *SharedContent         `protobuf:"bytes,13,opt,name=shared,proto3" json:"shared,omitempty"`                     // set on reposts and quote posts
+	EditedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"` // unset until the content is first edited
+	Media         []*MediaItem           `protobuf:"bytes,15,rep,name=media,proto3" json:"media,omitempty"`                       // every media in order, media_url is the first one
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimelineContent) Reset() {
+	*x = TimelineContent{}
+	mi := &file_api_v1_feed_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelineContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineContent) ProtoMessage() {}
+
+func (x *TimelineContent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineContent.ProtoReflect.Descriptor instead.
+func (*TimelineContent) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TimelineContent) GetContentId() int64 {
+	if x != nil {
+		return x.ContentId
+	}
+	return 0
+}
+
+func (x *TimelineContent) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *TimelineContent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TimelineContent) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *TimelineContent) GetMediaUrl() string {
+	if x != nil {
+		return x.MediaUrl
+	}
+	return ""
+}
+
+func (x *TimelineContent) GetPrivacy() string {
+	if x != nil {
+		return x.Privacy
+	}
+	return ""
+}
+
+func (x *TimelineContent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TimelineContent) GetCommentCount() int64 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+func (x *TimelineContent) GetReactions() *ReactionSummary {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *TimelineContent) GetSeen() bool {
+	if x != nil {
+		return x.Seen
+	}
+	return false
+}
+
+func (x *TimelineContent) GetMentions() []*Mention {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *TimelineContent) GetShareCount() int64 {
+	if x != nil {
+		return x.ShareCount
+	}
+	return 0
+}
+
+func (x *TimelineContent) GetShared() *SharedContent {
+	if x != nil {
+		return x.Shared
+	}
+	return nil
+}
+
+func (x *TimelineContent) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+func (x *TimelineContent) GetMedia() []*MediaItem {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+type MediaItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MediaItem) Reset() {
+	*x = MediaItem{}
+	mi := &file_api_v1_feed_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MediaItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaItem) ProtoMessage() {}
+
+func (x *MediaItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaItem.ProtoReflect.Descriptor instead.
+func (*MediaItem) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MediaItem) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MediaItem) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// the original of a share, content is only set while the viewer may still see it
+type SharedContent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentId     int64                  `protobuf:"varint,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	Available     bool                   `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	Content       *TimelineContent       `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedContent) Reset() {
+	*x = SharedContent{}
+	mi := &file_api_v1_feed_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedContent) ProtoMessage() {}
+
+func (x *SharedContent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedContent.ProtoReflect.Descriptor instead.
+func (*SharedContent) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SharedContent) GetContentId() int64 {
+	if x != nil {
+		return x.ContentId
+	}
+	return 0
+}
+
+func (x *SharedContent) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *SharedContent) GetContent() *TimelineContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// commentary makes a quote post, an empty privacy takes the original's and a wider one is refused
+type ShareContentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SharerId      int64                  `protobuf:"varint,1,opt,name=sharer_id,json=sharerId,proto3" json:"sharer_id,omitempty"`
+	ContentId     int64                  `protobuf:"varint,2,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	Commentary    string                 `protobuf:"bytes,3,opt,name=commentary,proto3" json:"commentary,omitempty"`
+	Privacy       string                 `protobuf:"bytes,4,opt,name=privacy,proto3" json:"privacy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareContentRequest) Reset() {
+	*x = ShareContentRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareContentRequest) ProtoMessage() {}
+
+func (x *ShareContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareContentRequest.ProtoReflect.Descriptor instead.
+func (*ShareContentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ShareContentRequest) GetSharerId() int64 {
+	if x != nil {
+		return x.SharerId
+	}
+	return 0
+}
+
+func (x *ShareContentRequest) GetContentId() int64 {
+	if x != nil {
+		return x.ContentId
+	}
+	return 0
+}
+
+func (x *ShareContentRequest) GetCommentary() string {
+	if x != nil {
+		return x.Commentary
+	}
+	return ""
+}
+
+func (x *ShareContentRequest) GetPrivacy() string {
+	if x != nil {
+		return x.Privacy
+	}
+	return ""
+}
+
+// offset and length count Unicode code points of text and include the '@'
+type Mention struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Handle        string                 `protobuf:"bytes,2,opt,name=handle,proto3" json:"handle,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length        int32                  `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mention) Reset() {
+	*x = Mention{}
+	mi := &file_api_v1_feed_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mention) ProtoMessage() {}
+
+func (x *Mention) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mention.ProtoReflect.Descriptor instead.
+func (*Mention) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Mention) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Mention) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *Mention) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Mention) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+// next_cursor is empty once the end of the timeline is reached.
+// highlights is only filled by GetUserContent.
+type TimelineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contents      []*TimelineContent     `protobuf:"bytes,1,rep,name=contents,proto3" json:"contents,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	Highlights    []*Highlight           `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimelineResponse) Reset() {
+	*x = TimelineResponse{}
+	mi := &file_api_v1_feed_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineResponse) ProtoMessage() {}
+
+func (x *TimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineResponse.ProtoReflect.Descriptor instead.
+func (*TimelineResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TimelineResponse) GetContents() []*TimelineContent {
+	if x != nil {
+		return x.Contents
+	}
+	return nil
+}
+
+func (x *TimelineResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *TimelineResponse) GetHighlights() []*Highlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+// parent_id is set to reply to a top-level comment, replies cannot be nested further
+type AddCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentId     int64                  `protobuf:"varint,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	AuthorId      int64                  `protobuf:"varint,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	ParentId      int64                  `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCommentRequest) Reset() {
+	*x = AddCommentRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCommentRequest) ProtoMessage() {}
+
+func (x *AddCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCommentRequest.ProtoReflect.Descriptor instead.
+func (*AddCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AddCommentRequest) GetContentId() int64 {
+	if x != nil {
+		return x.ContentId
+	}
+	return 0
+}
+
+func (x *AddCommentRequest) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *AddCommentRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *AddCommentRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// parent_id lists the replies of that comment instead of the top-level comments
+type ListCommentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentId     int64                  `protobuf:"varint,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	ViewerId      int64                  `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	ParentId      int64                  `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsRequest) Reset() {
+	*x = ListCommentsRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsRequest) ProtoMessage() {}
+
+func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListCommentsRequest) GetContentId() int64 {
+	if x != nil {
+		return x.ContentId
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type EditCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     int64                  `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	EditorId      int64                  `protobuf:"varint,2,opt,name=editor_id,json=editorId,proto3" json:"editor_id,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditCommentRequest) Reset() {
+	*x = EditCommentRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCommentRequest) ProtoMessage() {}
+
+func (x *EditCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCommentRequest.ProtoReflect.Descriptor instead.
+func (*EditCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *EditCommentRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *EditCommentRequest) GetEditorId() int64 {
+	if x != nil {
+		return x.EditorId
+	}
+	return 0
+}
+
+func (x *EditCommentRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type DeleteCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     int64                  `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	RequesterId   int64                  `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteCommentRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *DeleteCommentRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+type Comment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     int64                  `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	ContentId     int64                  `protobuf:"varint,2,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	AuthorId      int64                  `protobuf:"varint,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	ParentId      int64                  `protobuf:"varint,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Text          string                 `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	ReplyCount    int64                  `protobuf:"varint,6,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EditedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_api_v1_feed_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Comment) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *Comment) GetContentId() int64 {
+	if x != nil {
+		return x.ContentId
+	}
+	return 0
+}
+
+func (x *Comment) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *Comment) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Comment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Comment) GetReplyCount() int64 {
+	if x != nil {
+		return x.ReplyCount
+	}
+	return 0
+}
+
+func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+type CommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommentResponse) Reset() {
+	*x = CommentResponse{}
+	mi := &file_api_v1_feed_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentResponse) ProtoMessage() {}
+
+func (x *CommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentResponse.ProtoReflect.Descriptor instead.
+func (*CommentResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CommentResponse) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type CommentList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommentList) Reset() {
+	*x = CommentList{}
+	mi := &file_api_v1_feed_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentList) ProtoMessage() {}
+
+func (x *CommentList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentList.ProtoReflect.Descriptor instead.
+func (*CommentList) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CommentList) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *CommentList) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type StoryViewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StoryId       int64                  `protobuf:"varint,1,opt,name=story_id,json=storyId,proto3" json:"story_id,omitempty"`
+	ViewerId      int64                  `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoryViewRequest) Reset() {
+	*x = StoryViewRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoryViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoryViewRequest) ProtoMessage() {}
+
+func (x *StoryViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoryViewRequest.ProtoReflect.Descriptor instead.
+func (*StoryViewRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *StoryViewRequest) GetStoryId() int64 {
+	if x != nil {
+		return x.StoryId
+	}
+	return 0
+}
+
+func (x *StoryViewRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+// only the story author may list its viewers
+type ListStoryViewersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StoryId       int64                  `protobuf:"varint,1,opt,name=story_id,json=storyId,proto3" json:"story_id,omitempty"`
+	RequesterId   int64                  `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStoryViewersRequest) Reset() {
+	*x = ListStoryViewersRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStoryViewersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStoryViewersRequest) ProtoMessage() {}
+
+func (x *ListStoryViewersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStoryViewersRequest.ProtoReflect.Descriptor instead.
+func (*ListStoryViewersRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListStoryViewersRequest) GetStoryId() int64 {
+	if x != nil {
+		return x.StoryId
+	}
+	return 0
+}
+
+func (x *ListStoryViewersRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *ListStoryViewersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListStoryViewersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type StoryViewer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Handle        string                 `protobuf:"bytes,2,opt,name=handle,proto3" json:"handle,omitempty"`
+	ViewedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=viewed_at,json=viewedAt,proto3" json:"viewed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoryViewer) Reset() {
+	*x = StoryViewer{}
+	mi := &file_api_v1_feed_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoryViewer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoryViewer) ProtoMessage() {}
+
+func (x *StoryViewer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoryViewer.ProtoReflect.Descriptor instead.
+func (*StoryViewer) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *StoryViewer) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *StoryViewer) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *StoryViewer) GetViewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ViewedAt
+	}
+	return nil
+}
+
+type StoryViewerList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Viewers       []*StoryViewer         `protobuf:"bytes,1,rep,name=viewers,proto3" json:"viewers,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoryViewerList) Reset() {
+	*x = StoryViewerList{}
+	mi := &file_api_v1_feed_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoryViewerList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoryViewerList) ProtoMessage() {}
+
+func (x *StoryViewerList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoryViewerList.ProtoReflect.Descriptor instead.
+func (*StoryViewerList) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *StoryViewerList) GetViewers() []*StoryViewer {
+	if x != nil {
+		return x.Viewers
+	}
+	return nil
+}
+
+func (x *StoryViewerList) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// the archive of expired stories is only listed for its author
+type ListStoryArchiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStoryArchiveRequest) Reset() {
+	*x = ListStoryArchiveRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStoryArchiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStoryArchiveRequest) ProtoMessage() {}
+
+func (x *ListStoryArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStoryArchiveRequest.ProtoReflect.Descriptor instead.
+func (*ListStoryArchiveRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListStoryArchiveRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListStoryArchiveRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListStoryArchiveRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// story_ids must be archived stories of the owner, in display order
+type CreateHighlightRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       int64                  `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	StoryIds      []int64                `protobuf:"varint,3,rep,packed,name=story_ids,json=storyIds,proto3" json:"story_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHighlightRequest) Reset() {
+	*x = CreateHighlightRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHighlightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHighlightRequest) ProtoMessage() {}
+
+func (x *CreateHighlightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHighlightRequest.ProtoReflect.Descriptor instead.
+func (*CreateHighlightRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateHighlightRequest) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *CreateHighlightRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateHighlightRequest) GetStoryIds() []int64 {
+	if x != nil {
+		return x.StoryIds
+	}
+	return nil
+}
+
+// replaces the title and stories of the highlight
+type UpdateHighlightRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HighlightId   int64                  `protobuf:"varint,1,opt,name=highlight_id,json=highlightId,proto3" json:"highlight_id,omitempty"`
+	RequesterId   int64                  `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	StoryIds      []int64                `protobuf:"varint,4,rep,packed,name=story_ids,json=storyIds,proto3" json:"story_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateHighlightRequest) Reset() {
+	*x = UpdateHighlightRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHighlightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHighlightRequest) ProtoMessage() {}
+
+func (x *UpdateHighlightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHighlightRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHighlightRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpdateHighlightRequest) GetHighlightId() int64 {
+	if x != nil {
+		return x.HighlightId
+	}
+	return 0
+}
+
+func (x *UpdateHighlightRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *UpdateHighlightRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateHighlightRequest) GetStoryIds() []int64 {
+	if x != nil {
+		return x.StoryIds
+	}
+	return nil
+}
+
+type DeleteHighlightRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HighlightId   int64                  `protobuf:"varint,1,opt,name=highlight_id,json=highlightId,proto3" json:"highlight_id,omitempty"`
+	RequesterId   int64                  `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHighlightRequest) Reset() {
+	*x = DeleteHighlightRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHighlightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHighlightRequest) ProtoMessage() {}
+
+func (x *DeleteHighlightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHighlightRequest.ProtoReflect.Descriptor instead.
+func (*DeleteHighlightRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DeleteHighlightRequest) GetHighlightId() int64 {
+	if x != nil {
+		return x.HighlightId
+	}
+	return 0
+}
+
+func (x *DeleteHighlightRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+// highlight_ids must list every highlight of the owner in the new order
+type ReorderHighlightsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       int64                  `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	HighlightIds  []int64                `protobuf:"varint,2,rep,packed,name=highlight_ids,json=highlightIds,proto3" json:"highlight_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderHighlightsRequest) Reset() {
+	*x = ReorderHighlightsRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderHighlightsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderHighlightsRequest) ProtoMessage() {}
+
+func (x *ReorderHighlightsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderHighlightsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderHighlightsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ReorderHighlightsRequest) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *ReorderHighlightsRequest) GetHighlightIds() []int64 {
+	if x != nil {
+		return x.HighlightIds
+	}
+	return nil
+}
+
+type Highlight struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HighlightId   int64                  `protobuf:"varint,1,opt,name=highlight_id,json=highlightId,proto3" json:"highlight_id,omitempty"`
+	OwnerId       int64                  `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	Stories       []*TimelineContent     `protobuf:"bytes,5,rep,name=stories,proto3" json:"stories,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Highlight) Reset() {
+	*x = Highlight{}
+	mi := &file_api_v1_feed_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Highlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Highlight) ProtoMessage() {}
+
+func (x *Highlight) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Highlight.ProtoReflect.Descriptor instead.
+func (*Highlight) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Highlight) GetHighlightId() int64 {
+	if x != nil {
+		return x.HighlightId
+	}
+	return 0
+}
+
+func (x *Highlight) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *Highlight) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Highlight) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Highlight) GetStories() []*TimelineContent {
+	if x != nil {
+		return x.Stories
+	}
+	return nil
+}
+
+func (x *Highlight) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type HighlightResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Highlight     *Highlight             `protobuf:"bytes,1,opt,name=highlight,proto3" json:"highlight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HighlightResponse) Reset() {
+	*x = HighlightResponse{}
+	mi := &file_api_v1_feed_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HighlightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighlightResponse) ProtoMessage() {}
+
+func (x *HighlightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighlightResponse.ProtoReflect.Descriptor instead.
+func (*HighlightResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *HighlightResponse) GetHighlight() *Highlight {
+	if x != nil {
+		return x.Highlight
+	}
+	return nil
+}
+
+// tag may start with '#' and is matched case-insensitively, only public content is listed
+type HashtagFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ViewerId      int64                  `protobuf:"varint,1,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Cursor        string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HashtagFeedRequest) Reset() {
+	*x = HashtagFeedRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HashtagFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashtagFeedRequest) ProtoMessage() {}
+
+func (x *HashtagFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashtagFeedRequest.ProtoReflect.Descriptor instead.
+func (*HashtagFeedRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *HashtagFeedRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+func (x *HashtagFeedRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *HashtagFeedRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *HashtagFeedRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// window_seconds defaults to a day and is kept between an hour and a week
+type TrendingHashtagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WindowSeconds int64                  `protobuf:"varint,1,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingHashtagsRequest) Reset() {
+	*x = TrendingHashtagsRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingHashtagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingHashtagsRequest) ProtoMessage() {}
+
+func (x *TrendingHashtagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingHashtagsRequest.ProtoReflect.Descriptor instead.
+func (*TrendingHashtagsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *TrendingHashtagsRequest) GetWindowSeconds() int64 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *TrendingHashtagsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// score is the growth of uses against the previous window of the same length
+type TrendingHashtag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Uses          int64                  `protobuf:"varint,2,opt,name=uses,proto3" json:"uses,omitempty"`
+	PreviousUses  int64                  `protobuf:"varint,3,opt,name=previous_uses,json=previousUses,proto3" json:"previous_uses,omitempty"`
+	Score         float64                `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingHashtag) Reset() {
+	*x = TrendingHashtag{}
+	mi := &file_api_v1_feed_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingHashtag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingHashtag) ProtoMessage() {}
+
+func (x *TrendingHashtag) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingHashtag.ProtoReflect.Descriptor instead.
+func (*TrendingHashtag) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *TrendingHashtag) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TrendingHashtag) GetUses() int64 {
+	if x != nil {
+		return x.Uses
+	}
+	return 0
+}
+
+func (x *TrendingHashtag) GetPreviousUses() int64 {
+	if x != nil {
+		return x.PreviousUses
+	}
+	return 0
+}
+
+func (x *TrendingHashtag) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// lists the content mentioning user_id that the user may see
+type MentionsFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MentionsFeedRequest) Reset() {
+	*x = MentionsFeedRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MentionsFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MentionsFeedRequest) ProtoMessage() {}
+
+func (x *MentionsFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MentionsFeedRequest.ProtoReflect.Descriptor instead.
+func (*MentionsFeedRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *MentionsFeedRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MentionsFeedRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *MentionsFeedRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type TrendingHashtagList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hashtags      []*TrendingHashtag     `protobuf:"bytes,1,rep,name=hashtags,proto3" json:"hashtags,omitempty"`
+	WindowSeconds int64                  `protobuf:"varint,2,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingHashtagList) Reset() {
+	*x = TrendingHashtagList{}
+	mi := &file_api_v1_feed_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingHashtagList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingHashtagList) ProtoMessage() {}
+
+func (x *TrendingHashtagList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingHashtagList.ProtoReflect.Descriptor instead.
+func (*TrendingHashtagList) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *TrendingHashtagList) GetHashtags() []*TrendingHashtag {
+	if x != nil {
+		return x.Hashtags
+	}
+	return nil
+}
+
+func (x *TrendingHashtagList) GetWindowSeconds() int64 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+// collection names the collection to save into, it is created on first use and defaults to "Saved"
+type SaveContentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContentId     int64                  `protobuf:"varint,2,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	Collection    string                 `protobuf:"bytes,3,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveContentRequest) Reset() {
+	*x = SaveContentRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveContentRequest) ProtoMessage() {}
+
+func (x *SaveContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveContentRequest.ProtoReflect.Descriptor instead.
+func (*SaveContentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SaveContentRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SaveContentRequest) GetContentId() int64 {
+	if x != nil {
+		return x.ContentId
+	}
+	return 0
+}
+
+func (x *SaveContentRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+// a collection_id of 0 removes the content from every collection of the user
+type UnsaveContentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContentId     int64                  `protobuf:"varint,2,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	CollectionId  int64                  `protobuf:"varint,3,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsaveContentRequest) Reset() {
+	*x = UnsaveContentRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsaveContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsaveContentRequest) ProtoMessage() {}
+
+func (x *UnsaveContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsaveContentRequest.ProtoReflect.Descriptor instead.
+func (*UnsaveContentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UnsaveContentRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UnsaveContentRequest) GetContentId() int64 {
+	if x != nil {
+		return x.ContentId
+	}
+	return 0
+}
+
+func (x *UnsaveContentRequest) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+type ListSavedContentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CollectionId  int64                  `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedContentRequest) Reset() {
+	*x = ListSavedContentRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedContentRequest) ProtoMessage() {}
+
+func (x *ListSavedContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedContentRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedContentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListSavedContentRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListSavedContentRequest) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *ListSavedContentRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListSavedContentRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type Collection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionId  int64                  `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ItemCount     int64                  `protobuf:"varint,3,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Collection) Reset() {
+	*x = Collection{}
+	mi := &file_api_v1_feed_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collection) ProtoMessage() {}
+
+func (x *Collection) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
+func (*Collection) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Collection) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *Collection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Collection) GetItemCount() int64 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *Collection) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionResponse) Reset() {
+	*x = CollectionResponse{}
+	mi := &file_api_v1_feed_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionResponse) ProtoMessage() {}
+
+func (x *CollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionResponse.ProtoReflect.Descriptor instead.
+func (*CollectionResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CollectionResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+type CollectionList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collections   []*Collection          `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionList) Reset() {
+	*x = CollectionList{}
+	mi := &file_api_v1_feed_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionList) ProtoMessage() {}
+
+func (x *CollectionList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionList.ProtoReflect.Descriptor instead.
+func (*CollectionList) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CollectionList) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+// content is only set while the user may still see the saved content
+type SavedItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentId     int64                  `protobuf:"varint,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	SavedAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=saved_at,json=savedAt,proto3" json:"saved_at,omitempty"`
+	Available     bool                   `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`
+	Content       *TimelineContent       `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedItem) Reset() {
+	*x = SavedItem{}
+	mi := &file_api_v1_feed_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedItem) ProtoMessage() {}
+
+func (x *SavedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedItem.ProtoReflect.Descriptor instead.
+func (*SavedItem) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SavedItem) GetContentId() int64 {
+	if x != nil {
+		return x.ContentId
+	}
+	return 0
+}
+
+func (x *SavedItem) GetSavedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SavedAt
+	}
+	return nil
+}
+
+func (x *SavedItem) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *SavedItem) GetContent() *TimelineContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// most recently saved first
+type SavedContentList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*SavedItem           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedContentList) Reset() {
+	*x = SavedContentList{}
+	mi := &file_api_v1_feed_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedContentList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedContentList) ProtoMessage() {}
+
+func (x *SavedContentList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedContentList.ProtoReflect.Descriptor instead.
+func (*SavedContentList) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SavedContentList) GetItems() []*SavedItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SavedContentList) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// member_ids must be friends of the owner
+type CreateAudienceListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       int64                  `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MemberIds     []int64                `protobuf:"varint,3,rep,packed,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAudienceListRequest) Reset() {
+	*x = CreateAudienceListRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAudienceListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAudienceListRequest) ProtoMessage() {}
+
+func (x *CreateAudienceListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAudienceListRequest.ProtoReflect.Descriptor instead.
+func (*CreateAudienceListRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CreateAudienceListRequest) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *CreateAudienceListRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAudienceListRequest) GetMemberIds() []int64 {
+	if x != nil {
+		return x.MemberIds
+	}
+	return nil
+}
+
+type AudienceListMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       int64                  `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	ListId        int64                  `protobuf:"varint,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	UserIds       []int64                `protobuf:"varint,3,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AudienceListMembersRequest) Reset() {
+	*x = AudienceListMembersRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AudienceListMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AudienceListMembersRequest) ProtoMessage() {}
+
+func (x *AudienceListMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AudienceListMembersRequest.ProtoReflect.Descriptor instead.
+func (*AudienceListMembersRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AudienceListMembersRequest) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *AudienceListMembersRequest) GetListId() int64 {
+	if x != nil {
+		return x.ListId
+	}
+	return 0
+}
+
+func (x *AudienceListMembersRequest) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type DeleteAudienceListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       int64                  `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	ListId        int64                  `protobuf:"varint,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserContentRequest) Reset() {
-	*x = GetUserContentRequest{}
-	mi := &file_api_v1_feed_proto_msgTypes[2]
+func (x *DeleteAudienceListRequest) Reset() {
+	*x = DeleteAudienceListRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserContentRequest) String() string {
+func (x *DeleteAudienceListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserContentRequest) ProtoMessage() {}
+func (*DeleteAudienceListRequest) ProtoMessage() {}
 
-func (x *GetUserContentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_feed_proto_msgTypes[2]
+func (x *DeleteAudienceListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -143,52 +4179,50 @@ func (x *GetUserContentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserContentRequest.ProtoReflect.Descriptor instead.
-func (*GetUserContentRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_feed_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use DeleteAudienceListRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAudienceListRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *GetUserContentRequest) GetRequesterId() int64 {
+func (x *DeleteAudienceListRequest) GetOwnerId() int64 {
 	if x != nil {
-		return x.RequesterId
+		return x.OwnerId
 	}
 	return 0
 }
 
-func (x *GetUserContentRequest) GetTargetUserId() int64 {
+func (x *DeleteAudienceListRequest) GetListId() int64 {
 	if x != nil {
-		return x.TargetUserId
+		return x.ListId
 	}
 	return 0
 }
 
-type CreatePostRequest struct {
+type AudienceList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AuthorId      int64                  `protobuf:"varint,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
-	MediaData     []byte                 `protobuf:"bytes,3,opt,name=media_data,json=mediaData,proto3" json:"media_data,omitempty"`
-	MediaType     string                 `protobuf:"bytes,4,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
-	MediaName     string                 `protobuf:"bytes,5,opt,name=media_name,json=mediaName,proto3" json:"media_name,omitempty"`
-	Privacy       string                 `protobuf:"bytes,6,opt,name=privacy,proto3" json:"privacy,omitempty"`
+	ListId        int64                  `protobuf:"varint,1,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MemberIds     []int64                `protobuf:"varint,3,rep,packed,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePostRequest) Reset() {
-	*x = CreatePostRequest{}
-	mi := &file_api_v1_feed_proto_msgTypes[3]
+func (x *AudienceList) Reset() {
+	*x = AudienceList{}
+	mi := &file_api_v1_feed_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePostRequest) String() string {
+func (x *AudienceList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePostRequest) ProtoMessage() {}
+func (*AudienceList) ProtoMessage() {}
 
-func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_feed_proto_msgTypes[3]
+func (x *AudienceList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -199,80 +4233,105 @@ func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
-func (*CreatePostRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_feed_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use AudienceList.ProtoReflect.Descriptor instead.
+func (*AudienceList) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *CreatePostRequest) GetAuthorId() int64 {
+func (x *AudienceList) GetListId() int64 {
 	if x != nil {
-		return x.AuthorId
+		return x.ListId
 	}
 	return 0
 }
 
-func (x *CreatePostRequest) GetText() string {
+func (x *AudienceList) GetName() string {
 	if x != nil {
-		return x.Text
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CreatePostRequest) GetMediaData() []byte {
+func (x *AudienceList) GetMemberIds() []int64 {
 	if x != nil {
-		return x.MediaData
+		return x.MemberIds
 	}
 	return nil
 }
 
-func (x *CreatePostRequest) GetMediaType() string {
+func (x *AudienceList) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.MediaType
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CreatePostRequest) GetMediaName() string {
+type AudienceListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          *AudienceList          `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AudienceListResponse) Reset() {
+	*x = AudienceListResponse{}
+	mi := &file_api_v1_feed_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AudienceListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AudienceListResponse) ProtoMessage() {}
+
+func (x *AudienceListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[66]
 	if x != nil {
-		return x.MediaName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreatePostRequest) GetPrivacy() string {
+// Deprecated: Use AudienceListResponse.ProtoReflect.Descriptor instead.
+func (*AudienceListResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *AudienceListResponse) GetList() *AudienceList {
 	if x != nil {
-		return x.Privacy
+		return x.List
 	}
-	return ""
+	return nil
 }
 
-type CreateReelRequest struct {
+type AudienceListList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AuthorId      int64                  `protobuf:"varint,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	Caption       string                 `protobuf:"bytes,2,opt,name=caption,proto3" json:"caption,omitempty"`
-	MediaData     []byte                 `protobuf:"bytes,3,opt,name=media_data,json=mediaData,proto3" json:"media_data,omitempty"`
-	MediaName     string                 `protobuf:"bytes,4,opt,name=media_name,json=mediaName,proto3" json:"media_name,omitempty"`
-	DurationSecs  int32                  `protobuf:"varint,5,opt,name=duration_secs,json=durationSecs,proto3" json:"duration_secs,omitempty"`
-	Privacy       string                 `protobuf:"bytes,6,opt,name=privacy,proto3" json:"privacy,omitempty"`
+	Lists         []*AudienceList        `protobuf:"bytes,1,rep,name=lists,proto3" json:"lists,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateReelRequest) Reset() {
-	*x = CreateReelRequest{}
-	mi := &file_api_v1_feed_proto_msgTypes[4]
+func (x *AudienceListList) Reset() {
+	*x = AudienceListList{}
+	mi := &file_api_v1_feed_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateReelRequest) String() string {
+func (x *AudienceListList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateReelRequest) ProtoMessage() {}
+func (*AudienceListList) ProtoMessage() {}
 
-func (x *CreateReelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_feed_proto_msgTypes[4]
+func (x *AudienceListList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -283,80 +4342,95 @@ func (x *CreateReelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateReelRequest.ProtoReflect.Descriptor instead.
-func (*CreateReelRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_feed_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use AudienceListList.ProtoReflect.Descriptor instead.
+func (*AudienceListList) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *CreateReelRequest) GetAuthorId() int64 {
+func (x *AudienceListList) GetLists() []*AudienceList {
 	if x != nil {
-		return x.AuthorId
+		return x.Lists
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateReelRequest) GetCaption() string {
-	if x != nil {
-		return x.Caption
-	}
-	return ""
+type ReelViewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReelId        int64                  `protobuf:"varint,1,opt,name=reel_id,json=reelId,proto3" json:"reel_id,omitempty"`
+	ViewerId      int64                  `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateReelRequest) GetMediaData() []byte {
-	if x != nil {
-		return x.MediaData
-	}
-	return nil
+func (x *ReelViewRequest) Reset() {
+	*x = ReelViewRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateReelRequest) GetMediaName() string {
+func (x *ReelViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReelViewRequest) ProtoMessage() {}
+
+func (x *ReelViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[68]
 	if x != nil {
-		return x.MediaName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateReelRequest) GetDurationSecs() int32 {
+// Deprecated: Use ReelViewRequest.ProtoReflect.Descriptor instead.
+func (*ReelViewRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ReelViewRequest) GetReelId() int64 {
 	if x != nil {
-		return x.DurationSecs
+		return x.ReelId
 	}
 	return 0
 }
 
-func (x *CreateReelRequest) GetPrivacy() string {
+func (x *ReelViewRequest) GetViewerId() int64 {
 	if x != nil {
-		return x.Privacy
+		return x.ViewerId
 	}
-	return ""
+	return 0
 }
 
-type CreateStoryRequest struct {
+// public reels from non-friends by popularity, pass next_cursor back to continue the session without repeats
+type ExploreReelsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AuthorId      int64                  `protobuf:"varint,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	MediaType     string                 `protobuf:"bytes,2,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
-	MediaName     string                 `protobuf:"bytes,3,opt,name=media_name,json=mediaName,proto3" json:"media_name,omitempty"`
-	MediaData     []byte                 `protobuf:"bytes,4,opt,name=media_data,json=mediaData,proto3" json:"media_data,omitempty"`
-	DurationSecs  int32                  `protobuf:"varint,5,opt,name=duration_secs,json=durationSecs,proto3" json:"duration_secs,omitempty"`
-	Privacy       string                 `protobuf:"bytes,6,opt,name=privacy,proto3" json:"privacy,omitempty"`
+	ViewerId      int64                  `protobuf:"varint,1,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateStoryRequest) Reset() {
-	*x = CreateStoryRequest{}
-	mi := &file_api_v1_feed_proto_msgTypes[5]
+func (x *ExploreReelsRequest) Reset() {
+	*x = ExploreReelsRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateStoryRequest) String() string {
+func (x *ExploreReelsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateStoryRequest) ProtoMessage() {}
+func (*ExploreReelsRequest) ProtoMessage() {}
 
-func (x *CreateStoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_feed_proto_msgTypes[5]
+func (x *ExploreReelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -367,77 +4441,117 @@ func (x *CreateStoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateStoryRequest.ProtoReflect.Descriptor instead.
-func (*CreateStoryRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_feed_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ExploreReelsRequest.ProtoReflect.Descriptor instead.
+func (*ExploreReelsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *CreateStoryRequest) GetAuthorId() int64 {
+func (x *ExploreReelsRequest) GetViewerId() int64 {
 	if x != nil {
-		return x.AuthorId
+		return x.ViewerId
 	}
 	return 0
 }
 
-func (x *CreateStoryRequest) GetMediaType() string {
+func (x *ExploreReelsRequest) GetCursor() string {
 	if x != nil {
-		return x.MediaType
+		return x.Cursor
 	}
 	return ""
 }
 
-func (x *CreateStoryRequest) GetMediaName() string {
+func (x *ExploreReelsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.MediaName
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateStoryRequest) GetMediaData() []byte {
+// the author's posts and reels waiting to be published, soonest first
+type ListScheduledContentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId      int64                  `protobuf:"varint,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledContentRequest) Reset() {
+	*x = ListScheduledContentRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledContentRequest) ProtoMessage() {}
+
+func (x *ListScheduledContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[70]
 	if x != nil {
-		return x.MediaData
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CreateStoryRequest) GetDurationSecs() int32 {
+// Deprecated: Use ListScheduledContentRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledContentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListScheduledContentRequest) GetAuthorId() int64 {
 	if x != nil {
-		return x.DurationSecs
+		return x.AuthorId
 	}
 	return 0
 }
 
-func (x *CreateStoryRequest) GetPrivacy() string {
+func (x *ListScheduledContentRequest) GetCursor() string {
 	if x != nil {
-		return x.Privacy
+		return x.Cursor
 	}
 	return ""
 }
 
-type ReactionRequest struct {
+func (x *ListScheduledContentRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type RescheduleContentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ContentId     int64                  `protobuf:"varint,2,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // like, love, laugh, etc.
+	ContentId     int64                  `protobuf:"varint,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	AuthorId      int64                  `protobuf:"varint,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReactionRequest) Reset() {
-	*x = ReactionRequest{}
-	mi := &file_api_v1_feed_proto_msgTypes[6]
+func (x *RescheduleContentRequest) Reset() {
+	*x = RescheduleContentRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReactionRequest) String() string {
+func (x *RescheduleContentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReactionRequest) ProtoMessage() {}
+func (*RescheduleContentRequest) ProtoMessage() {}
 
-func (x *ReactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_feed_proto_msgTypes[6]
+func (x *RescheduleContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -448,55 +4562,55 @@ func (x *ReactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReactionRequest.ProtoReflect.Descriptor instead.
-func (*ReactionRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_feed_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use RescheduleContentRequest.ProtoReflect.Descriptor instead.
+func (*RescheduleContentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *ReactionRequest) GetUserId() int64 {
+func (x *RescheduleContentRequest) GetContentId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.ContentId
 	}
 	return 0
 }
 
-func (x *ReactionRequest) GetContentId() int64 {
+func (x *RescheduleContentRequest) GetAuthorId() int64 {
 	if x != nil {
-		return x.ContentId
+		return x.AuthorId
 	}
 	return 0
 }
 
-func (x *ReactionRequest) GetType() string {
+func (x *RescheduleContentRequest) GetPublishAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Type
+		return x.PublishAt
 	}
-	return ""
+	return nil
 }
 
-type DeleteReactionRequest struct {
+type CancelScheduledContentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ContentId     int64                  `protobuf:"varint,2,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	ContentId     int64                  `protobuf:"varint,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	AuthorId      int64                  `protobuf:"varint,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteReactionRequest) Reset() {
-	*x = DeleteReactionRequest{}
-	mi := &file_api_v1_feed_proto_msgTypes[7]
+func (x *CancelScheduledContentRequest) Reset() {
+	*x = CancelScheduledContentRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteReactionRequest) String() string {
+func (x *CancelScheduledContentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteReactionRequest) ProtoMessage() {}
+func (*CancelScheduledContentRequest) ProtoMessage() {}
 
-func (x *DeleteReactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_feed_proto_msgTypes[7]
+func (x *CancelScheduledContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -507,51 +4621,55 @@ func (x *DeleteReactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteReactionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteReactionRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_feed_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use CancelScheduledContentRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledContentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *DeleteReactionRequest) GetUserId() int64 {
+func (x *CancelScheduledContentRequest) GetContentId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.ContentId
 	}
 	return 0
 }
 
-func (x *DeleteReactionRequest) GetContentId() int64 {
+func (x *CancelScheduledContentRequest) GetAuthorId() int64 {
 	if x != nil {
-		return x.ContentId
+		return x.AuthorId
 	}
 	return 0
-}
-
-type Reaction struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ContentId     int64                  `protobuf:"varint,3,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
-	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *Reaction) Reset() {
-	*x = Reaction{}
-	mi := &file_api_v1_feed_proto_msgTypes[8]
+}
+
+// a post or reel only its author sees, media_ref_ids are uploads from UploadMedia in order.
+// Drafts left unchanged for 30 days are deleted with their media
+type SaveDraftRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId       int64                  `protobuf:"varint,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Type           string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // POST or REEL
+	Text           string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	MediaRefIds    []int64                `protobuf:"varint,4,rep,packed,name=media_ref_ids,json=mediaRefIds,proto3" json:"media_ref_ids,omitempty"`
+	DurationSecs   int32                  `protobuf:"varint,5,opt,name=duration_secs,json=durationSecs,proto3" json:"duration_secs,omitempty"`
+	Privacy        string                 `protobuf:"bytes,6,opt,name=privacy,proto3" json:"privacy,omitempty"`
+	AudienceListId int64                  `protobuf:"varint,7,opt,name=audience_list_id,json=audienceListId,proto3" json:"audience_list_id,omitempty"` // required when privacy is list
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SaveDraftRequest) Reset() {
+	*x = SaveDraftRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Reaction) String() string {
+func (x *SaveDraftRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Reaction) ProtoMessage() {}
+func (*SaveDraftRequest) ProtoMessage() {}
 
-func (x *Reaction) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_feed_proto_msgTypes[8]
+func (x *SaveDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -562,68 +4680,84 @@ func (x *Reaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Reaction.ProtoReflect.Descriptor instead.
-func (*Reaction) Descriptor() ([]byte, []int) {
-	return file_api_v1_feed_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use SaveDraftRequest.ProtoReflect.Descriptor instead.
+func (*SaveDraftRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *Reaction) GetId() int64 {
+func (x *SaveDraftRequest) GetAuthorId() int64 {
 	if x != nil {
-		return x.Id
+		return x.AuthorId
 	}
 	return 0
 }
 
-func (x *Reaction) GetUserId() int64 {
+func (x *SaveDraftRequest) GetType() string {
 	if x != nil {
-		return x.UserId
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *Reaction) GetContentId() int64 {
+func (x *SaveDraftRequest) GetText() string {
 	if x != nil {
-		return x.ContentId
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SaveDraftRequest) GetMediaRefIds() []int64 {
+	if x != nil {
+		return x.MediaRefIds
+	}
+	return nil
+}
+
+func (x *SaveDraftRequest) GetDurationSecs() int32 {
+	if x != nil {
+		return x.DurationSecs
 	}
 	return 0
 }
 
-func (x *Reaction) GetType() string {
+func (x *SaveDraftRequest) GetPrivacy() string {
 	if x != nil {
-		return x.Type
+		return x.Privacy
 	}
 	return ""
 }
 
-func (x *Reaction) GetCreatedAt() *timestamppb.Timestamp {
+func (x *SaveDraftRequest) GetAudienceListId() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.AudienceListId
 	}
-	return nil
+	return 0
 }
 
-type ReactionList struct {
+type ListDraftsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reactions     []*Reaction            `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	AuthorId      int64                  `protobuf:"varint,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReactionList) Reset() {
-	*x = ReactionList{}
-	mi := &file_api_v1_feed_proto_msgTypes[9]
+func (x *ListDraftsRequest) Reset() {
+	*x = ListDraftsRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReactionList) String() string {
+func (x *ListDraftsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReactionList) ProtoMessage() {}
+func (*ListDraftsRequest) ProtoMessage() {}
 
-func (x *ReactionList) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_feed_proto_msgTypes[9]
+func (x *ListDraftsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -634,46 +4768,61 @@ func (x *ReactionList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReactionList.ProtoReflect.Descriptor instead.
-func (*ReactionList) Descriptor() ([]byte, []int) {
-	return file_api_v1_feed_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ListDraftsRequest.ProtoReflect.Descriptor instead.
+func (*ListDraftsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *ReactionList) GetReactions() []*Reaction {
+func (x *ListDraftsRequest) GetAuthorId() int64 {
 	if x != nil {
-		return x.Reactions
+		return x.AuthorId
 	}
-	return nil
+	return 0
 }
 
-type TimelineContent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ContentId     int64                  `protobuf:"varint,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
-	AuthorId      int64                  `protobuf:"varint,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
-	MediaUrl      string                 `protobuf:"bytes,5,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
-	Privacy       string                 `protobuf:"bytes,6,opt,name=privacy,proto3" json:"privacy,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListDraftsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
 }
 
-func (x *TimelineContent) Reset() {
-	*x = TimelineContent{}
-	mi := &file_api_v1_feed_proto_msgTypes[10]
+func (x *ListDraftsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// replaces the whole draft, media left out are deleted
+type UpdateDraftRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ContentId      int64                  `protobuf:"varint,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	AuthorId       int64                  `protobuf:"varint,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Text           string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	MediaRefIds    []int64                `protobuf:"varint,4,rep,packed,name=media_ref_ids,json=mediaRefIds,proto3" json:"media_ref_ids,omitempty"`
+	DurationSecs   int32                  `protobuf:"varint,5,opt,name=duration_secs,json=durationSecs,proto3" json:"duration_secs,omitempty"`
+	Privacy        string                 `protobuf:"bytes,6,opt,name=privacy,proto3" json:"privacy,omitempty"`
+	AudienceListId int64                  `protobuf:"varint,7,opt,name=audience_list_id,json=audienceListId,proto3" json:"audience_list_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateDraftRequest) Reset() {
+	*x = UpdateDraftRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TimelineContent) String() string {
+func (x *UpdateDraftRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimelineContent) ProtoMessage() {}
+func (*UpdateDraftRequest) ProtoMessage() {}
 
-func (x *TimelineContent) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_feed_proto_msgTypes[10]
+func (x *UpdateDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -684,82 +4833,84 @@ func (x *TimelineContent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimelineContent.ProtoReflect.Descriptor instead.
-func (*TimelineContent) Descriptor() ([]byte, []int) {
-	return file_api_v1_feed_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use UpdateDraftRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDraftRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *TimelineContent) GetContentId() int64 {
+func (x *UpdateDraftRequest) GetContentId() int64 {
 	if x != nil {
 		return x.ContentId
 	}
 	return 0
 }
 
-func (x *TimelineContent) GetAuthorId() int64 {
+func (x *UpdateDraftRequest) GetAuthorId() int64 {
 	if x != nil {
 		return x.AuthorId
 	}
 	return 0
 }
 
-func (x *TimelineContent) GetType() string {
+func (x *UpdateDraftRequest) GetText() string {
 	if x != nil {
-		return x.Type
+		return x.Text
 	}
 	return ""
 }
 
-func (x *TimelineContent) GetText() string {
+func (x *UpdateDraftRequest) GetMediaRefIds() []int64 {
 	if x != nil {
-		return x.Text
+		return x.MediaRefIds
 	}
-	return ""
+	return nil
 }
 
-func (x *TimelineContent) GetMediaUrl() string {
+func (x *UpdateDraftRequest) GetDurationSecs() int32 {
 	if x != nil {
-		return x.MediaUrl
+		return x.DurationSecs
 	}
-	return ""
+	return 0
 }
 
-func (x *TimelineContent) GetPrivacy() string {
+func (x *UpdateDraftRequest) GetPrivacy() string {
 	if x != nil {
 		return x.Privacy
 	}
 	return ""
 }
 
-func (x *TimelineContent) GetCreatedAt() *timestamppb.Timestamp {
+func (x *UpdateDraftRequest) GetAudienceListId() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.AudienceListId
 	}
-	return nil
+	return 0
 }
 
-type TimelineResponse struct {
+type PublishDraftRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Contents      []*TimelineContent     `protobuf:"bytes,1,rep,name=contents,proto3" json:"contents,omitempty"`
+	ContentId     int64                  `protobuf:"varint,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	AuthorId      int64                  `protobuf:"varint,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"` // optional, schedules the draft instead of publishing it now
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TimelineResponse) Reset() {
-	*x = TimelineResponse{}
-	mi := &file_api_v1_feed_proto_msgTypes[11]
+func (x *PublishDraftRequest) Reset() {
+	*x = PublishDraftRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TimelineResponse) String() string {
+func (x *PublishDraftRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimelineResponse) ProtoMessage() {}
+func (*PublishDraftRequest) ProtoMessage() {}
 
-func (x *TimelineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_feed_proto_msgTypes[11]
+func (x *PublishDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -770,30 +4921,98 @@ func (x *TimelineResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimelineResponse.ProtoReflect.Descriptor instead.
-func (*TimelineResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_feed_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use PublishDraftRequest.ProtoReflect.Descriptor instead.
+func (*PublishDraftRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *TimelineResponse) GetContents() []*TimelineContent {
+func (x *PublishDraftRequest) GetContentId() int64 {
 	if x != nil {
-		return x.Contents
+		return x.ContentId
+	}
+	return 0
+}
+
+func (x *PublishDraftRequest) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *PublishDraftRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
 	}
 	return nil
 }
 
+type DiscardDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentId     int64                  `protobuf:"varint,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	AuthorId      int64                  `protobuf:"varint,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscardDraftRequest) Reset() {
+	*x = DiscardDraftRequest{}
+	mi := &file_api_v1_feed_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscardDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardDraftRequest) ProtoMessage() {}
+
+func (x *DiscardDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_feed_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardDraftRequest.ProtoReflect.Descriptor instead.
+func (*DiscardDraftRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DiscardDraftRequest) GetContentId() int64 {
+	if x != nil {
+		return x.ContentId
+	}
+	return 0
+}
+
+func (x *DiscardDraftRequest) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
 type FeedResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ContentId     int64                  `protobuf:"varint,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
 	MediaUrl      string                 `protobuf:"bytes,2,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
 	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Reactions     *ReactionSummary       `protobuf:"bytes,4,opt,name=reactions,proto3" json:"reactions,omitempty"`
+	ShareCount    int64                  `protobuf:"varint,5,opt,name=share_count,json=shareCount,proto3" json:"share_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FeedResponse) Reset() {
 	*x = FeedResponse{}
-	mi := &file_api_v1_feed_proto_msgTypes[12]
+	mi := &file_api_v1_feed_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -805,7 +5024,7 @@ func (x *FeedResponse) String() string {
 func (*FeedResponse) ProtoMessage() {}
 
 func (x *FeedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_feed_proto_msgTypes[12]
+	mi := &file_api_v1_feed_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -818,7 +5037,7 @@ func (x *FeedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FeedResponse.ProtoReflect.Descriptor instead.
 func (*FeedResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_feed_proto_rawDescGZIP(), []int{12}
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *FeedResponse) GetContentId() int64 {
@@ -842,6 +5061,20 @@ func (x *FeedResponse) GetMessage() string {
 	return ""
 }
 
+func (x *FeedResponse) GetReactions() *ReactionSummary {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *FeedResponse) GetShareCount() int64 {
+	if x != nil {
+		return x.ShareCount
+	}
+	return 0
+}
+
 type FeedStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
@@ -851,7 +5084,7 @@ type FeedStatusResponse struct {
 
 func (x *FeedStatusResponse) Reset() {
 	*x = FeedStatusResponse{}
-	mi := &file_api_v1_feed_proto_msgTypes[13]
+	mi := &file_api_v1_feed_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -863,7 +5096,7 @@ func (x *FeedStatusResponse) String() string {
 func (*FeedStatusResponse) ProtoMessage() {}
 
 func (x *FeedStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_feed_proto_msgTypes[13]
+	mi := &file_api_v1_feed_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -876,7 +5109,7 @@ func (x *FeedStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FeedStatusResponse.ProtoReflect.Descriptor instead.
 func (*FeedStatusResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_feed_proto_rawDescGZIP(), []int{13}
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *FeedStatusResponse) GetMessage() string {
@@ -898,7 +5131,7 @@ type MediaResponse struct {
 
 func (x *MediaResponse) Reset() {
 	*x = MediaResponse{}
-	mi := &file_api_v1_feed_proto_msgTypes[14]
+	mi := &file_api_v1_feed_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -910,7 +5143,7 @@ func (x *MediaResponse) String() string {
 func (*MediaResponse) ProtoMessage() {}
 
 func (x *MediaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_feed_proto_msgTypes[14]
+	mi := &file_api_v1_feed_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -923,7 +5156,7 @@ func (x *MediaResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MediaResponse.ProtoReflect.Descriptor instead.
 func (*MediaResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_feed_proto_rawDescGZIP(), []int{14}
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *MediaResponse) GetMediaRefId() int64 {
@@ -969,7 +5202,7 @@ type Content struct {
 
 func (x *Content) Reset() {
 	*x = Content{}
-	mi := &file_api_v1_feed_proto_msgTypes[15]
+	mi := &file_api_v1_feed_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -981,7 +5214,7 @@ func (x *Content) String() string {
 func (*Content) ProtoMessage() {}
 
 func (x *Content) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_feed_proto_msgTypes[15]
+	mi := &file_api_v1_feed_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -994,7 +5227,7 @@ func (x *Content) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Content.ProtoReflect.Descriptor instead.
 func (*Content) Descriptor() ([]byte, []int) {
-	return file_api_v1_feed_proto_rawDescGZIP(), []int{15}
+	return file_api_v1_feed_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *Content) GetContentId() int64 {
@@ -1052,13 +5285,58 @@ const file_api_v1_feed_proto_rawDesc = "" +
 	"\n" +
 	"\x11api/v1/feed.proto\x12\vapi.v1.feed\x1a\x1fgoogle/protobuf/timestamp.proto\"!\n" +
 	"\x06UserID\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"*\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"G\n" +
 	"\tContentID\x12\x1d\n" +
 	"\n" +
-	"content_id\x18\x01 \x01(\x03R\tcontentId\"`\n" +
+	"content_id\x18\x01 \x01(\x03R\tcontentId\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\x03R\bviewerId\"|\n" +
+	"\x12GetTimelineRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x18\n" +
+	"\aranking\x18\x04 \x01(\tR\aranking\"`\n" +
 	"\x15GetUserContentRequest\x12!\n" +
 	"\frequester_id\x18\x01 \x01(\x03R\vrequesterId\x12$\n" +
-	"\x0etarget_user_id\x18\x02 \x01(\x03R\ftargetUserId\"\xbb\x01\n" +
+	"\x0etarget_user_id\x18\x02 \x01(\x03R\ftargetUserId\"y\n" +
+	"\x1bUpdateContentPrivacyRequest\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\x03R\tcontentId\x12!\n" +
+	"\frequester_id\x18\x02 \x01(\x03R\vrequesterId\x12\x18\n" +
+	"\aprivacy\x18\x03 \x01(\tR\aprivacy\"\xe3\x01\n" +
+	"\x14UpdateContentRequest\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\x03R\tcontentId\x12\x1b\n" +
+	"\teditor_id\x18\x02 \x01(\x03R\beditorId\x12\x17\n" +
+	"\x04text\x18\x03 \x01(\tH\x00R\x04text\x88\x01\x01\x12\x1d\n" +
+	"\aprivacy\x18\x04 \x01(\tH\x01R\aprivacy\x88\x01\x01\x12-\n" +
+	"\x10audience_list_id\x18\x05 \x01(\x03H\x02R\x0eaudienceListId\x88\x01\x01B\a\n" +
+	"\x05_textB\n" +
+	"\n" +
+	"\b_privacyB\x13\n" +
+	"\x11_audience_list_id\"I\n" +
+	"\x0fContentResponse\x126\n" +
+	"\acontent\x18\x01 \x01(\v2\x1c.api.v1.feed.TimelineContentR\acontent\"\x87\x01\n" +
+	"\x14ListRevisionsRequest\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\x03R\tcontentId\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\x03R\bviewerId\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xc0\x01\n" +
+	"\bRevision\x12\x1f\n" +
+	"\vrevision_id\x18\x01 \x01(\x03R\n" +
+	"revisionId\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x18\n" +
+	"\aprivacy\x18\x03 \x01(\tR\aprivacy\x12;\n" +
+	"\vreplaced_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"replacedAt\x12(\n" +
+	"\x10audience_list_id\x18\x05 \x01(\x03R\x0eaudienceListId\"d\n" +
+	"\fRevisionList\x123\n" +
+	"\trevisions\x18\x01 \x03(\v2\x15.api.v1.feed.RevisionR\trevisions\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"I\n" +
+	"\x11FriendshipRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tfriend_id\x18\x02 \x01(\x03R\bfriendId\"\x82\x03\n" +
 	"\x11CreatePostRequest\x12\x1b\n" +
 	"\tauthor_id\x18\x01 \x01(\x03R\bauthorId\x12\x12\n" +
 	"\x04text\x18\x02 \x01(\tR\x04text\x12\x1d\n" +
@@ -1068,7 +5346,18 @@ const file_api_v1_feed_proto_rawDesc = "" +
 	"media_type\x18\x04 \x01(\tR\tmediaType\x12\x1d\n" +
 	"\n" +
 	"media_name\x18\x05 \x01(\tR\tmediaName\x12\x18\n" +
-	"\aprivacy\x18\x06 \x01(\tR\aprivacy\"\xc7\x01\n" +
+	"\aprivacy\x18\x06 \x01(\tR\aprivacy\x12>\n" +
+	"\vattachments\x18\a \x03(\v2\x1c.api.v1.feed.MediaAttachmentR\vattachments\x12 \n" +
+	"\fmedia_ref_id\x18\b \x01(\x03R\n" +
+	"mediaRefId\x12(\n" +
+	"\x10audience_list_id\x18\t \x01(\x03R\x0eaudienceListId\x129\n" +
+	"\n" +
+	"publish_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\"M\n" +
+	"\x0fMediaAttachment\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"\xa4\x02\n" +
 	"\x11CreateReelRequest\x12\x1b\n" +
 	"\tauthor_id\x18\x01 \x01(\x03R\bauthorId\x12\x18\n" +
 	"\acaption\x18\x02 \x01(\tR\acaption\x12\x1d\n" +
@@ -1077,7 +5366,11 @@ const file_api_v1_feed_proto_rawDesc = "" +
 	"\n" +
 	"media_name\x18\x04 \x01(\tR\tmediaName\x12#\n" +
 	"\rduration_secs\x18\x05 \x01(\x05R\fdurationSecs\x12\x18\n" +
-	"\aprivacy\x18\x06 \x01(\tR\aprivacy\"\xcd\x01\n" +
+	"\aprivacy\x18\x06 \x01(\tR\aprivacy\x12 \n" +
+	"\fmedia_ref_id\x18\a \x01(\x03R\n" +
+	"mediaRefId\x129\n" +
+	"\n" +
+	"publish_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\"\x99\x02\n" +
 	"\x12CreateStoryRequest\x12\x1b\n" +
 	"\tauthor_id\x18\x01 \x01(\x03R\bauthorId\x12\x1d\n" +
 	"\n" +
@@ -1087,7 +5380,23 @@ const file_api_v1_feed_proto_rawDesc = "" +
 	"\n" +
 	"media_data\x18\x04 \x01(\fR\tmediaData\x12#\n" +
 	"\rduration_secs\x18\x05 \x01(\x05R\fdurationSecs\x12\x18\n" +
-	"\aprivacy\x18\x06 \x01(\tR\aprivacy\"]\n" +
+	"\aprivacy\x18\x06 \x01(\tR\aprivacy\x12 \n" +
+	"\fmedia_ref_id\x18\a \x01(\x03R\n" +
+	"mediaRefId\x12(\n" +
+	"\x10audience_list_id\x18\b \x01(\x03R\x0eaudienceListId\"~\n" +
+	"\vUploadChunk\x12\x1f\n" +
+	"\vuploader_id\x18\x01 \x01(\x03R\n" +
+	"uploaderId\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12\x1d\n" +
+	"\n" +
+	"media_type\x18\x03 \x01(\tR\tmediaType\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\"s\n" +
+	"\x13UploadMediaResponse\x12 \n" +
+	"\fmedia_ref_id\x18\x01 \x01(\x03R\n" +
+	"mediaRefId\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x02 \x01(\x03R\tsizeBytes\x12\x1b\n" +
+	"\tmedia_url\x18\x03 \x01(\tR\bmediaUrl\"]\n" +
 	"\x0fReactionRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
 	"\n" +
@@ -1106,7 +5415,31 @@ const file_api_v1_feed_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"C\n" +
 	"\fReactionList\x123\n" +
-	"\treactions\x18\x01 \x03(\v2\x15.api.v1.feed.ReactionR\treactions\"\xe7\x01\n" +
+	"\treactions\x18\x01 \x03(\v2\x15.api.v1.feed.ReactionR\treactions\"\xcd\x01\n" +
+	"\x0fReactionSummary\x12@\n" +
+	"\x06counts\x18\x01 \x03(\v2(.api.v1.feed.ReactionSummary.CountsEntryR\x06counts\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12'\n" +
+	"\x0fviewer_reaction\x18\x03 \x01(\tR\x0eviewerReaction\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\x9a\x01\n" +
+	"\x13ListReactorsRequest\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\x03R\tcontentId\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\x03R\bviewerId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\"\x89\x01\n" +
+	"\aReactor\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06handle\x18\x02 \x01(\tR\x06handle\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x129\n" +
+	"\n" +
+	"reacted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\treactedAt\"`\n" +
+	"\vReactorList\x120\n" +
+	"\breactors\x18\x01 \x03(\v2\x14.api.v1.feed.ReactorR\breactors\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xca\x04\n" +
 	"\x0fTimelineContent\x12\x1d\n" +
 	"\n" +
 	"content_id\x18\x01 \x01(\x03R\tcontentId\x12\x1b\n" +
@@ -1116,14 +5449,275 @@ const file_api_v1_feed_proto_rawDesc = "" +
 	"\tmedia_url\x18\x05 \x01(\tR\bmediaUrl\x12\x18\n" +
 	"\aprivacy\x18\x06 \x01(\tR\aprivacy\x129\n" +
 	"\n" +
-	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"L\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12#\n" +
+	"\rcomment_count\x18\b \x01(\x03R\fcommentCount\x12:\n" +
+	"\treactions\x18\t \x01(\v2\x1c.api.v1.feed.ReactionSummaryR\treactions\x12\x12\n" +
+	"\x04seen\x18\n" +
+	" \x01(\bR\x04seen\x120\n" +
+	"\bmentions\x18\v \x03(\v2\x14.api.v1.feed.MentionR\bmentions\x12\x1f\n" +
+	"\vshare_count\x18\f \x01(\x03R\n" +
+	"shareCount\x122\n" +
+	"\x06shared\x18\r \x01(\v2\x1a.api.v1.feed.SharedContentR\x06shared\x127\n" +
+	"\tedited_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\x12,\n" +
+	"\x05media\x18\x0f \x03(\v2\x16.api.v1.feed.MediaItemR\x05media\"1\n" +
+	"\tMediaItem\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\"\x84\x01\n" +
+	"\rSharedContent\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\x03R\tcontentId\x12\x1c\n" +
+	"\tavailable\x18\x02 \x01(\bR\tavailable\x126\n" +
+	"\acontent\x18\x03 \x01(\v2\x1c.api.v1.feed.TimelineContentR\acontent\"\x8b\x01\n" +
+	"\x13ShareContentRequest\x12\x1b\n" +
+	"\tsharer_id\x18\x01 \x01(\x03R\bsharerId\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x02 \x01(\x03R\tcontentId\x12\x1e\n" +
+	"\n" +
+	"commentary\x18\x03 \x01(\tR\n" +
+	"commentary\x12\x18\n" +
+	"\aprivacy\x18\x04 \x01(\tR\aprivacy\"j\n" +
+	"\aMention\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06handle\x18\x02 \x01(\tR\x06handle\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x16\n" +
+	"\x06length\x18\x04 \x01(\x05R\x06length\"\xa5\x01\n" +
 	"\x10TimelineResponse\x128\n" +
-	"\bcontents\x18\x01 \x03(\v2\x1c.api.v1.feed.TimelineContentR\bcontents\"d\n" +
+	"\bcontents\x18\x01 \x03(\v2\x1c.api.v1.feed.TimelineContentR\bcontents\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x126\n" +
+	"\n" +
+	"highlights\x18\x03 \x03(\v2\x16.api.v1.feed.HighlightR\n" +
+	"highlights\"\x80\x01\n" +
+	"\x11AddCommentRequest\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\x03R\tcontentId\x12\x1b\n" +
+	"\tauthor_id\x18\x02 \x01(\x03R\bauthorId\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\x03R\bparentId\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\"\xa3\x01\n" +
+	"\x13ListCommentsRequest\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\x03R\tcontentId\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\x03R\bviewerId\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\x03R\bparentId\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\"d\n" +
+	"\x12EditCommentRequest\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\x03R\tcommentId\x12\x1b\n" +
+	"\teditor_id\x18\x02 \x01(\x03R\beditorId\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\"X\n" +
+	"\x14DeleteCommentRequest\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\x03R\tcommentId\x12!\n" +
+	"\frequester_id\x18\x02 \x01(\x03R\vrequesterId\"\xaa\x02\n" +
+	"\aComment\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\x03R\tcommentId\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x02 \x01(\x03R\tcontentId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\x03R\bauthorId\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x01(\x03R\bparentId\x12\x12\n" +
+	"\x04text\x18\x05 \x01(\tR\x04text\x12\x1f\n" +
+	"\vreply_count\x18\x06 \x01(\x03R\n" +
+	"replyCount\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tedited_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\"A\n" +
+	"\x0fCommentResponse\x12.\n" +
+	"\acomment\x18\x01 \x01(\v2\x14.api.v1.feed.CommentR\acomment\"`\n" +
+	"\vCommentList\x120\n" +
+	"\bcomments\x18\x01 \x03(\v2\x14.api.v1.feed.CommentR\bcomments\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"J\n" +
+	"\x10StoryViewRequest\x12\x19\n" +
+	"\bstory_id\x18\x01 \x01(\x03R\astoryId\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\x03R\bviewerId\"\x8c\x01\n" +
+	"\x17ListStoryViewersRequest\x12\x19\n" +
+	"\bstory_id\x18\x01 \x01(\x03R\astoryId\x12!\n" +
+	"\frequester_id\x18\x02 \x01(\x03R\vrequesterId\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"w\n" +
+	"\vStoryViewer\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06handle\x18\x02 \x01(\tR\x06handle\x127\n" +
+	"\tviewed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bviewedAt\"f\n" +
+	"\x0fStoryViewerList\x122\n" +
+	"\aviewers\x18\x01 \x03(\v2\x18.api.v1.feed.StoryViewerR\aviewers\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"g\n" +
+	"\x17ListStoryArchiveRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"f\n" +
+	"\x16CreateHighlightRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\x03R\aownerId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1b\n" +
+	"\tstory_ids\x18\x03 \x03(\x03R\bstoryIds\"\x91\x01\n" +
+	"\x16UpdateHighlightRequest\x12!\n" +
+	"\fhighlight_id\x18\x01 \x01(\x03R\vhighlightId\x12!\n" +
+	"\frequester_id\x18\x02 \x01(\x03R\vrequesterId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1b\n" +
+	"\tstory_ids\x18\x04 \x03(\x03R\bstoryIds\"^\n" +
+	"\x16DeleteHighlightRequest\x12!\n" +
+	"\fhighlight_id\x18\x01 \x01(\x03R\vhighlightId\x12!\n" +
+	"\frequester_id\x18\x02 \x01(\x03R\vrequesterId\"Z\n" +
+	"\x18ReorderHighlightsRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\x03R\aownerId\x12#\n" +
+	"\rhighlight_ids\x18\x02 \x03(\x03R\fhighlightIds\"\xee\x01\n" +
+	"\tHighlight\x12!\n" +
+	"\fhighlight_id\x18\x01 \x01(\x03R\vhighlightId\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\x03R\aownerId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\x126\n" +
+	"\astories\x18\x05 \x03(\v2\x1c.api.v1.feed.TimelineContentR\astories\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"I\n" +
+	"\x11HighlightResponse\x124\n" +
+	"\thighlight\x18\x01 \x01(\v2\x16.api.v1.feed.HighlightR\thighlight\"x\n" +
+	"\x12HashtagFeedRequest\x12\x1b\n" +
+	"\tviewer_id\x18\x01 \x01(\x03R\bviewerId\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"V\n" +
+	"\x17TrendingHashtagsRequest\x12%\n" +
+	"\x0ewindow_seconds\x18\x01 \x01(\x03R\rwindowSeconds\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"r\n" +
+	"\x0fTrendingHashtag\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x12\n" +
+	"\x04uses\x18\x02 \x01(\x03R\x04uses\x12#\n" +
+	"\rprevious_uses\x18\x03 \x01(\x03R\fpreviousUses\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x01R\x05score\"c\n" +
+	"\x13MentionsFeedRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"v\n" +
+	"\x13TrendingHashtagList\x128\n" +
+	"\bhashtags\x18\x01 \x03(\v2\x1c.api.v1.feed.TrendingHashtagR\bhashtags\x12%\n" +
+	"\x0ewindow_seconds\x18\x02 \x01(\x03R\rwindowSeconds\"l\n" +
+	"\x12SaveContentRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x02 \x01(\x03R\tcontentId\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x03 \x01(\tR\n" +
+	"collection\"s\n" +
+	"\x14UnsaveContentRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x02 \x01(\x03R\tcontentId\x12#\n" +
+	"\rcollection_id\x18\x03 \x01(\x03R\fcollectionId\"\x8c\x01\n" +
+	"\x17ListSavedContentRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12#\n" +
+	"\rcollection_id\x18\x02 \x01(\x03R\fcollectionId\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x9f\x01\n" +
+	"\n" +
+	"Collection\x12#\n" +
+	"\rcollection_id\x18\x01 \x01(\x03R\fcollectionId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"item_count\x18\x03 \x01(\x03R\titemCount\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"M\n" +
+	"\x12CollectionResponse\x127\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x17.api.v1.feed.CollectionR\n" +
+	"collection\"K\n" +
+	"\x0eCollectionList\x129\n" +
+	"\vcollections\x18\x01 \x03(\v2\x17.api.v1.feed.CollectionR\vcollections\"\xb7\x01\n" +
+	"\tSavedItem\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\x03R\tcontentId\x125\n" +
+	"\bsaved_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\asavedAt\x12\x1c\n" +
+	"\tavailable\x18\x03 \x01(\bR\tavailable\x126\n" +
+	"\acontent\x18\x04 \x01(\v2\x1c.api.v1.feed.TimelineContentR\acontent\"a\n" +
+	"\x10SavedContentList\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.api.v1.feed.SavedItemR\x05items\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"i\n" +
+	"\x19CreateAudienceListRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\x03R\aownerId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"member_ids\x18\x03 \x03(\x03R\tmemberIds\"k\n" +
+	"\x1aAudienceListMembersRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\x03R\aownerId\x12\x17\n" +
+	"\alist_id\x18\x02 \x01(\x03R\x06listId\x12\x19\n" +
+	"\buser_ids\x18\x03 \x03(\x03R\auserIds\"O\n" +
+	"\x19DeleteAudienceListRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\x03R\aownerId\x12\x17\n" +
+	"\alist_id\x18\x02 \x01(\x03R\x06listId\"\x95\x01\n" +
+	"\fAudienceList\x12\x17\n" +
+	"\alist_id\x18\x01 \x01(\x03R\x06listId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"member_ids\x18\x03 \x03(\x03R\tmemberIds\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"E\n" +
+	"\x14AudienceListResponse\x12-\n" +
+	"\x04list\x18\x01 \x01(\v2\x19.api.v1.feed.AudienceListR\x04list\"C\n" +
+	"\x10AudienceListList\x12/\n" +
+	"\x05lists\x18\x01 \x03(\v2\x19.api.v1.feed.AudienceListR\x05lists\"G\n" +
+	"\x0fReelViewRequest\x12\x17\n" +
+	"\areel_id\x18\x01 \x01(\x03R\x06reelId\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\x03R\bviewerId\"g\n" +
+	"\x13ExploreReelsRequest\x12\x1b\n" +
+	"\tviewer_id\x18\x01 \x01(\x03R\bviewerId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"o\n" +
+	"\x1bListScheduledContentRequest\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\x03R\bauthorId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x91\x01\n" +
+	"\x18RescheduleContentRequest\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\x03R\tcontentId\x12\x1b\n" +
+	"\tauthor_id\x18\x02 \x01(\x03R\bauthorId\x129\n" +
+	"\n" +
+	"publish_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\"[\n" +
+	"\x1dCancelScheduledContentRequest\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\x03R\tcontentId\x12\x1b\n" +
+	"\tauthor_id\x18\x02 \x01(\x03R\bauthorId\"\xe4\x01\n" +
+	"\x10SaveDraftRequest\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\x03R\bauthorId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\"\n" +
+	"\rmedia_ref_ids\x18\x04 \x03(\x03R\vmediaRefIds\x12#\n" +
+	"\rduration_secs\x18\x05 \x01(\x05R\fdurationSecs\x12\x18\n" +
+	"\aprivacy\x18\x06 \x01(\tR\aprivacy\x12(\n" +
+	"\x10audience_list_id\x18\a \x01(\x03R\x0eaudienceListId\"e\n" +
+	"\x11ListDraftsRequest\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\x03R\bauthorId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\xf1\x01\n" +
+	"\x12UpdateDraftRequest\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\x03R\tcontentId\x12\x1b\n" +
+	"\tauthor_id\x18\x02 \x01(\x03R\bauthorId\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\"\n" +
+	"\rmedia_ref_ids\x18\x04 \x03(\x03R\vmediaRefIds\x12#\n" +
+	"\rduration_secs\x18\x05 \x01(\x05R\fdurationSecs\x12\x18\n" +
+	"\aprivacy\x18\x06 \x01(\tR\aprivacy\x12(\n" +
+	"\x10audience_list_id\x18\a \x01(\x03R\x0eaudienceListId\"\x8c\x01\n" +
+	"\x13PublishDraftRequest\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\x03R\tcontentId\x12\x1b\n" +
+	"\tauthor_id\x18\x02 \x01(\x03R\bauthorId\x129\n" +
+	"\n" +
+	"publish_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\"Q\n" +
+	"\x13DiscardDraftRequest\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\x03R\tcontentId\x12\x1b\n" +
+	"\tauthor_id\x18\x02 \x01(\x03R\bauthorId\"\xc1\x01\n" +
 	"\fFeedResponse\x12\x1d\n" +
 	"\n" +
 	"content_id\x18\x01 \x01(\x03R\tcontentId\x12\x1b\n" +
 	"\tmedia_url\x18\x02 \x01(\tR\bmediaUrl\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\".\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12:\n" +
+	"\treactions\x18\x04 \x01(\v2\x1c.api.v1.feed.ReactionSummaryR\treactions\x12\x1f\n" +
+	"\vshare_count\x18\x05 \x01(\x03R\n" +
+	"shareCount\".\n" +
 	"\x12FeedStatusResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\"\xaa\x01\n" +
 	"\rMediaResponse\x12 \n" +
@@ -1142,22 +5736,64 @@ const file_api_v1_feed_proto_rawDesc = "" +
 	"\ftext_content\x18\x04 \x01(\tR\vtextContent\x12\x1b\n" +
 	"\tmedia_url\x18\x05 \x01(\tR\bmediaUrl\x12\x18\n" +
 	"\aprivacy\x18\x06 \x01(\tR\aprivacy\x12\x1c\n" +
-	"\ttimestamp\x18\a \x01(\tR\ttimestamp2\xbb\x06\n" +
+	"\ttimestamp\x18\a \x01(\tR\ttimestamp2\x96!\n" +
 	"\vFeedService\x12G\n" +
 	"\n" +
 	"CreatePost\x12\x1e.api.v1.feed.CreatePostRequest\x1a\x19.api.v1.feed.FeedResponse\x12G\n" +
 	"\n" +
 	"CreateReel\x12\x1e.api.v1.feed.CreateReelRequest\x1a\x19.api.v1.feed.FeedResponse\x12I\n" +
-	"\vCreateStory\x12\x1f.api.v1.feed.CreateStoryRequest\x1a\x19.api.v1.feed.FeedResponse\x12O\n" +
+	"\vCreateStory\x12\x1f.api.v1.feed.CreateStoryRequest\x1a\x19.api.v1.feed.FeedResponse\x12K\n" +
+	"\vUploadMedia\x12\x18.api.v1.feed.UploadChunk\x1a .api.v1.feed.UploadMediaResponse(\x01\x12O\n" +
 	"\x0eReactToContent\x12\x1c.api.v1.feed.ReactionRequest\x1a\x1f.api.v1.feed.FeedStatusResponse\x12A\n" +
 	"\fGetReactions\x12\x16.api.v1.feed.ContentID\x1a\x19.api.v1.feed.ReactionList\x12U\n" +
-	"\x0eDeleteReaction\x12\".api.v1.feed.DeleteReactionRequest\x1a\x1f.api.v1.feed.FeedStatusResponse\x12A\n" +
-	"\vGetTimeline\x12\x13.api.v1.feed.UserID\x1a\x1d.api.v1.feed.TimelineResponse\x12S\n" +
+	"\x0eDeleteReaction\x12\".api.v1.feed.DeleteReactionRequest\x1a\x1f.api.v1.feed.FeedStatusResponse\x12J\n" +
+	"\fListReactors\x12 .api.v1.feed.ListReactorsRequest\x1a\x18.api.v1.feed.ReactorList\x12M\n" +
+	"\vGetTimeline\x12\x1f.api.v1.feed.GetTimelineRequest\x1a\x1d.api.v1.feed.TimelineResponse\x12S\n" +
 	"\x0eGetUserContent\x12\".api.v1.feed.GetUserContentRequest\x1a\x1d.api.v1.feed.TimelineResponse\x12A\n" +
 	"\vGetMediaRef\x12\x16.api.v1.feed.ContentID\x1a\x1a.api.v1.feed.MediaResponse\x12?\n" +
 	"\n" +
 	"GetContent\x12\x16.api.v1.feed.ContentID\x1a\x19.api.v1.feed.FeedResponse\x12H\n" +
-	"\rDeleteContent\x12\x16.api.v1.feed.ContentID\x1a\x1f.api.v1.feed.FeedStatusResponseB\x12Z\x10api/v1/feed;feedb\x06proto3"
+	"\rDeleteContent\x12\x16.api.v1.feed.ContentID\x1a\x1f.api.v1.feed.FeedStatusResponse\x12a\n" +
+	"\x14UpdateContentPrivacy\x12(.api.v1.feed.UpdateContentPrivacyRequest\x1a\x1f.api.v1.feed.FeedStatusResponse\x12P\n" +
+	"\rUpdateContent\x12!.api.v1.feed.UpdateContentRequest\x1a\x1c.api.v1.feed.ContentResponse\x12M\n" +
+	"\rListRevisions\x12!.api.v1.feed.ListRevisionsRequest\x1a\x19.api.v1.feed.RevisionList\x12U\n" +
+	"\x12FriendshipAccepted\x12\x1e.api.v1.feed.FriendshipRequest\x1a\x1f.api.v1.feed.FeedStatusResponse\x12J\n" +
+	"\n" +
+	"AddComment\x12\x1e.api.v1.feed.AddCommentRequest\x1a\x1c.api.v1.feed.CommentResponse\x12J\n" +
+	"\fListComments\x12 .api.v1.feed.ListCommentsRequest\x1a\x18.api.v1.feed.CommentList\x12L\n" +
+	"\vEditComment\x12\x1f.api.v1.feed.EditCommentRequest\x1a\x1c.api.v1.feed.CommentResponse\x12S\n" +
+	"\rDeleteComment\x12!.api.v1.feed.DeleteCommentRequest\x1a\x1f.api.v1.feed.FeedStatusResponse\x12Q\n" +
+	"\x0fMarkStoryViewed\x12\x1d.api.v1.feed.StoryViewRequest\x1a\x1f.api.v1.feed.FeedStatusResponse\x12V\n" +
+	"\x10ListStoryViewers\x12$.api.v1.feed.ListStoryViewersRequest\x1a\x1c.api.v1.feed.StoryViewerList\x12W\n" +
+	"\x10ListStoryArchive\x12$.api.v1.feed.ListStoryArchiveRequest\x1a\x1d.api.v1.feed.TimelineResponse\x12V\n" +
+	"\x0fCreateHighlight\x12#.api.v1.feed.CreateHighlightRequest\x1a\x1e.api.v1.feed.HighlightResponse\x12V\n" +
+	"\x0fUpdateHighlight\x12#.api.v1.feed.UpdateHighlightRequest\x1a\x1e.api.v1.feed.HighlightResponse\x12W\n" +
+	"\x0fDeleteHighlight\x12#.api.v1.feed.DeleteHighlightRequest\x1a\x1f.api.v1.feed.FeedStatusResponse\x12[\n" +
+	"\x11ReorderHighlights\x12%.api.v1.feed.ReorderHighlightsRequest\x1a\x1f.api.v1.feed.FeedStatusResponse\x12P\n" +
+	"\x0eGetHashtagFeed\x12\x1f.api.v1.feed.HashtagFeedRequest\x1a\x1d.api.v1.feed.TimelineResponse\x12]\n" +
+	"\x13GetTrendingHashtags\x12$.api.v1.feed.TrendingHashtagsRequest\x1a .api.v1.feed.TrendingHashtagList\x12R\n" +
+	"\x0fGetMentionsFeed\x12 .api.v1.feed.MentionsFeedRequest\x1a\x1d.api.v1.feed.TimelineResponse\x12K\n" +
+	"\fShareContent\x12 .api.v1.feed.ShareContentRequest\x1a\x19.api.v1.feed.FeedResponse\x12O\n" +
+	"\vSaveContent\x12\x1f.api.v1.feed.SaveContentRequest\x1a\x1f.api.v1.feed.CollectionResponse\x12S\n" +
+	"\rUnsaveContent\x12!.api.v1.feed.UnsaveContentRequest\x1a\x1f.api.v1.feed.FeedStatusResponse\x12C\n" +
+	"\x0fListCollections\x12\x13.api.v1.feed.UserID\x1a\x1b.api.v1.feed.CollectionList\x12W\n" +
+	"\x10ListSavedContent\x12$.api.v1.feed.ListSavedContentRequest\x1a\x1d.api.v1.feed.SavedContentList\x12_\n" +
+	"\x12CreateAudienceList\x12&.api.v1.feed.CreateAudienceListRequest\x1a!.api.v1.feed.AudienceListResponse\x12G\n" +
+	"\x11ListAudienceLists\x12\x13.api.v1.feed.UserID\x1a\x1d.api.v1.feed.AudienceListList\x12b\n" +
+	"\x16AddAudienceListMembers\x12'.api.v1.feed.AudienceListMembersRequest\x1a\x1f.api.v1.feed.FeedStatusResponse\x12e\n" +
+	"\x19RemoveAudienceListMembers\x12'.api.v1.feed.AudienceListMembersRequest\x1a\x1f.api.v1.feed.FeedStatusResponse\x12]\n" +
+	"\x12DeleteAudienceList\x12&.api.v1.feed.DeleteAudienceListRequest\x1a\x1f.api.v1.feed.FeedStatusResponse\x12O\n" +
+	"\x0eMarkReelViewed\x12\x1c.api.v1.feed.ReelViewRequest\x1a\x1f.api.v1.feed.FeedStatusResponse\x12R\n" +
+	"\x0fGetExploreReels\x12 .api.v1.feed.ExploreReelsRequest\x1a\x1d.api.v1.feed.TimelineResponse\x12_\n" +
+	"\x14ListScheduledContent\x12(.api.v1.feed.ListScheduledContentRequest\x1a\x1d.api.v1.feed.TimelineResponse\x12X\n" +
+	"\x11RescheduleContent\x12%.api.v1.feed.RescheduleContentRequest\x1a\x1c.api.v1.feed.ContentResponse\x12e\n" +
+	"\x16CancelScheduledContent\x12*.api.v1.feed.CancelScheduledContentRequest\x1a\x1f.api.v1.feed.FeedStatusResponse\x12H\n" +
+	"\tSaveDraft\x12\x1d.api.v1.feed.SaveDraftRequest\x1a\x1c.api.v1.feed.ContentResponse\x12K\n" +
+	"\n" +
+	"ListDrafts\x12\x1e.api.v1.feed.ListDraftsRequest\x1a\x1d.api.v1.feed.TimelineResponse\x12L\n" +
+	"\vUpdateDraft\x12\x1f.api.v1.feed.UpdateDraftRequest\x1a\x1c.api.v1.feed.ContentResponse\x12K\n" +
+	"\fPublishDraft\x12 .api.v1.feed.PublishDraftRequest\x1a\x19.api.v1.feed.FeedResponse\x12Q\n" +
+	"\fDiscardDraft\x12 .api.v1.feed.DiscardDraftRequest\x1a\x1f.api.v1.feed.FeedStatusResponseB\x12Z\x10api/v1/feed;feedb\x06proto3"
 
 var (
 	file_api_v1_feed_proto_rawDescOnce sync.Once
@@ -1171,59 +5807,244 @@ func file_api_v1_feed_proto_rawDescGZIP() []byte {
 	return file_api_v1_feed_proto_rawDescData
 }
 
-var file_api_v1_feed_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_api_v1_feed_proto_msgTypes = make([]protoimpl.MessageInfo, 83)
 var file_api_v1_feed_proto_goTypes = []any{
-	(*UserID)(nil),                // 0: api.v1.feed.UserID
-	(*ContentID)(nil),             // 1: api.v1.feed.ContentID
-	(*GetUserContentRequest)(nil), // 2: api.v1.feed.GetUserContentRequest
-	(*CreatePostRequest)(nil),     // 3: api.v1.feed.CreatePostRequest
-	(*CreateReelRequest)(nil),     // 4: api.v1.feed.CreateReelRequest
-	(*CreateStoryRequest)(nil),    // 5: api.v1.feed.CreateStoryRequest
-	(*ReactionRequest)(nil),       // 6: api.v1.feed.ReactionRequest
-	(*DeleteReactionRequest)(nil), // 7: api.v1.feed.DeleteReactionRequest
-	(*Reaction)(nil),              // 8: api.v1.feed.Reaction
-	(*ReactionList)(nil),          // 9: api.v1.feed.ReactionList
-	(*TimelineContent)(nil),       // 10: api.v1.feed.TimelineContent
-	(*TimelineResponse)(nil),      // 11: api.v1.feed.TimelineResponse
-	(*FeedResponse)(nil),          // 12: api.v1.feed.FeedResponse
-	(*FeedStatusResponse)(nil),    // 13: api.v1.feed.FeedStatusResponse
-	(*MediaResponse)(nil),         // 14: api.v1.feed.MediaResponse
-	(*Content)(nil),               // 15: api.v1.feed.Content
-	(*timestamppb.Timestamp)(nil), // 16: google.protobuf.Timestamp
+	(*UserID)(nil),                        // 0: api.v1.feed.UserID
+	(*ContentID)(nil),                     // 1: api.v1.feed.ContentID
+	(*GetTimelineRequest)(nil),            // 2: api.v1.feed.GetTimelineRequest
+	(*GetUserContentRequest)(nil),         // 3: api.v1.feed.GetUserContentRequest
+	(*UpdateContentPrivacyRequest)(nil),   // 4: api.v1.feed.UpdateContentPrivacyRequest
+	(*UpdateContentRequest)(nil),          // 5: api.v1.feed.UpdateContentRequest
+	(*ContentResponse)(nil),               // 6: api.v1.feed.ContentResponse
+	(*ListRevisionsRequest)(nil),          // 7: api.v1.feed.ListRevisionsRequest
+	(*Revision)(nil),                      // 8: api.v1.feed.Revision
+	(*RevisionList)(nil),                  // 9: api.v1.feed.RevisionList
+	(*FriendshipRequest)(nil),             // 10: api.v1.feed.FriendshipRequest
+	(*CreatePostRequest)(nil),             // 11: api.v1.feed.CreatePostRequest
+	(*MediaAttachment)(nil),               // 12: api.v1.feed.MediaAttachment
+	(*CreateReelRequest)(nil),             // 13: api.v1.feed.CreateReelRequest
+	(*CreateStoryRequest)(nil),            // 14: api.v1.feed.CreateStoryRequest
+	(*UploadChunk)(nil),                   // 15: api.v1.feed.UploadChunk
+	(*UploadMediaResponse)(nil),           // 16: api.v1.feed.UploadMediaResponse
+	(*ReactionRequest)(nil),               // 17: api.v1.feed.ReactionRequest
+	(*DeleteReactionRequest)(nil),         // 18: api.v1.feed.DeleteReactionRequest
+	(*Reaction)(nil),                      // 19: api.v1.feed.Reaction
+	(*ReactionList)(nil),                  // 20: api.v1.feed.ReactionList
+	(*ReactionSummary)(nil),               // 21: api.v1.feed.ReactionSummary
+	(*ListReactorsRequest)(nil),           // 22: api.v1.feed.ListReactorsRequest
+	(*Reactor)(nil),                       // 23: api.v1.feed.Reactor
+	(*ReactorList)(nil),                   // 24: api.v1.feed.ReactorList
+	(*TimelineContent)(nil),               // 25: api.v1.feed.TimelineContent
+	(*MediaItem)(nil),                     // 26: api.v1.feed.MediaItem
+	(*SharedContent)(nil),                 // 27: api.v1.feed.SharedContent
+	(*ShareContentRequest)(nil),           // 28: api.v1.feed.ShareContentRequest
+	(*Mention)(nil),                       // 29: api.v1.feed.Mention
+	(*TimelineResponse)(nil),              // 30: api.v1.feed.TimelineResponse
+	(*AddCommentRequest)(nil),             // 31: api.v1.feed.AddCommentRequest
+	(*ListCommentsRequest)(nil),           // 32: api.v1.feed.ListCommentsRequest
+	(*EditCommentRequest)(nil),            // 33: api.v1.feed.EditCommentRequest
+	(*DeleteCommentRequest)(nil),          // 34: api.v1.feed.DeleteCommentRequest
+	(*Comment)(nil),                       // 35: api.v1.feed.Comment
+	(*CommentResponse)(nil),               // 36: api.v1.feed.CommentResponse
+	(*CommentList)(nil),                   // 37: api.v1.feed.CommentList
+	(*StoryViewRequest)(nil),              // 38: api.v1.feed.StoryViewRequest
+	(*ListStoryViewersRequest)(nil),       // 39: api.v1.feed.ListStoryViewersRequest
+	(*StoryViewer)(nil),                   // 40: api.v1.feed.StoryViewer
+	(*StoryViewerList)(nil),               // 41: api.v1.feed.StoryViewerList
+	(*ListStoryArchiveRequest)(nil),       // 42: api.v1.feed.ListStoryArchiveRequest
+	(*CreateHighlightRequest)(nil),        // 43: api.v1.feed.CreateHighlightRequest
+	(*UpdateHighlightRequest)(nil),        // 44: api.v1.feed.UpdateHighlightRequest
+	(*DeleteHighlightRequest)(nil),        // 45: api.v1.feed.DeleteHighlightRequest
+	(*ReorderHighlightsRequest)(nil),      // 46: api.v1.feed.ReorderHighlightsRequest
+	(*Highlight)(nil),                     // 47: api.v1.feed.Highlight
+	(*HighlightResponse)(nil),             // 48: api.v1.feed.HighlightResponse
+	(*HashtagFeedRequest)(nil),            // 49: api.v1.feed.HashtagFeedRequest
+	(*TrendingHashtagsRequest)(nil),       // 50: api.v1.feed.TrendingHashtagsRequest
+	(*TrendingHashtag)(nil),               // 51: api.v1.feed.TrendingHashtag
+	(*MentionsFeedRequest)(nil),           // 52: api.v1.feed.MentionsFeedRequest
+	(*TrendingHashtagList)(nil),           // 53: api.v1.feed.TrendingHashtagList
+	(*SaveContentRequest)(nil),            // 54: api.v1.feed.SaveContentRequest
+	(*UnsaveContentRequest)(nil),          // 55: api.v1.feed.UnsaveContentRequest
+	(*ListSavedContentRequest)(nil),       // 56: api.v1.feed.ListSavedContentRequest
+	(*Collection)(nil),                    // 57: api.v1.feed.Collection
+	(*CollectionResponse)(nil),            // 58: api.v1.feed.CollectionResponse
+	(*CollectionList)(nil),                // 59: api.v1.feed.CollectionList
+	(*SavedItem)(nil),                     // 60: api.v1.feed.SavedItem
+	(*SavedContentList)(nil),              // 61: api.v1.feed.SavedContentList
+	(*CreateAudienceListRequest)(nil),     // 62: api.v1.feed.CreateAudienceListRequest
+	(*AudienceListMembersRequest)(nil),    // 63: api.v1.feed.AudienceListMembersRequest
+	(*DeleteAudienceListRequest)(nil),     // 64: api.v1.feed.DeleteAudienceListRequest
+	(*AudienceList)(nil),                  // 65: api.v1.feed.AudienceList
+	(*AudienceListResponse)(nil),          // 66: api.v1.feed.AudienceListResponse
+	(*AudienceListList)(nil),              // 67: api.v1.feed.AudienceListList
+	(*ReelViewRequest)(nil),               // 68: api.v1.feed.ReelViewRequest
+	(*ExploreReelsRequest)(nil),           // 69: api.v1.feed.ExploreReelsRequest
+	(*ListScheduledContentRequest)(nil),   // 70: api.v1.feed.ListScheduledContentRequest
+	(*RescheduleContentRequest)(nil),      // 71: api.v1.feed.RescheduleContentRequest
+	(*CancelScheduledContentRequest)(nil), // 72: api.v1.feed.CancelScheduledContentRequest
+	(*SaveDraftRequest)(nil),              // 73: api.v1.feed.SaveDraftRequest
+	(*ListDraftsRequest)(nil),             // 74: api.v1.feed.ListDraftsRequest
+	(*UpdateDraftRequest)(nil),            // 75: api.v1.feed.UpdateDraftRequest
+	(*PublishDraftRequest)(nil),           // 76: api.v1.feed.PublishDraftRequest
+	(*DiscardDraftRequest)(nil),           // 77: api.v1.feed.DiscardDraftRequest
+	(*FeedResponse)(nil),                  // 78: api.v1.feed.FeedResponse
+	(*FeedStatusResponse)(nil),            // 79: api.v1.feed.FeedStatusResponse
+	(*MediaResponse)(nil),                 // 80: api.v1.feed.MediaResponse
+	(*Content)(nil),                       // 81: api.v1.feed.Content
+	nil,                                   // 82: api.v1.feed.ReactionSummary.CountsEntry
+	(*timestamppb.Timestamp)(nil),         // 83: google.protobuf.Timestamp
 }
 var file_api_v1_feed_proto_depIdxs = []int32{
-	16, // 0: api.v1.feed.Reaction.created_at:type_name -> google.protobuf.Timestamp
-	8,  // 1: api.v1.feed.ReactionList.reactions:type_name -> api.v1.feed.Reaction
-	16, // 2: api.v1.feed.TimelineContent.created_at:type_name -> google.protobuf.Timestamp
-	10, // 3: api.v1.feed.TimelineResponse.contents:type_name -> api.v1.feed.TimelineContent
-	16, // 4: api.v1.feed.MediaResponse.uploaded_at:type_name -> google.protobuf.Timestamp
-	3,  // 5: api.v1.feed.FeedService.CreatePost:input_type -> api.v1.feed.CreatePostRequest
-	4,  // 6: api.v1.feed.FeedService.CreateReel:input_type -> api.v1.feed.CreateReelRequest
-	5,  // 7: api.v1.feed.FeedService.CreateStory:input_type -> api.v1.feed.CreateStoryRequest
-	6,  // 8: api.v1.feed.FeedService.ReactToContent:input_type -> api.v1.feed.ReactionRequest
-	1,  // 9: api.v1.feed.FeedService.GetReactions:input_type -> api.v1.feed.ContentID
-	7,  // 10: api.v1.feed.FeedService.DeleteReaction:input_type -> api.v1.feed.DeleteReactionRequest
-	0,  // 11: api.v1.feed.FeedService.GetTimeline:input_type -> api.v1.feed.UserID
-	2,  // 12: api.v1.feed.FeedService.GetUserContent:input_type -> api.v1.feed.GetUserContentRequest
-	1,  // 13: api.v1.feed.FeedService.GetMediaRef:input_type -> api.v1.feed.ContentID
-	1,  // 14: api.v1.feed.FeedService.GetContent:input_type -> api.v1.feed.ContentID
-	1,  // 15: api.v1.feed.FeedService.DeleteContent:input_type -> api.v1.feed.ContentID
-	12, // 16: api.v1.feed.FeedService.CreatePost:output_type -> api.v1.feed.FeedResponse
-	12, // 17: api.v1.feed.FeedService.CreateReel:output_type -> api.v1.feed.FeedResponse
-	12, // 18: api.v1.feed.FeedService.CreateStory:output_type -> api.v1.feed.FeedResponse
-	13, // 19: api.v1.feed.FeedService.ReactToContent:output_type -> api.v1.feed.FeedStatusResponse
-	9,  // 20: api.v1.feed.FeedService.GetReactions:output_type -> api.v1.feed.ReactionList
-	13, // 21: api.v1.feed.FeedService.DeleteReaction:output_type -> api.v1.feed.FeedStatusResponse
-	11, // 22: api.v1.feed.FeedService.GetTimeline:output_type -> api.v1.feed.TimelineResponse
-	11, // 23: api.v1.feed.FeedService.GetUserContent:output_type -> api.v1.feed.TimelineResponse
-	14, // 24: api.v1.feed.FeedService.GetMediaRef:output_type -> api.v1.feed.MediaResponse
-	12, // 25: api.v1.feed.FeedService.GetContent:output_type -> api.v1.feed.FeedResponse
-	13, // 26: api.v1.feed.FeedService.DeleteContent:output_type -> api.v1.feed.FeedStatusResponse
-	16, // [16:27] is the sub-list for method output_type
-	5,  // [5:16] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	25, // 0: api.v1.feed.ContentResponse.content:type_name -> api.v1.feed.TimelineContent
+	83, // 1: api.v1.feed.Revision.replaced_at:type_name -> google.protobuf.Timestamp
+	8,  // 2: api.v1.feed.RevisionList.revisions:type_name -> api.v1.feed.Revision
+	12, // 3: api.v1.feed.CreatePostRequest.attachments:type_name -> api.v1.feed.MediaAttachment
+	83, // 4: api.v1.feed.CreatePostRequest.publish_at:type_name -> google.protobuf.Timestamp
+	83, // 5: api.v1.feed.CreateReelRequest.publish_at:type_name -> google.protobuf.Timestamp
+	83, // 6: api.v1.feed.Reaction.created_at:type_name -> google.protobuf.Timestamp
+	19, // 7: api.v1.feed.ReactionList.reactions:type_name -> api.v1.feed.Reaction
+	82, // 8: api.v1.feed.ReactionSummary.counts:type_name -> api.v1.feed.ReactionSummary.CountsEntry
+	83, // 9: api.v1.feed.Reactor.reacted_at:type_name -> google.protobuf.Timestamp
+	23, // 10: api.v1.feed.ReactorList.reactors:type_name -> api.v1.feed.Reactor
+	83, // 11: api.v1.feed.TimelineContent.created_at:type_name -> google.protobuf.Timestamp
+	21, // 12: api.v1.feed.TimelineContent.reactions:type_name -> api.v1.feed.ReactionSummary
+	29, // 13: api.v1.feed.TimelineContent.mentions:type_name -> api.v1.feed.Mention
+	27, // 14: api.v1.feed.TimelineContent.shared:type_name -> api.v1.feed.SharedContent
+	83, // 15: api.v1.feed.TimelineContent.edited_at:type_name -> google.protobuf.Timestamp
+	26, // 16: api.v1.feed.TimelineContent.media:type_name -> api.v1.feed.MediaItem
+	25, // 17: api.v1.feed.SharedContent.content:type_name -> api.v1.feed.TimelineContent
+	25, // 18: api.v1.feed.TimelineResponse.contents:type_name -> api.v1.feed.TimelineContent
+	47, // 19: api.v1.feed.TimelineResponse.highlights:type_name -> api.v1.feed.Highlight
+	83, // 20: api.v1.feed.Comment.created_at:type_name -> google.protobuf.Timestamp
+	83, // 21: api.v1.feed.Comment.edited_at:type_name -> google.protobuf.Timestamp
+	35, // 22: api.v1.feed.CommentResponse.comment:type_name -> api.v1.feed.Comment
+	35, // 23: api.v1.feed.CommentList.comments:type_name -> api.v1.feed.Comment
+	83, // 24: api.v1.feed.StoryViewer.viewed_at:type_name -> google.protobuf.Timestamp
+	40, // 25: api.v1.feed.StoryViewerList.viewers:type_name -> api.v1.feed.StoryViewer
+	25, // 26: api.v1.feed.Highlight.stories:type_name -> api.v1.feed.TimelineContent
+	83, // 27: api.v1.feed.Highlight.created_at:type_name -> google.protobuf.Timestamp
+	47, // 28: api.v1.feed.HighlightResponse.highlight:type_name -> api.v1.feed.Highlight
+	51, // 29: api.v1.feed.TrendingHashtagList.hashtags:type_name -> api.v1.feed.TrendingHashtag
+	83, // 30: api.v1.feed.Collection.created_at:type_name -> google.protobuf.Timestamp
+	57, // 31: api.v1.feed.CollectionResponse.collection:type_name -> api.v1.feed.Collection
+	57, // 32: api.v1.feed.CollectionList.collections:type_name -> api.v1.feed.Collection
+	83, // 33: api.v1.feed.SavedItem.saved_at:type_name -> google.protobuf.Timestamp
+	25, // 34: api.v1.feed.SavedItem.content:type_name -> api.v1.feed.TimelineContent
+	60, // 35: api.v1.feed.SavedContentList.items:type_name -> api.v1.feed.SavedItem
+	83, // 36: api.v1.feed.AudienceList.created_at:type_name -> google.protobuf.Timestamp
+	65, // 37: api.v1.feed.AudienceListResponse.list:type_name -> api.v1.feed.AudienceList
+	65, // 38: api.v1.feed.AudienceListList.lists:type_name -> api.v1.feed.AudienceList
+	83, // 39: api.v1.feed.RescheduleContentRequest.publish_at:type_name -> google.protobuf.Timestamp
+	83, // 40: api.v1.feed.PublishDraftRequest.publish_at:type_name -> google.protobuf.Timestamp
+	21, // 41: api.v1.feed.FeedResponse.reactions:type_name -> api.v1.feed.ReactionSummary
+	83, // 42: api.v1.feed.MediaResponse.uploaded_at:type_name -> google.protobuf.Timestamp
+	11, // 43: api.v1.feed.FeedService.CreatePost:input_type -> api.v1.feed.CreatePostRequest
+	13, // 44: api.v1.feed.FeedService.CreateReel:input_type -> api.v1.feed.CreateReelRequest
+	14, // 45: api.v1.feed.FeedService.CreateStory:input_type -> api.v1.feed.CreateStoryRequest
+	15, // 46: api.v1.feed.FeedService.UploadMedia:input_type -> api.v1.feed.UploadChunk
+	17, // 47: api.v1.feed.FeedService.ReactToContent:input_type -> api.v1.feed.ReactionRequest
+	1,  // 48: api.v1.feed.FeedService.GetReactions:input_type -> api.v1.feed.ContentID
+	18, // 49: api.v1.feed.FeedService.DeleteReaction:input_type -> api.v1.feed.DeleteReactionRequest
+	22, // 50: api.v1.feed.FeedService.ListReactors:input_type -> api.v1.feed.ListReactorsRequest
+	2,  // 51: api.v1.feed.FeedService.GetTimeline:input_type -> api.v1.feed.GetTimelineRequest
+	3,  // 52: api.v1.feed.FeedService.GetUserContent:input_type -> api.v1.feed.GetUserContentRequest
+	1,  // 53: api.v1.feed.FeedService.GetMediaRef:input_type -> api.v1.feed.ContentID
+	1,  // 54: api.v1.feed.FeedService.GetContent:input_type -> api.v1.feed.ContentID
+	1,  // 55: api.v1.feed.FeedService.DeleteContent:input_type -> api.v1.feed.ContentID
+	4,  // 56: api.v1.feed.FeedService.UpdateContentPrivacy:input_type -> api.v1.feed.UpdateContentPrivacyRequest
+	5,  // 57: api.v1.feed.FeedService.UpdateContent:input_type -> api.v1.feed.UpdateContentRequest
+	7,  // 58: api.v1.feed.FeedService.ListRevisions:input_type -> api.v1.feed.ListRevisionsRequest
+	10, // 59: api.v1.feed.FeedService.FriendshipAccepted:input_type -> api.v1.feed.FriendshipRequest
+	31, // 60: api.v1.feed.FeedService.AddComment:input_type -> api.v1.feed.AddCommentRequest
+	32, // 61: api.v1.feed.FeedService.ListComments:input_type -> api.v1.feed.ListCommentsRequest
+	33, // 62: api.v1.feed.FeedService.EditComment:input_type -> api.v1.feed.EditCommentRequest
+	34, // 63: api.v1.feed.FeedService.DeleteComment:input_type -> api.v1.feed.DeleteCommentRequest
+	38, // 64: api.v1.feed.FeedService.MarkStoryViewed:input_type -> api.v1.feed.StoryViewRequest
+	39, // 65: api.v1.feed.FeedService.ListStoryViewers:input_type -> api.v1.feed.ListStoryViewersRequest
+	42, // 66: api.v1.feed.FeedService.ListStoryArchive:input_type -> api.v1.feed.ListStoryArchiveRequest
+	43, // 67: api.v1.feed.FeedService.CreateHighlight:input_type -> api.v1.feed.CreateHighlightRequest
+	44, // 68: api.v1.feed.FeedService.UpdateHighlight:input_type -> api.v1.feed.UpdateHighlightRequest
+	45, // 69: api.v1.feed.FeedService.DeleteHighlight:input_type -> api.v1.feed.DeleteHighlightRequest
+	46, // 70: api.v1.feed.FeedService.ReorderHighlights:input_type -> api.v1.feed.ReorderHighlightsRequest
+	49, // 71: api.v1.feed.FeedService.GetHashtagFeed:input_type -> api.v1.feed.HashtagFeedRequest
+	50, // 72: api.v1.feed.FeedService.GetTrendingHashtags:input_type -> api.v1.feed.TrendingHashtagsRequest
+	52, // 73: api.v1.feed.FeedService.GetMentionsFeed:input_type -> api.v1.feed.MentionsFeedRequest
+	28, // 74: api.v1.feed.FeedService.ShareContent:input_type -> api.v1.feed.ShareContentRequest
+	54, // 75: api.v1.feed.FeedService.SaveContent:input_type -> api.v1.feed.SaveContentRequest
+	55, // 76: api.v1.feed.FeedService.UnsaveContent:input_type -> api.v1.feed.UnsaveContentRequest
+	0,  // 77: api.v1.feed.FeedService.ListCollections:input_type -> api.v1.feed.UserID
+	56, // 78: api.v1.feed.FeedService.ListSavedContent:input_type -> api.v1.feed.ListSavedContentRequest
+	62, // 79: api.v1.feed.FeedService.CreateAudienceList:input_type -> api.v1.feed.CreateAudienceListRequest
+	0,  // 80: api.v1.feed.FeedService.ListAudienceLists:input_type -> api.v1.feed.UserID
+	63, // 81: api.v1.feed.FeedService.AddAudienceListMembers:input_type -> api.v1.feed.AudienceListMembersRequest
+	63, // 82: api.v1.feed.FeedService.RemoveAudienceListMembers:input_type -> api.v1.feed.AudienceListMembersRequest
+	64, // 83: api.v1.feed.FeedService.DeleteAudienceList:input_type -> api.v1.feed.DeleteAudienceListRequest
+	68, // 84: api.v1.feed.FeedService.MarkReelViewed:input_type -> api.v1.feed.ReelViewRequest
+	69, // 85: api.v1.feed.FeedService.GetExploreReels:input_type -> api.v1.feed.ExploreReelsRequest
+	70, // 86: api.v1.feed.FeedService.ListScheduledContent:input_type -> api.v1.feed.ListScheduledContentRequest
+	71, // 87: api.v1.feed.FeedService.RescheduleContent:input_type -> api.v1.feed.RescheduleContentRequest
+	72, // 88: api.v1.feed.FeedService.CancelScheduledContent:input_type -> api.v1.feed.CancelScheduledContentRequest
+	73, // 89: api.v1.feed.FeedService.SaveDraft:input_type -> api.v1.feed.SaveDraftRequest
+	74, // 90: api.v1.feed.FeedService.ListDrafts:input_type -> api.v1.feed.ListDraftsRequest
+	75, // 91: api.v1.feed.FeedService.UpdateDraft:input_type -> api.v1.feed.UpdateDraftRequest
+	76, // 92: api.v1.feed.FeedService.PublishDraft:input_type -> api.v1.feed.PublishDraftRequest
+	77, // 93: api.v1.feed.FeedService.DiscardDraft:input_type -> api.v1.feed.DiscardDraftRequest
+	78, // 94: api.v1.feed.FeedService.CreatePost:output_type -> api.v1.feed.FeedResponse
+	78, // 95: api.v1.feed.FeedService.CreateReel:output_type -> api.v1.feed.FeedResponse
+	78, // 96: api.v1.feed.FeedService.CreateStory:output_type -> api.v1.feed.FeedResponse
+	16, // 97: api.v1.feed.FeedService.UploadMedia:output_type -> api.v1.feed.UploadMediaResponse
+	79, // 98: api.v1.feed.FeedService.ReactToContent:output_type -> api.v1.feed.FeedStatusResponse
+	20, // 99: api.v1.feed.FeedService.GetReactions:output_type -> api.v1.feed.ReactionList
+	79, // 100: api.v1.feed.FeedService.DeleteReaction:output_type -> api.v1.feed.FeedStatusResponse
+	24, // 101: api.v1.feed.FeedService.ListReactors:output_type -> api.v1.feed.ReactorList
+	30, // 102: api.v1.feed.FeedService.GetTimeline:output_type -> api.v1.feed.TimelineResponse
+	30, // 103: api.v1.feed.FeedService.GetUserContent:output_type -> api.v1.feed.TimelineResponse
+	80, // 104: api.v1.feed.FeedService.GetMediaRef:output_type -> api.v1.feed.MediaResponse
+	78, // 105: api.v1.feed.FeedService.GetContent:output_type -> api.v1.feed.FeedResponse
+	79, // 106: api.v1.feed.FeedService.DeleteContent:output_type -> api.v1.feed.FeedStatusResponse
+	79, // 107: api.v1.feed.FeedService.UpdateContentPrivacy:output_type -> api.v1.feed.FeedStatusResponse
+	6,  // 108: api.v1.feed.FeedService.UpdateContent:output_type -> api.v1.feed.ContentResponse
+	9,  // 109: api.v1.feed.FeedService.ListRevisions:output_type -> api.v1.feed.RevisionList
+	79, // 110: api.v1.feed.FeedService.FriendshipAccepted:output_type -> api.v1.feed.FeedStatusResponse
+	36, // 111: api.v1.feed.FeedService.AddComment:output_type -> api.v1.feed.CommentResponse
+	37, // 112: api.v1.feed.FeedService.ListComments:output_type -> api.v1.feed.CommentList
+	36, // 113: api.v1.feed.FeedService.EditComment:output_type -> api.v1.feed.CommentResponse
+	79, // 114: api.v1.feed.FeedService.DeleteComment:output_type -> api.v1.feed.FeedStatusResponse
+	79, // 115: api.v1.feed.FeedService.MarkStoryViewed:output_type -> api.v1.feed.FeedStatusResponse
+	41, // 116: api.v1.feed.FeedService.ListStoryViewers:output_type -> api.v1.feed.StoryViewerList
+	30, // 117: api.v1.feed.FeedService.ListStoryArchive:output_type -> api.v1.feed.TimelineResponse
+	48, // 118: api.v1.feed.FeedService.CreateHighlight:output_type -> api.v1.feed.HighlightResponse
+	48, // 119: api.v1.feed.FeedService.UpdateHighlight:output_type -> api.v1.feed.HighlightResponse
+	79, // 120: api.v1.feed.FeedService.DeleteHighlight:output_type -> api.v1.feed.FeedStatusResponse
+	79, // 121: api.v1.feed.FeedService.ReorderHighlights:output_type -> api.v1.feed.FeedStatusResponse
+	30, // 122: api.v1.feed.FeedService.GetHashtagFeed:output_type -> api.v1.feed.TimelineResponse
+	53, // 123: api.v1.feed.FeedService.GetTrendingHashtags:output_type -> api.v1.feed.TrendingHashtagList
+	30, // 124: api.v1.feed.FeedService.GetMentionsFeed:output_type -> api.v1.feed.TimelineResponse
+	78, // 125: api.v1.feed.FeedService.ShareContent:output_type -> api.v1.feed.FeedResponse
+	58, // 126: api.v1.feed.FeedService.SaveContent:output_type -> api.v1.feed.CollectionResponse
+	79, // 127: api.v1.feed.FeedService.UnsaveContent:output_type -> api.v1.feed.FeedStatusResponse
+	59, // 128: api.v1.feed.FeedService.ListCollections:output_type -> api.v1.feed.CollectionList
+	61, // 129: api.v1.feed.FeedService.ListSavedContent:output_type -> api.v1.feed.SavedContentList
+	66, // 130: api.v1.feed.FeedService.CreateAudienceList:output_type -> api.v1.feed.AudienceListResponse
+	67, // 131: api.v1.feed.FeedService.ListAudienceLists:output_type -> api.v1.feed.AudienceListList
+	79, // 132: api.v1.feed.FeedService.AddAudienceListMembers:output_type -> api.v1.feed.FeedStatusResponse
+	79, // 133: api.v1.feed.FeedService.RemoveAudienceListMembers:output_type -> api.v1.feed.FeedStatusResponse
+	79, // 134: api.v1.feed.FeedService.DeleteAudienceList:output_type -> api.v1.feed.FeedStatusResponse
+	79, // 135: api.v1.feed.FeedService.MarkReelViewed:output_type -> api.v1.feed.FeedStatusResponse
+	30, // 136: api.v1.feed.FeedService.GetExploreReels:output_type -> api.v1.feed.TimelineResponse
+	30, // 137: api.v1.feed.FeedService.ListScheduledContent:output_type -> api.v1.feed.TimelineResponse
+	6,  // 138: api.v1.feed.FeedService.RescheduleContent:output_type -> api.v1.feed.ContentResponse
+	79, // 139: api.v1.feed.FeedService.CancelScheduledContent:output_type -> api.v1.feed.FeedStatusResponse
+	6,  // 140: api.v1.feed.FeedService.SaveDraft:output_type -> api.v1.feed.ContentResponse
+	30, // 141: api.v1.feed.FeedService.ListDrafts:output_type -> api.v1.feed.TimelineResponse
+	6,  // 142: api.v1.feed.FeedService.UpdateDraft:output_type -> api.v1.feed.ContentResponse
+	78, // 143: api.v1.feed.FeedService.PublishDraft:output_type -> api.v1.feed.FeedResponse
+	79, // 144: api.v1.feed.FeedService.DiscardDraft:output_type -> api.v1.feed.FeedStatusResponse
+	94, // [94:145] is the sub-list for method output_type
+	43, // [43:94] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_feed_proto_init() }
@@ -1231,13 +6052,14 @@ func file_api_v1_feed_proto_init() {
 	if File_api_v1_feed_proto != nil {
 		return
 	}
+	file_api_v1_feed_proto_msgTypes[5].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_feed_proto_rawDesc), len(file_api_v1_feed_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   83,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
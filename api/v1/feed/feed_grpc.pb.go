@@ -19,17 +19,57 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	FeedService_CreatePost_FullMethodName     = "/api.v1.feed.FeedService/CreatePost"
-	FeedService_CreateReel_FullMethodName     = "/api.v1.feed.FeedService/CreateReel"
-	FeedService_CreateStory_FullMethodName    = "/api.v1.feed.FeedService/CreateStory"
-	FeedService_ReactToContent_FullMethodName = "/api.v1.feed.FeedService/ReactToContent"
-	FeedService_GetReactions_FullMethodName   = "/api.v1.feed.FeedService/GetReactions"
-	FeedService_DeleteReaction_FullMethodName = "/api.v1.feed.FeedService/DeleteReaction"
-	FeedService_GetTimeline_FullMethodName    = "/api.v1.feed.FeedService/GetTimeline"
-	FeedService_GetUserContent_FullMethodName = "/api.v1.feed.FeedService/GetUserContent"
-	FeedService_GetMediaRef_FullMethodName    = "/api.v1.feed.FeedService/GetMediaRef"
-	FeedService_GetContent_FullMethodName     = "/api.v1.feed.FeedService/GetContent"
-	FeedService_DeleteContent_FullMethodName  = "/api.v1.feed.FeedService/DeleteContent"
+	FeedService_CreatePost_FullMethodName                = "/api.v1.feed.FeedService/CreatePost"
+	FeedService_CreateReel_FullMethodName                = "/api.v1.feed.FeedService/CreateReel"
+	FeedService_CreateStory_FullMethodName               = "/api.v1.feed.FeedService/CreateStory"
+	FeedService_UploadMedia_FullMethodName               = "/api.v1.feed.FeedService/UploadMedia"
+	FeedService_ReactToContent_FullMethodName            = "/api.v1.feed.FeedService/ReactToContent"
+	FeedService_GetReactions_FullMethodName              = "/api.v1.feed.FeedService/GetReactions"
+	FeedService_DeleteReaction_FullMethodName            = "/api.v1.feed.FeedService/DeleteReaction"
+	FeedService_ListReactors_FullMethodName              = "/api.v1.feed.FeedService/ListReactors"
+	FeedService_GetTimeline_FullMethodName               = "/api.v1.feed.FeedService/GetTimeline"
+	FeedService_GetUserContent_FullMethodName            = "/api.v1.feed.FeedService/GetUserContent"
+	FeedService_GetMediaRef_FullMethodName               = "/api.v1.feed.FeedService/GetMediaRef"
+	FeedService_GetContent_FullMethodName                = "/api.v1.feed.FeedService/GetContent"
+	FeedService_DeleteContent_FullMethodName             = "/api.v1.feed.FeedService/DeleteContent"
+	FeedService_UpdateContentPrivacy_FullMethodName      = "/api.v1.feed.FeedService/UpdateContentPrivacy"
+	FeedService_UpdateContent_FullMethodName             = "/api.v1.feed.FeedService/UpdateContent"
+	FeedService_ListRevisions_FullMethodName             = "/api.v1.feed.FeedService/ListRevisions"
+	FeedService_FriendshipAccepted_FullMethodName        = "/api.v1.feed.FeedService/FriendshipAccepted"
+	FeedService_AddComment_FullMethodName                = "/api.v1.feed.FeedService/AddComment"
+	FeedService_ListComments_FullMethodName              = "/api.v1.feed.FeedService/ListComments"
+	FeedService_EditComment_FullMethodName               = "/api.v1.feed.FeedService/EditComment"
+	FeedService_DeleteComment_FullMethodName             = "/api.v1.feed.FeedService/DeleteComment"
+	FeedService_MarkStoryViewed_FullMethodName           = "/api.v1.feed.FeedService/MarkStoryViewed"
+	FeedService_ListStoryViewers_FullMethodName          = "/api.v1.feed.FeedService/ListStoryViewers"
+	FeedService_ListStoryArchive_FullMethodName          = "/api.v1.feed.FeedService/ListStoryArchive"
+	FeedService_CreateHighlight_FullMethodName           = "/api.v1.feed.FeedService/CreateHighlight"
+	FeedService_UpdateHighlight_FullMethodName           = "/api.v1.feed.FeedService/UpdateHighlight"
+	FeedService_DeleteHighlight_FullMethodName           = "/api.v1.feed.FeedService/DeleteHighlight"
+	FeedService_ReorderHighlights_FullMethodName         = "/api.v1.feed.FeedService/ReorderHighlights"
+	FeedService_GetHashtagFeed_FullMethodName            = "/api.v1.feed.FeedService/GetHashtagFeed"
+	FeedService_GetTrendingHashtags_FullMethodName       = "/api.v1.feed.FeedService/GetTrendingHashtags"
+	FeedService_GetMentionsFeed_FullMethodName           = "/api.v1.feed.FeedService/GetMentionsFeed"
+	FeedService_ShareContent_FullMethodName              = "/api.v1.feed.FeedService/ShareContent"
+	FeedService_SaveContent_FullMethodName               = "/api.v1.feed.FeedService/SaveContent"
+	FeedService_UnsaveContent_FullMethodName             = "/api.v1.feed.FeedService/UnsaveContent"
+	FeedService_ListCollections_FullMethodName           = "/api.v1.feed.FeedService/ListCollections"
+	FeedService_ListSavedContent_FullMethodName          = "/api.v1.feed.FeedService/ListSavedContent"
+	FeedService_CreateAudienceList_FullMethodName        = "/api.v1.feed.FeedService/CreateAudienceList"
+	FeedService_ListAudienceLists_FullMethodName         = "/api.v1.feed.FeedService/ListAudienceLists"
+	FeedService_AddAudienceListMembers_FullMethodName    = "/api.v1.feed.FeedService/AddAudienceListMembers"
+	FeedService_RemoveAudienceListMembers_FullMethodName = "/api.v1.feed.FeedService/RemoveAudienceListMembers"
+	FeedService_DeleteAudienceList_FullMethodName        = "/api.v1.feed.FeedService/DeleteAudienceList"
+	FeedService_MarkReelViewed_FullMethodName            = "/api.v1.feed.FeedService/MarkReelViewed"
+	FeedService_GetExploreReels_FullMethodName           = "/api.v1.feed.FeedService/GetExploreReels"
+	FeedService_ListScheduledContent_FullMethodName      = "/api.v1.feed.FeedService/ListScheduledContent"
+	FeedService_RescheduleContent_FullMethodName         = "/api.v1.feed.FeedService/RescheduleContent"
+	FeedService_CancelScheduledContent_FullMethodName    = "/api.v1.feed.FeedService/CancelScheduledContent"
+	FeedService_SaveDraft_FullMethodName                 = "/api.v1.feed.FeedService/SaveDraft"
+	FeedService_ListDrafts_FullMethodName                = "/api.v1.feed.FeedService/ListDrafts"
+	FeedService_UpdateDraft_FullMethodName               = "/api.v1.feed.FeedService/UpdateDraft"
+	FeedService_PublishDraft_FullMethodName              = "/api.v1.feed.FeedService/PublishDraft"
+	FeedService_DiscardDraft_FullMethodName              = "/api.v1.feed.FeedService/DiscardDraft"
 )
 
 // FeedServiceClient is the client API for FeedService service.
@@ -41,14 +81,54 @@ type FeedServiceClient interface {
 	CreatePost(ctx context.Context, in *CreatePostRequest, opts ...grpc.CallOption) (*FeedResponse, error)
 	CreateReel(ctx context.Context, in *CreateReelRequest, opts ...grpc.CallOption) (*FeedResponse, error)
 	CreateStory(ctx context.Context, in *CreateStoryRequest, opts ...grpc.CallOption) (*FeedResponse, error)
+	UploadMedia(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadChunk, UploadMediaResponse], error)
 	ReactToContent(ctx context.Context, in *ReactionRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error)
 	GetReactions(ctx context.Context, in *ContentID, opts ...grpc.CallOption) (*ReactionList, error)
 	DeleteReaction(ctx context.Context, in *DeleteReactionRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error)
-	GetTimeline(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*TimelineResponse, error)
+	ListReactors(ctx context.Context, in *ListReactorsRequest, opts ...grpc.CallOption) (*ReactorList, error)
+	GetTimeline(ctx context.Context, in *GetTimelineRequest, opts ...grpc.CallOption) (*TimelineResponse, error)
 	GetUserContent(ctx context.Context, in *GetUserContentRequest, opts ...grpc.CallOption) (*TimelineResponse, error)
 	GetMediaRef(ctx context.Context, in *ContentID, opts ...grpc.CallOption) (*MediaResponse, error)
 	GetContent(ctx context.Context, in *ContentID, opts ...grpc.CallOption) (*FeedResponse, error)
 	DeleteContent(ctx context.Context, in *ContentID, opts ...grpc.CallOption) (*FeedStatusResponse, error)
+	UpdateContentPrivacy(ctx context.Context, in *UpdateContentPrivacyRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error)
+	UpdateContent(ctx context.Context, in *UpdateContentRequest, opts ...grpc.CallOption) (*ContentResponse, error)
+	ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*RevisionList, error)
+	FriendshipAccepted(ctx context.Context, in *FriendshipRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error)
+	AddComment(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*CommentResponse, error)
+	ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*CommentList, error)
+	EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*CommentResponse, error)
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error)
+	MarkStoryViewed(ctx context.Context, in *StoryViewRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error)
+	ListStoryViewers(ctx context.Context, in *ListStoryViewersRequest, opts ...grpc.CallOption) (*StoryViewerList, error)
+	ListStoryArchive(ctx context.Context, in *ListStoryArchiveRequest, opts ...grpc.CallOption) (*TimelineResponse, error)
+	CreateHighlight(ctx context.Context, in *CreateHighlightRequest, opts ...grpc.CallOption) (*HighlightResponse, error)
+	UpdateHighlight(ctx context.Context, in *UpdateHighlightRequest, opts ...grpc.CallOption) (*HighlightResponse, error)
+	DeleteHighlight(ctx context.Context, in *DeleteHighlightRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error)
+	ReorderHighlights(ctx context.Context, in *ReorderHighlightsRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error)
+	GetHashtagFeed(ctx context.Context, in *HashtagFeedRequest, opts ...grpc.CallOption) (*TimelineResponse, error)
+	GetTrendingHashtags(ctx context.Context, in *TrendingHashtagsRequest, opts ...grpc.CallOption) (*TrendingHashtagList, error)
+	GetMentionsFeed(ctx context.Context, in *MentionsFeedRequest, opts ...grpc.CallOption) (*TimelineResponse, error)
+	ShareContent(ctx context.Context, in *ShareContentRequest, opts ...grpc.CallOption) (*FeedResponse, error)
+	SaveContent(ctx context.Context, in *SaveContentRequest, opts ...grpc.CallOption) (*CollectionResponse, error)
+	UnsaveContent(ctx context.Context, in *UnsaveContentRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error)
+	ListCollections(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*CollectionList, error)
+	ListSavedContent(ctx context.Context, in *ListSavedContentRequest, opts ...grpc.CallOption) (*SavedContentList, error)
+	CreateAudienceList(ctx context.Context, in *CreateAudienceListRequest, opts ...grpc.CallOption) (*AudienceListResponse, error)
+	ListAudienceLists(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*AudienceListList, error)
+	AddAudienceListMembers(ctx context.Context, in *AudienceListMembersRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error)
+	RemoveAudienceListMembers(ctx context.Context, in *AudienceListMembersRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error)
+	DeleteAudienceList(ctx context.Context, in *DeleteAudienceListRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error)
+	MarkReelViewed(ctx context.Context, in *ReelViewRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error)
+	GetExploreReels(ctx context.Context, in *ExploreReelsRequest, opts ...grpc.CallOption) (*TimelineResponse, error)
+	ListScheduledContent(ctx context.Context, in *ListScheduledContentRequest, opts ...grpc.CallOption) (*TimelineResponse, error)
+	RescheduleContent(ctx context.Context, in *RescheduleContentRequest, opts ...grpc.CallOption) (*ContentResponse, error)
+	CancelScheduledContent(ctx context.Context, in *CancelScheduledContentRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error)
+	SaveDraft(ctx context.Context, in *SaveDraftRequest, opts ...grpc.CallOption) (*ContentResponse, error)
+	ListDrafts(ctx context.Context, in *ListDraftsRequest, opts ...grpc.CallOption) (*TimelineResponse, error)
+	UpdateDraft(ctx context.Context, in *UpdateDraftRequest, opts ...grpc.CallOption) (*ContentResponse, error)
+	PublishDraft(ctx context.Context, in *PublishDraftRequest, opts ...grpc.CallOption) (*FeedResponse, error)
+	DiscardDraft(ctx context.Context, in *DiscardDraftRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error)
 }
 
 type feedServiceClient struct {
@@ -89,6 +169,19 @@ func (c *feedServiceClient) CreateStory(ctx context.Context, in *CreateStoryRequ
 	return out, nil
 }
 
+func (c *feedServiceClient) UploadMedia(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadChunk, UploadMediaResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FeedService_ServiceDesc.Streams[0], FeedService_UploadMedia_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadChunk, UploadMediaResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FeedService_UploadMediaClient = grpc.ClientStreamingClient[UploadChunk, UploadMediaResponse]
+
 func (c *feedServiceClient) ReactToContent(ctx context.Context, in *ReactionRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(FeedStatusResponse)
@@ -119,7 +212,17 @@ func (c *feedServiceClient) DeleteReaction(ctx context.Context, in *DeleteReacti
 	return out, nil
 }
 
-func (c *feedServiceClient) GetTimeline(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*TimelineResponse, error) {
+func (c *feedServiceClient) ListReactors(ctx context.Context, in *ListReactorsRequest, opts ...grpc.CallOption) (*ReactorList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactorList)
+	err := c.cc.Invoke(ctx, FeedService_ListReactors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) GetTimeline(ctx context.Context, in *GetTimelineRequest, opts ...grpc.CallOption) (*TimelineResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TimelineResponse)
 	err := c.cc.Invoke(ctx, FeedService_GetTimeline_FullMethodName, in, out, cOpts...)
@@ -146,304 +249,1553 @@ func (c *feedServiceClient) GetMediaRef(ctx context.Context, in *ContentID, opts
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *feedServiceClient) GetContent(ctx context.Context, in *ContentID, opts ...grpc.CallOption) (*FeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeedResponse)
+	err := c.cc.Invoke(ctx, FeedService_GetContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) DeleteContent(ctx context.Context, in *ContentID, opts ...grpc.CallOption) (*FeedStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeedStatusResponse)
+	err := c.cc.Invoke(ctx, FeedService_DeleteContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) UpdateContentPrivacy(ctx context.Context, in *UpdateContentPrivacyRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeedStatusResponse)
+	err := c.cc.Invoke(ctx, FeedService_UpdateContentPrivacy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) UpdateContent(ctx context.Context, in *UpdateContentRequest, opts ...grpc.CallOption) (*ContentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContentResponse)
+	err := c.cc.Invoke(ctx, FeedService_UpdateContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*RevisionList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevisionList)
+	err := c.cc.Invoke(ctx, FeedService_ListRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) FriendshipAccepted(ctx context.Context, in *FriendshipRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeedStatusResponse)
+	err := c.cc.Invoke(ctx, FeedService_FriendshipAccepted_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) AddComment(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*CommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommentResponse)
+	err := c.cc.Invoke(ctx, FeedService_AddComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*CommentList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommentList)
+	err := c.cc.Invoke(ctx, FeedService_ListComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*CommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommentResponse)
+	err := c.cc.Invoke(ctx, FeedService_EditComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeedStatusResponse)
+	err := c.cc.Invoke(ctx, FeedService_DeleteComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) MarkStoryViewed(ctx context.Context, in *StoryViewRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeedStatusResponse)
+	err := c.cc.Invoke(ctx, FeedService_MarkStoryViewed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) ListStoryViewers(ctx context.Context, in *ListStoryViewersRequest, opts ...grpc.CallOption) (*StoryViewerList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StoryViewerList)
+	err := c.cc.Invoke(ctx, FeedService_ListStoryViewers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) ListStoryArchive(ctx context.Context, in *ListStoryArchiveRequest, opts ...grpc.CallOption) (*TimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TimelineResponse)
+	err := c.cc.Invoke(ctx, FeedService_ListStoryArchive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) CreateHighlight(ctx context.Context, in *CreateHighlightRequest, opts ...grpc.CallOption) (*HighlightResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HighlightResponse)
+	err := c.cc.Invoke(ctx, FeedService_CreateHighlight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) UpdateHighlight(ctx context.Context, in *UpdateHighlightRequest, opts ...grpc.CallOption) (*HighlightResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HighlightResponse)
+	err := c.cc.Invoke(ctx, FeedService_UpdateHighlight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) DeleteHighlight(ctx context.Context, in *DeleteHighlightRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeedStatusResponse)
+	err := c.cc.Invoke(ctx, FeedService_DeleteHighlight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) ReorderHighlights(ctx context.Context, in *ReorderHighlightsRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeedStatusResponse)
+	err := c.cc.Invoke(ctx, FeedService_ReorderHighlights_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) GetHashtagFeed(ctx context.Context, in *HashtagFeedRequest, opts ...grpc.CallOption) (*TimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TimelineResponse)
+	err := c.cc.Invoke(ctx, FeedService_GetHashtagFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) GetTrendingHashtags(ctx context.Context, in *TrendingHashtagsRequest, opts ...grpc.CallOption) (*TrendingHashtagList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrendingHashtagList)
+	err := c.cc.Invoke(ctx, FeedService_GetTrendingHashtags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) GetMentionsFeed(ctx context.Context, in *MentionsFeedRequest, opts ...grpc.CallOption) (*TimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TimelineResponse)
+	err := c.cc.Invoke(ctx, FeedService_GetMentionsFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) ShareContent(ctx context.Context, in *ShareContentRequest, opts ...grpc.CallOption) (*FeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeedResponse)
+	err := c.cc.Invoke(ctx, FeedService_ShareContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) SaveContent(ctx context.Context, in *SaveContentRequest, opts ...grpc.CallOption) (*CollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionResponse)
+	err := c.cc.Invoke(ctx, FeedService_SaveContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) UnsaveContent(ctx context.Context, in *UnsaveContentRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeedStatusResponse)
+	err := c.cc.Invoke(ctx, FeedService_UnsaveContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) ListCollections(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*CollectionList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionList)
+	err := c.cc.Invoke(ctx, FeedService_ListCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) ListSavedContent(ctx context.Context, in *ListSavedContentRequest, opts ...grpc.CallOption) (*SavedContentList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedContentList)
+	err := c.cc.Invoke(ctx, FeedService_ListSavedContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) CreateAudienceList(ctx context.Context, in *CreateAudienceListRequest, opts ...grpc.CallOption) (*AudienceListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AudienceListResponse)
+	err := c.cc.Invoke(ctx, FeedService_CreateAudienceList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) ListAudienceLists(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*AudienceListList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AudienceListList)
+	err := c.cc.Invoke(ctx, FeedService_ListAudienceLists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) AddAudienceListMembers(ctx context.Context, in *AudienceListMembersRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeedStatusResponse)
+	err := c.cc.Invoke(ctx, FeedService_AddAudienceListMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) RemoveAudienceListMembers(ctx context.Context, in *AudienceListMembersRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeedStatusResponse)
+	err := c.cc.Invoke(ctx, FeedService_RemoveAudienceListMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) DeleteAudienceList(ctx context.Context, in *DeleteAudienceListRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeedStatusResponse)
+	err := c.cc.Invoke(ctx, FeedService_DeleteAudienceList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) MarkReelViewed(ctx context.Context, in *ReelViewRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeedStatusResponse)
+	err := c.cc.Invoke(ctx, FeedService_MarkReelViewed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) GetExploreReels(ctx context.Context, in *ExploreReelsRequest, opts ...grpc.CallOption) (*TimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TimelineResponse)
+	err := c.cc.Invoke(ctx, FeedService_GetExploreReels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) ListScheduledContent(ctx context.Context, in *ListScheduledContentRequest, opts ...grpc.CallOption) (*TimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TimelineResponse)
+	err := c.cc.Invoke(ctx, FeedService_ListScheduledContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) RescheduleContent(ctx context.Context, in *RescheduleContentRequest, opts ...grpc.CallOption) (*ContentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContentResponse)
+	err := c.cc.Invoke(ctx, FeedService_RescheduleContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) CancelScheduledContent(ctx context.Context, in *CancelScheduledContentRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeedStatusResponse)
+	err := c.cc.Invoke(ctx, FeedService_CancelScheduledContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) SaveDraft(ctx context.Context, in *SaveDraftRequest, opts ...grpc.CallOption) (*ContentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContentResponse)
+	err := c.cc.Invoke(ctx, FeedService_SaveDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) ListDrafts(ctx context.Context, in *ListDraftsRequest, opts ...grpc.CallOption) (*TimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TimelineResponse)
+	err := c.cc.Invoke(ctx, FeedService_ListDrafts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) UpdateDraft(ctx context.Context, in *UpdateDraftRequest, opts ...grpc.CallOption) (*ContentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContentResponse)
+	err := c.cc.Invoke(ctx, FeedService_UpdateDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) PublishDraft(ctx context.Context, in *PublishDraftRequest, opts ...grpc.CallOption) (*FeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeedResponse)
+	err := c.cc.Invoke(ctx, FeedService_PublishDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) DiscardDraft(ctx context.Context, in *DiscardDraftRequest, opts ...grpc.CallOption) (*FeedStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeedStatusResponse)
+	err := c.cc.Invoke(ctx, FeedService_DiscardDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FeedServiceServer is the server API for FeedService service.
+// All implementations must embed UnimplementedFeedServiceServer
+// for forward compatibility.
+//
+// ---------- Feed Service ----------
+type FeedServiceServer interface {
+	CreatePost(context.Context, *CreatePostRequest) (*FeedResponse, error)
+	CreateReel(context.Context, *CreateReelRequest) (*FeedResponse, error)
+	CreateStory(context.Context, *CreateStoryRequest) (*FeedResponse, error)
+	UploadMedia(grpc.ClientStreamingServer[UploadChunk, UploadMediaResponse]) error
+	ReactToContent(context.Context, *ReactionRequest) (*FeedStatusResponse, error)
+	GetReactions(context.Context, *ContentID) (*ReactionList, error)
+	DeleteReaction(context.Context, *DeleteReactionRequest) (*FeedStatusResponse, error)
+	ListReactors(context.Context, *ListReactorsRequest) (*ReactorList, error)
+	GetTimeline(context.Context, *GetTimelineRequest) (*TimelineResponse, error)
+	GetUserContent(context.Context, *GetUserContentRequest) (*TimelineResponse, error)
+	GetMediaRef(context.Context, *ContentID) (*MediaResponse, error)
+	GetContent(context.Context, *ContentID) (*FeedResponse, error)
+	DeleteContent(context.Context, *ContentID) (*FeedStatusResponse, error)
+	UpdateContentPrivacy(context.Context, *UpdateContentPrivacyRequest) (*FeedStatusResponse, error)
+	UpdateContent(context.Context, *UpdateContentRequest) (*ContentResponse, error)
+	ListRevisions(context.Context, *ListRevisionsRequest) (*RevisionList, error)
+	FriendshipAccepted(context.Context, *FriendshipRequest) (*FeedStatusResponse, error)
+	AddComment(context.Context, *AddCommentRequest) (*CommentResponse, error)
+	ListComments(context.Context, *ListCommentsRequest) (*CommentList, error)
+	EditComment(context.Context, *EditCommentRequest) (*CommentResponse, error)
+	DeleteComment(context.Context, *DeleteCommentRequest) (*FeedStatusResponse, error)
+	MarkStoryViewed(context.Context, *StoryViewRequest) (*FeedStatusResponse, error)
+	ListStoryViewers(context.Context, *ListStoryViewersRequest) (*StoryViewerList, error)
+	ListStoryArchive(context.Context, *ListStoryArchiveRequest) (*TimelineResponse, error)
+	CreateHighlight(context.Context, *CreateHighlightRequest) (*HighlightResponse, error)
+	UpdateHighlight(context.Context, *UpdateHighlightRequest) (*HighlightResponse, error)
+	DeleteHighlight(context.Context, *DeleteHighlightRequest) (*FeedStatusResponse, error)
+	ReorderHighlights(context.Context, *ReorderHighlightsRequest) (*FeedStatusResponse, error)
+	GetHashtagFeed(context.Context, *HashtagFeedRequest) (*TimelineResponse, error)
+	GetTrendingHashtags(context.Context, *TrendingHashtagsRequest) (*TrendingHashtagList, error)
+	GetMentionsFeed(context.Context, *MentionsFeedRequest) (*TimelineResponse, error)
+	ShareContent(context.Context, *ShareContentRequest) (*FeedResponse, error)
+	SaveContent(context.Context, *SaveContentRequest) (*CollectionResponse, error)
+	UnsaveContent(context.Context, *UnsaveContentRequest) (*FeedStatusResponse, error)
+	ListCollections(context.Context, *UserID) (*CollectionList, error)
+	ListSavedContent(context.Context, *ListSavedContentRequest) (*SavedContentList, error)
+	CreateAudienceList(context.Context, *CreateAudienceListRequest) (*AudienceListResponse, error)
+	ListAudienceLists(context.Context, *UserID) (*AudienceListList, error)
+	AddAudienceListMembers(context.Context, *AudienceListMembersRequest) (*FeedStatusResponse, error)
+	RemoveAudienceListMembers(context.Context, *AudienceListMembersRequest) (*FeedStatusResponse, error)
+	DeleteAudienceList(context.Context, *DeleteAudienceListRequest) (*FeedStatusResponse, error)
+	MarkReelViewed(context.Context, *ReelViewRequest) (*FeedStatusResponse, error)
+	GetExploreReels(context.Context, *ExploreReelsRequest) (*TimelineResponse, error)
+	ListScheduledContent(context.Context, *ListScheduledContentRequest) (*TimelineResponse, error)
+	RescheduleContent(context.Context, *RescheduleContentRequest) (*ContentResponse, error)
+	CancelScheduledContent(context.Context, *CancelScheduledContentRequest) (*FeedStatusResponse, error)
+	SaveDraft(context.Context, *SaveDraftRequest) (*ContentResponse, error)
+	ListDrafts(context.Context, *ListDraftsRequest) (*TimelineResponse, error)
+	UpdateDraft(context.Context, *UpdateDraftRequest) (*ContentResponse, error)
+	PublishDraft(context.Context, *PublishDraftRequest) (*FeedResponse, error)
+	DiscardDraft(context.Context, *DiscardDraftRequest) (*FeedStatusResponse, error)
+	mustEmbedUnimplementedFeedServiceServer()
+}
+
+// UnimplementedFeedServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFeedServiceServer struct{}
+
+func (UnimplementedFeedServiceServer) CreatePost(context.Context, *CreatePostRequest) (*FeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
+}
+func (UnimplementedFeedServiceServer) CreateReel(context.Context, *CreateReelRequest) (*FeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReel not implemented")
+}
+func (UnimplementedFeedServiceServer) CreateStory(context.Context, *CreateStoryRequest) (*FeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStory not implemented")
+}
+func (UnimplementedFeedServiceServer) UploadMedia(grpc.ClientStreamingServer[UploadChunk, UploadMediaResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadMedia not implemented")
+}
+func (UnimplementedFeedServiceServer) ReactToContent(context.Context, *ReactionRequest) (*FeedStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactToContent not implemented")
+}
+func (UnimplementedFeedServiceServer) GetReactions(context.Context, *ContentID) (*ReactionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReactions not implemented")
+}
+func (UnimplementedFeedServiceServer) DeleteReaction(context.Context, *DeleteReactionRequest) (*FeedStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReaction not implemented")
+}
+func (UnimplementedFeedServiceServer) ListReactors(context.Context, *ListReactorsRequest) (*ReactorList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReactors not implemented")
+}
+func (UnimplementedFeedServiceServer) GetTimeline(context.Context, *GetTimelineRequest) (*TimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimeline not implemented")
+}
+func (UnimplementedFeedServiceServer) GetUserContent(context.Context, *GetUserContentRequest) (*TimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserContent not implemented")
+}
+func (UnimplementedFeedServiceServer) GetMediaRef(context.Context, *ContentID) (*MediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMediaRef not implemented")
+}
+func (UnimplementedFeedServiceServer) GetContent(context.Context, *ContentID) (*FeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContent not implemented")
+}
+func (UnimplementedFeedServiceServer) DeleteContent(context.Context, *ContentID) (*FeedStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteContent not implemented")
+}
+func (UnimplementedFeedServiceServer) UpdateContentPrivacy(context.Context, *UpdateContentPrivacyRequest) (*FeedStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateContentPrivacy not implemented")
+}
+func (UnimplementedFeedServiceServer) UpdateContent(context.Context, *UpdateContentRequest) (*ContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateContent not implemented")
+}
+func (UnimplementedFeedServiceServer) ListRevisions(context.Context, *ListRevisionsRequest) (*RevisionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevisions not implemented")
+}
+func (UnimplementedFeedServiceServer) FriendshipAccepted(context.Context, *FriendshipRequest) (*FeedStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FriendshipAccepted not implemented")
+}
+func (UnimplementedFeedServiceServer) AddComment(context.Context, *AddCommentRequest) (*CommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddComment not implemented")
+}
+func (UnimplementedFeedServiceServer) ListComments(context.Context, *ListCommentsRequest) (*CommentList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComments not implemented")
+}
+func (UnimplementedFeedServiceServer) EditComment(context.Context, *EditCommentRequest) (*CommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditComment not implemented")
+}
+func (UnimplementedFeedServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*FeedStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedFeedServiceServer) MarkStoryViewed(context.Context, *StoryViewRequest) (*FeedStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkStoryViewed not implemented")
+}
+func (UnimplementedFeedServiceServer) ListStoryViewers(context.Context, *ListStoryViewersRequest) (*StoryViewerList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStoryViewers not implemented")
+}
+func (UnimplementedFeedServiceServer) ListStoryArchive(context.Context, *ListStoryArchiveRequest) (*TimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStoryArchive not implemented")
+}
+func (UnimplementedFeedServiceServer) CreateHighlight(context.Context, *CreateHighlightRequest) (*HighlightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHighlight not implemented")
+}
+func (UnimplementedFeedServiceServer) UpdateHighlight(context.Context, *UpdateHighlightRequest) (*HighlightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHighlight not implemented")
+}
+func (UnimplementedFeedServiceServer) DeleteHighlight(context.Context, *DeleteHighlightRequest) (*FeedStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHighlight not implemented")
+}
+func (UnimplementedFeedServiceServer) ReorderHighlights(context.Context, *ReorderHighlightsRequest) (*FeedStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderHighlights not implemented")
+}
+func (UnimplementedFeedServiceServer) GetHashtagFeed(context.Context, *HashtagFeedRequest) (*TimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHashtagFeed not implemented")
+}
+func (UnimplementedFeedServiceServer) GetTrendingHashtags(context.Context, *TrendingHashtagsRequest) (*TrendingHashtagList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrendingHashtags not implemented")
+}
+func (UnimplementedFeedServiceServer) GetMentionsFeed(context.Context, *MentionsFeedRequest) (*TimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMentionsFeed not implemented")
+}
+func (UnimplementedFeedServiceServer) ShareContent(context.Context, *ShareContentRequest) (*FeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareContent not implemented")
+}
+func (UnimplementedFeedServiceServer) SaveContent(context.Context, *SaveContentRequest) (*CollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveContent not implemented")
+}
+func (UnimplementedFeedServiceServer) UnsaveContent(context.Context, *UnsaveContentRequest) (*FeedStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsaveContent not implemented")
+}
+func (UnimplementedFeedServiceServer) ListCollections(context.Context, *UserID) (*CollectionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollections not implemented")
+}
+func (UnimplementedFeedServiceServer) ListSavedContent(context.Context, *ListSavedContentRequest) (*SavedContentList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSavedContent not implemented")
+}
+func (UnimplementedFeedServiceServer) CreateAudienceList(context.Context, *CreateAudienceListRequest) (*AudienceListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAudienceList not implemented")
+}
+func (UnimplementedFeedServiceServer) ListAudienceLists(context.Context, *UserID) (*AudienceListList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAudienceLists not implemented")
+}
+func (UnimplementedFeedServiceServer) AddAudienceListMembers(context.Context, *AudienceListMembersRequest) (*FeedStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAudienceListMembers not implemented")
+}
+func (UnimplementedFeedServiceServer) RemoveAudienceListMembers(context.Context, *AudienceListMembersRequest) (*FeedStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAudienceListMembers not implemented")
+}
+func (UnimplementedFeedServiceServer) DeleteAudienceList(context.Context, *DeleteAudienceListRequest) (*FeedStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAudienceList not implemented")
+}
+func (UnimplementedFeedServiceServer) MarkReelViewed(context.Context, *ReelViewRequest) (*FeedStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkReelViewed not implemented")
+}
+func (UnimplementedFeedServiceServer) GetExploreReels(context.Context, *ExploreReelsRequest) (*TimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExploreReels not implemented")
+}
+func (UnimplementedFeedServiceServer) ListScheduledContent(context.Context, *ListScheduledContentRequest) (*TimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledContent not implemented")
+}
+func (UnimplementedFeedServiceServer) RescheduleContent(context.Context, *RescheduleContentRequest) (*ContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleContent not implemented")
+}
+func (UnimplementedFeedServiceServer) CancelScheduledContent(context.Context, *CancelScheduledContentRequest) (*FeedStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledContent not implemented")
+}
+func (UnimplementedFeedServiceServer) SaveDraft(context.Context, *SaveDraftRequest) (*ContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveDraft not implemented")
+}
+func (UnimplementedFeedServiceServer) ListDrafts(context.Context, *ListDraftsRequest) (*TimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDrafts not implemented")
+}
+func (UnimplementedFeedServiceServer) UpdateDraft(context.Context, *UpdateDraftRequest) (*ContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDraft not implemented")
+}
+func (UnimplementedFeedServiceServer) PublishDraft(context.Context, *PublishDraftRequest) (*FeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishDraft not implemented")
+}
+func (UnimplementedFeedServiceServer) DiscardDraft(context.Context, *DiscardDraftRequest) (*FeedStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscardDraft not implemented")
+}
+func (UnimplementedFeedServiceServer) mustEmbedUnimplementedFeedServiceServer() {}
+func (UnimplementedFeedServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeFeedServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FeedServiceServer will
+// result in compilation errors.
+type UnsafeFeedServiceServer interface {
+	mustEmbedUnimplementedFeedServiceServer()
+}
+
+func RegisterFeedServiceServer(s grpc.ServiceRegistrar, srv FeedServiceServer) {
+	// If the following call pancis, it indicates UnimplementedFeedServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FeedService_ServiceDesc, srv)
+}
+
+func _FeedService_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).CreatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_CreatePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).CreatePost(ctx, req.(*CreatePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_CreateReel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).CreateReel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_CreateReel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).CreateReel(ctx, req.(*CreateReelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_CreateStory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).CreateStory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_CreateStory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).CreateStory(ctx, req.(*CreateStoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_UploadMedia_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FeedServiceServer).UploadMedia(&grpc.GenericServerStream[UploadChunk, UploadMediaResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FeedService_UploadMediaServer = grpc.ClientStreamingServer[UploadChunk, UploadMediaResponse]
+
+func _FeedService_ReactToContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).ReactToContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_ReactToContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).ReactToContent(ctx, req.(*ReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_GetReactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContentID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).GetReactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_GetReactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).GetReactions(ctx, req.(*ContentID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_DeleteReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).DeleteReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_DeleteReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).DeleteReaction(ctx, req.(*DeleteReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_ListReactors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReactorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).ListReactors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_ListReactors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).ListReactors(ctx, req.(*ListReactorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_GetTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).GetTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_GetTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).GetTimeline(ctx, req.(*GetTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_GetUserContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).GetUserContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_GetUserContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).GetUserContent(ctx, req.(*GetUserContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_GetMediaRef_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContentID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).GetMediaRef(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_GetMediaRef_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).GetMediaRef(ctx, req.(*ContentID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_GetContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContentID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).GetContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_GetContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).GetContent(ctx, req.(*ContentID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_DeleteContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContentID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).DeleteContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_DeleteContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).DeleteContent(ctx, req.(*ContentID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_UpdateContentPrivacy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateContentPrivacyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).UpdateContentPrivacy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_UpdateContentPrivacy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).UpdateContentPrivacy(ctx, req.(*UpdateContentPrivacyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_UpdateContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).UpdateContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_UpdateContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).UpdateContent(ctx, req.(*UpdateContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_ListRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).ListRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_ListRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).ListRevisions(ctx, req.(*ListRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_FriendshipAccepted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FriendshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).FriendshipAccepted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_FriendshipAccepted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).FriendshipAccepted(ctx, req.(*FriendshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_AddComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).AddComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_AddComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).AddComment(ctx, req.(*AddCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).ListComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_ListComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).ListComments(ctx, req.(*ListCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_EditComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).EditComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_EditComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).EditComment(ctx, req.(*EditCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_MarkStoryViewed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoryViewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).MarkStoryViewed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_MarkStoryViewed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).MarkStoryViewed(ctx, req.(*StoryViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_ListStoryViewers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStoryViewersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).ListStoryViewers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_ListStoryViewers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).ListStoryViewers(ctx, req.(*ListStoryViewersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_ListStoryArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStoryArchiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).ListStoryArchive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_ListStoryArchive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).ListStoryArchive(ctx, req.(*ListStoryArchiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_CreateHighlight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHighlightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).CreateHighlight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_CreateHighlight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).CreateHighlight(ctx, req.(*CreateHighlightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_UpdateHighlight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHighlightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).UpdateHighlight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_UpdateHighlight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).UpdateHighlight(ctx, req.(*UpdateHighlightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_DeleteHighlight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHighlightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).DeleteHighlight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_DeleteHighlight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).DeleteHighlight(ctx, req.(*DeleteHighlightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_ReorderHighlights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderHighlightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).ReorderHighlights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_ReorderHighlights_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).ReorderHighlights(ctx, req.(*ReorderHighlightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_GetHashtagFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashtagFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).GetHashtagFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_GetHashtagFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).GetHashtagFeed(ctx, req.(*HashtagFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_GetTrendingHashtags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrendingHashtagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).GetTrendingHashtags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_GetTrendingHashtags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).GetTrendingHashtags(ctx, req.(*TrendingHashtagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_GetMentionsFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MentionsFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).GetMentionsFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_GetMentionsFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).GetMentionsFeed(ctx, req.(*MentionsFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_ShareContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).ShareContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_ShareContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).ShareContent(ctx, req.(*ShareContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_SaveContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).SaveContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_SaveContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).SaveContent(ctx, req.(*SaveContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_UnsaveContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsaveContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).UnsaveContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_UnsaveContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).UnsaveContent(ctx, req.(*UnsaveContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_ListCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).ListCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_ListCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).ListCollections(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_ListSavedContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).ListSavedContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_ListSavedContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).ListSavedContent(ctx, req.(*ListSavedContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_CreateAudienceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAudienceListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).CreateAudienceList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_CreateAudienceList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).CreateAudienceList(ctx, req.(*CreateAudienceListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *feedServiceClient) GetContent(ctx context.Context, in *ContentID, opts ...grpc.CallOption) (*FeedResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(FeedResponse)
-	err := c.cc.Invoke(ctx, FeedService_GetContent_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _FeedService_ListAudienceLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(FeedServiceServer).ListAudienceLists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_ListAudienceLists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).ListAudienceLists(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *feedServiceClient) DeleteContent(ctx context.Context, in *ContentID, opts ...grpc.CallOption) (*FeedStatusResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(FeedStatusResponse)
-	err := c.cc.Invoke(ctx, FeedService_DeleteContent_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _FeedService_AddAudienceListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AudienceListMembersRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// FeedServiceServer is the server API for FeedService service.
-// All implementations must embed UnimplementedFeedServiceServer
-// for forward compatibility.
-//
-// ---------- Feed Service ----------
-type FeedServiceServer interface {
-	CreatePost(context.Context, *CreatePostRequest) (*FeedResponse, error)
-	CreateReel(context.Context, *CreateReelRequest) (*FeedResponse, error)
-	CreateStory(context.Context, *CreateStoryRequest) (*FeedResponse, error)
-	ReactToContent(context.Context, *ReactionRequest) (*FeedStatusResponse, error)
-	GetReactions(context.Context, *ContentID) (*ReactionList, error)
-	DeleteReaction(context.Context, *DeleteReactionRequest) (*FeedStatusResponse, error)
-	GetTimeline(context.Context, *UserID) (*TimelineResponse, error)
-	GetUserContent(context.Context, *GetUserContentRequest) (*TimelineResponse, error)
-	GetMediaRef(context.Context, *ContentID) (*MediaResponse, error)
-	GetContent(context.Context, *ContentID) (*FeedResponse, error)
-	DeleteContent(context.Context, *ContentID) (*FeedStatusResponse, error)
-	mustEmbedUnimplementedFeedServiceServer()
-}
-
-// UnimplementedFeedServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedFeedServiceServer struct{}
-
-func (UnimplementedFeedServiceServer) CreatePost(context.Context, *CreatePostRequest) (*FeedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
-}
-func (UnimplementedFeedServiceServer) CreateReel(context.Context, *CreateReelRequest) (*FeedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateReel not implemented")
-}
-func (UnimplementedFeedServiceServer) CreateStory(context.Context, *CreateStoryRequest) (*FeedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateStory not implemented")
-}
-func (UnimplementedFeedServiceServer) ReactToContent(context.Context, *ReactionRequest) (*FeedStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReactToContent not implemented")
-}
-func (UnimplementedFeedServiceServer) GetReactions(context.Context, *ContentID) (*ReactionList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReactions not implemented")
-}
-func (UnimplementedFeedServiceServer) DeleteReaction(context.Context, *DeleteReactionRequest) (*FeedStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteReaction not implemented")
-}
-func (UnimplementedFeedServiceServer) GetTimeline(context.Context, *UserID) (*TimelineResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTimeline not implemented")
-}
-func (UnimplementedFeedServiceServer) GetUserContent(context.Context, *GetUserContentRequest) (*TimelineResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserContent not implemented")
-}
-func (UnimplementedFeedServiceServer) GetMediaRef(context.Context, *ContentID) (*MediaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMediaRef not implemented")
-}
-func (UnimplementedFeedServiceServer) GetContent(context.Context, *ContentID) (*FeedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetContent not implemented")
-}
-func (UnimplementedFeedServiceServer) DeleteContent(context.Context, *ContentID) (*FeedStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteContent not implemented")
-}
-func (UnimplementedFeedServiceServer) mustEmbedUnimplementedFeedServiceServer() {}
-func (UnimplementedFeedServiceServer) testEmbeddedByValue()                     {}
-
-// UnsafeFeedServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to FeedServiceServer will
-// result in compilation errors.
-type UnsafeFeedServiceServer interface {
-	mustEmbedUnimplementedFeedServiceServer()
+	if interceptor == nil {
+		return srv.(FeedServiceServer).AddAudienceListMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_AddAudienceListMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).AddAudienceListMembers(ctx, req.(*AudienceListMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterFeedServiceServer(s grpc.ServiceRegistrar, srv FeedServiceServer) {
-	// If the following call pancis, it indicates UnimplementedFeedServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _FeedService_RemoveAudienceListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AudienceListMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&FeedService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(FeedServiceServer).RemoveAudienceListMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_RemoveAudienceListMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).RemoveAudienceListMembers(ctx, req.(*AudienceListMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _FeedService_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePostRequest)
+func _FeedService_DeleteAudienceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAudienceListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FeedServiceServer).CreatePost(ctx, in)
+		return srv.(FeedServiceServer).DeleteAudienceList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FeedService_CreatePost_FullMethodName,
+		FullMethod: FeedService_DeleteAudienceList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FeedServiceServer).CreatePost(ctx, req.(*CreatePostRequest))
+		return srv.(FeedServiceServer).DeleteAudienceList(ctx, req.(*DeleteAudienceListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FeedService_CreateReel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateReelRequest)
+func _FeedService_MarkReelViewed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReelViewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FeedServiceServer).CreateReel(ctx, in)
+		return srv.(FeedServiceServer).MarkReelViewed(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FeedService_CreateReel_FullMethodName,
+		FullMethod: FeedService_MarkReelViewed_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FeedServiceServer).CreateReel(ctx, req.(*CreateReelRequest))
+		return srv.(FeedServiceServer).MarkReelViewed(ctx, req.(*ReelViewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FeedService_CreateStory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateStoryRequest)
+func _FeedService_GetExploreReels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExploreReelsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FeedServiceServer).CreateStory(ctx, in)
+		return srv.(FeedServiceServer).GetExploreReels(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FeedService_CreateStory_FullMethodName,
+		FullMethod: FeedService_GetExploreReels_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FeedServiceServer).CreateStory(ctx, req.(*CreateStoryRequest))
+		return srv.(FeedServiceServer).GetExploreReels(ctx, req.(*ExploreReelsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FeedService_ReactToContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReactionRequest)
+func _FeedService_ListScheduledContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledContentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FeedServiceServer).ReactToContent(ctx, in)
+		return srv.(FeedServiceServer).ListScheduledContent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FeedService_ReactToContent_FullMethodName,
+		FullMethod: FeedService_ListScheduledContent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FeedServiceServer).ReactToContent(ctx, req.(*ReactionRequest))
+		return srv.(FeedServiceServer).ListScheduledContent(ctx, req.(*ListScheduledContentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FeedService_GetReactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContentID)
+func _FeedService_RescheduleContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleContentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FeedServiceServer).GetReactions(ctx, in)
+		return srv.(FeedServiceServer).RescheduleContent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FeedService_GetReactions_FullMethodName,
+		FullMethod: FeedService_RescheduleContent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FeedServiceServer).GetReactions(ctx, req.(*ContentID))
+		return srv.(FeedServiceServer).RescheduleContent(ctx, req.(*RescheduleContentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FeedService_DeleteReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteReactionRequest)
+func _FeedService_CancelScheduledContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledContentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FeedServiceServer).DeleteReaction(ctx, in)
+		return srv.(FeedServiceServer).CancelScheduledContent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FeedService_DeleteReaction_FullMethodName,
+		FullMethod: FeedService_CancelScheduledContent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FeedServiceServer).DeleteReaction(ctx, req.(*DeleteReactionRequest))
+		return srv.(FeedServiceServer).CancelScheduledContent(ctx, req.(*CancelScheduledContentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FeedService_GetTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserID)
+func _FeedService_SaveDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveDraftRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FeedServiceServer).GetTimeline(ctx, in)
+		return srv.(FeedServiceServer).SaveDraft(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FeedService_GetTimeline_FullMethodName,
+		FullMethod: FeedService_SaveDraft_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FeedServiceServer).GetTimeline(ctx, req.(*UserID))
+		return srv.(FeedServiceServer).SaveDraft(ctx, req.(*SaveDraftRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FeedService_GetUserContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserContentRequest)
+func _FeedService_ListDrafts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDraftsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FeedServiceServer).GetUserContent(ctx, in)
+		return srv.(FeedServiceServer).ListDrafts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FeedService_GetUserContent_FullMethodName,
+		FullMethod: FeedService_ListDrafts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FeedServiceServer).GetUserContent(ctx, req.(*GetUserContentRequest))
+		return srv.(FeedServiceServer).ListDrafts(ctx, req.(*ListDraftsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FeedService_GetMediaRef_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContentID)
+func _FeedService_UpdateDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDraftRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FeedServiceServer).GetMediaRef(ctx, in)
+		return srv.(FeedServiceServer).UpdateDraft(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FeedService_GetMediaRef_FullMethodName,
+		FullMethod: FeedService_UpdateDraft_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FeedServiceServer).GetMediaRef(ctx, req.(*ContentID))
+		return srv.(FeedServiceServer).UpdateDraft(ctx, req.(*UpdateDraftRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FeedService_GetContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContentID)
+func _FeedService_PublishDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishDraftRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FeedServiceServer).GetContent(ctx, in)
+		return srv.(FeedServiceServer).PublishDraft(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FeedService_GetContent_FullMethodName,
+		FullMethod: FeedService_PublishDraft_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FeedServiceServer).GetContent(ctx, req.(*ContentID))
+		return srv.(FeedServiceServer).PublishDraft(ctx, req.(*PublishDraftRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FeedService_DeleteContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContentID)
+func _FeedService_DiscardDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardDraftRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FeedServiceServer).DeleteContent(ctx, in)
+		return srv.(FeedServiceServer).DiscardDraft(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FeedService_DeleteContent_FullMethodName,
+		FullMethod: FeedService_DiscardDraft_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FeedServiceServer).DeleteContent(ctx, req.(*ContentID))
+		return srv.(FeedServiceServer).DiscardDraft(ctx, req.(*DiscardDraftRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -479,6 +1831,10 @@ var FeedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteReaction",
 			Handler:    _FeedService_DeleteReaction_Handler,
 		},
+		{
+			MethodName: "ListReactors",
+			Handler:    _FeedService_ListReactors_Handler,
+		},
 		{
 			MethodName: "GetTimeline",
 			Handler:    _FeedService_GetTimeline_Handler,
@@ -499,7 +1855,165 @@ var FeedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteContent",
 			Handler:    _FeedService_DeleteContent_Handler,
 		},
+		{
+			MethodName: "UpdateContentPrivacy",
+			Handler:    _FeedService_UpdateContentPrivacy_Handler,
+		},
+		{
+			MethodName: "UpdateContent",
+			Handler:    _FeedService_UpdateContent_Handler,
+		},
+		{
+			MethodName: "ListRevisions",
+			Handler:    _FeedService_ListRevisions_Handler,
+		},
+		{
+			MethodName: "FriendshipAccepted",
+			Handler:    _FeedService_FriendshipAccepted_Handler,
+		},
+		{
+			MethodName: "AddComment",
+			Handler:    _FeedService_AddComment_Handler,
+		},
+		{
+			MethodName: "ListComments",
+			Handler:    _FeedService_ListComments_Handler,
+		},
+		{
+			MethodName: "EditComment",
+			Handler:    _FeedService_EditComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _FeedService_DeleteComment_Handler,
+		},
+		{
+			MethodName: "MarkStoryViewed",
+			Handler:    _FeedService_MarkStoryViewed_Handler,
+		},
+		{
+			MethodName: "ListStoryViewers",
+			Handler:    _FeedService_ListStoryViewers_Handler,
+		},
+		{
+			MethodName: "ListStoryArchive",
+			Handler:    _FeedService_ListStoryArchive_Handler,
+		},
+		{
+			MethodName: "CreateHighlight",
+			Handler:    _FeedService_CreateHighlight_Handler,
+		},
+		{
+			MethodName: "UpdateHighlight",
+			Handler:    _FeedService_UpdateHighlight_Handler,
+		},
+		{
+			MethodName: "DeleteHighlight",
+			Handler:    _FeedService_DeleteHighlight_Handler,
+		},
+		{
+			MethodName: "ReorderHighlights",
+			Handler:    _FeedService_ReorderHighlights_Handler,
+		},
+		{
+			MethodName: "GetHashtagFeed",
+			Handler:    _FeedService_GetHashtagFeed_Handler,
+		},
+		{
+			MethodName: "GetTrendingHashtags",
+			Handler:    _FeedService_GetTrendingHashtags_Handler,
+		},
+		{
+			MethodName: "GetMentionsFeed",
+			Handler:    _FeedService_GetMentionsFeed_Handler,
+		},
+		{
+			MethodName: "ShareContent",
+			Handler:    _FeedService_ShareContent_Handler,
+		},
+		{
+			MethodName: "SaveContent",
+			Handler:    _FeedService_SaveContent_Handler,
+		},
+		{
+			MethodName: "UnsaveContent",
+			Handler:    _FeedService_UnsaveContent_Handler,
+		},
+		{
+			MethodName: "ListCollections",
+			Handler:    _FeedService_ListCollections_Handler,
+		},
+		{
+			MethodName: "ListSavedContent",
+			Handler:    _FeedService_ListSavedContent_Handler,
+		},
+		{
+			MethodName: "CreateAudienceList",
+			Handler:    _FeedService_CreateAudienceList_Handler,
+		},
+		{
+			MethodName: "ListAudienceLists",
+			Handler:    _FeedService_ListAudienceLists_Handler,
+		},
+		{
+			MethodName: "AddAudienceListMembers",
+			Handler:    _FeedService_AddAudienceListMembers_Handler,
+		},
+		{
+			MethodName: "RemoveAudienceListMembers",
+			Handler:    _FeedService_RemoveAudienceListMembers_Handler,
+		},
+		{
+			MethodName: "DeleteAudienceList",
+			Handler:    _FeedService_DeleteAudienceList_Handler,
+		},
+		{
+			MethodName: "MarkReelViewed",
+			Handler:    _FeedService_MarkReelViewed_Handler,
+		},
+		{
+			MethodName: "GetExploreReels",
+			Handler:    _FeedService_GetExploreReels_Handler,
+		},
+		{
+			MethodName: "ListScheduledContent",
+			Handler:    _FeedService_ListScheduledContent_Handler,
+		},
+		{
+			MethodName: "RescheduleContent",
+			Handler:    _FeedService_RescheduleContent_Handler,
+		},
+		{
+			MethodName: "CancelScheduledContent",
+			Handler:    _FeedService_CancelScheduledContent_Handler,
+		},
+		{
+			MethodName: "SaveDraft",
+			Handler:    _FeedService_SaveDraft_Handler,
+		},
+		{
+			MethodName: "ListDrafts",
+			Handler:    _FeedService_ListDrafts_Handler,
+		},
+		{
+			MethodName: "UpdateDraft",
+			Handler:    _FeedService_UpdateDraft_Handler,
+		},
+		{
+			MethodName: "PublishDraft",
+			Handler:    _FeedService_PublishDraft_Handler,
+		},
+		{
+			MethodName: "DiscardDraft",
+			Handler:    _FeedService_DiscardDraft_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadMedia",
+			Handler:       _FeedService_UploadMedia_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/feed.proto",
 }
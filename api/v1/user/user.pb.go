@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.36.6
-// 	protoc        v6.31.1
+// 	protoc        v5.29.3
 // source: api/v1/user.proto
 
 package user
@@ -355,6 +355,184 @@ func (x *ProfileResponse) GetUpdatedAt() string {
 	return ""
 }
 
+// Resolves @handles to user IDs, unknown or inactive handles are left out of user_ids
+type ResolveHandlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Handles       []string               `protobuf:"bytes,1,rep,name=handles,proto3" json:"handles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveHandlesRequest) Reset() {
+	*x = ResolveHandlesRequest{}
+	mi := &file_api_v1_user_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveHandlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveHandlesRequest) ProtoMessage() {}
+
+func (x *ResolveHandlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveHandlesRequest.ProtoReflect.Descriptor instead.
+func (*ResolveHandlesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ResolveHandlesRequest) GetHandles() []string {
+	if x != nil {
+		return x.Handles
+	}
+	return nil
+}
+
+type ResolveHandlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       map[string]int64       `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveHandlesResponse) Reset() {
+	*x = ResolveHandlesResponse{}
+	mi := &file_api_v1_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveHandlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveHandlesResponse) ProtoMessage() {}
+
+func (x *ResolveHandlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveHandlesResponse.ProtoReflect.Descriptor instead.
+func (*ResolveHandlesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ResolveHandlesResponse) GetUserIds() map[string]int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type GetHandlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []int64                `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHandlesRequest) Reset() {
+	*x = GetHandlesRequest{}
+	mi := &file_api_v1_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHandlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHandlesRequest) ProtoMessage() {}
+
+func (x *GetHandlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHandlesRequest.ProtoReflect.Descriptor instead.
+func (*GetHandlesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetHandlesRequest) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+// Handles of the active users among user_ids, keyed by user ID
+type GetHandlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Handles       map[int64]string       `protobuf:"bytes,1,rep,name=handles,proto3" json:"handles,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHandlesResponse) Reset() {
+	*x = GetHandlesResponse{}
+	mi := &file_api_v1_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHandlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHandlesResponse) ProtoMessage() {}
+
+func (x *GetHandlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHandlesResponse.ProtoReflect.Descriptor instead.
+func (*GetHandlesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetHandlesResponse) GetHandles() map[int64]string {
+	if x != nil {
+		return x.Handles
+	}
+	return nil
+}
+
 // For updating any part of the profile
 type UpdateProfileRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -368,7 +546,7 @@ type UpdateProfileRequest struct {
 
 func (x *UpdateProfileRequest) Reset() {
 	*x = UpdateProfileRequest{}
-	mi := &file_api_v1_user_proto_msgTypes[5]
+	mi := &file_api_v1_user_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -380,7 +558,7 @@ func (x *UpdateProfileRequest) String() string {
 func (*UpdateProfileRequest) ProtoMessage() {}
 
 func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_proto_msgTypes[5]
+	mi := &file_api_v1_user_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -393,7 +571,7 @@ func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_proto_rawDescGZIP(), []int{5}
+	return file_api_v1_user_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateProfileRequest) GetUserId() int64 {
@@ -435,7 +613,7 @@ type FriendRequest struct {
 
 func (x *FriendRequest) Reset() {
 	*x = FriendRequest{}
-	mi := &file_api_v1_user_proto_msgTypes[6]
+	mi := &file_api_v1_user_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -447,7 +625,7 @@ func (x *FriendRequest) String() string {
 func (*FriendRequest) ProtoMessage() {}
 
 func (x *FriendRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_proto_msgTypes[6]
+	mi := &file_api_v1_user_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -460,7 +638,7 @@ func (x *FriendRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FriendRequest.ProtoReflect.Descriptor instead.
 func (*FriendRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_proto_rawDescGZIP(), []int{6}
+	return file_api_v1_user_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *FriendRequest) GetUserId() int64 {
@@ -487,7 +665,7 @@ type FriendAcceptRequest struct {
 
 func (x *FriendAcceptRequest) Reset() {
 	*x = FriendAcceptRequest{}
-	mi := &file_api_v1_user_proto_msgTypes[7]
+	mi := &file_api_v1_user_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -499,7 +677,7 @@ func (x *FriendAcceptRequest) String() string {
 func (*FriendAcceptRequest) ProtoMessage() {}
 
 func (x *FriendAcceptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_proto_msgTypes[7]
+	mi := &file_api_v1_user_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -512,7 +690,7 @@ func (x *FriendAcceptRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FriendAcceptRequest.ProtoReflect.Descriptor instead.
 func (*FriendAcceptRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_proto_rawDescGZIP(), []int{7}
+	return file_api_v1_user_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *FriendAcceptRequest) GetUserId() int64 {
@@ -538,7 +716,7 @@ type UserID struct {
 
 func (x *UserID) Reset() {
 	*x = UserID{}
-	mi := &file_api_v1_user_proto_msgTypes[8]
+	mi := &file_api_v1_user_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -550,7 +728,7 @@ func (x *UserID) String() string {
 func (*UserID) ProtoMessage() {}
 
 func (x *UserID) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_proto_msgTypes[8]
+	mi := &file_api_v1_user_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -563,7 +741,7 @@ func (x *UserID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserID.ProtoReflect.Descriptor instead.
 func (*UserID) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_proto_rawDescGZIP(), []int{8}
+	return file_api_v1_user_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UserID) GetUserId() int64 {
@@ -586,7 +764,7 @@ type Friend struct {
 
 func (x *Friend) Reset() {
 	*x = Friend{}
-	mi := &file_api_v1_user_proto_msgTypes[9]
+	mi := &file_api_v1_user_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -598,7 +776,7 @@ func (x *Friend) String() string {
 func (*Friend) ProtoMessage() {}
 
 func (x *Friend) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_proto_msgTypes[9]
+	mi := &file_api_v1_user_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -611,7 +789,7 @@ func (x *Friend) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Friend.ProtoReflect.Descriptor instead.
 func (*Friend) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_proto_rawDescGZIP(), []int{9}
+	return file_api_v1_user_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Friend) GetUserId() int64 {
@@ -658,7 +836,7 @@ type FriendList struct {
 
 func (x *FriendList) Reset() {
 	*x = FriendList{}
-	mi := &file_api_v1_user_proto_msgTypes[10]
+	mi := &file_api_v1_user_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -670,7 +848,7 @@ func (x *FriendList) String() string {
 func (*FriendList) ProtoMessage() {}
 
 func (x *FriendList) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_proto_msgTypes[10]
+	mi := &file_api_v1_user_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -683,7 +861,7 @@ func (x *FriendList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FriendList.ProtoReflect.Descriptor instead.
 func (*FriendList) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_proto_rawDescGZIP(), []int{10}
+	return file_api_v1_user_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *FriendList) GetFriends() []*Friend {
@@ -693,6 +871,50 @@ func (x *FriendList) GetFriends() []*Friend {
 	return nil
 }
 
+type UserIDList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []int64                `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserIDList) Reset() {
+	*x = UserIDList{}
+	mi := &file_api_v1_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserIDList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserIDList) ProtoMessage() {}
+
+func (x *UserIDList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserIDList.ProtoReflect.Descriptor instead.
+func (*UserIDList) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UserIDList) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
 // --------------- Device Registration --------------------
 type DeviceTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -705,7 +927,7 @@ type DeviceTokenRequest struct {
 
 func (x *DeviceTokenRequest) Reset() {
 	*x = DeviceTokenRequest{}
-	mi := &file_api_v1_user_proto_msgTypes[11]
+	mi := &file_api_v1_user_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -717,7 +939,7 @@ func (x *DeviceTokenRequest) String() string {
 func (*DeviceTokenRequest) ProtoMessage() {}
 
 func (x *DeviceTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_proto_msgTypes[11]
+	mi := &file_api_v1_user_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -730,7 +952,7 @@ func (x *DeviceTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeviceTokenRequest.ProtoReflect.Descriptor instead.
 func (*DeviceTokenRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_proto_rawDescGZIP(), []int{11}
+	return file_api_v1_user_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *DeviceTokenRequest) GetUserId() int64 {
@@ -764,7 +986,7 @@ type DeviceToken struct {
 
 func (x *DeviceToken) Reset() {
 	*x = DeviceToken{}
-	mi := &file_api_v1_user_proto_msgTypes[12]
+	mi := &file_api_v1_user_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -776,7 +998,7 @@ func (x *DeviceToken) String() string {
 func (*DeviceToken) ProtoMessage() {}
 
 func (x *DeviceToken) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_proto_msgTypes[12]
+	mi := &file_api_v1_user_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -789,7 +1011,7 @@ func (x *DeviceToken) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeviceToken.ProtoReflect.Descriptor instead.
 func (*DeviceToken) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_proto_rawDescGZIP(), []int{12}
+	return file_api_v1_user_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *DeviceToken) GetDeviceToken() string {
@@ -815,7 +1037,7 @@ type DeviceTokenList struct {
 
 func (x *DeviceTokenList) Reset() {
 	*x = DeviceTokenList{}
-	mi := &file_api_v1_user_proto_msgTypes[13]
+	mi := &file_api_v1_user_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -827,7 +1049,7 @@ func (x *DeviceTokenList) String() string {
 func (*DeviceTokenList) ProtoMessage() {}
 
 func (x *DeviceTokenList) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_proto_msgTypes[13]
+	mi := &file_api_v1_user_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -840,7 +1062,7 @@ func (x *DeviceTokenList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeviceTokenList.ProtoReflect.Descriptor instead.
 func (*DeviceTokenList) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_proto_rawDescGZIP(), []int{13}
+	return file_api_v1_user_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *DeviceTokenList) GetDevices() []*DeviceToken {
@@ -859,7 +1081,7 @@ type Empty struct {
 
 func (x *Empty) Reset() {
 	*x = Empty{}
-	mi := &file_api_v1_user_proto_msgTypes[14]
+	mi := &file_api_v1_user_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -871,7 +1093,7 @@ func (x *Empty) String() string {
 func (*Empty) ProtoMessage() {}
 
 func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_proto_msgTypes[14]
+	mi := &file_api_v1_user_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -884,7 +1106,7 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Empty.ProtoReflect.Descriptor instead.
 func (*Empty) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_proto_rawDescGZIP(), []int{14}
+	return file_api_v1_user_proto_rawDescGZIP(), []int{19}
 }
 
 type StatusResponse struct {
@@ -897,7 +1119,7 @@ type StatusResponse struct {
 
 func (x *StatusResponse) Reset() {
 	*x = StatusResponse{}
-	mi := &file_api_v1_user_proto_msgTypes[15]
+	mi := &file_api_v1_user_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -909,7 +1131,7 @@ func (x *StatusResponse) String() string {
 func (*StatusResponse) ProtoMessage() {}
 
 func (x *StatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_proto_msgTypes[15]
+	mi := &file_api_v1_user_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -922,7 +1144,7 @@ func (x *StatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
 func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_proto_rawDescGZIP(), []int{15}
+	return file_api_v1_user_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *StatusResponse) GetMessage() string {
@@ -969,7 +1191,21 @@ const file_api_v1_user_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\tR\tupdatedAt\"\x84\x01\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\"1\n" +
+	"\x15ResolveHandlesRequest\x12\x18\n" +
+	"\ahandles\x18\x01 \x03(\tR\ahandles\"\x9c\x01\n" +
+	"\x16ResolveHandlesResponse\x12F\n" +
+	"\buser_ids\x18\x01 \x03(\v2+.api.v1.ResolveHandlesResponse.UserIdsEntryR\auserIds\x1a:\n" +
+	"\fUserIdsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\".\n" +
+	"\x11GetHandlesRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x03R\auserIds\"\x93\x01\n" +
+	"\x12GetHandlesResponse\x12A\n" +
+	"\ahandles\x18\x01 \x03(\v2'.api.v1.GetHandlesResponse.HandlesEntryR\ahandles\x1a:\n" +
+	"\fHandlesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x03R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x84\x01\n" +
 	"\x14UpdateProfileRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x14\n" +
@@ -991,7 +1227,10 @@ const file_api_v1_user_proto_rawDesc = "" +
 	"\x0ffriendship_date\x18\x05 \x01(\tR\x0efriendshipDate\"6\n" +
 	"\n" +
 	"FriendList\x12(\n" +
-	"\afriends\x18\x01 \x03(\v2\x0e.api.v1.FriendR\afriends\"l\n" +
+	"\afriends\x18\x01 \x03(\v2\x0e.api.v1.FriendR\afriends\"'\n" +
+	"\n" +
+	"UserIDList\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x03R\auserIds\"l\n" +
 	"\x12DeviceTokenRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12!\n" +
 	"\fdevice_token\x18\x02 \x01(\tR\vdeviceToken\x12\x1a\n" +
@@ -1004,16 +1243,20 @@ const file_api_v1_user_proto_rawDesc = "" +
 	"\x05Empty\"D\n" +
 	"\x0eStatusResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
-	"\asuccess\x18\x02 \x01(\bR\asuccess2\x8e\x05\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess2\xd8\x06\n" +
 	"\vUserService\x129\n" +
 	"\bRegister\x12\x17.api.v1.RegisterRequest\x1a\x14.api.v1.AuthResponse\x123\n" +
 	"\x05Login\x12\x14.api.v1.LoginRequest\x1a\x14.api.v1.AuthResponse\x12@\n" +
 	"\n" +
 	"GetProfile\x12\x19.api.v1.GetProfileRequest\x1a\x17.api.v1.ProfileResponse\x12E\n" +
-	"\rUpdateProfile\x12\x1c.api.v1.UpdateProfileRequest\x1a\x16.api.v1.StatusResponse\x12B\n" +
+	"\rUpdateProfile\x12\x1c.api.v1.UpdateProfileRequest\x1a\x16.api.v1.StatusResponse\x12O\n" +
+	"\x0eResolveHandles\x12\x1d.api.v1.ResolveHandlesRequest\x1a\x1e.api.v1.ResolveHandlesResponse\x12C\n" +
+	"\n" +
+	"GetHandles\x12\x19.api.v1.GetHandlesRequest\x1a\x1a.api.v1.GetHandlesResponse\x12B\n" +
 	"\x11SendFriendRequest\x12\x15.api.v1.FriendRequest\x1a\x16.api.v1.StatusResponse\x12J\n" +
 	"\x13AcceptFriendRequest\x12\x1b.api.v1.FriendAcceptRequest\x1a\x16.api.v1.StatusResponse\x121\n" +
-	"\vListFriends\x12\x0e.api.v1.UserID\x1a\x12.api.v1.FriendList\x12D\n" +
+	"\vListFriends\x12\x0e.api.v1.UserID\x1a\x12.api.v1.FriendList\x122\n" +
+	"\fListBlockers\x12\x0e.api.v1.UserID\x1a\x12.api.v1.UserIDList\x12D\n" +
 	"\x0eRegisterDevice\x12\x1a.api.v1.DeviceTokenRequest\x1a\x16.api.v1.StatusResponse\x12B\n" +
 	"\fRemoveDevice\x12\x1a.api.v1.DeviceTokenRequest\x1a\x16.api.v1.StatusResponse\x129\n" +
 	"\x0eGetUserDevices\x12\x0e.api.v1.UserID\x1a\x17.api.v1.DeviceTokenListB\x0fZ\r./api/v1/userb\x06proto3"
@@ -1030,53 +1273,68 @@ func file_api_v1_user_proto_rawDescGZIP() []byte {
 	return file_api_v1_user_proto_rawDescData
 }
 
-var file_api_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_api_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_api_v1_user_proto_goTypes = []any{
-	(*RegisterRequest)(nil),      // 0: api.v1.RegisterRequest
-	(*LoginRequest)(nil),         // 1: api.v1.LoginRequest
-	(*AuthResponse)(nil),         // 2: api.v1.AuthResponse
-	(*GetProfileRequest)(nil),    // 3: api.v1.GetProfileRequest
-	(*ProfileResponse)(nil),      // 4: api.v1.ProfileResponse
-	(*UpdateProfileRequest)(nil), // 5: api.v1.UpdateProfileRequest
-	(*FriendRequest)(nil),        // 6: api.v1.FriendRequest
-	(*FriendAcceptRequest)(nil),  // 7: api.v1.FriendAcceptRequest
-	(*UserID)(nil),               // 8: api.v1.UserID
-	(*Friend)(nil),               // 9: api.v1.Friend
-	(*FriendList)(nil),           // 10: api.v1.FriendList
-	(*DeviceTokenRequest)(nil),   // 11: api.v1.DeviceTokenRequest
-	(*DeviceToken)(nil),          // 12: api.v1.DeviceToken
-	(*DeviceTokenList)(nil),      // 13: api.v1.DeviceTokenList
-	(*Empty)(nil),                // 14: api.v1.Empty
-	(*StatusResponse)(nil),       // 15: api.v1.StatusResponse
+	(*RegisterRequest)(nil),        // 0: api.v1.RegisterRequest
+	(*LoginRequest)(nil),           // 1: api.v1.LoginRequest
+	(*AuthResponse)(nil),           // 2: api.v1.AuthResponse
+	(*GetProfileRequest)(nil),      // 3: api.v1.GetProfileRequest
+	(*ProfileResponse)(nil),        // 4: api.v1.ProfileResponse
+	(*ResolveHandlesRequest)(nil),  // 5: api.v1.ResolveHandlesRequest
+	(*ResolveHandlesResponse)(nil), // 6: api.v1.ResolveHandlesResponse
+	(*GetHandlesRequest)(nil),      // 7: api.v1.GetHandlesRequest
+	(*GetHandlesResponse)(nil),     // 8: api.v1.GetHandlesResponse
+	(*UpdateProfileRequest)(nil),   // 9: api.v1.UpdateProfileRequest
+	(*FriendRequest)(nil),          // 10: api.v1.FriendRequest
+	(*FriendAcceptRequest)(nil),    // 11: api.v1.FriendAcceptRequest
+	(*UserID)(nil),                 // 12: api.v1.UserID
+	(*Friend)(nil),                 // 13: api.v1.Friend
+	(*FriendList)(nil),             // 14: api.v1.FriendList
+	(*UserIDList)(nil),             // 15: api.v1.UserIDList
+	(*DeviceTokenRequest)(nil),     // 16: api.v1.DeviceTokenRequest
+	(*DeviceToken)(nil),            // 17: api.v1.DeviceToken
+	(*DeviceTokenList)(nil),        // 18: api.v1.DeviceTokenList
+	(*Empty)(nil),                  // 19: api.v1.Empty
+	(*StatusResponse)(nil),         // 20: api.v1.StatusResponse
+	nil,                            // 21: api.v1.ResolveHandlesResponse.UserIdsEntry
+	nil,                            // 22: api.v1.GetHandlesResponse.HandlesEntry
 }
 var file_api_v1_user_proto_depIdxs = []int32{
-	9,  // 0: api.v1.FriendList.friends:type_name -> api.v1.Friend
-	12, // 1: api.v1.DeviceTokenList.devices:type_name -> api.v1.DeviceToken
-	0,  // 2: api.v1.UserService.Register:input_type -> api.v1.RegisterRequest
-	1,  // 3: api.v1.UserService.Login:input_type -> api.v1.LoginRequest
-	3,  // 4: api.v1.UserService.GetProfile:input_type -> api.v1.GetProfileRequest
-	5,  // 5: api.v1.UserService.UpdateProfile:input_type -> api.v1.UpdateProfileRequest
-	6,  // 6: api.v1.UserService.SendFriendRequest:input_type -> api.v1.FriendRequest
-	7,  // 7: api.v1.UserService.AcceptFriendRequest:input_type -> api.v1.FriendAcceptRequest
-	8,  // 8: api.v1.UserService.ListFriends:input_type -> api.v1.UserID
-	11, // 9: api.v1.UserService.RegisterDevice:input_type -> api.v1.DeviceTokenRequest
-	11, // 10: api.v1.UserService.RemoveDevice:input_type -> api.v1.DeviceTokenRequest
-	8,  // 11: api.v1.UserService.GetUserDevices:input_type -> api.v1.UserID
-	2,  // 12: api.v1.UserService.Register:output_type -> api.v1.AuthResponse
-	2,  // 13: api.v1.UserService.Login:output_type -> api.v1.AuthResponse
-	4,  // 14: api.v1.UserService.GetProfile:output_type -> api.v1.ProfileResponse
-	15, // 15: api.v1.UserService.UpdateProfile:output_type -> api.v1.StatusResponse
-	15, // 16: api.v1.UserService.SendFriendRequest:output_type -> api.v1.StatusResponse
-	15, // 17: api.v1.UserService.AcceptFriendRequest:output_type -> api.v1.StatusResponse
-	10, // 18: api.v1.UserService.ListFriends:output_type -> api.v1.FriendList
-	15, // 19: api.v1.UserService.RegisterDevice:output_type -> api.v1.StatusResponse
-	15, // 20: api.v1.UserService.RemoveDevice:output_type -> api.v1.StatusResponse
-	13, // 21: api.v1.UserService.GetUserDevices:output_type -> api.v1.DeviceTokenList
-	12, // [12:22] is the sub-list for method output_type
-	2,  // [2:12] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	21, // 0: api.v1.ResolveHandlesResponse.user_ids:type_name -> api.v1.ResolveHandlesResponse.UserIdsEntry
+	22, // 1: api.v1.GetHandlesResponse.handles:type_name -> api.v1.GetHandlesResponse.HandlesEntry
+	13, // 2: api.v1.FriendList.friends:type_name -> api.v1.Friend
+	17, // 3: api.v1.DeviceTokenList.devices:type_name -> api.v1.DeviceToken
+	0,  // 4: api.v1.UserService.Register:input_type -> api.v1.RegisterRequest
+	1,  // 5: api.v1.UserService.Login:input_type -> api.v1.LoginRequest
+	3,  // 6: api.v1.UserService.GetProfile:input_type -> api.v1.GetProfileRequest
+	9,  // 7: api.v1.UserService.UpdateProfile:input_type -> api.v1.UpdateProfileRequest
+	5,  // 8: api.v1.UserService.ResolveHandles:input_type -> api.v1.ResolveHandlesRequest
+	7,  // 9: api.v1.UserService.GetHandles:input_type -> api.v1.GetHandlesRequest
+	10, // 10: api.v1.UserService.SendFriendRequest:input_type -> api.v1.FriendRequest
+	11, // 11: api.v1.UserService.AcceptFriendRequest:input_type -> api.v1.FriendAcceptRequest
+	12, // 12: api.v1.UserService.ListFriends:input_type -> api.v1.UserID
+	12, // 13: api.v1.UserService.ListBlockers:input_type -> api.v1.UserID
+	16, // 14: api.v1.UserService.RegisterDevice:input_type -> api.v1.DeviceTokenRequest
+	16, // 15: api.v1.UserService.RemoveDevice:input_type -> api.v1.DeviceTokenRequest
+	12, // 16: api.v1.UserService.GetUserDevices:input_type -> api.v1.UserID
+	2,  // 17: api.v1.UserService.Register:output_type -> api.v1.AuthResponse
+	2,  // 18: api.v1.UserService.Login:output_type -> api.v1.AuthResponse
+	4,  // 19: api.v1.UserService.GetProfile:output_type -> api.v1.ProfileResponse
+	20, // 20: api.v1.UserService.UpdateProfile:output_type -> api.v1.StatusResponse
+	6,  // 21: api.v1.UserService.ResolveHandles:output_type -> api.v1.ResolveHandlesResponse
+	8,  // 22: api.v1.UserService.GetHandles:output_type -> api.v1.GetHandlesResponse
+	20, // 23: api.v1.UserService.SendFriendRequest:output_type -> api.v1.StatusResponse
+	20, // 24: api.v1.UserService.AcceptFriendRequest:output_type -> api.v1.StatusResponse
+	14, // 25: api.v1.UserService.ListFriends:output_type -> api.v1.FriendList
+	15, // 26: api.v1.UserService.ListBlockers:output_type -> api.v1.UserIDList
+	20, // 27: api.v1.UserService.RegisterDevice:output_type -> api.v1.StatusResponse
+	20, // 28: api.v1.UserService.RemoveDevice:output_type -> api.v1.StatusResponse
+	18, // 29: api.v1.UserService.GetUserDevices:output_type -> api.v1.DeviceTokenList
+	17, // [17:30] is the sub-list for method output_type
+	4,  // [4:17] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_user_proto_init() }
@@ -1090,7 +1348,7 @@ func file_api_v1_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_user_proto_rawDesc), len(file_api_v1_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
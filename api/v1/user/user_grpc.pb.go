@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.5.1
-// - protoc             v6.31.1
+// - protoc             v5.29.3
 // source: api/v1/user.proto
 
 package user
@@ -23,9 +23,12 @@ const (
 	UserService_Login_FullMethodName               = "/api.v1.UserService/Login"
 	UserService_GetProfile_FullMethodName          = "/api.v1.UserService/GetProfile"
 	UserService_UpdateProfile_FullMethodName       = "/api.v1.UserService/UpdateProfile"
+	UserService_ResolveHandles_FullMethodName      = "/api.v1.UserService/ResolveHandles"
+	UserService_GetHandles_FullMethodName          = "/api.v1.UserService/GetHandles"
 	UserService_SendFriendRequest_FullMethodName   = "/api.v1.UserService/SendFriendRequest"
 	UserService_AcceptFriendRequest_FullMethodName = "/api.v1.UserService/AcceptFriendRequest"
 	UserService_ListFriends_FullMethodName         = "/api.v1.UserService/ListFriends"
+	UserService_ListBlockers_FullMethodName        = "/api.v1.UserService/ListBlockers"
 	UserService_RegisterDevice_FullMethodName      = "/api.v1.UserService/RegisterDevice"
 	UserService_RemoveDevice_FullMethodName        = "/api.v1.UserService/RemoveDevice"
 	UserService_GetUserDevices_FullMethodName      = "/api.v1.UserService/GetUserDevices"
@@ -43,10 +46,13 @@ type UserServiceClient interface {
 	// Profile management (require auth)
 	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error)
 	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	ResolveHandles(ctx context.Context, in *ResolveHandlesRequest, opts ...grpc.CallOption) (*ResolveHandlesResponse, error)
+	GetHandles(ctx context.Context, in *GetHandlesRequest, opts ...grpc.CallOption) (*GetHandlesResponse, error)
 	// Friendships
 	SendFriendRequest(ctx context.Context, in *FriendRequest, opts ...grpc.CallOption) (*StatusResponse, error)
 	AcceptFriendRequest(ctx context.Context, in *FriendAcceptRequest, opts ...grpc.CallOption) (*StatusResponse, error)
 	ListFriends(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*FriendList, error)
+	ListBlockers(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*UserIDList, error)
 	// Devices
 	RegisterDevice(ctx context.Context, in *DeviceTokenRequest, opts ...grpc.CallOption) (*StatusResponse, error)
 	RemoveDevice(ctx context.Context, in *DeviceTokenRequest, opts ...grpc.CallOption) (*StatusResponse, error)
@@ -101,6 +107,26 @@ func (c *userServiceClient) UpdateProfile(ctx context.Context, in *UpdateProfile
 	return out, nil
 }
 
+func (c *userServiceClient) ResolveHandles(ctx context.Context, in *ResolveHandlesRequest, opts ...grpc.CallOption) (*ResolveHandlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveHandlesResponse)
+	err := c.cc.Invoke(ctx, UserService_ResolveHandles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetHandles(ctx context.Context, in *GetHandlesRequest, opts ...grpc.CallOption) (*GetHandlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHandlesResponse)
+	err := c.cc.Invoke(ctx, UserService_GetHandles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) SendFriendRequest(ctx context.Context, in *FriendRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StatusResponse)
@@ -131,6 +157,16 @@ func (c *userServiceClient) ListFriends(ctx context.Context, in *UserID, opts ..
 	return out, nil
 }
 
+func (c *userServiceClient) ListBlockers(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*UserIDList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserIDList)
+	err := c.cc.Invoke(ctx, UserService_ListBlockers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) RegisterDevice(ctx context.Context, in *DeviceTokenRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StatusResponse)
@@ -173,10 +209,13 @@ type UserServiceServer interface {
 	// Profile management (require auth)
 	GetProfile(context.Context, *GetProfileRequest) (*ProfileResponse, error)
 	UpdateProfile(context.Context, *UpdateProfileRequest) (*StatusResponse, error)
+	ResolveHandles(context.Context, *ResolveHandlesRequest) (*ResolveHandlesResponse, error)
+	GetHandles(context.Context, *GetHandlesRequest) (*GetHandlesResponse, error)
 	// Friendships
 	SendFriendRequest(context.Context, *FriendRequest) (*StatusResponse, error)
 	AcceptFriendRequest(context.Context, *FriendAcceptRequest) (*StatusResponse, error)
 	ListFriends(context.Context, *UserID) (*FriendList, error)
+	ListBlockers(context.Context, *UserID) (*UserIDList, error)
 	// Devices
 	RegisterDevice(context.Context, *DeviceTokenRequest) (*StatusResponse, error)
 	RemoveDevice(context.Context, *DeviceTokenRequest) (*StatusResponse, error)
@@ -203,6 +242,12 @@ func (UnimplementedUserServiceServer) GetProfile(context.Context, *GetProfileReq
 func (UnimplementedUserServiceServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*StatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
 }
+func (UnimplementedUserServiceServer) ResolveHandles(context.Context, *ResolveHandlesRequest) (*ResolveHandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveHandles not implemented")
+}
+func (UnimplementedUserServiceServer) GetHandles(context.Context, *GetHandlesRequest) (*GetHandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHandles not implemented")
+}
 func (UnimplementedUserServiceServer) SendFriendRequest(context.Context, *FriendRequest) (*StatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendFriendRequest not implemented")
 }
@@ -212,6 +257,9 @@ func (UnimplementedUserServiceServer) AcceptFriendRequest(context.Context, *Frie
 func (UnimplementedUserServiceServer) ListFriends(context.Context, *UserID) (*FriendList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFriends not implemented")
 }
+func (UnimplementedUserServiceServer) ListBlockers(context.Context, *UserID) (*UserIDList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlockers not implemented")
+}
 func (UnimplementedUserServiceServer) RegisterDevice(context.Context, *DeviceTokenRequest) (*StatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterDevice not implemented")
 }
@@ -314,6 +362,42 @@ func _UserService_UpdateProfile_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ResolveHandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveHandlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ResolveHandles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ResolveHandles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ResolveHandles(ctx, req.(*ResolveHandlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetHandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHandlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetHandles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetHandles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetHandles(ctx, req.(*GetHandlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_SendFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FriendRequest)
 	if err := dec(in); err != nil {
@@ -368,6 +452,24 @@ func _UserService_ListFriends_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ListBlockers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListBlockers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListBlockers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListBlockers(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_RegisterDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeviceTokenRequest)
 	if err := dec(in); err != nil {
@@ -445,6 +547,14 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateProfile",
 			Handler:    _UserService_UpdateProfile_Handler,
 		},
+		{
+			MethodName: "ResolveHandles",
+			Handler:    _UserService_ResolveHandles_Handler,
+		},
+		{
+			MethodName: "GetHandles",
+			Handler:    _UserService_GetHandles_Handler,
+		},
 		{
 			MethodName: "SendFriendRequest",
 			Handler:    _UserService_SendFriendRequest_Handler,
@@ -457,6 +567,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListFriends",
 			Handler:    _UserService_ListFriends_Handler,
 		},
+		{
+			MethodName: "ListBlockers",
+			Handler:    _UserService_ListBlockers_Handler,
+		},
 		{
 			MethodName: "RegisterDevice",
 			Handler:    _UserService_RegisterDevice_Handler,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
@@ -27,7 +28,10 @@ func main() {
 	defer cleanup()
 
 	// Run migrations in main.go where they belong
-	if err := app.DB.AutoMigrate(&dbmysql.Message{}); err != nil {
+	if err := app.DB.AutoMigrate(&dbmysql.Message{}, &dbmysql.Bot{}, &dbmysql.BotConversation{},
+		&dbmysql.ChatEvent{}, &dbmysql.ConversationMember{}, &dbmysql.DeviceSyncCursor{},
+		&dbmysql.ConversationSetting{}, &dbmysql.DeviceKeyBundle{}, &dbmysql.OneTimePrekey{}, &dbmysql.E2EConversation{},
+		&dbmysql.ModerationDecision{}); err != nil {
 		log.Fatalf("Failed to migrate database: %v", err)
 	}
 
@@ -55,12 +59,29 @@ func main() {
 		}
 	}()
 
+	// Inbound HTTP endpoint for bots posting into conversations
+	botServer := &http.Server{
+		Addr:    ":" + app.Config.Server.BotHTTPPort,
+		Handler: app.BotHTTP,
+	}
+	go func() {
+		log.Printf("Bot HTTP endpoint running on port %s", app.Config.Server.BotHTTPPort)
+		if err := botServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("Failed to serve bot endpoint: %v", err)
+		}
+	}()
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
 	log.Println("Shutting down Chat Service...")
 	grpcServer.GracefulStop()
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := botServer.Shutdown(shutdownCtx); err != nil {
+		log.Printf("Bot HTTP endpoint shutdown error: %v", err)
+	}
 	log.Println("Chat Service stopped")
 }
 
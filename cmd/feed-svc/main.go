@@ -11,6 +11,7 @@ import (
 	"time"
 
 	feedpb "gosocial/api/v1/feed"
+	"gosocial/internal/common"
 	"gosocial/internal/dbmysql"
 	"gosocial/internal/di"
 	//"gosocial/internal/dbmongo/media_storage.go" // <--- correct import
@@ -37,14 +38,30 @@ func main() {
 		&dbmysql.MediaRef{},
 		&dbmysql.Reaction{},
 		&dbmysql.User{},
+		&dbmysql.TimelineEntry{},
+		&dbmysql.Comment{},
+		&dbmysql.StoryView{},
+		&dbmysql.ReelView{},
+		&dbmysql.Highlight{},
+		&dbmysql.HighlightStory{},
+		&dbmysql.Hashtag{},
+		&dbmysql.ContentHashtag{},
+		&dbmysql.Mention{},
+		&dbmysql.ContentRevision{},
+		&dbmysql.ContentMedia{},
+		&dbmysql.Collection{},
+		&dbmysql.SavedItem{},
+		&dbmysql.AudienceList{},
+		&dbmysql.AudienceListMember{},
 	); err != nil {
 		log.Fatalf("Failed to migrate database: %v", err)
 	}
 	log.Println("✅ Database migration completed")
 
-	// Create gRPC server
+	// Create gRPC server, every feed RPC acts for the user authenticated from the request's JWT
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(loggingUnaryInterceptor),
+		grpc.ChainUnaryInterceptor(loggingUnaryInterceptor, common.AuthInterceptor()),
+		grpc.StreamInterceptor(common.AuthStreamInterceptor()),
 	)
 	feedpb.RegisterFeedServiceServer(grpcServer, app.Handler)
 	reflection.Register(grpcServer)
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"gosocial/internal/chat/service"
+)
+
+// BotHTTPHandler is the inbound endpoint bots use to post into their conversations
+type BotHTTPHandler struct {
+	botService service.BotService
+	router     *mux.Router
+}
+
+type botMessageRequest struct {
+	Content string `json:"content"`
+}
+
+type botMessageResponse struct {
+	MessageID      uint      `json:"message_id"`
+	ConversationID string    `json:"conversation_id"`
+	SenderID       string    `json:"sender_id"`
+	Content        string    `json:"content"`
+	SentAt         time.Time `json:"sent_at"`
+}
+
+func NewBotHTTPHandler(botService service.BotService) *BotHTTPHandler {
+	h := &BotHTTPHandler{botService: botService}
+
+	router := mux.NewRouter()
+	// POST /bots/v1/conversations/{conversationId}/messages with "Authorization: Bot <token>"
+	router.HandleFunc("/bots/v1/conversations/{conversationId}/messages", h.postMessage).Methods("POST")
+	h.router = router
+
+	return h
+}
+
+func (h *BotHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.router.ServeHTTP(w, r)
+}
+
+func (h *BotHTTPHandler) postMessage(w http.ResponseWriter, r *http.Request) {
+	bot, err := h.botService.Authenticate(r.Context(), botToken(r))
+	if err != nil {
+		http.Error(w, "invalid bot token", http.StatusUnauthorized)
+		return
+	}
+
+	var req botMessageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid JSON body", http.StatusBadRequest)
+		return
+	}
+
+	conversationID := mux.Vars(r)["conversationId"]
+	msg, err := h.botService.PostMessage(r.Context(), bot, conversationID, req.Content)
+	if errors.Is(err, service.ErrBotNotInConversation) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(botMessageResponse{
+		MessageID:      msg.MessageID,
+		ConversationID: msg.ConversationID,
+		SenderID:       msg.SenderID,
+		Content:        msg.Content,
+		SentAt:         msg.SentAt,
+	}); err != nil {
+		log.Printf("Failed to write bot message response: %v", err)
+	}
+}
+
+// botToken reads the token from "Authorization: Bot <token>", "Bearer" is accepted too
+func botToken(r *http.Request) string {
+	parts := strings.Fields(r.Header.Get("Authorization"))
+	if len(parts) != 2 {
+		return ""
+	}
+	scheme := strings.ToLower(parts[0])
+	if scheme != "bot" && scheme != "bearer" {
+		return ""
+	}
+	return parts[1]
+}
@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"gosocial/internal/chat/handler/mocks"
+	"gosocial/internal/chat/service"
+	"gosocial/internal/dbmysql"
+)
+
+func TestBotHTTPHandler_PostMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBots := mocks.NewMockBotService(ctrl)
+	h := NewBotHTTPHandler(mockBots)
+	bot := &dbmysql.Bot{BotID: 4}
+
+	tests := []struct {
+		name       string
+		auth       string
+		body       string
+		mockSetup  func()
+		wantStatus int
+	}{
+		{
+			name: "invalid token",
+			auth: "Bot nope",
+			body: `{"content":"hi"}`,
+			mockSetup: func() {
+				mockBots.EXPECT().Authenticate(gomock.Any(), "nope").Return(nil, service.ErrInvalidBotToken)
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "bad body",
+			auth: "Bot good",
+			body: `not json`,
+			mockSetup: func() {
+				mockBots.EXPECT().Authenticate(gomock.Any(), "good").Return(bot, nil)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "bot not in conversation",
+			auth: "Bot good",
+			body: `{"content":"hi"}`,
+			mockSetup: func() {
+				mockBots.EXPECT().Authenticate(gomock.Any(), "good").Return(bot, nil)
+				mockBots.EXPECT().PostMessage(gomock.Any(), bot, "conv-1", "hi").Return(nil, service.ErrBotNotInConversation)
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name: "validation error",
+			auth: "Bearer good",
+			body: `{"content":""}`,
+			mockSetup: func() {
+				mockBots.EXPECT().Authenticate(gomock.Any(), "good").Return(bot, nil)
+				mockBots.EXPECT().PostMessage(gomock.Any(), bot, "conv-1", "").Return(nil, errors.New("message content cannot be empty"))
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "message posted",
+			auth: "Bot good",
+			body: `{"content":"deploy finished"}`,
+			mockSetup: func() {
+				mockBots.EXPECT().Authenticate(gomock.Any(), "good").Return(bot, nil)
+				mockBots.EXPECT().PostMessage(gomock.Any(), bot, "conv-1", "deploy finished").Return(&dbmysql.Message{
+					MessageID:      10,
+					ConversationID: "conv-1",
+					SenderID:       "bot:4",
+					Content:        "deploy finished",
+					SentAt:         time.Now().UTC(),
+				}, nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+
+			req := httptest.NewRequest(http.MethodPost, "/bots/v1/conversations/conv-1/messages", strings.NewReader(tt.body))
+			req.Header.Set("Authorization", tt.auth)
+			rec := httptest.NewRecorder()
+
+			h.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusCreated {
+				var resp botMessageResponse
+				require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+				assert.Equal(t, uint(10), resp.MessageID)
+				assert.Equal(t, "bot:4", resp.SenderID)
+			}
+		})
+	}
+}
@@ -3,10 +3,12 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"sync"
+	"time"
 
 	pb "gosocial/api/v1/chat" 
 
@@ -14,25 +16,50 @@ import (
 	"gosocial/internal/dbmysql"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type ChatHandler struct {
 	pb.UnimplementedChatServiceServer
 	chatService service.ChatService
+	botService  service.BotService
+	syncService  service.SyncService
+	inboxService service.InboxService
+	e2eService   service.E2EService
 	mu          sync.RWMutex
 	streams     map[string][]pb.ChatService_StreamMessagesServer
 }
 
-func NewChatHandler(chatService service.ChatService) *ChatHandler {
-	return &ChatHandler{
-		chatService: chatService,
+// NewChatHandler subscribes to the chat service so every saved message, bot replies included, reaches open streams
+func NewChatHandler(
+	chatService service.ChatService,
+	botService service.BotService,
+	syncService service.SyncService,
+	inboxService service.InboxService,
+	e2eService service.E2EService,
+) *ChatHandler {
+	h := &ChatHandler{
+		chatService:  chatService,
+		botService:   botService,
+		syncService:  syncService,
+		inboxService: inboxService,
+		e2eService:   e2eService,
 		streams: make(map[string][]pb.ChatService_StreamMessagesServer),
 	}
+	if chatService != nil {
+		chatService.Subscribe(h)
+	}
+	return h
 }
 
 //SendMessages is a method that exists
 func (h *ChatHandler) SendMessages(ctx context.Context, req *pb.SendMessageRequest) (*pb.SendMessageResponse, error) {
+	// Bots post through the authenticated bot endpoint only
+	if service.IsBotSender(req.SenderId) {
+		return nil, status.Error(codes.PermissionDenied, "bot senders must use the bot API")
+	}
+
 	domainMsg := &dbmysql.Message{
 		ConversationID: req.ConversationId,
 		SenderID: req.SenderId,
@@ -41,31 +68,36 @@ func (h *ChatHandler) SendMessages(ctx context.Context, req *pb.SendMessageReque
 
 	savedMsg, err := h.chatService.SendMessage(ctx, domainMsg)
 
+	if errors.Is(err, service.ErrMessageRejected) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, service.ErrNotConversationMember) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
 	if err != nil {
 		return nil, fmt.Errorf( "failed to send Message %v : internal codes : %v", err, codes.Internal )
 	}
 
-	protoMsg := &pb.ChatMessage{
-		ConversationId: savedMsg.ConversationID,
-		SenderId: savedMsg.SenderID,
-		Content: savedMsg.Content,
-		SentAt: timestamppb.New(savedMsg.SentAt),
-	}
-
-	h.broadcastToStream(savedMsg.ConversationID, protoMsg)
-
 	return &pb.SendMessageResponse{
 		Success: true,
-		Message: protoMsg,
+		Message: toProtoMessage(savedMsg),
 	}, nil
 }
 
 func (h *ChatHandler) GetChatHistory(ctx context.Context, req *pb.GetChatHistoryRequest) (*pb.GetChatHistoryResponse, error) {
-	domainMessages, err := h.chatService.GetMessageHistory(ctx, req.ConversationId)
+	domainMessages, err := h.chatService.GetMessageHistory(ctx, req.ConversationId, req.UserId)
+	if errors.Is(err, service.ErrNotConversationMember) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
 	if err != nil {
 		return nil, fmt.Errorf("Failed to get chat history : %v, Error codes: %v", err, codes.Internal)
 	}
 
+	endToEnd, err := h.isEndToEnd(ctx, req.ConversationId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get chat history: %v", err)
+	}
+
 	protoMessages := make([]*pb.ChatMessage, 0, len(domainMessages))
 
 	start := int(req.Offset)
@@ -76,12 +108,12 @@ func (h *ChatHandler) GetChatHistory(ctx context.Context, req *pb.GetChatHistory
 		start = 0
 	}
 	if limit <= 0 {
-		return &pb.GetChatHistoryResponse{Messages: []*pb.ChatMessage{}}, nil
+		return &pb.GetChatHistoryResponse{Messages: []*pb.ChatMessage{}, EndToEndEncrypted: endToEnd}, nil
 	}
 
 	// Check if start is beyond available messages
 	if start >= len(domainMessages) {
-		return &pb.GetChatHistoryResponse{Messages: []*pb.ChatMessage{}}, nil
+		return &pb.GetChatHistoryResponse{Messages: []*pb.ChatMessage{}, EndToEndEncrypted: endToEnd}, nil
 	}
 
 	end := start + limit
@@ -90,17 +122,12 @@ func (h *ChatHandler) GetChatHistory(ctx context.Context, req *pb.GetChatHistory
 	}
 
 	for _, msg := range domainMessages[start:end] {
-		protoMessage := &pb.ChatMessage{
-			ConversationId: msg.ConversationID,
-			SenderId:       msg.SenderID,
-			Content:        msg.Content,
-			SentAt:         timestamppb.New(msg.SentAt),
-		}
-		protoMessages = append(protoMessages, protoMessage)
+		protoMessages = append(protoMessages, toProtoMessage(msg))
 	}
 
 	return &pb.GetChatHistoryResponse{
-		Messages: protoMessages,
+		Messages:          protoMessages,
+		EndToEndEncrypted: endToEnd,
 	}, nil
 }
 
@@ -123,6 +150,10 @@ func (h *ChatHandler) StreamMessages(stream pb.ChatService_StreamMessagesServer)
 				h.streams[conversationID] = append(h.streams[conversationID], stream)
 				h.mu.Unlock()
 			}
+			if service.IsBotSender(protoMsg.SenderId) {
+				log.Printf("Rejected streamed message from bot sender %s", protoMsg.SenderId)
+				continue
+			}
 
 			domainMsg := &dbmysql.Message{
 				ConversationID: protoMsg.ConversationId,
@@ -130,20 +161,11 @@ func (h *ChatHandler) StreamMessages(stream pb.ChatService_StreamMessagesServer)
 				Content: protoMsg.Content,
 			} 
 
-			savedMsg, err := h.chatService.SendMessage(stream.Context(), domainMsg)
-
-			if err != nil {
+			// The saved message comes back to the streams through OnMessage
+			if _, err := h.chatService.SendMessage(stream.Context(), domainMsg); err != nil {
 				log.Printf("Failed to save Steamed Messages: %v", err)
 				continue
 			}
-
-			brodcastMsg := &pb.ChatMessage{
-				ConversationId: savedMsg.ConversationID,
-				SenderId: savedMsg.SenderID,
-				Content: savedMsg.Content,
-				SentAt: timestamppb.New(savedMsg.SentAt),
-			}
-			h.broadcastToStream(savedMsg.ConversationID, brodcastMsg)
 		}
 	}()
 
@@ -154,6 +176,329 @@ func (h *ChatHandler) StreamMessages(stream pb.ChatService_StreamMessagesServer)
 	}
 }
 
+func (h *ChatHandler) RegisterBot(ctx context.Context, req *pb.RegisterBotRequest) (*pb.RegisterBotResponse, error) {
+	bot, token, err := h.botService.RegisterBot(ctx, req.OwnerId, req.Name, req.WebhookUrl)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to register bot: %v", err)
+	}
+
+	return &pb.RegisterBotResponse{
+		BotId:         int64(bot.BotID),
+		ApiToken:      token,
+		WebhookSecret: bot.WebhookSecret,
+	}, nil
+}
+
+func (h *ChatHandler) AddBotToConversation(ctx context.Context, req *pb.AddBotToConversationRequest) (*pb.AddBotToConversationResponse, error) {
+	if req.BotId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid bot ID")
+	}
+
+	err := h.botService.AddToConversation(ctx, uint(req.BotId), req.ConversationId, req.AddedBy)
+	if errors.Is(err, service.ErrNotConversationMember) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to add bot: %v", err)
+	}
+
+	return &pb.AddBotToConversationResponse{Success: true}, nil
+}
+
+func (h *ChatHandler) EditMessage(ctx context.Context, req *pb.EditMessageRequest) (*pb.MessageActionResponse, error) {
+	if req.MessageId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid message ID")
+	}
+
+	msg, err := h.chatService.EditMessage(ctx, uint(req.MessageId), req.EditorId, req.Content)
+	if err != nil {
+		return nil, messageActionError("edit", err)
+	}
+
+	return &pb.MessageActionResponse{Success: true, Message: toProtoMessage(msg)}, nil
+}
+
+func (h *ChatHandler) DeleteMessage(ctx context.Context, req *pb.DeleteMessageRequest) (*pb.MessageActionResponse, error) {
+	if req.MessageId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid message ID")
+	}
+
+	msg, err := h.chatService.DeleteMessage(ctx, uint(req.MessageId), req.RequesterId)
+	if err != nil {
+		return nil, messageActionError("delete", err)
+	}
+
+	return &pb.MessageActionResponse{Success: true, Message: toProtoMessage(msg)}, nil
+}
+
+func (h *ChatHandler) MarkAsRead(ctx context.Context, req *pb.MarkAsReadRequest) (*pb.ChatStatusResponse, error) {
+	if req.UpToMessageId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid message ID")
+	}
+
+	if err := h.chatService.MarkRead(ctx, req.ConversationId, req.UserId, uint(req.UpToMessageId)); err != nil {
+		return nil, memberError("mark messages as read", err)
+	}
+
+	return &pb.ChatStatusResponse{Success: true}, nil
+}
+
+func (h *ChatHandler) CreateConversation(ctx context.Context, req *pb.CreateConversationRequest) (*pb.CreateConversationResponse, error) {
+	conversationID, err := h.syncService.CreateConversation(ctx, req.CreatorId, req.MemberIds)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to create conversation: %v", err)
+	}
+
+	return &pb.CreateConversationResponse{ConversationId: conversationID}, nil
+}
+
+func (h *ChatHandler) AddConversationMember(ctx context.Context, req *pb.ConversationMemberRequest) (*pb.ChatStatusResponse, error) {
+	if err := h.syncService.AddMember(ctx, req.ConversationId, req.UserId, req.ActorId); err != nil {
+		return nil, memberError("add member", err)
+	}
+
+	return &pb.ChatStatusResponse{Success: true}, nil
+}
+
+func (h *ChatHandler) RemoveConversationMember(ctx context.Context, req *pb.ConversationMemberRequest) (*pb.ChatStatusResponse, error) {
+	if err := h.syncService.RemoveMember(ctx, req.ConversationId, req.UserId, req.ActorId); err != nil {
+		return nil, memberError("remove member", err)
+	}
+
+	return &pb.ChatStatusResponse{Success: true}, nil
+}
+
+func (h *ChatHandler) SyncChanges(ctx context.Context, req *pb.SyncChangesRequest) (*pb.SyncChangesResponse, error) {
+	var sinceCursor *uint64
+	if req.SinceCursor != nil {
+		if *req.SinceCursor < 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+		cursor := uint64(*req.SinceCursor)
+		sinceCursor = &cursor
+	}
+
+	result, err := h.syncService.SyncChanges(ctx, req.UserId, req.DeviceToken, sinceCursor, int(req.Limit))
+	switch {
+	case errors.Is(err, service.ErrDeviceNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrDeviceNotOwned):
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "failed to sync changes: %v", err)
+	}
+
+	changes := make([]*pb.SyncChange, 0, len(result.Events))
+	for _, event := range result.Events {
+		change := &pb.SyncChange{
+			Cursor:         int64(event.EventID),
+			Kind:           event.Kind,
+			ConversationId: event.ConversationID,
+			ActorId:        event.ActorID,
+			SubjectId:      event.SubjectID,
+			Content:        event.Content,
+			OccurredAt:     timestamppb.New(event.CreatedAt),
+		}
+		if event.MessageID != nil {
+			change.MessageId = int64(*event.MessageID)
+		}
+		changes = append(changes, change)
+	}
+
+	return &pb.SyncChangesResponse{
+		Changes:    changes,
+		NextCursor: int64(result.NextCursor),
+		HasMore:    result.HasMore,
+	}, nil
+}
+
+func (h *ChatHandler) MuteConversation(ctx context.Context, req *pb.MuteConversationRequest) (*pb.ChatStatusResponse, error) {
+	var until time.Time
+	if req.MutedUntil != nil {
+		until = req.MutedUntil.AsTime()
+	}
+
+	if err := h.inboxService.Mute(ctx, req.ConversationId, req.UserId, until); err != nil {
+		return nil, memberError("mute conversation", err)
+	}
+
+	return &pb.ChatStatusResponse{Success: true}, nil
+}
+
+func (h *ChatHandler) ArchiveConversation(ctx context.Context, req *pb.ConversationFlagRequest) (*pb.ChatStatusResponse, error) {
+	if err := h.inboxService.SetArchived(ctx, req.ConversationId, req.UserId, req.Enabled); err != nil {
+		return nil, memberError("archive conversation", err)
+	}
+
+	return &pb.ChatStatusResponse{Success: true}, nil
+}
+
+func (h *ChatHandler) PinConversation(ctx context.Context, req *pb.ConversationFlagRequest) (*pb.ChatStatusResponse, error) {
+	if err := h.inboxService.SetPinned(ctx, req.ConversationId, req.UserId, req.Enabled); err != nil {
+		return nil, memberError("pin conversation", err)
+	}
+
+	return &pb.ChatStatusResponse{Success: true}, nil
+}
+
+func (h *ChatHandler) ListConversations(ctx context.Context, req *pb.ListConversationsRequest) (*pb.ListConversationsResponse, error) {
+	entries, err := h.inboxService.ListInbox(ctx, req.UserId, req.IncludeArchived)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to list conversations: %v", err)
+	}
+
+	conversations := make([]*pb.ConversationSummary, 0, len(entries))
+	for _, entry := range entries {
+		summary := &pb.ConversationSummary{
+			ConversationId: entry.ConversationID,
+			Archived:          entry.Setting.Archived,
+			Pinned:            entry.Setting.Pinned,
+			EndToEndEncrypted: entry.EndToEnd,
+		}
+		if entry.LastMessage != nil {
+			summary.LastMessage = toProtoMessage(entry.LastMessage)
+		}
+		if entry.Setting.IsMuted(time.Now()) {
+			summary.MutedUntil = timestamppb.New(*entry.Setting.MutedUntil)
+		}
+		conversations = append(conversations, summary)
+	}
+
+	return &pb.ListConversationsResponse{Conversations: conversations}, nil
+}
+
+func (h *ChatHandler) PublishKeys(ctx context.Context, req *pb.PublishKeysRequest) (*pb.PublishKeysResponse, error) {
+	bundle := &dbmysql.DeviceKeyBundle{
+		IdentityKey:           req.IdentityKey,
+		SignedPrekeyID:        req.SignedPrekeyId,
+		SignedPrekey:          req.SignedPrekey,
+		SignedPrekeySignature: req.SignedPrekeySignature,
+	}
+	prekeys := make([]*dbmysql.OneTimePrekey, 0, len(req.OneTimePrekeys))
+	for _, prekey := range req.OneTimePrekeys {
+		prekeys = append(prekeys, &dbmysql.OneTimePrekey{KeyID: prekey.KeyId, PublicKey: prekey.PublicKey})
+	}
+
+	deviceID, err := h.e2eService.PublishKeys(ctx, req.UserId, req.DeviceToken, bundle, prekeys)
+	switch {
+	case errors.Is(err, service.ErrDeviceNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrDeviceNotOwned):
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	case err != nil:
+		return nil, status.Errorf(codes.InvalidArgument, "failed to publish keys: %v", err)
+	}
+
+	return &pb.PublishKeysResponse{Success: true, DeviceId: deviceID}, nil
+}
+
+func (h *ChatHandler) GetKeyBundles(ctx context.Context, req *pb.GetKeyBundlesRequest) (*pb.GetKeyBundlesResponse, error) {
+	bundles, err := h.e2eService.GetKeyBundles(ctx, req.RequesterId, req.UserId)
+	if errors.Is(err, service.ErrNoSharedConversation) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to get key bundles: %v", err)
+	}
+
+	protoBundles := make([]*pb.KeyBundle, 0, len(bundles))
+	for _, bundle := range bundles {
+		protoBundle := &pb.KeyBundle{
+			DeviceId:              bundle.DeviceID,
+			IdentityKey:           bundle.IdentityKey,
+			SignedPrekeyId:        bundle.SignedPrekeyID,
+			SignedPrekey:          bundle.SignedPrekey,
+			SignedPrekeySignature: bundle.SignedPrekeySignature,
+		}
+		if bundle.OneTimePrekey != nil {
+			protoBundle.OneTimePrekey = &pb.OneTimePrekey{
+				KeyId:     bundle.OneTimePrekey.KeyID,
+				PublicKey: bundle.OneTimePrekey.PublicKey,
+			}
+		}
+		protoBundles = append(protoBundles, protoBundle)
+	}
+
+	return &pb.GetKeyBundlesResponse{UserId: req.UserId, Bundles: protoBundles}, nil
+}
+
+func (h *ChatHandler) EnableE2E(ctx context.Context, req *pb.EnableE2ERequest) (*pb.ChatStatusResponse, error) {
+	if err := h.e2eService.EnableE2E(ctx, req.ConversationId, req.UserId); err != nil {
+		return nil, memberError("enable end-to-end encryption", err)
+	}
+
+	return &pb.ChatStatusResponse{Success: true}, nil
+}
+
+func (h *ChatHandler) SearchMessages(ctx context.Context, req *pb.SearchMessagesRequest) (*pb.SearchMessagesResponse, error) {
+	messages, err := h.chatService.SearchMessages(ctx, req.ConversationId, req.UserId, req.Query, int(req.Limit))
+	if errors.Is(err, service.ErrE2EPlaintextRequired) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if errors.Is(err, service.ErrNotConversationMember) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to search messages: %v", err)
+	}
+
+	protoMessages := make([]*pb.ChatMessage, 0, len(messages))
+	for _, msg := range messages {
+		protoMessages = append(protoMessages, toProtoMessage(msg))
+	}
+
+	return &pb.SearchMessagesResponse{Messages: protoMessages}, nil
+}
+
+func (h *ChatHandler) isEndToEnd(ctx context.Context, conversationID string) (bool, error) {
+	err := h.chatService.CheckPlaintextAccess(ctx, conversationID)
+	if errors.Is(err, service.ErrE2EPlaintextRequired) {
+		return true, nil
+	}
+	return false, err
+}
+
+func toProtoMessage(msg *dbmysql.Message) *pb.ChatMessage {
+	return &pb.ChatMessage{
+		ConversationId: msg.ConversationID,
+		SenderId:       msg.SenderID,
+		Content:        msg.Content,
+		SentAt:         timestamppb.New(msg.SentAt),
+		MessageId:      int64(msg.MessageID),
+		Status:         msg.Status,
+	}
+}
+
+func messageActionError(action string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrMessageRejected):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrMessageNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrNotMessageSender):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrMessageDeleted):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Errorf(codes.InvalidArgument, "failed to %s message: %v", action, err)
+	}
+}
+
+func memberError(action string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrNotConversationMember):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrE2ENotOneToOne), errors.Is(err, service.ErrE2EMissingKeys):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Errorf(codes.InvalidArgument, "failed to %s: %v", action, err)
+}
+
+// OnMessage relays every saved message to the streams open on its conversation
+func (h *ChatHandler) OnMessage(ctx context.Context, msg *dbmysql.Message) {
+	h.broadcastToStream(msg.ConversationID, toProtoMessage(msg))
+}
+
 func (h *ChatHandler) broadcastToStream(conversationID string, msg *pb.ChatMessage) {
 	h.mu.RLock()
 	streams, ok := h.streams[conversationID]
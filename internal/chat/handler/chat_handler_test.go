@@ -10,10 +10,13 @@ import (
 	"go.uber.org/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "gosocial/api/v1/chat"
 	"gosocial/internal/chat/handler/mocks"
+	"gosocial/internal/chat/service"
 	"gosocial/internal/dbmysql"
 )
 
@@ -22,7 +25,8 @@ func TestChatHandler_SendMessages_Complete(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockService := mocks.NewMockChatService(ctrl)
-	handler := NewChatHandler(mockService)
+	mockService.EXPECT().Subscribe(gomock.Any())
+	handler := NewChatHandler(mockService, nil, nil, nil, nil)
 
 	tests := []struct {
 		name        string
@@ -75,6 +79,16 @@ func TestChatHandler_SendMessages_Complete(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			name: "bot_sender_refused",
+			request: &pb.SendMessageRequest{
+				ConversationId: "conv-123",
+				SenderId:       "bot:1",
+				Content:        "Hello",
+			},
+			mockSetup:   func() {},
+			expectError: true,
+		},
 		{
 			name: "empty_fields_handling",
 			request: &pb.SendMessageRequest{
@@ -115,13 +129,15 @@ func TestChatHandler_GetChatHistory_Complete(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockService := mocks.NewMockChatService(ctrl)
-	handler := NewChatHandler(mockService)
+	mockService.EXPECT().Subscribe(gomock.Any())
+	handler := NewChatHandler(mockService, nil, nil, nil, nil)
 
 	sampleMessages := []*dbmysql.Message{
 		{MessageID: 1, ConversationID: "conv-123", SenderID: "user-1", Content: "Msg1", SentAt: time.Now()},
 		{MessageID: 2, ConversationID: "conv-123", SenderID: "user-2", Content: "Msg2", SentAt: time.Now()},
 		{MessageID: 3, ConversationID: "conv-123", SenderID: "user-1", Content: "Msg3", SentAt: time.Now()},
 	}
+	mockService.EXPECT().CheckPlaintextAccess(gomock.Any(), "conv-123").Return(nil).AnyTimes()
 
 	tests := []struct {
 		name        string
@@ -134,12 +150,13 @@ func TestChatHandler_GetChatHistory_Complete(t *testing.T) {
 			name: "successful_pagination",
 			request: &pb.GetChatHistoryRequest{
 				ConversationId: "conv-123",
+				UserId:         "user-456",
 				Limit:          2,
 				Offset:         0,
 			},
 			mockSetup: func() {
 				mockService.EXPECT().
-					GetMessageHistory(gomock.Any(), "conv-123").
+					GetMessageHistory(gomock.Any(), "conv-123", "user-456").
 					Return(sampleMessages, nil).
 					Times(1)
 			},
@@ -152,12 +169,13 @@ func TestChatHandler_GetChatHistory_Complete(t *testing.T) {
 			name: "offset_beyond_messages",
 			request: &pb.GetChatHistoryRequest{
 				ConversationId: "conv-123",
+				UserId:         "user-456",
 				Limit:          10,
 				Offset:         50,
 			},
 			mockSetup: func() {
 				mockService.EXPECT().
-					GetMessageHistory(gomock.Any(), "conv-123").
+					GetMessageHistory(gomock.Any(), "conv-123", "user-456").
 					Return(sampleMessages, nil).
 					Times(1)
 			},
@@ -170,12 +188,13 @@ func TestChatHandler_GetChatHistory_Complete(t *testing.T) {
 			name: "negative_values",
 			request: &pb.GetChatHistoryRequest{
 				ConversationId: "conv-123",
+				UserId:         "user-456",
 				Limit:          -5,
 				Offset:         -10,
 			},
 			mockSetup: func() {
 				mockService.EXPECT().
-					GetMessageHistory(gomock.Any(), "conv-123").
+					GetMessageHistory(gomock.Any(), "conv-123", "user-456").
 					Return(sampleMessages, nil).
 					Times(1)
 			},
@@ -188,27 +207,43 @@ func TestChatHandler_GetChatHistory_Complete(t *testing.T) {
 			name: "service_error",
 			request: &pb.GetChatHistoryRequest{
 				ConversationId: "conv-123",
+				UserId:         "user-456",
 				Limit:          10,
 				Offset:         0,
 			},
 			mockSetup: func() {
 				mockService.EXPECT().
-					GetMessageHistory(gomock.Any(), "conv-123").
+					GetMessageHistory(gomock.Any(), "conv-123", "user-456").
 					Return(nil, errors.New("database error")).
 					Times(1)
 			},
 			expectError: true,
 		},
+		{
+			name: "non_member",
+			request: &pb.GetChatHistoryRequest{
+				ConversationId: "conv-123",
+				UserId:         "user-999",
+			},
+			mockSetup: func() {
+				mockService.EXPECT().
+					GetMessageHistory(gomock.Any(), "conv-123", "user-999").
+					Return(nil, service.ErrNotConversationMember).
+					Times(1)
+			},
+			expectError: true,
+		},
 		{
 			name: "zero_limit",
 			request: &pb.GetChatHistoryRequest{
 				ConversationId: "conv-123",
+				UserId:         "user-456",
 				Limit:          0,
 				Offset:         0,
 			},
 			mockSetup: func() {
 				mockService.EXPECT().
-					GetMessageHistory(gomock.Any(), "conv-123").
+					GetMessageHistory(gomock.Any(), "conv-123", "user-456").
 					Return(sampleMessages, nil).
 					Times(1)
 			},
@@ -243,7 +278,8 @@ func TestChatHandler_BroadcastToStream_BusinessLogic(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockService := mocks.NewMockChatService(ctrl)
-	handler := NewChatHandler(mockService)
+	mockService.EXPECT().Subscribe(gomock.Any())
+	handler := NewChatHandler(mockService, nil, nil, nil, nil)
 
 	t.Run("broadcast_to_nonexistent_conversation", func(t *testing.T) {
 		msg := &pb.ChatMessage{
@@ -278,7 +314,8 @@ func TestChatHandler_RemoveStream_BusinessLogic(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockService := mocks.NewMockChatService(ctrl)
-	handler := NewChatHandler(mockService)
+	mockService.EXPECT().Subscribe(gomock.Any())
+	handler := NewChatHandler(mockService, nil, nil, nil, nil)
 
 	t.Run("remove_from_nonexistent_conversation", func(t *testing.T) {
 		assert.NotPanics(t, func() {
@@ -298,7 +335,8 @@ func TestChatHandler_ConcurrentAccess(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockService := mocks.NewMockChatService(ctrl)
-	handler := NewChatHandler(mockService)
+	mockService.EXPECT().Subscribe(gomock.Any())
+	handler := NewChatHandler(mockService, nil, nil, nil, nil)
 
 	t.Run("concurrent_operations", func(t *testing.T) {
 		var wg sync.WaitGroup
@@ -329,3 +367,105 @@ func TestChatHandler_ConcurrentAccess(t *testing.T) {
 	})
 }
 
+
+func TestChatHandler_SyncChanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSync := mocks.NewMockSyncService(ctrl)
+	handler := NewChatHandler(nil, nil, mockSync, nil, nil)
+
+	t.Run("maps events to changes", func(t *testing.T) {
+		messageID := uint(9)
+		mockSync.EXPECT().
+			SyncChanges(gomock.Any(), "42", "phone-token", gomock.Nil(), 50).
+			Return(&service.SyncResult{
+				Events: []*dbmysql.ChatEvent{
+					{EventID: 3, ConversationID: "conv-1", Kind: service.EventMessageEdited, MessageID: &messageID, ActorID: "42", Content: "fixed typo"},
+					{EventID: 4, ConversationID: "conv-2", Kind: service.EventMemberRemoved, ActorID: "7", SubjectID: "42"},
+				},
+				NextCursor: 4,
+				HasMore:    true,
+			}, nil)
+
+		resp, err := handler.SyncChanges(context.Background(), &pb.SyncChangesRequest{UserId: "42", DeviceToken: "phone-token", Limit: 50})
+
+		require.NoError(t, err)
+		require.Len(t, resp.Changes, 2)
+		assert.Equal(t, int64(4), resp.NextCursor)
+		assert.True(t, resp.HasMore)
+		assert.Equal(t, int64(9), resp.Changes[0].MessageId)
+		assert.Equal(t, "fixed typo", resp.Changes[0].Content)
+		assert.Equal(t, int64(0), resp.Changes[1].MessageId)
+		assert.Equal(t, "42", resp.Changes[1].SubjectId)
+	})
+
+	t.Run("foreign device is denied", func(t *testing.T) {
+		mockSync.EXPECT().
+			SyncChanges(gomock.Any(), "42", "other-token", gomock.Nil(), 0).
+			Return(nil, service.ErrDeviceNotOwned)
+
+		_, err := handler.SyncChanges(context.Background(), &pb.SyncChangesRequest{UserId: "42", DeviceToken: "other-token"})
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("zero cursor asks for a full resync", func(t *testing.T) {
+		fromStart := uint64(0)
+		mockSync.EXPECT().
+			SyncChanges(gomock.Any(), "42", "phone-token", &fromStart, 0).
+			Return(&service.SyncResult{}, nil)
+
+		zero := int64(0)
+		_, err := handler.SyncChanges(context.Background(), &pb.SyncChangesRequest{UserId: "42", DeviceToken: "phone-token", SinceCursor: &zero})
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestChatHandler_EditMessage_NotSender(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockChatService(ctrl)
+	mockService.EXPECT().Subscribe(gomock.Any())
+	handler := NewChatHandler(mockService, nil, nil, nil, nil)
+
+	mockService.EXPECT().
+		EditMessage(gomock.Any(), uint(1), "user-789", "Edited").
+		Return(nil, service.ErrNotMessageSender)
+
+	_, err := handler.EditMessage(context.Background(), &pb.EditMessageRequest{MessageId: 1, EditorId: "user-789", Content: "Edited"})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestChatHandler_EndToEndConversation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockChatService(ctrl)
+	mockService.EXPECT().Subscribe(gomock.Any())
+	handler := NewChatHandler(mockService, nil, nil, nil, nil)
+
+	t.Run("history is flagged", func(t *testing.T) {
+		mockService.EXPECT().GetMessageHistory(gomock.Any(), "conv-e2e", "1").
+			Return([]*dbmysql.Message{{MessageID: 1, ConversationID: "conv-e2e", Content: "b64ciphertext"}}, nil)
+		mockService.EXPECT().CheckPlaintextAccess(gomock.Any(), "conv-e2e").Return(service.ErrE2EPlaintextRequired)
+
+		resp, err := handler.GetChatHistory(context.Background(), &pb.GetChatHistoryRequest{ConversationId: "conv-e2e", UserId: "1", Limit: 10})
+
+		require.NoError(t, err)
+		assert.True(t, resp.EndToEndEncrypted)
+		assert.Equal(t, "b64ciphertext", resp.Messages[0].Content)
+	})
+
+	t.Run("search is refused", func(t *testing.T) {
+		mockService.EXPECT().SearchMessages(gomock.Any(), "conv-e2e", "1", "hello", 0).
+			Return(nil, service.ErrE2EPlaintextRequired)
+
+		_, err := handler.SearchMessages(context.Background(), &pb.SearchMessagesRequest{ConversationId: "conv-e2e", UserId: "1", Query: "hello"})
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
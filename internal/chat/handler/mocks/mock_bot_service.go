@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../service/bot_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../service/bot_service.go -destination=mocks/mock_bot_service.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mocks 
+
+import (
+	context "context"
+	dbmysql "gosocial/internal/dbmysql"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBotService is a mock of BotService interface.
+type MockBotService struct {
+	ctrl     *gomock.Controller
+	recorder *MockBotServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockBotServiceMockRecorder is the mock recorder for MockBotService.
+type MockBotServiceMockRecorder struct {
+	mock *MockBotService
+}
+
+// NewMockBotService creates a new mock instance.
+func NewMockBotService(ctrl *gomock.Controller) *MockBotService {
+	mock := &MockBotService{ctrl: ctrl}
+	mock.recorder = &MockBotServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBotService) EXPECT() *MockBotServiceMockRecorder {
+	return m.recorder
+}
+
+// AddToConversation mocks base method.
+func (m *MockBotService) AddToConversation(ctx context.Context, botID uint, conversationID, addedBy string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddToConversation", ctx, botID, conversationID, addedBy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddToConversation indicates an expected call of AddToConversation.
+func (mr *MockBotServiceMockRecorder) AddToConversation(ctx, botID, conversationID, addedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToConversation", reflect.TypeOf((*MockBotService)(nil).AddToConversation), ctx, botID, conversationID, addedBy)
+}
+
+// Authenticate mocks base method.
+func (m *MockBotService) Authenticate(ctx context.Context, token string) (*dbmysql.Bot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, token)
+	ret0, _ := ret[0].(*dbmysql.Bot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockBotServiceMockRecorder) Authenticate(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockBotService)(nil).Authenticate), ctx, token)
+}
+
+// OnMessage mocks base method.
+func (m *MockBotService) OnMessage(ctx context.Context, msg *dbmysql.Message) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnMessage", ctx, msg)
+}
+
+// OnMessage indicates an expected call of OnMessage.
+func (mr *MockBotServiceMockRecorder) OnMessage(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnMessage", reflect.TypeOf((*MockBotService)(nil).OnMessage), ctx, msg)
+}
+
+// PostMessage mocks base method.
+func (m *MockBotService) PostMessage(ctx context.Context, bot *dbmysql.Bot, conversationID, content string) (*dbmysql.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostMessage", ctx, bot, conversationID, content)
+	ret0, _ := ret[0].(*dbmysql.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostMessage indicates an expected call of PostMessage.
+func (mr *MockBotServiceMockRecorder) PostMessage(ctx, bot, conversationID, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostMessage", reflect.TypeOf((*MockBotService)(nil).PostMessage), ctx, bot, conversationID, content)
+}
+
+// RegisterBot mocks base method.
+func (m *MockBotService) RegisterBot(ctx context.Context, ownerID, name, webhookURL string) (*dbmysql.Bot, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterBot", ctx, ownerID, name, webhookURL)
+	ret0, _ := ret[0].(*dbmysql.Bot)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RegisterBot indicates an expected call of RegisterBot.
+func (mr *MockBotServiceMockRecorder) RegisterBot(ctx, ownerID, name, webhookURL any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterBot", reflect.TypeOf((*MockBotService)(nil).RegisterBot), ctx, ownerID, name, webhookURL)
+}
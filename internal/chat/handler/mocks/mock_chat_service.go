@@ -11,12 +11,151 @@ package mocks
 
 import (
 	context "context"
+	service "gosocial/internal/chat/service"
 	dbmysql "gosocial/internal/dbmysql"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
 )
 
+// MockMessageObserver is a mock of MessageObserver interface.
+type MockMessageObserver struct {
+	ctrl     *gomock.Controller
+	recorder *MockMessageObserverMockRecorder
+	isgomock struct{}
+}
+
+// MockMessageObserverMockRecorder is the mock recorder for MockMessageObserver.
+type MockMessageObserverMockRecorder struct {
+	mock *MockMessageObserver
+}
+
+// NewMockMessageObserver creates a new mock instance.
+func NewMockMessageObserver(ctrl *gomock.Controller) *MockMessageObserver {
+	mock := &MockMessageObserver{ctrl: ctrl}
+	mock.recorder = &MockMessageObserverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMessageObserver) EXPECT() *MockMessageObserverMockRecorder {
+	return m.recorder
+}
+
+// OnMessage mocks base method.
+func (m *MockMessageObserver) OnMessage(ctx context.Context, msg *dbmysql.Message) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnMessage", ctx, msg)
+}
+
+// OnMessage indicates an expected call of OnMessage.
+func (mr *MockMessageObserverMockRecorder) OnMessage(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnMessage", reflect.TypeOf((*MockMessageObserver)(nil).OnMessage), ctx, msg)
+}
+
+// MockChangeObserver is a mock of ChangeObserver interface.
+type MockChangeObserver struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangeObserverMockRecorder
+	isgomock struct{}
+}
+
+// MockChangeObserverMockRecorder is the mock recorder for MockChangeObserver.
+type MockChangeObserverMockRecorder struct {
+	mock *MockChangeObserver
+}
+
+// NewMockChangeObserver creates a new mock instance.
+func NewMockChangeObserver(ctrl *gomock.Controller) *MockChangeObserver {
+	mock := &MockChangeObserver{ctrl: ctrl}
+	mock.recorder = &MockChangeObserverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangeObserver) EXPECT() *MockChangeObserverMockRecorder {
+	return m.recorder
+}
+
+// OnChange mocks base method.
+func (m *MockChangeObserver) OnChange(ctx context.Context, event *dbmysql.ChatEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnChange", ctx, event)
+}
+
+// OnChange indicates an expected call of OnChange.
+func (mr *MockChangeObserverMockRecorder) OnChange(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnChange", reflect.TypeOf((*MockChangeObserver)(nil).OnChange), ctx, event)
+}
+
+// MockConversationPolicy is a mock of ConversationPolicy interface.
+type MockConversationPolicy struct {
+	ctrl     *gomock.Controller
+	recorder *MockConversationPolicyMockRecorder
+	isgomock struct{}
+}
+
+// MockConversationPolicyMockRecorder is the mock recorder for MockConversationPolicy.
+type MockConversationPolicyMockRecorder struct {
+	mock *MockConversationPolicy
+}
+
+// NewMockConversationPolicy creates a new mock instance.
+func NewMockConversationPolicy(ctrl *gomock.Controller) *MockConversationPolicy {
+	mock := &MockConversationPolicy{ctrl: ctrl}
+	mock.recorder = &MockConversationPolicyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConversationPolicy) EXPECT() *MockConversationPolicyMockRecorder {
+	return m.recorder
+}
+
+// CheckAccess mocks base method.
+func (m *MockConversationPolicy) CheckAccess(ctx context.Context, conversationID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAccess", ctx, conversationID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckAccess indicates an expected call of CheckAccess.
+func (mr *MockConversationPolicyMockRecorder) CheckAccess(ctx, conversationID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccess", reflect.TypeOf((*MockConversationPolicy)(nil).CheckAccess), ctx, conversationID, userID)
+}
+
+// CheckPlaintextAccess mocks base method.
+func (m *MockConversationPolicy) CheckPlaintextAccess(ctx context.Context, conversationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckPlaintextAccess", ctx, conversationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckPlaintextAccess indicates an expected call of CheckPlaintextAccess.
+func (mr *MockConversationPolicyMockRecorder) CheckPlaintextAccess(ctx, conversationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPlaintextAccess", reflect.TypeOf((*MockConversationPolicy)(nil).CheckPlaintextAccess), ctx, conversationID)
+}
+
+// CheckSend mocks base method.
+func (m *MockConversationPolicy) CheckSend(ctx context.Context, msg *dbmysql.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckSend", ctx, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckSend indicates an expected call of CheckSend.
+func (mr *MockConversationPolicyMockRecorder) CheckSend(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSend", reflect.TypeOf((*MockConversationPolicy)(nil).CheckSend), ctx, msg)
+}
+
 // MockChatService is a mock of ChatService interface.
 type MockChatService struct {
 	ctrl     *gomock.Controller
@@ -41,19 +180,104 @@ func (m *MockChatService) EXPECT() *MockChatServiceMockRecorder {
 	return m.recorder
 }
 
+// AddPolicy mocks base method.
+func (m *MockChatService) AddPolicy(policy service.ConversationPolicy) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddPolicy", policy)
+}
+
+// AddPolicy indicates an expected call of AddPolicy.
+func (mr *MockChatServiceMockRecorder) AddPolicy(policy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPolicy", reflect.TypeOf((*MockChatService)(nil).AddPolicy), policy)
+}
+
+// CheckPlaintextAccess mocks base method.
+func (m *MockChatService) CheckPlaintextAccess(ctx context.Context, conversationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckPlaintextAccess", ctx, conversationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckPlaintextAccess indicates an expected call of CheckPlaintextAccess.
+func (mr *MockChatServiceMockRecorder) CheckPlaintextAccess(ctx, conversationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPlaintextAccess", reflect.TypeOf((*MockChatService)(nil).CheckPlaintextAccess), ctx, conversationID)
+}
+
+// DeleteMessage mocks base method.
+func (m *MockChatService) DeleteMessage(ctx context.Context, messageID uint, requesterID string) (*dbmysql.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMessage", ctx, messageID, requesterID)
+	ret0, _ := ret[0].(*dbmysql.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMessage indicates an expected call of DeleteMessage.
+func (mr *MockChatServiceMockRecorder) DeleteMessage(ctx, messageID, requesterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessage", reflect.TypeOf((*MockChatService)(nil).DeleteMessage), ctx, messageID, requesterID)
+}
+
+// EditMessage mocks base method.
+func (m *MockChatService) EditMessage(ctx context.Context, messageID uint, editorID, content string) (*dbmysql.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditMessage", ctx, messageID, editorID, content)
+	ret0, _ := ret[0].(*dbmysql.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditMessage indicates an expected call of EditMessage.
+func (mr *MockChatServiceMockRecorder) EditMessage(ctx, messageID, editorID, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditMessage", reflect.TypeOf((*MockChatService)(nil).EditMessage), ctx, messageID, editorID, content)
+}
+
 // GetMessageHistory mocks base method.
-func (m *MockChatService) GetMessageHistory(ctx context.Context, conversationID string) ([]*dbmysql.Message, error) {
+func (m *MockChatService) GetMessageHistory(ctx context.Context, conversationID, requesterID string) ([]*dbmysql.Message, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMessageHistory", ctx, conversationID)
+	ret := m.ctrl.Call(m, "GetMessageHistory", ctx, conversationID, requesterID)
 	ret0, _ := ret[0].([]*dbmysql.Message)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetMessageHistory indicates an expected call of GetMessageHistory.
-func (mr *MockChatServiceMockRecorder) GetMessageHistory(ctx, conversationID any) *gomock.Call {
+func (mr *MockChatServiceMockRecorder) GetMessageHistory(ctx, conversationID, requesterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageHistory", reflect.TypeOf((*MockChatService)(nil).GetMessageHistory), ctx, conversationID, requesterID)
+}
+
+// MarkRead mocks base method.
+func (m *MockChatService) MarkRead(ctx context.Context, conversationID, readerID string, upToMessageID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, conversationID, readerID, upToMessageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockChatServiceMockRecorder) MarkRead(ctx, conversationID, readerID, upToMessageID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageHistory", reflect.TypeOf((*MockChatService)(nil).GetMessageHistory), ctx, conversationID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockChatService)(nil).MarkRead), ctx, conversationID, readerID, upToMessageID)
+}
+
+// SearchMessages mocks base method.
+func (m *MockChatService) SearchMessages(ctx context.Context, conversationID, requesterID, query string, limit int) ([]*dbmysql.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMessages", ctx, conversationID, requesterID, query, limit)
+	ret0, _ := ret[0].([]*dbmysql.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchMessages indicates an expected call of SearchMessages.
+func (mr *MockChatServiceMockRecorder) SearchMessages(ctx, conversationID, requesterID, query, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMessages", reflect.TypeOf((*MockChatService)(nil).SearchMessages), ctx, conversationID, requesterID, query, limit)
 }
 
 // SendMessage mocks base method.
@@ -70,3 +294,27 @@ func (mr *MockChatServiceMockRecorder) SendMessage(ctx, msg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockChatService)(nil).SendMessage), ctx, msg)
 }
+
+// SetModerator mocks base method.
+func (m *MockChatService) SetModerator(moderator service.MessageModerator) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetModerator", moderator)
+}
+
+// SetModerator indicates an expected call of SetModerator.
+func (mr *MockChatServiceMockRecorder) SetModerator(moderator any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetModerator", reflect.TypeOf((*MockChatService)(nil).SetModerator), moderator)
+}
+
+// Subscribe mocks base method.
+func (m *MockChatService) Subscribe(observer service.MessageObserver) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Subscribe", observer)
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockChatServiceMockRecorder) Subscribe(observer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockChatService)(nil).Subscribe), observer)
+}
@@ -0,0 +1,144 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../service/e2e_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../service/e2e_service.go -destination=mocks/mock_e2e_service.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mocks 
+
+import (
+	context "context"
+	service "gosocial/internal/chat/service"
+	dbmysql "gosocial/internal/dbmysql"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockE2EService is a mock of E2EService interface.
+type MockE2EService struct {
+	ctrl     *gomock.Controller
+	recorder *MockE2EServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockE2EServiceMockRecorder is the mock recorder for MockE2EService.
+type MockE2EServiceMockRecorder struct {
+	mock *MockE2EService
+}
+
+// NewMockE2EService creates a new mock instance.
+func NewMockE2EService(ctrl *gomock.Controller) *MockE2EService {
+	mock := &MockE2EService{ctrl: ctrl}
+	mock.recorder = &MockE2EServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockE2EService) EXPECT() *MockE2EServiceMockRecorder {
+	return m.recorder
+}
+
+// CheckAccess mocks base method.
+func (m *MockE2EService) CheckAccess(ctx context.Context, conversationID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAccess", ctx, conversationID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckAccess indicates an expected call of CheckAccess.
+func (mr *MockE2EServiceMockRecorder) CheckAccess(ctx, conversationID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccess", reflect.TypeOf((*MockE2EService)(nil).CheckAccess), ctx, conversationID, userID)
+}
+
+// CheckPlaintextAccess mocks base method.
+func (m *MockE2EService) CheckPlaintextAccess(ctx context.Context, conversationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckPlaintextAccess", ctx, conversationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckPlaintextAccess indicates an expected call of CheckPlaintextAccess.
+func (mr *MockE2EServiceMockRecorder) CheckPlaintextAccess(ctx, conversationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPlaintextAccess", reflect.TypeOf((*MockE2EService)(nil).CheckPlaintextAccess), ctx, conversationID)
+}
+
+// CheckSend mocks base method.
+func (m *MockE2EService) CheckSend(ctx context.Context, msg *dbmysql.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckSend", ctx, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckSend indicates an expected call of CheckSend.
+func (mr *MockE2EServiceMockRecorder) CheckSend(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSend", reflect.TypeOf((*MockE2EService)(nil).CheckSend), ctx, msg)
+}
+
+// EnableE2E mocks base method.
+func (m *MockE2EService) EnableE2E(ctx context.Context, conversationID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableE2E", ctx, conversationID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableE2E indicates an expected call of EnableE2E.
+func (mr *MockE2EServiceMockRecorder) EnableE2E(ctx, conversationID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableE2E", reflect.TypeOf((*MockE2EService)(nil).EnableE2E), ctx, conversationID, userID)
+}
+
+// GetKeyBundles mocks base method.
+func (m *MockE2EService) GetKeyBundles(ctx context.Context, requesterID, userID string) ([]*service.KeyBundle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKeyBundles", ctx, requesterID, userID)
+	ret0, _ := ret[0].([]*service.KeyBundle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKeyBundles indicates an expected call of GetKeyBundles.
+func (mr *MockE2EServiceMockRecorder) GetKeyBundles(ctx, requesterID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeyBundles", reflect.TypeOf((*MockE2EService)(nil).GetKeyBundles), ctx, requesterID, userID)
+}
+
+// IsE2E mocks base method.
+func (m *MockE2EService) IsE2E(ctx context.Context, conversationID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsE2E", ctx, conversationID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsE2E indicates an expected call of IsE2E.
+func (mr *MockE2EServiceMockRecorder) IsE2E(ctx, conversationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsE2E", reflect.TypeOf((*MockE2EService)(nil).IsE2E), ctx, conversationID)
+}
+
+// PublishKeys mocks base method.
+func (m *MockE2EService) PublishKeys(ctx context.Context, userID, deviceToken string, bundle *dbmysql.DeviceKeyBundle, prekeys []*dbmysql.OneTimePrekey) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishKeys", ctx, userID, deviceToken, bundle, prekeys)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishKeys indicates an expected call of PublishKeys.
+func (mr *MockE2EServiceMockRecorder) PublishKeys(ctx, userID, deviceToken, bundle, prekeys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishKeys", reflect.TypeOf((*MockE2EService)(nil).PublishKeys), ctx, userID, deviceToken, bundle, prekeys)
+}
@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../service/inbox_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../service/inbox_service.go -destination=mocks/mock_inbox_service.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mocks 
+
+import (
+	context "context"
+	service "gosocial/internal/chat/service"
+	dbmysql "gosocial/internal/dbmysql"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockInboxService is a mock of InboxService interface.
+type MockInboxService struct {
+	ctrl     *gomock.Controller
+	recorder *MockInboxServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockInboxServiceMockRecorder is the mock recorder for MockInboxService.
+type MockInboxServiceMockRecorder struct {
+	mock *MockInboxService
+}
+
+// NewMockInboxService creates a new mock instance.
+func NewMockInboxService(ctrl *gomock.Controller) *MockInboxService {
+	mock := &MockInboxService{ctrl: ctrl}
+	mock.recorder = &MockInboxServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInboxService) EXPECT() *MockInboxServiceMockRecorder {
+	return m.recorder
+}
+
+// ListInbox mocks base method.
+func (m *MockInboxService) ListInbox(ctx context.Context, userID string, includeArchived bool) ([]*service.InboxEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInbox", ctx, userID, includeArchived)
+	ret0, _ := ret[0].([]*service.InboxEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInbox indicates an expected call of ListInbox.
+func (mr *MockInboxServiceMockRecorder) ListInbox(ctx, userID, includeArchived any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInbox", reflect.TypeOf((*MockInboxService)(nil).ListInbox), ctx, userID, includeArchived)
+}
+
+// Mute mocks base method.
+func (m *MockInboxService) Mute(ctx context.Context, conversationID, userID string, until time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Mute", ctx, conversationID, userID, until)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Mute indicates an expected call of Mute.
+func (mr *MockInboxServiceMockRecorder) Mute(ctx, conversationID, userID, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Mute", reflect.TypeOf((*MockInboxService)(nil).Mute), ctx, conversationID, userID, until)
+}
+
+// OnMessage mocks base method.
+func (m *MockInboxService) OnMessage(ctx context.Context, msg *dbmysql.Message) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnMessage", ctx, msg)
+}
+
+// OnMessage indicates an expected call of OnMessage.
+func (mr *MockInboxServiceMockRecorder) OnMessage(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnMessage", reflect.TypeOf((*MockInboxService)(nil).OnMessage), ctx, msg)
+}
+
+// SetArchived mocks base method.
+func (m *MockInboxService) SetArchived(ctx context.Context, conversationID, userID string, archived bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetArchived", ctx, conversationID, userID, archived)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetArchived indicates an expected call of SetArchived.
+func (mr *MockInboxServiceMockRecorder) SetArchived(ctx, conversationID, userID, archived any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetArchived", reflect.TypeOf((*MockInboxService)(nil).SetArchived), ctx, conversationID, userID, archived)
+}
+
+// SetPinned mocks base method.
+func (m *MockInboxService) SetPinned(ctx context.Context, conversationID, userID string, pinned bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPinned", ctx, conversationID, userID, pinned)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPinned indicates an expected call of SetPinned.
+func (mr *MockInboxServiceMockRecorder) SetPinned(ctx, conversationID, userID, pinned any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPinned", reflect.TypeOf((*MockInboxService)(nil).SetPinned), ctx, conversationID, userID, pinned)
+}
@@ -0,0 +1,167 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../service/sync_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../service/sync_service.go -destination=mocks/mock_sync_service.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mocks 
+
+import (
+	context "context"
+	service "gosocial/internal/chat/service"
+	dbmysql "gosocial/internal/dbmysql"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSyncService is a mock of SyncService interface.
+type MockSyncService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSyncServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSyncServiceMockRecorder is the mock recorder for MockSyncService.
+type MockSyncServiceMockRecorder struct {
+	mock *MockSyncService
+}
+
+// NewMockSyncService creates a new mock instance.
+func NewMockSyncService(ctrl *gomock.Controller) *MockSyncService {
+	mock := &MockSyncService{ctrl: ctrl}
+	mock.recorder = &MockSyncServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSyncService) EXPECT() *MockSyncServiceMockRecorder {
+	return m.recorder
+}
+
+// AddMember mocks base method.
+func (m *MockSyncService) AddMember(ctx context.Context, conversationID, userID, actorID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", ctx, conversationID, userID, actorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockSyncServiceMockRecorder) AddMember(ctx, conversationID, userID, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockSyncService)(nil).AddMember), ctx, conversationID, userID, actorID)
+}
+
+// CheckAccess mocks base method.
+func (m *MockSyncService) CheckAccess(ctx context.Context, conversationID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAccess", ctx, conversationID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckAccess indicates an expected call of CheckAccess.
+func (mr *MockSyncServiceMockRecorder) CheckAccess(ctx, conversationID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccess", reflect.TypeOf((*MockSyncService)(nil).CheckAccess), ctx, conversationID, userID)
+}
+
+// CheckPlaintextAccess mocks base method.
+func (m *MockSyncService) CheckPlaintextAccess(ctx context.Context, conversationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckPlaintextAccess", ctx, conversationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckPlaintextAccess indicates an expected call of CheckPlaintextAccess.
+func (mr *MockSyncServiceMockRecorder) CheckPlaintextAccess(ctx, conversationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPlaintextAccess", reflect.TypeOf((*MockSyncService)(nil).CheckPlaintextAccess), ctx, conversationID)
+}
+
+// CheckSend mocks base method.
+func (m *MockSyncService) CheckSend(ctx context.Context, msg *dbmysql.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckSend", ctx, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckSend indicates an expected call of CheckSend.
+func (mr *MockSyncServiceMockRecorder) CheckSend(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSend", reflect.TypeOf((*MockSyncService)(nil).CheckSend), ctx, msg)
+}
+
+// CreateConversation mocks base method.
+func (m *MockSyncService) CreateConversation(ctx context.Context, creatorID string, memberIDs []string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateConversation", ctx, creatorID, memberIDs)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateConversation indicates an expected call of CreateConversation.
+func (mr *MockSyncServiceMockRecorder) CreateConversation(ctx, creatorID, memberIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateConversation", reflect.TypeOf((*MockSyncService)(nil).CreateConversation), ctx, creatorID, memberIDs)
+}
+
+// OnChange mocks base method.
+func (m *MockSyncService) OnChange(ctx context.Context, event *dbmysql.ChatEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnChange", ctx, event)
+}
+
+// OnChange indicates an expected call of OnChange.
+func (mr *MockSyncServiceMockRecorder) OnChange(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnChange", reflect.TypeOf((*MockSyncService)(nil).OnChange), ctx, event)
+}
+
+// OnMessage mocks base method.
+func (m *MockSyncService) OnMessage(ctx context.Context, msg *dbmysql.Message) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnMessage", ctx, msg)
+}
+
+// OnMessage indicates an expected call of OnMessage.
+func (mr *MockSyncServiceMockRecorder) OnMessage(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnMessage", reflect.TypeOf((*MockSyncService)(nil).OnMessage), ctx, msg)
+}
+
+// RemoveMember mocks base method.
+func (m *MockSyncService) RemoveMember(ctx context.Context, conversationID, userID, actorID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", ctx, conversationID, userID, actorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockSyncServiceMockRecorder) RemoveMember(ctx, conversationID, userID, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockSyncService)(nil).RemoveMember), ctx, conversationID, userID, actorID)
+}
+
+// SyncChanges mocks base method.
+func (m *MockSyncService) SyncChanges(ctx context.Context, userID, deviceToken string, sinceCursor *uint64, limit int) (*service.SyncResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncChanges", ctx, userID, deviceToken, sinceCursor, limit)
+	ret0, _ := ret[0].(*service.SyncResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncChanges indicates an expected call of SyncChanges.
+func (mr *MockSyncServiceMockRecorder) SyncChanges(ctx, userID, deviceToken, sinceCursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncChanges", reflect.TypeOf((*MockSyncService)(nil).SyncChanges), ctx, userID, deviceToken, sinceCursor, limit)
+}
@@ -14,20 +14,25 @@ import (
 
     pb "gosocial/api/v1/chat"
     "gosocial/internal/chat/handler/mocks"
+    "gosocial/internal/chat/service"
     "gosocial/internal/dbmysql"
 )
 
 const bufSize = 1024 * 1024
 
-func setupGRPCTest(t *testing.T) (pb.ChatServiceClient, *mocks.MockChatService, func()) {
+func setupGRPCTest(t *testing.T) (pb.ChatServiceClient, *mocks.MockChatService, *service.MessageObserver, func()) {
     // Create in-memory gRPC server
     lis := bufconn.Listen(bufSize)
     
     ctrl := gomock.NewController(t)
     mockService := mocks.NewMockChatService(ctrl)
+
+    // Keep the handler's subscription so saved messages can be relayed like the real service does
+    var observer service.MessageObserver
+    mockService.EXPECT().Subscribe(gomock.Any()).Do(func(o service.MessageObserver) { observer = o })
     
     // Create handler with mock service
-    handler := NewChatHandler(mockService)
+    handler := NewChatHandler(mockService, nil, nil, nil, nil)
     
     // Create gRPC server
     s := grpc.NewServer()
@@ -58,11 +63,11 @@ func setupGRPCTest(t *testing.T) (pb.ChatServiceClient, *mocks.MockChatService,
         ctrl.Finish()
     }
     
-    return client, mockService, cleanup
+    return client, mockService, &observer, cleanup
 }
 
 func TestChatHandler_StreamMessages_RealGRPC(t *testing.T) {
-    client, mockService, cleanup := setupGRPCTest(t)
+    client, mockService, observer, cleanup := setupGRPCTest(t)
     defer cleanup()
 
     t.Run("successful_streaming_workflow", func(t *testing.T) {
@@ -70,13 +75,15 @@ func TestChatHandler_StreamMessages_RealGRPC(t *testing.T) {
         mockService.EXPECT().
             SendMessage(gomock.Any(), gomock.Any()).
             DoAndReturn(func(ctx context.Context, msg *dbmysql.Message) (*dbmysql.Message, error) {
-                return &dbmysql.Message{
+                saved := &dbmysql.Message{
                     MessageID: 1,
                     ConversationID: msg.ConversationID,
                     SenderID: msg.SenderID, 
                     Content: msg.Content,
                     SentAt: time.Now(),
-                }, nil
+                }
+                (*observer).OnMessage(ctx, saved)
+                return saved, nil
             }).
             AnyTimes()
 
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+
+	"gosocial/internal/dbmysql"
+)
+
+type BotRepository interface {
+	CreateBot(ctx context.Context, bot *dbmysql.Bot) error
+	GetBotByID(ctx context.Context, botID uint) (*dbmysql.Bot, error)
+	GetBotByTokenHash(ctx context.Context, tokenHash string) (*dbmysql.Bot, error)
+	AddToConversation(ctx context.Context, link *dbmysql.BotConversation) error
+	IsInConversation(ctx context.Context, botID uint, conversationID string) (bool, error)
+	ListConversationBots(ctx context.Context, conversationID string) ([]*dbmysql.Bot, error)
+}
+
+type botRepo struct {
+	db *gorm.DB
+}
+
+func NewBotRepository(db *gorm.DB) BotRepository {
+	return &botRepo{
+		db: db,
+	}
+}
+
+func (r *botRepo) CreateBot(ctx context.Context, bot *dbmysql.Bot) error {
+	return r.db.WithContext(ctx).Create(bot).Error
+}
+
+func (r *botRepo) GetBotByID(ctx context.Context, botID uint) (*dbmysql.Bot, error) {
+	var bot dbmysql.Bot
+	if err := r.db.WithContext(ctx).Where("bot_id = ?", botID).First(&bot).Error; err != nil {
+		return nil, err
+	}
+	return &bot, nil
+}
+
+func (r *botRepo) GetBotByTokenHash(ctx context.Context, tokenHash string) (*dbmysql.Bot, error) {
+	var bot dbmysql.Bot
+	if err := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&bot).Error; err != nil {
+		return nil, err
+	}
+	return &bot, nil
+}
+
+func (r *botRepo) AddToConversation(ctx context.Context, link *dbmysql.BotConversation) error {
+	return r.db.WithContext(ctx).Create(link).Error
+}
+
+func (r *botRepo) IsInConversation(ctx context.Context, botID uint, conversationID string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&dbmysql.BotConversation{}).
+		Where("bot_id = ? AND conversation_id = ?", botID, conversationID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *botRepo) ListConversationBots(ctx context.Context, conversationID string) ([]*dbmysql.Bot, error) {
+	var bots []*dbmysql.Bot
+	err := r.db.WithContext(ctx).
+		Joins("JOIN bot_conversations ON bot_conversations.bot_id = bots.bot_id").
+		Where("bot_conversations.conversation_id = ?", conversationID).
+		Find(&bots).Error
+	return bots, err
+}
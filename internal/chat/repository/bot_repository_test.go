@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBotRepository_IsInConversation(t *testing.T) {
+	db, mock, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT count(*) FROM `bot_conversations` WHERE bot_id = ? AND conversation_id = ?")).
+		WithArgs(3, "conv-1").
+		WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(1))
+
+	repo := NewBotRepository(db)
+	ok, err := repo.IsInConversation(context.Background(), 3, "conv-1")
+
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBotRepository_ListConversationBots(t *testing.T) {
+	db, mock, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	rows := sqlmock.NewRows([]string{"bot_id", "owner_id", "name", "token_hash", "webhook_url", "webhook_secret"}).
+		AddRow(1, "user-1", "reminders", "hash", "http://localhost:9000/hook", "secret")
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT `bots`.`bot_id`,`bots`.`owner_id`,`bots`.`name`,`bots`.`token_hash`,`bots`.`webhook_url`,`bots`.`webhook_secret`,`bots`.`created_at`,`bots`.`updated_at` FROM `bots` JOIN bot_conversations ON bot_conversations.bot_id = bots.bot_id WHERE bot_conversations.conversation_id = ?")).
+		WithArgs("conv-1").
+		WillReturnRows(rows)
+
+	repo := NewBotRepository(db)
+	bots, err := repo.ListConversationBots(context.Background(), "conv-1")
+
+	require.NoError(t, err)
+	assert.Len(t, bots, 1)
+	assert.Equal(t, "reminders", bots[0].Name)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
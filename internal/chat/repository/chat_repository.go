@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"strings"
+
 	"gorm.io/gorm"
 	
 	"gosocial/internal/dbmysql"
@@ -10,6 +12,11 @@ import (
 type ChatRepository interface {
 	Save(ctx context.Context, msg *dbmysql.Message) error
 	FetchHistory(ctx context.Context, conversationID string) ([]*dbmysql.Message, error)
+	GetMessage(ctx context.Context, messageID uint) (*dbmysql.Message, error)
+	UpdateMessage(ctx context.Context, msg *dbmysql.Message) error
+	MarkRead(ctx context.Context, conversationID, readerID string, upToMessageID uint) (int64, error)
+	LatestMessages(ctx context.Context, conversationIDs []string) ([]*dbmysql.Message, error)
+	SearchMessages(ctx context.Context, conversationID, query string, limit int) ([]*dbmysql.Message, error)
 }
 
 type chatRepo struct {
@@ -31,3 +38,52 @@ func (r *chatRepo) FetchHistory(ctx context.Context, conversationID string) ([]*
 	var messages []*dbmysql.Message
 	return messages, r.db.Where("conversation_id = ?", conversationID).Find(&messages).Error
 }
+
+func (r *chatRepo) GetMessage(ctx context.Context, messageID uint) (*dbmysql.Message, error) {
+	var msg dbmysql.Message
+	if err := r.db.WithContext(ctx).Where("message_id = ?", messageID).First(&msg).Error; err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+func (r *chatRepo) UpdateMessage(ctx context.Context, msg *dbmysql.Message) error {
+	return r.db.WithContext(ctx).
+		Model(&dbmysql.Message{}).
+		Where("message_id = ?", msg.MessageID).
+		Updates(map[string]interface{}{"content": msg.Content, "status": msg.Status}).Error
+}
+
+// MarkRead flips every delivered message from other senders up to upToMessageID to read
+func (r *chatRepo) MarkRead(ctx context.Context, conversationID, readerID string, upToMessageID uint) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Model(&dbmysql.Message{}).
+		Where("conversation_id = ? AND message_id <= ? AND sender_id <> ? AND status = ?", conversationID, upToMessageID, readerID, "delivered").
+		Update("status", "read")
+	return result.RowsAffected, result.Error
+}
+
+// LatestMessages returns the newest message of each given conversation
+func (r *chatRepo) LatestMessages(ctx context.Context, conversationIDs []string) ([]*dbmysql.Message, error) {
+	var messages []*dbmysql.Message
+	latest := r.db.Model(&dbmysql.Message{}).
+		Select("MAX(message_id)").
+		Where("conversation_id IN ?", conversationIDs).
+		Group("conversation_id")
+	err := r.db.WithContext(ctx).Where("message_id IN (?)", latest).Find(&messages).Error
+	return messages, err
+}
+
+// SearchMessages does a plain substring match over the conversation, newest first
+func (r *chatRepo) SearchMessages(ctx context.Context, conversationID, query string, limit int) ([]*dbmysql.Message, error) {
+	var messages []*dbmysql.Message
+	pattern := "%" + likeEscaper.Replace(query) + "%"
+	err := r.db.WithContext(ctx).
+		Where("conversation_id = ? AND status <> ? AND content LIKE ?", conversationID, "deleted", pattern).
+		Order("message_id DESC").
+		Limit(limit).
+		Find(&messages).Error
+	return messages, err
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
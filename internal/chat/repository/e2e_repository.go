@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"gosocial/internal/dbmysql"
+)
+
+type E2ERepository interface {
+	UpsertKeyBundle(ctx context.Context, bundle *dbmysql.DeviceKeyBundle) error
+	AddPrekeys(ctx context.Context, prekeys []*dbmysql.OneTimePrekey) error
+	ListKeyBundles(ctx context.Context, userID string) ([]*dbmysql.DeviceKeyBundle, error)
+	ClaimPrekey(ctx context.Context, deviceToken string) (*dbmysql.OneTimePrekey, error)
+	EnableConversation(ctx context.Context, conversation *dbmysql.E2EConversation) error
+	IsE2E(ctx context.Context, conversationID string) (bool, error)
+	ListE2E(ctx context.Context, conversationIDs []string) ([]string, error)
+}
+
+type e2eRepo struct {
+	db *gorm.DB
+}
+
+func NewE2ERepository(db *gorm.DB) E2ERepository {
+	return &e2eRepo{
+		db: db,
+	}
+}
+
+func (r *e2eRepo) UpsertKeyBundle(ctx context.Context, bundle *dbmysql.DeviceKeyBundle) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{UpdateAll: true}).
+		Create(bundle).Error
+}
+
+func (r *e2eRepo) AddPrekeys(ctx context.Context, prekeys []*dbmysql.OneTimePrekey) error {
+	if len(prekeys) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Create(&prekeys).Error
+}
+
+func (r *e2eRepo) ListKeyBundles(ctx context.Context, userID string) ([]*dbmysql.DeviceKeyBundle, error) {
+	var bundles []*dbmysql.DeviceKeyBundle
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Find(&bundles).Error
+	return bundles, err
+}
+
+// ClaimPrekey removes and returns the oldest one-time prekey of a device, nil when none are left
+func (r *e2eRepo) ClaimPrekey(ctx context.Context, deviceToken string) (*dbmysql.OneTimePrekey, error) {
+	var prekey dbmysql.OneTimePrekey
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("device_token = ?", deviceToken).
+			Order("id ASC").
+			First(&prekey).Error
+		if err != nil {
+			return err
+		}
+		return tx.Delete(&prekey).Error
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &prekey, nil
+}
+
+func (r *e2eRepo) EnableConversation(ctx context.Context, conversation *dbmysql.E2EConversation) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(conversation).Error
+}
+
+func (r *e2eRepo) IsE2E(ctx context.Context, conversationID string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&dbmysql.E2EConversation{}).
+		Where("conversation_id = ?", conversationID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// ListE2E returns which of the given conversations are end-to-end encrypted
+func (r *e2eRepo) ListE2E(ctx context.Context, conversationIDs []string) ([]string, error) {
+	var encrypted []string
+	err := r.db.WithContext(ctx).
+		Model(&dbmysql.E2EConversation{}).
+		Where("conversation_id IN ?", conversationIDs).
+		Pluck("conversation_id", &encrypted).Error
+	return encrypted, err
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"gosocial/internal/dbmysql"
+)
+
+type MemberRepository interface {
+	AddMember(ctx context.Context, member *dbmysql.ConversationMember) (bool, error)
+	RemoveMember(ctx context.Context, conversationID, userID string) (bool, error)
+	IsMember(ctx context.Context, conversationID, userID string) (bool, error)
+	ListMembers(ctx context.Context, conversationID string) ([]string, error)
+	ListUserConversations(ctx context.Context, userID string) ([]string, error)
+	AdoptSenders(ctx context.Context, conversationID string) (int64, error)
+}
+
+type memberRepo struct {
+	db *gorm.DB
+}
+
+func NewMemberRepository(db *gorm.DB) MemberRepository {
+	return &memberRepo{
+		db: db,
+	}
+}
+
+// AddMember reports false when the user was already a member
+func (r *memberRepo) AddMember(ctx context.Context, member *dbmysql.ConversationMember) (bool, error) {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(member)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *memberRepo) RemoveMember(ctx context.Context, conversationID, userID string) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Where("conversation_id = ? AND user_id = ?", conversationID, userID).
+		Delete(&dbmysql.ConversationMember{})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *memberRepo) IsMember(ctx context.Context, conversationID, userID string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&dbmysql.ConversationMember{}).
+		Where("conversation_id = ? AND user_id = ?", conversationID, userID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *memberRepo) ListMembers(ctx context.Context, conversationID string) ([]string, error) {
+	var userIDs []string
+	err := r.db.WithContext(ctx).
+		Model(&dbmysql.ConversationMember{}).
+		Where("conversation_id = ?", conversationID).
+		Pluck("user_id", &userIDs).Error
+	return userIDs, err
+}
+
+func (r *memberRepo) ListUserConversations(ctx context.Context, userID string) ([]string, error) {
+	var conversationIDs []string
+	err := r.db.WithContext(ctx).
+		Model(&dbmysql.ConversationMember{}).
+		Where("user_id = ?", userID).
+		Pluck("conversation_id", &conversationIDs).Error
+	return conversationIDs, err
+}
+
+// AdoptSenders turns the senders of a conversation that predates membership into its members,
+// conversations that already have members are left alone
+func (r *memberRepo) AdoptSenders(ctx context.Context, conversationID string) (int64, error) {
+	result := r.db.WithContext(ctx).Exec(
+		"INSERT IGNORE INTO conversation_members (conversation_id, user_id, joined_at) "+
+			"SELECT conversation_id, sender_id, MIN(sent_at) FROM messages "+
+			"WHERE conversation_id = ? AND sender_id NOT LIKE 'bot:%' "+
+			"AND NOT EXISTS (SELECT 1 FROM conversation_members WHERE conversation_id = ?) "+
+			"GROUP BY conversation_id, sender_id",
+		conversationID, conversationID)
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemberRepository_AdoptSenders(t *testing.T) {
+	db, mock, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	mock.ExpectExec(regexp.QuoteMeta(
+		"INSERT IGNORE INTO conversation_members (conversation_id, user_id, joined_at) "+
+			"SELECT conversation_id, sender_id, MIN(sent_at) FROM messages "+
+			"WHERE conversation_id = ? AND sender_id NOT LIKE 'bot:%' "+
+			"AND NOT EXISTS (SELECT 1 FROM conversation_members WHERE conversation_id = ?) "+
+			"GROUP BY conversation_id, sender_id")).
+		WithArgs("old-conv", "old-conv").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	repo := NewMemberRepository(db)
+	adopted, err := repo.AdoptSenders(context.Background(), "old-conv")
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), adopted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+
+	"gosocial/internal/dbmysql"
+)
+
+type ModerationRepository interface {
+	SaveDecisions(ctx context.Context, decisions []*dbmysql.ModerationDecision) error
+}
+
+type moderationRepo struct {
+	db *gorm.DB
+}
+
+func NewModerationRepository(db *gorm.DB) ModerationRepository {
+	return &moderationRepo{
+		db: db,
+	}
+}
+
+func (r *moderationRepo) SaveDecisions(ctx context.Context, decisions []*dbmysql.ModerationDecision) error {
+	if len(decisions) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Create(&decisions).Error
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"gosocial/internal/dbmysql"
+)
+
+type SettingsRepository interface {
+	UpsertSetting(ctx context.Context, setting *dbmysql.ConversationSetting, columns ...string) error
+	ListUserSettings(ctx context.Context, userID string) ([]*dbmysql.ConversationSetting, error)
+	ListMutedUsers(ctx context.Context, conversationID string, now time.Time) ([]string, error)
+	UnarchiveConversation(ctx context.Context, conversationID string) error
+}
+
+type settingsRepo struct {
+	db *gorm.DB
+}
+
+func NewSettingsRepository(db *gorm.DB) SettingsRepository {
+	return &settingsRepo{
+		db: db,
+	}
+}
+
+// UpsertSetting creates the row or overwrites only the given columns of an existing one
+func (r *settingsRepo) UpsertSetting(ctx context.Context, setting *dbmysql.ConversationSetting, columns ...string) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoUpdates: clause.AssignmentColumns(append(columns, "updated_at"))}).
+		Create(setting).Error
+}
+
+func (r *settingsRepo) ListUserSettings(ctx context.Context, userID string) ([]*dbmysql.ConversationSetting, error) {
+	var settings []*dbmysql.ConversationSetting
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Find(&settings).Error
+	return settings, err
+}
+
+func (r *settingsRepo) ListMutedUsers(ctx context.Context, conversationID string, now time.Time) ([]string, error) {
+	var userIDs []string
+	err := r.db.WithContext(ctx).
+		Model(&dbmysql.ConversationSetting{}).
+		Where("conversation_id = ? AND muted_until > ?", conversationID, now).
+		Pluck("user_id", &userIDs).Error
+	return userIDs, err
+}
+
+func (r *settingsRepo) UnarchiveConversation(ctx context.Context, conversationID string) error {
+	return r.db.WithContext(ctx).
+		Model(&dbmysql.ConversationSetting{}).
+		Where("conversation_id = ? AND archived = ?", conversationID, true).
+		Update("archived", false).Error
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"gosocial/internal/dbmysql"
+)
+
+type SyncRepository interface {
+	AppendEvent(ctx context.Context, event *dbmysql.ChatEvent) error
+	ListEventsSince(ctx context.Context, userID string, conversationIDs []string, sinceEventID uint64, limit int) ([]*dbmysql.ChatEvent, error)
+	GetCursor(ctx context.Context, deviceToken string) (*dbmysql.DeviceSyncCursor, error)
+	SaveCursor(ctx context.Context, cursor *dbmysql.DeviceSyncCursor) error
+	GetDevice(ctx context.Context, deviceToken string) (*dbmysql.Device, error)
+}
+
+type syncRepo struct {
+	db *gorm.DB
+}
+
+func NewSyncRepository(db *gorm.DB) SyncRepository {
+	return &syncRepo{
+		db: db,
+	}
+}
+
+func (r *syncRepo) AppendEvent(ctx context.Context, event *dbmysql.ChatEvent) error {
+	return r.db.WithContext(ctx).Create(event).Error
+}
+
+// contentEvents are the event kinds that show the content of their message
+var contentEvents = []string{"message.created", "message.edited"}
+
+// ListEventsSince returns events from the given conversations plus membership events about the user
+// itself, so a device also learns about conversations it was removed from. Message events carry the
+// current content of their message, an edited or deleted message never shows its old text
+func (r *syncRepo) ListEventsSince(ctx context.Context, userID string, conversationIDs []string, sinceEventID uint64, limit int) ([]*dbmysql.ChatEvent, error) {
+	var events []*dbmysql.ChatEvent
+	err := r.db.WithContext(ctx).
+		Select("chat_events.*, COALESCE(messages.content, '') AS content").
+		Joins("LEFT JOIN messages ON messages.message_id = chat_events.message_id AND chat_events.kind IN ?", contentEvents).
+		Where("chat_events.event_id > ? AND (chat_events.conversation_id IN ? OR chat_events.subject_id = ?)", sinceEventID, conversationIDs, userID).
+		Order("chat_events.event_id ASC").
+		Limit(limit).
+		Find(&events).Error
+	return events, err
+}
+
+func (r *syncRepo) GetCursor(ctx context.Context, deviceToken string) (*dbmysql.DeviceSyncCursor, error) {
+	var cursor dbmysql.DeviceSyncCursor
+	if err := r.db.WithContext(ctx).Where("device_token = ?", deviceToken).First(&cursor).Error; err != nil {
+		return nil, err
+	}
+	return &cursor, nil
+}
+
+func (r *syncRepo) SaveCursor(ctx context.Context, cursor *dbmysql.DeviceSyncCursor) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{UpdateAll: true}).
+		Create(cursor).Error
+}
+
+func (r *syncRepo) GetDevice(ctx context.Context, deviceToken string) (*dbmysql.Device, error) {
+	var device dbmysql.Device
+	if err := r.db.WithContext(ctx).Where("device_token = ?", deviceToken).First(&device).Error; err != nil {
+		return nil, err
+	}
+	return &device, nil
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gosocial/internal/dbmysql"
+)
+
+func TestSyncRepository_ListEventsSince(t *testing.T) {
+	db, mock, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	rows := sqlmock.NewRows([]string{"event_id", "conversation_id", "kind", "message_id", "actor_id", "subject_id", "content"}).
+		AddRow(11, "conv-1", "message.created", 5, "user-1", "", "hello").
+		AddRow(12, "conv-9", "member.removed", nil, "user-2", "user-1", "")
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT chat_events.*, COALESCE(messages.content, '') AS content FROM `chat_events` "+
+			"LEFT JOIN messages ON messages.message_id = chat_events.message_id AND chat_events.kind IN (?,?) "+
+			"WHERE chat_events.event_id > ? AND (chat_events.conversation_id IN (?,?) OR chat_events.subject_id = ?) "+
+			"ORDER BY chat_events.event_id ASC LIMIT ?")).
+		WithArgs("message.created", "message.edited", 10, "conv-1", "conv-2", "user-1", 51).
+		WillReturnRows(rows)
+
+	repo := NewSyncRepository(db)
+	events, err := repo.ListEventsSince(context.Background(), "user-1", []string{"conv-1", "conv-2"}, 10, 51)
+
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, uint(5), *events[0].MessageID)
+	assert.Equal(t, "hello", events[0].Content)
+	assert.Nil(t, events[1].MessageID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSyncRepository_AppendEventStoresNoContent(t *testing.T) {
+	db, mock, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		"INSERT INTO `chat_events` (`conversation_id`,`kind`,`message_id`,`actor_id`,`subject_id`,`created_at`) VALUES (?,?,?,?,?,?)")).
+		WithArgs("conv-1", "message.edited", 5, "user-1", "", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(12, 1))
+	mock.ExpectCommit()
+
+	messageID := uint(5)
+	repo := NewSyncRepository(db)
+	err := repo.AppendEvent(context.Background(), &dbmysql.ChatEvent{
+		ConversationID: "conv-1",
+		Kind:           "message.edited",
+		MessageID:      &messageID,
+		ActorID:        "user-1",
+		Content:        "edited text",
+	})
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMemberRepository_AddMember(t *testing.T) {
+	db, mock, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		"INSERT INTO `conversation_members` (`conversation_id`,`user_id`,`joined_at`) VALUES (?,?,?) ON DUPLICATE KEY UPDATE `conversation_id`=`conversation_id`")).
+		WithArgs("conv-1", "user-1", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	repo := NewMemberRepository(db)
+	added, err := repo.AddMember(context.Background(), &dbmysql.ConversationMember{ConversationID: "conv-1", UserID: "user-1"})
+
+	require.NoError(t, err)
+	assert.False(t, added)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSettingsRepository_UpsertSetting(t *testing.T) {
+	db, mock, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		"INSERT INTO `conversation_settings` (`conversation_id`,`user_id`,`muted_until`,`archived`,`pinned`,`pinned_at`,`updated_at`) VALUES (?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `archived`=VALUES(`archived`),`updated_at`=VALUES(`updated_at`)")).
+		WithArgs("conv-1", "user-1", nil, true, false, nil, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := NewSettingsRepository(db)
+	err := repo.UpsertSetting(context.Background(), &dbmysql.ConversationSetting{ConversationID: "conv-1", UserID: "user-1", Archived: true}, "archived")
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
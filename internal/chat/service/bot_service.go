@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"gosocial/internal/chat/repository"
+	"gosocial/internal/dbmysql"
+)
+
+var (
+	ErrInvalidBotToken      = errors.New("invalid bot token")
+	ErrBotNotInConversation = errors.New("bot is not a member of this conversation")
+)
+
+// BotSenderID is the sender ID stored on messages posted by a bot
+func BotSenderID(botID uint) string {
+	return fmt.Sprintf("bot:%d", botID)
+}
+
+// IsBotSender reports whether a message sender ID belongs to a bot
+func IsBotSender(senderID string) bool {
+	return strings.HasPrefix(senderID, "bot:")
+}
+
+// botIDOf parses the bot ID out of a bot sender ID
+func botIDOf(senderID string) (uint, bool) {
+	id, err := strconv.ParseUint(strings.TrimPrefix(senderID, "bot:"), 10, 0)
+	if err != nil || !IsBotSender(senderID) {
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// BotService manages bot accounts, their conversations and webhook delivery
+type BotService interface {
+	RegisterBot(ctx context.Context, ownerID, name, webhookURL string) (*dbmysql.Bot, string, error)
+	AddToConversation(ctx context.Context, botID uint, conversationID, addedBy string) error
+	Authenticate(ctx context.Context, token string) (*dbmysql.Bot, error)
+	PostMessage(ctx context.Context, bot *dbmysql.Bot, conversationID, content string) (*dbmysql.Message, error)
+	OnMessage(ctx context.Context, msg *dbmysql.Message)
+}
+
+type botService struct {
+	repo        repository.BotRepository
+	memberRepo  repository.MemberRepository
+	chatService ChatService
+	sender      WebhookSender
+}
+
+// NewBotService subscribes the bot service to new messages so webhooks fire for every saved message
+func NewBotService(r repository.BotRepository, memberRepo repository.MemberRepository, chatService ChatService, sender WebhookSender) BotService {
+	s := &botService{
+		repo:        r,
+		memberRepo:  memberRepo,
+		chatService: chatService,
+		sender:      sender,
+	}
+	chatService.Subscribe(s)
+	return s
+}
+
+// RegisterBot creates a bot and returns its api token, only a hash of the token is stored
+func (s *botService) RegisterBot(ctx context.Context, ownerID, name, webhookURL string) (*dbmysql.Bot, string, error) {
+	if ownerID == "" {
+		return nil, "", errors.New("owner ID cannot be empty")
+	}
+	if name == "" {
+		return nil, "", errors.New("bot name cannot be empty")
+	}
+	if webhookURL != "" {
+		u, err := url.Parse(webhookURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, "", errors.New("webhook URL must be an absolute http(s) URL")
+		}
+		// Hostnames are checked again at dial time once they resolve
+		if ip := net.ParseIP(u.Hostname()); u.Hostname() == "localhost" || (ip != nil && !isPublicIP(ip)) {
+			return nil, "", ErrWebhookAddressNotAllowed
+		}
+	}
+
+	token, err := randomHex(32)
+	if err != nil {
+		return nil, "", err
+	}
+	secret, err := randomHex(32)
+	if err != nil {
+		return nil, "", err
+	}
+
+	bot := &dbmysql.Bot{
+		OwnerID:       ownerID,
+		Name:          name,
+		TokenHash:     hashToken(token),
+		WebhookURL:    webhookURL,
+		WebhookSecret: secret,
+	}
+	if err := s.repo.CreateBot(ctx, bot); err != nil {
+		return nil, "", err
+	}
+
+	return bot, token, nil
+}
+
+// AddToConversation lets a member of the conversation add a bot, the bot's webhook then receives every message
+func (s *botService) AddToConversation(ctx context.Context, botID uint, conversationID, addedBy string) error {
+	if conversationID == "" {
+		return errors.New("conversation ID cannot be empty")
+	}
+	if addedBy == "" {
+		return errors.New("added by cannot be empty")
+	}
+
+	isMember, err := s.memberRepo.IsMember(ctx, conversationID, addedBy)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return ErrNotConversationMember
+	}
+
+	if _, err := s.repo.GetBotByID(ctx, botID); err != nil {
+		return fmt.Errorf("bot not found: %w", err)
+	}
+
+	// Bots read message content through their webhook
+	if err := s.chatService.CheckPlaintextAccess(ctx, conversationID); err != nil {
+		return err
+	}
+
+	return s.repo.AddToConversation(ctx, &dbmysql.BotConversation{
+		BotID:          botID,
+		ConversationID: conversationID,
+		AddedBy:        addedBy,
+	})
+}
+
+func (s *botService) Authenticate(ctx context.Context, token string) (*dbmysql.Bot, error) {
+	if token == "" {
+		return nil, ErrInvalidBotToken
+	}
+	bot, err := s.repo.GetBotByTokenHash(ctx, hashToken(token))
+	if err != nil {
+		return nil, ErrInvalidBotToken
+	}
+	return bot, nil
+}
+
+// PostMessage sends a message as the bot, the bot must have been added to the conversation
+func (s *botService) PostMessage(ctx context.Context, bot *dbmysql.Bot, conversationID, content string) (*dbmysql.Message, error) {
+	ok, err := s.repo.IsInConversation(ctx, bot.BotID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrBotNotInConversation
+	}
+
+	return s.chatService.SendMessage(ctx, &dbmysql.Message{
+		ConversationID: conversationID,
+		SenderID:       BotSenderID(bot.BotID),
+		Content:        content,
+	})
+}
+
+// OnMessage fans a new message out to the webhooks of every bot in the conversation
+func (s *botService) OnMessage(ctx context.Context, msg *dbmysql.Message) {
+	bots, err := s.repo.ListConversationBots(ctx, msg.ConversationID)
+	if err != nil {
+		log.Printf("Failed to list bots for conversation %s: %v", msg.ConversationID, err)
+		return
+	}
+
+	for _, bot := range bots {
+		// Bots do not get their own messages echoed back
+		if bot.WebhookURL == "" || BotSenderID(bot.BotID) == msg.SenderID {
+			continue
+		}
+
+		event := &WebhookEvent{
+			Event:          EventMessageCreated,
+			BotID:          bot.BotID,
+			MessageID:      msg.MessageID,
+			ConversationID: msg.ConversationID,
+			SenderID:       msg.SenderID,
+			Content:        msg.Content,
+			SentAt:         msg.SentAt,
+		}
+
+		go func(bot *dbmysql.Bot) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			if err := s.sender.Send(ctx, bot.WebhookURL, bot.WebhookSecret, event); err != nil {
+				log.Printf("Webhook delivery to bot %d failed: %v", bot.BotID, err)
+			}
+		}(bot)
+	}
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
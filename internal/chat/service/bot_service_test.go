@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"gosocial/internal/chat/service/mocks"
+	"gosocial/internal/dbmysql"
+)
+
+func TestBotService_RegisterBot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockChatRepository(ctrl)
+	mockBotRepo := mocks.NewMockBotRepository(ctrl)
+	botService := NewBotService(mockBotRepo, mocks.NewMockMemberRepository(ctrl), NewChatService(mockRepo), NewWebhookSender())
+
+	tests := []struct {
+		name        string
+		ownerID     string
+		botName     string
+		webhookURL  string
+		mockSetup   func()
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:       "successful registration",
+			ownerID:    "user-1",
+			botName:    "ci-alerts",
+			webhookURL: "https://hooks.example.com/ci",
+			mockSetup: func() {
+				mockBotRepo.EXPECT().
+					CreateBot(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, bot *dbmysql.Bot) error {
+						bot.BotID = 7
+						return nil
+					}).
+					Times(1)
+			},
+			expectError: false,
+		},
+		{
+			name:        "empty owner",
+			botName:     "ci-alerts",
+			mockSetup:   func() {},
+			expectError: true,
+			errorMsg:    "owner ID cannot be empty",
+		},
+		{
+			name:        "relative webhook URL",
+			ownerID:     "user-1",
+			botName:     "ci-alerts",
+			webhookURL:  "/hooks/ci",
+			mockSetup:   func() {},
+			expectError: true,
+			errorMsg:    "webhook URL must be an absolute http(s) URL",
+		},
+		{
+			name:        "internal webhook address",
+			ownerID:     "user-1",
+			botName:     "ci-alerts",
+			webhookURL:  "http://127.0.0.1:8080/admin",
+			mockSetup:   func() {},
+			expectError: true,
+			errorMsg:    ErrWebhookAddressNotAllowed.Error(),
+		},
+		{
+			name:    "repository error",
+			ownerID: "user-1",
+			botName: "reminders",
+			mockSetup: func() {
+				mockBotRepo.EXPECT().
+					CreateBot(gomock.Any(), gomock.Any()).
+					Return(errors.New("duplicate token")).
+					Times(1)
+			},
+			expectError: true,
+			errorMsg:    "duplicate token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+
+			bot, token, err := botService.RegisterBot(context.Background(), tt.ownerID, tt.botName, tt.webhookURL)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorMsg)
+				assert.Nil(t, bot)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, token, 64)
+				assert.Equal(t, hashToken(token), bot.TokenHash)
+				assert.NotEqual(t, token, bot.TokenHash)
+				assert.NotEmpty(t, bot.WebhookSecret)
+			}
+		})
+	}
+}
+
+func TestBotService_Authenticate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBotRepo := mocks.NewMockBotRepository(ctrl)
+	botService := NewBotService(mockBotRepo, mocks.NewMockMemberRepository(ctrl), NewChatService(mocks.NewMockChatRepository(ctrl)), NewWebhookSender())
+
+	mockBotRepo.EXPECT().
+		GetBotByTokenHash(gomock.Any(), hashToken("good-token")).
+		Return(&dbmysql.Bot{BotID: 3}, nil)
+	mockBotRepo.EXPECT().
+		GetBotByTokenHash(gomock.Any(), hashToken("bad-token")).
+		Return(nil, errors.New("record not found"))
+
+	bot, err := botService.Authenticate(context.Background(), "good-token")
+	assert.NoError(t, err)
+	assert.Equal(t, uint(3), bot.BotID)
+
+	_, err = botService.Authenticate(context.Background(), "bad-token")
+	assert.ErrorIs(t, err, ErrInvalidBotToken)
+
+	_, err = botService.Authenticate(context.Background(), "")
+	assert.ErrorIs(t, err, ErrInvalidBotToken)
+}
+
+func TestBotService_AddToConversation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBotRepo := mocks.NewMockBotRepository(ctrl)
+	mockMemberRepo := mocks.NewMockMemberRepository(ctrl)
+	botService := NewBotService(mockBotRepo, mockMemberRepo, NewChatService(mocks.NewMockChatRepository(ctrl)), NewWebhookSender())
+
+	t.Run("non member cannot add a bot", func(t *testing.T) {
+		mockMemberRepo.EXPECT().IsMember(gomock.Any(), "conv-1", "user-9").Return(false, nil)
+
+		err := botService.AddToConversation(context.Background(), 5, "conv-1", "user-9")
+		assert.ErrorIs(t, err, ErrNotConversationMember)
+	})
+
+	t.Run("member adds a bot", func(t *testing.T) {
+		mockMemberRepo.EXPECT().IsMember(gomock.Any(), "conv-1", "user-1").Return(true, nil)
+		mockBotRepo.EXPECT().GetBotByID(gomock.Any(), uint(5)).Return(&dbmysql.Bot{BotID: 5}, nil)
+		mockBotRepo.EXPECT().AddToConversation(gomock.Any(), &dbmysql.BotConversation{
+			BotID: 5, ConversationID: "conv-1", AddedBy: "user-1",
+		}).Return(nil)
+
+		assert.NoError(t, botService.AddToConversation(context.Background(), 5, "conv-1", "user-1"))
+	})
+}
+
+func TestBotService_PostMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockChatRepository(ctrl)
+	mockBotRepo := mocks.NewMockBotRepository(ctrl)
+	botService := NewBotService(mockBotRepo, mocks.NewMockMemberRepository(ctrl), NewChatService(mockRepo), NewWebhookSender())
+	bot := &dbmysql.Bot{BotID: 5}
+
+	t.Run("bot not in conversation", func(t *testing.T) {
+		mockBotRepo.EXPECT().IsInConversation(gomock.Any(), uint(5), "conv-1").Return(false, nil)
+
+		msg, err := botService.PostMessage(context.Background(), bot, "conv-1", "build failed")
+		assert.ErrorIs(t, err, ErrBotNotInConversation)
+		assert.Nil(t, msg)
+	})
+
+	t.Run("bot posts as bot sender", func(t *testing.T) {
+		mockBotRepo.EXPECT().IsInConversation(gomock.Any(), uint(5), "conv-2").Return(true, nil)
+		mockRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+		// The bot's own message still goes through the observers, but it is not echoed back
+		mockBotRepo.EXPECT().ListConversationBots(gomock.Any(), "conv-2").Return([]*dbmysql.Bot{bot}, nil)
+
+		msg, err := botService.PostMessage(context.Background(), bot, "conv-2", "build passed")
+		require.NoError(t, err)
+		assert.Equal(t, "bot:5", msg.SenderID)
+		assert.Equal(t, "conv-2", msg.ConversationID)
+	})
+}
+
+func TestBotService_OnMessage_DeliversSignedWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	received := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- r
+		bodies <- body
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	mockRepo := mocks.NewMockChatRepository(ctrl)
+	mockBotRepo := mocks.NewMockBotRepository(ctrl)
+	chatService := NewChatService(mockRepo)
+	NewBotService(mockBotRepo, mocks.NewMockMemberRepository(ctrl), chatService, &httpWebhookSender{client: server.Client()})
+
+	mockRepo.EXPECT().
+		Save(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, msg *dbmysql.Message) error {
+			msg.MessageID = 42
+			return nil
+		})
+	mockBotRepo.EXPECT().
+		ListConversationBots(gomock.Any(), "conv-9").
+		Return([]*dbmysql.Bot{
+			{BotID: 1, WebhookURL: server.URL, WebhookSecret: "s3cret"},
+			{BotID: 2}, // no webhook registered
+		}, nil)
+
+	_, err := chatService.SendMessage(context.Background(), &dbmysql.Message{
+		ConversationID: "conv-9",
+		SenderID:       "user-1",
+		Content:        "standup in 5",
+	})
+	require.NoError(t, err)
+
+	select {
+	case r := <-received:
+		body := <-bodies
+		assert.Equal(t, EventMessageCreated, r.Header.Get(EventHeader))
+		assert.Equal(t, "sha256="+SignPayload("s3cret", body), r.Header.Get(SignatureHeader))
+
+		var event WebhookEvent
+		require.NoError(t, json.Unmarshal(body, &event))
+		assert.Equal(t, uint(1), event.BotID)
+		assert.Equal(t, uint(42), event.MessageID)
+		assert.Equal(t, "standup in 5", event.Content)
+	case <-time.After(2 * time.Second):
+		t.Fatal("webhook was not delivered")
+	}
+}
+
+func TestWebhookSender_Non2xxIsError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	sender := &httpWebhookSender{client: server.Client()}
+	err := sender.Send(context.Background(), server.URL, "secret", &WebhookEvent{Event: EventMessageCreated})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "500")
+}
+
+func TestWebhookSender_RefusesInternalAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("webhook reached a loopback server")
+	}))
+	defer server.Close()
+
+	for _, url := range []string{
+		server.URL,
+		"http://localhost:9/hook",
+		"http://169.254.169.254/latest/meta-data",
+		"http://10.0.0.1/hook",
+	} {
+		err := NewWebhookSender().Send(context.Background(), url, "secret", &WebhookEvent{Event: EventMessageCreated})
+		assert.ErrorIs(t, err, ErrWebhookAddressNotAllowed, url)
+	}
+}
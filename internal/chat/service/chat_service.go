@@ -3,19 +3,58 @@ package service
 import (
 	"context"
 	"errors"
+	"gorm.io/gorm"
 	"gosocial/internal/chat/repository"
 	"gosocial/internal/dbmysql"
+	"sync"
 	"time"
 )
 
+var (
+	ErrMessageNotFound  = errors.New("message not found")
+	ErrNotMessageSender = errors.New("only the sender can change this message")
+	ErrMessageDeleted   = errors.New("message has been deleted")
+)
+
+// MessageObserver is notified after a message has been persisted
+type MessageObserver interface {
+	OnMessage(ctx context.Context, msg *dbmysql.Message)
+}
+
+// ChangeObserver is an optional extension of MessageObserver for edits, deletes and read receipts
+type ChangeObserver interface {
+	OnChange(ctx context.Context, event *dbmysql.ChatEvent)
+}
+
+// ConversationPolicy can veto operations on a conversation. It is consulted before a
+// message is saved, before a user touches a conversation and before any server-side
+// feature that needs message plaintext
+type ConversationPolicy interface {
+	CheckSend(ctx context.Context, msg *dbmysql.Message) error
+	CheckAccess(ctx context.Context, conversationID, userID string) error
+	CheckPlaintextAccess(ctx context.Context, conversationID string) error
+}
+
 // ChatService defines 
 type ChatService interface {
 	SendMessage(ctx context.Context, msg *dbmysql.Message) (*dbmysql.Message, error)
-	GetMessageHistory(ctx context.Context, conversationID string) ([]*dbmysql.Message, error)
+	GetMessageHistory(ctx context.Context, conversationID, requesterID string) ([]*dbmysql.Message, error)
+	EditMessage(ctx context.Context, messageID uint, editorID, content string) (*dbmysql.Message, error)
+	DeleteMessage(ctx context.Context, messageID uint, requesterID string) (*dbmysql.Message, error)
+	MarkRead(ctx context.Context, conversationID, readerID string, upToMessageID uint) error
+	SearchMessages(ctx context.Context, conversationID, requesterID, query string, limit int) ([]*dbmysql.Message, error)
+	CheckPlaintextAccess(ctx context.Context, conversationID string) error
+	Subscribe(observer MessageObserver)
+	AddPolicy(policy ConversationPolicy)
+	SetModerator(moderator MessageModerator)
 }
 
 type chatService struct {
-	repo repository.ChatRepository
+	repo      repository.ChatRepository
+	mu        sync.RWMutex
+	observers []MessageObserver
+	policies  []ConversationPolicy
+	moderator MessageModerator
 }
 
 // Constructor used in DI/wire
@@ -36,23 +75,272 @@ func (s *chatService) SendMessage(ctx context.Context, msg *dbmysql.Message) (*d
 		return nil, errors.New("message content cannot be empty")
 	}
 
+	for _, policy := range s.currentPolicies() {
+		if err := policy.CheckSend(ctx, msg); err != nil {
+			return nil, err
+		}
+	}
+
+	recordModeration, err := s.moderate(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Set server-side timestamp
 	msg.SentAt = time.Now().UTC()
 
 	// Save to DB via repository
-	err := s.repo.Save(ctx, msg)
+	err = s.repo.Save(ctx, msg)
 	if err != nil {
 		return nil, err
 	}
 
+	recordModeration()
+
+	s.notifyObservers(ctx, msg)
+
 	return msg, nil
 }
 
-// GetMessageHistory returns full message history of a conversation
-func (s *chatService) GetMessageHistory(ctx context.Context, conversationID string) ([]*dbmysql.Message, error) {
+// GetMessageHistory returns full message history of a conversation to one of its members
+func (s *chatService) GetMessageHistory(ctx context.Context, conversationID, requesterID string) ([]*dbmysql.Message, error) {
 	if conversationID == "" {
 		return nil, errors.New("conversation ID is required")
 	}
+	if requesterID == "" {
+		return nil, errors.New("requester ID is required")
+	}
+	if err := s.checkAccess(ctx, conversationID, requesterID); err != nil {
+		return nil, err
+	}
 
 	return s.repo.FetchHistory(ctx, conversationID)
 }
+
+// EditMessage replaces the content of a message, only its sender may edit it
+func (s *chatService) EditMessage(ctx context.Context, messageID uint, editorID, content string) (*dbmysql.Message, error) {
+	if content == "" {
+		return nil, errors.New("message content cannot be empty")
+	}
+
+	msg, err := s.ownMessage(ctx, messageID, editorID)
+	if err != nil {
+		return nil, err
+	}
+
+	msg.Content = content
+
+	// Edits go through the same filters so they cannot be used to slip content past them
+	recordModeration, err := s.moderate(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateMessage(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	recordModeration()
+
+	s.notifyChange(ctx, &dbmysql.ChatEvent{
+		ConversationID: msg.ConversationID,
+		Kind:           EventMessageEdited,
+		MessageID:      &msg.MessageID,
+		ActorID:        editorID,
+	})
+
+	return msg, nil
+}
+
+// DeleteMessage soft deletes a message by clearing its content, only its sender may delete it
+func (s *chatService) DeleteMessage(ctx context.Context, messageID uint, requesterID string) (*dbmysql.Message, error) {
+	msg, err := s.ownMessage(ctx, messageID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	msg.Content = ""
+	msg.Status = "deleted"
+	if err := s.repo.UpdateMessage(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	s.notifyChange(ctx, &dbmysql.ChatEvent{
+		ConversationID: msg.ConversationID,
+		Kind:           EventMessageDeleted,
+		MessageID:      &msg.MessageID,
+		ActorID:        requesterID,
+	})
+
+	return msg, nil
+}
+
+// MarkRead records a read receipt for the reader up to and including upToMessageID
+func (s *chatService) MarkRead(ctx context.Context, conversationID, readerID string, upToMessageID uint) error {
+	if conversationID == "" {
+		return errors.New("conversation ID is required")
+	}
+	if readerID == "" {
+		return errors.New("reader ID is required")
+	}
+	if upToMessageID == 0 {
+		return errors.New("message ID is required")
+	}
+
+	if err := s.checkAccess(ctx, conversationID, readerID); err != nil {
+		return err
+	}
+
+	if _, err := s.repo.MarkRead(ctx, conversationID, readerID, upToMessageID); err != nil {
+		return err
+	}
+
+	s.notifyChange(ctx, &dbmysql.ChatEvent{
+		ConversationID: conversationID,
+		Kind:           EventReceiptRead,
+		MessageID:      &upToMessageID,
+		ActorID:        readerID,
+	})
+
+	return nil
+}
+
+func (s *chatService) ownMessage(ctx context.Context, messageID uint, userID string) (*dbmysql.Message, error) {
+	if messageID == 0 {
+		return nil, errors.New("message ID is required")
+	}
+
+	msg, err := s.repo.GetMessage(ctx, messageID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrMessageNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if msg.SenderID != userID {
+		return nil, ErrNotMessageSender
+	}
+	if msg.Status == "deleted" {
+		return nil, ErrMessageDeleted
+	}
+	return msg, nil
+}
+
+// SearchMessages finds messages containing query, it is refused for conversations
+// whose content the server cannot read
+func (s *chatService) SearchMessages(ctx context.Context, conversationID, requesterID, query string, limit int) ([]*dbmysql.Message, error) {
+	if conversationID == "" {
+		return nil, errors.New("conversation ID is required")
+	}
+	if requesterID == "" {
+		return nil, errors.New("requester ID is required")
+	}
+	if query == "" {
+		return nil, errors.New("search query cannot be empty")
+	}
+	if err := s.checkAccess(ctx, conversationID, requesterID); err != nil {
+		return nil, err
+	}
+	if err := s.CheckPlaintextAccess(ctx, conversationID); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+	return s.repo.SearchMessages(ctx, conversationID, query, limit)
+}
+
+// CheckPlaintextAccess returns the first policy error for features that need to read message content
+// checkAccess asks every policy whether the user may touch the conversation
+func (s *chatService) checkAccess(ctx context.Context, conversationID, userID string) error {
+	for _, policy := range s.currentPolicies() {
+		if err := policy.CheckAccess(ctx, conversationID, userID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *chatService) CheckPlaintextAccess(ctx context.Context, conversationID string) error {
+	for _, policy := range s.currentPolicies() {
+		if err := policy.CheckPlaintextAccess(ctx, conversationID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddPolicy registers a policy consulted on every send and plaintext access
+func (s *chatService) AddPolicy(policy ConversationPolicy) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.policies = append(s.policies, policy)
+}
+
+func (s *chatService) currentPolicies() []ConversationPolicy {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	policies := make([]ConversationPolicy, len(s.policies))
+	copy(policies, s.policies)
+	return policies
+}
+
+// SetModerator installs the filter chain run on every new or edited message
+func (s *chatService) SetModerator(moderator MessageModerator) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.moderator = moderator
+}
+
+// moderate runs the filter chain and returns a callback that records its decisions once msg is stored
+func (s *chatService) moderate(ctx context.Context, msg *dbmysql.Message) (func(), error) {
+	moderator := s.currentModerator()
+	if moderator == nil {
+		return func() {}, nil
+	}
+
+	result, err := moderator.Moderate(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	return func() { moderator.Record(ctx, msg, result) }, nil
+}
+
+func (s *chatService) currentModerator() MessageModerator {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.moderator
+}
+
+// Subscribe registers an observer for every saved message, observers that also
+// implement ChangeObserver receive edits, deletes and read receipts
+func (s *chatService) Subscribe(observer MessageObserver) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.observers = append(s.observers, observer)
+}
+
+func (s *chatService) notifyObservers(ctx context.Context, msg *dbmysql.Message) {
+	s.mu.RLock()
+	observers := make([]MessageObserver, len(s.observers))
+	copy(observers, s.observers)
+	s.mu.RUnlock()
+
+	for _, observer := range observers {
+		observer.OnMessage(ctx, msg)
+	}
+}
+
+func (s *chatService) notifyChange(ctx context.Context, event *dbmysql.ChatEvent) {
+	s.mu.RLock()
+	observers := make([]MessageObserver, len(s.observers))
+	copy(observers, s.observers)
+	s.mu.RUnlock()
+
+	for _, observer := range observers {
+		if changeObserver, ok := observer.(ChangeObserver); ok {
+			changeObserver.OnChange(ctx, event)
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
 
 
 	"gosocial/internal/chat/service/mocks" 
@@ -154,7 +155,7 @@ func TestChatService_GetMessageHistory(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockSetup()
 
-			messages, err := service.GetMessageHistory(context.Background(), tt.conversationID)
+			messages, err := service.GetMessageHistory(context.Background(), tt.conversationID, "user-456")
 
 			if tt.expectError {
 				assert.Error(t, err)
@@ -168,3 +169,92 @@ func TestChatService_GetMessageHistory(t *testing.T) {
 	}
 }
 
+func TestChatService_EditMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockChatRepository(ctrl)
+	service := NewChatService(mockRepo)
+
+	tests := []struct {
+		name        string
+		messageID   uint
+		editorID    string
+		mockSetup   func()
+		expectError error
+	}{
+		{
+			name:      "sender edits message",
+			messageID: 1,
+			editorID:  "user-456",
+			mockSetup: func() {
+				mockRepo.EXPECT().GetMessage(gomock.Any(), uint(1)).
+					Return(&dbmysql.Message{MessageID: 1, SenderID: "user-456", Content: "Hello", Status: "delivered"}, nil)
+				mockRepo.EXPECT().UpdateMessage(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:      "someone else's message",
+			messageID: 1,
+			editorID:  "user-789",
+			mockSetup: func() {
+				mockRepo.EXPECT().GetMessage(gomock.Any(), uint(1)).
+					Return(&dbmysql.Message{MessageID: 1, SenderID: "user-456", Status: "delivered"}, nil)
+			},
+			expectError: ErrNotMessageSender,
+		},
+		{
+			name:      "deleted message",
+			messageID: 1,
+			editorID:  "user-456",
+			mockSetup: func() {
+				mockRepo.EXPECT().GetMessage(gomock.Any(), uint(1)).
+					Return(&dbmysql.Message{MessageID: 1, SenderID: "user-456", Status: "deleted"}, nil)
+			},
+			expectError: ErrMessageDeleted,
+		},
+		{
+			name:      "missing message",
+			messageID: 2,
+			editorID:  "user-456",
+			mockSetup: func() {
+				mockRepo.EXPECT().GetMessage(gomock.Any(), uint(2)).Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectError: ErrMessageNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+
+			msg, err := service.EditMessage(context.Background(), tt.messageID, tt.editorID, "Edited")
+
+			if tt.expectError != nil {
+				assert.ErrorIs(t, err, tt.expectError)
+				assert.Nil(t, msg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "Edited", msg.Content)
+			}
+		})
+	}
+}
+
+func TestChatService_DeleteMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockChatRepository(ctrl)
+	service := NewChatService(mockRepo)
+
+	mockRepo.EXPECT().GetMessage(gomock.Any(), uint(1)).
+		Return(&dbmysql.Message{MessageID: 1, SenderID: "user-456", Content: "Hello", Status: "delivered"}, nil)
+	mockRepo.EXPECT().UpdateMessage(gomock.Any(), gomock.Any()).Return(nil)
+
+	msg, err := service.DeleteMessage(context.Background(), 1, "user-456")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "deleted", msg.Status)
+	assert.Empty(t, msg.Content)
+}
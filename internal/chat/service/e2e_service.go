@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+
+	"gosocial/internal/chat/repository"
+	"gosocial/internal/dbmysql"
+)
+
+const maxPrekeysPerPublish = 100
+
+var (
+	ErrE2EPlaintextRequired = errors.New("conversation is end-to-end encrypted, this feature needs plaintext")
+	ErrE2ENotOneToOne       = errors.New("end-to-end encryption is only available for 1:1 conversations")
+	ErrE2EMissingKeys       = errors.New("both participants must publish device keys first")
+	ErrNoSharedConversation = errors.New("requester shares no conversation with this user")
+)
+
+// KeyBundle is what a session initiator needs to encrypt for one device of a user
+type KeyBundle struct {
+	*dbmysql.DeviceKeyBundle
+	OneTimePrekey *dbmysql.OneTimePrekey
+}
+
+// E2EService runs the public key directory and the opt-in end-to-end mode of 1:1
+// conversations. Once a conversation is E2E the server only stores and relays opaque
+// ciphertext in messages.content and refuses features that need to read it
+type E2EService interface {
+	PublishKeys(ctx context.Context, userID, deviceToken string, bundle *dbmysql.DeviceKeyBundle, prekeys []*dbmysql.OneTimePrekey) (string, error)
+	GetKeyBundles(ctx context.Context, requesterID, userID string) ([]*KeyBundle, error)
+	EnableE2E(ctx context.Context, conversationID, userID string) error
+	IsE2E(ctx context.Context, conversationID string) (bool, error)
+	CheckSend(ctx context.Context, msg *dbmysql.Message) error
+	CheckAccess(ctx context.Context, conversationID, userID string) error
+	CheckPlaintextAccess(ctx context.Context, conversationID string) error
+}
+
+type e2eService struct {
+	e2eRepo    repository.E2ERepository
+	syncRepo   repository.SyncRepository
+	memberRepo repository.MemberRepository
+	botRepo    repository.BotRepository
+}
+
+// NewE2EService registers the service as a conversation policy on the chat service
+func NewE2EService(
+	e2eRepo repository.E2ERepository,
+	syncRepo repository.SyncRepository,
+	memberRepo repository.MemberRepository,
+	botRepo repository.BotRepository,
+	chatService ChatService,
+) E2EService {
+	s := &e2eService{
+		e2eRepo:    e2eRepo,
+		syncRepo:   syncRepo,
+		memberRepo: memberRepo,
+		botRepo:    botRepo,
+	}
+	chatService.AddPolicy(s)
+	return s
+}
+
+// PublishKeys stores the key bundle of one of the user's devices and returns its public device ID
+func (s *e2eService) PublishKeys(ctx context.Context, userID, deviceToken string, bundle *dbmysql.DeviceKeyBundle, prekeys []*dbmysql.OneTimePrekey) (string, error) {
+	if len(bundle.IdentityKey) == 0 || len(bundle.SignedPrekey) == 0 || len(bundle.SignedPrekeySignature) == 0 {
+		return "", errors.New("identity key, signed prekey and signature are required")
+	}
+	if len(prekeys) > maxPrekeysPerPublish {
+		return "", errors.New("too many one-time prekeys in one request")
+	}
+	if err := checkDeviceOwner(ctx, s.syncRepo, userID, deviceToken); err != nil {
+		return "", err
+	}
+
+	bundle.DeviceToken = deviceToken
+	bundle.DeviceID = deviceKeyID(deviceToken)
+	bundle.UserID = userID
+	if err := s.e2eRepo.UpsertKeyBundle(ctx, bundle); err != nil {
+		return "", err
+	}
+
+	for _, prekey := range prekeys {
+		if len(prekey.PublicKey) == 0 {
+			return "", errors.New("one-time prekey cannot be empty")
+		}
+		prekey.DeviceToken = deviceToken
+	}
+	if err := s.e2eRepo.AddPrekeys(ctx, prekeys); err != nil {
+		return "", err
+	}
+
+	return bundle.DeviceID, nil
+}
+
+// GetKeyBundles returns a bundle per device of userID, each claiming one one-time prekey if any are left
+func (s *e2eService) GetKeyBundles(ctx context.Context, requesterID, userID string) ([]*KeyBundle, error) {
+	if requesterID == "" || userID == "" {
+		return nil, errors.New("requester ID and user ID are required")
+	}
+	if err := s.checkSharesConversation(ctx, requesterID, userID); err != nil {
+		return nil, err
+	}
+
+	bundles, err := s.e2eRepo.ListKeyBundles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*KeyBundle, 0, len(bundles))
+	for _, bundle := range bundles {
+		prekey, err := s.e2eRepo.ClaimPrekey(ctx, bundle.DeviceToken)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &KeyBundle{DeviceKeyBundle: bundle, OneTimePrekey: prekey})
+	}
+	return result, nil
+}
+
+// checkSharesConversation keeps strangers from draining a user's one-time prekeys
+func (s *e2eService) checkSharesConversation(ctx context.Context, requesterID, userID string) error {
+	if requesterID == userID {
+		return nil
+	}
+
+	requesterConversations, err := s.memberRepo.ListUserConversations(ctx, requesterID)
+	if err != nil {
+		return err
+	}
+	userConversations, err := s.memberRepo.ListUserConversations(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, conversationID := range userConversations {
+		if contains(requesterConversations, conversationID) {
+			return nil
+		}
+	}
+	return ErrNoSharedConversation
+}
+
+// EnableE2E switches a 1:1 conversation to end-to-end mode. It needs exactly two human
+// members with published keys and no bots, and it cannot be switched off again
+func (s *e2eService) EnableE2E(ctx context.Context, conversationID, userID string) error {
+	if conversationID == "" || userID == "" {
+		return errors.New("conversation ID and user ID are required")
+	}
+
+	members, err := s.memberRepo.ListMembers(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+	if !contains(members, userID) {
+		return ErrNotConversationMember
+	}
+	if len(members) != 2 {
+		return ErrE2ENotOneToOne
+	}
+
+	bots, err := s.botRepo.ListConversationBots(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+	if len(bots) > 0 {
+		return ErrE2ENotOneToOne
+	}
+
+	for _, memberID := range members {
+		bundles, err := s.e2eRepo.ListKeyBundles(ctx, memberID)
+		if err != nil {
+			return err
+		}
+		if len(bundles) == 0 {
+			return ErrE2EMissingKeys
+		}
+	}
+
+	return s.e2eRepo.EnableConversation(ctx, &dbmysql.E2EConversation{
+		ConversationID: conversationID,
+		EnabledBy:      userID,
+	})
+}
+
+func (s *e2eService) IsE2E(ctx context.Context, conversationID string) (bool, error) {
+	return s.e2eRepo.IsE2E(ctx, conversationID)
+}
+
+// CheckSend only lets the two participants post into an E2E conversation, bots cannot
+// encrypt for them
+func (s *e2eService) CheckSend(ctx context.Context, msg *dbmysql.Message) error {
+	isE2E, err := s.e2eRepo.IsE2E(ctx, msg.ConversationID)
+	if err != nil || !isE2E {
+		return err
+	}
+	if IsBotSender(msg.SenderID) {
+		return ErrE2EPlaintextRequired
+	}
+
+	isMember, err := s.memberRepo.IsMember(ctx, msg.ConversationID, msg.SenderID)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return ErrNotConversationMember
+	}
+	return nil
+}
+
+// CheckAccess leaves membership to the sync service
+func (s *e2eService) CheckAccess(ctx context.Context, conversationID, userID string) error {
+	return nil
+}
+
+func (s *e2eService) CheckPlaintextAccess(ctx context.Context, conversationID string) error {
+	isE2E, err := s.e2eRepo.IsE2E(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+	if isE2E {
+		return ErrE2EPlaintextRequired
+	}
+	return nil
+}
+
+// deviceKeyID derives a stable public handle for a device without revealing its push token
+func deviceKeyID(deviceToken string) string {
+	sum := sha256.Sum256([]byte(deviceToken))
+	return hex.EncodeToString(sum[:16])
+}
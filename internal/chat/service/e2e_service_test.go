@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"gosocial/internal/chat/service/mocks"
+	"gosocial/internal/dbmysql"
+)
+
+type e2eTestDeps struct {
+	chatRepo   *mocks.MockChatRepository
+	e2eRepo    *mocks.MockE2ERepository
+	syncRepo   *mocks.MockSyncRepository
+	memberRepo *mocks.MockMemberRepository
+	botRepo    *mocks.MockBotRepository
+}
+
+func newTestE2EService(ctrl *gomock.Controller) (E2EService, ChatService, *e2eTestDeps) {
+	deps := &e2eTestDeps{
+		chatRepo:   mocks.NewMockChatRepository(ctrl),
+		e2eRepo:    mocks.NewMockE2ERepository(ctrl),
+		syncRepo:   mocks.NewMockSyncRepository(ctrl),
+		memberRepo: mocks.NewMockMemberRepository(ctrl),
+		botRepo:    mocks.NewMockBotRepository(ctrl),
+	}
+	chatService := NewChatService(deps.chatRepo)
+	e2e := NewE2EService(deps.e2eRepo, deps.syncRepo, deps.memberRepo, deps.botRepo, chatService)
+	return e2e, chatService, deps
+}
+
+func TestE2EService_EnableE2E(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	e2e, _, deps := newTestE2EService(ctrl)
+
+	tests := []struct {
+		name        string
+		userID      string
+		mockSetup   func()
+		expectError error
+	}{
+		{
+			name:   "group conversation",
+			userID: "1",
+			mockSetup: func() {
+				deps.memberRepo.EXPECT().ListMembers(gomock.Any(), "conv-1").Return([]string{"1", "2", "3"}, nil)
+			},
+			expectError: ErrE2ENotOneToOne,
+		},
+		{
+			name:   "caller is not a participant",
+			userID: "9",
+			mockSetup: func() {
+				deps.memberRepo.EXPECT().ListMembers(gomock.Any(), "conv-1").Return([]string{"1", "2"}, nil)
+			},
+			expectError: ErrNotConversationMember,
+		},
+		{
+			name:   "bot in conversation",
+			userID: "1",
+			mockSetup: func() {
+				deps.memberRepo.EXPECT().ListMembers(gomock.Any(), "conv-1").Return([]string{"1", "2"}, nil)
+				deps.botRepo.EXPECT().ListConversationBots(gomock.Any(), "conv-1").Return([]*dbmysql.Bot{{BotID: 1}}, nil)
+			},
+			expectError: ErrE2ENotOneToOne,
+		},
+		{
+			name:   "peer has no keys",
+			userID: "1",
+			mockSetup: func() {
+				deps.memberRepo.EXPECT().ListMembers(gomock.Any(), "conv-1").Return([]string{"1", "2"}, nil)
+				deps.botRepo.EXPECT().ListConversationBots(gomock.Any(), "conv-1").Return(nil, nil)
+				deps.e2eRepo.EXPECT().ListKeyBundles(gomock.Any(), "1").Return([]*dbmysql.DeviceKeyBundle{{DeviceID: "a"}}, nil)
+				deps.e2eRepo.EXPECT().ListKeyBundles(gomock.Any(), "2").Return(nil, nil)
+			},
+			expectError: ErrE2EMissingKeys,
+		},
+		{
+			name:   "enabled",
+			userID: "1",
+			mockSetup: func() {
+				deps.memberRepo.EXPECT().ListMembers(gomock.Any(), "conv-1").Return([]string{"1", "2"}, nil)
+				deps.botRepo.EXPECT().ListConversationBots(gomock.Any(), "conv-1").Return(nil, nil)
+				deps.e2eRepo.EXPECT().ListKeyBundles(gomock.Any(), gomock.Any()).Return([]*dbmysql.DeviceKeyBundle{{DeviceID: "a"}}, nil).Times(2)
+				deps.e2eRepo.EXPECT().EnableConversation(gomock.Any(), &dbmysql.E2EConversation{ConversationID: "conv-1", EnabledBy: "1"}).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+
+			err := e2e.EnableE2E(context.Background(), "conv-1", tt.userID)
+
+			if tt.expectError != nil {
+				assert.ErrorIs(t, err, tt.expectError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestE2EService_ChatPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_, chatService, deps := newTestE2EService(ctrl)
+	deps.e2eRepo.EXPECT().IsE2E(gomock.Any(), "conv-e2e").Return(true, nil).AnyTimes()
+
+	t.Run("outsider cannot post", func(t *testing.T) {
+		deps.memberRepo.EXPECT().IsMember(gomock.Any(), "conv-e2e", "3").Return(false, nil)
+
+		_, err := chatService.SendMessage(context.Background(), &dbmysql.Message{ConversationID: "conv-e2e", SenderID: "3", Content: "x"})
+		assert.ErrorIs(t, err, ErrNotConversationMember)
+	})
+
+	t.Run("bots cannot post", func(t *testing.T) {
+		_, err := chatService.SendMessage(context.Background(), &dbmysql.Message{ConversationID: "conv-e2e", SenderID: BotSenderID(1), Content: "x"})
+		assert.ErrorIs(t, err, ErrE2EPlaintextRequired)
+	})
+
+	t.Run("participant ciphertext is stored as is", func(t *testing.T) {
+		deps.memberRepo.EXPECT().IsMember(gomock.Any(), "conv-e2e", "1").Return(true, nil)
+		deps.chatRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, msg *dbmysql.Message) error {
+				assert.Equal(t, "AwEBq2V5Y2lwaGVy", msg.Content)
+				return nil
+			})
+
+		_, err := chatService.SendMessage(context.Background(), &dbmysql.Message{ConversationID: "conv-e2e", SenderID: "1", Content: "AwEBq2V5Y2lwaGVy"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("search needs plaintext", func(t *testing.T) {
+		_, err := chatService.SearchMessages(context.Background(), "conv-e2e", "1", "hello", 10)
+		assert.ErrorIs(t, err, ErrE2EPlaintextRequired)
+	})
+}
+
+func TestE2EService_KeyDirectory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	e2e, _, deps := newTestE2EService(ctrl)
+
+	bundle := &dbmysql.DeviceKeyBundle{
+		IdentityKey:           []byte("identity"),
+		SignedPrekeyID:        1,
+		SignedPrekey:          []byte("signed"),
+		SignedPrekeySignature: []byte("signature"),
+	}
+
+	t.Run("foreign device is rejected", func(t *testing.T) {
+		deps.syncRepo.EXPECT().GetDevice(gomock.Any(), "phone-token").Return(&dbmysql.Device{UserID: 7}, nil)
+
+		_, err := e2e.PublishKeys(context.Background(), "42", "phone-token", bundle, nil)
+		assert.ErrorIs(t, err, ErrDeviceNotOwned)
+	})
+
+	t.Run("publish hides the device token", func(t *testing.T) {
+		deps.syncRepo.EXPECT().GetDevice(gomock.Any(), "phone-token").Return(&dbmysql.Device{UserID: 42}, nil)
+		deps.e2eRepo.EXPECT().UpsertKeyBundle(gomock.Any(), bundle).Return(nil)
+		deps.e2eRepo.EXPECT().AddPrekeys(gomock.Any(), gomock.Len(2)).Return(nil)
+
+		deviceID, err := e2e.PublishKeys(context.Background(), "42", "phone-token", bundle, []*dbmysql.OneTimePrekey{
+			{KeyID: 1, PublicKey: []byte("pk1")},
+			{KeyID: 2, PublicKey: []byte("pk2")},
+		})
+
+		require.NoError(t, err)
+		assert.Len(t, deviceID, 32)
+		assert.NotContains(t, deviceID, "phone-token")
+		assert.Equal(t, "42", bundle.UserID)
+	})
+
+	t.Run("strangers cannot claim prekeys", func(t *testing.T) {
+		deps.memberRepo.EXPECT().ListUserConversations(gomock.Any(), "9").Return([]string{"conv-other"}, nil)
+		deps.memberRepo.EXPECT().ListUserConversations(gomock.Any(), "42").Return([]string{"conv-1"}, nil)
+
+		_, err := e2e.GetKeyBundles(context.Background(), "9", "42")
+		assert.ErrorIs(t, err, ErrNoSharedConversation)
+	})
+
+	t.Run("fetch claims one prekey per device", func(t *testing.T) {
+		deps.memberRepo.EXPECT().ListUserConversations(gomock.Any(), "7").Return([]string{"conv-1"}, nil)
+		deps.memberRepo.EXPECT().ListUserConversations(gomock.Any(), "42").Return([]string{"conv-1"}, nil)
+		deps.e2eRepo.EXPECT().ListKeyBundles(gomock.Any(), "42").Return([]*dbmysql.DeviceKeyBundle{
+			{DeviceToken: "phone-token", DeviceID: "a"},
+			{DeviceToken: "web-token", DeviceID: "b"},
+		}, nil)
+		deps.e2eRepo.EXPECT().ClaimPrekey(gomock.Any(), "phone-token").Return(&dbmysql.OneTimePrekey{KeyID: 1}, nil)
+		deps.e2eRepo.EXPECT().ClaimPrekey(gomock.Any(), "web-token").Return(nil, nil)
+
+		bundles, err := e2e.GetKeyBundles(context.Background(), "7", "42")
+
+		require.NoError(t, err)
+		require.Len(t, bundles, 2)
+		assert.Equal(t, uint32(1), bundles[0].OneTimePrekey.KeyID)
+		assert.Nil(t, bundles[1].OneTimePrekey)
+	})
+}
@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	notifpb "gosocial/api/v1"
+	"gosocial/internal/chat/repository"
+	"gosocial/internal/common"
+	"gosocial/internal/dbmysql"
+)
+
+const pushPreviewLength = 100
+
+// InboxEntry is one conversation in a user's inbox with that user's settings for it
+type InboxEntry struct {
+	ConversationID string
+	LastMessage    *dbmysql.Message
+	Setting        *dbmysql.ConversationSetting
+	EndToEnd       bool
+}
+
+// InboxService manages per-participant mute, archive and pin settings, lists the inbox
+// and sends message push notifications to members who have not muted the conversation
+type InboxService interface {
+	Mute(ctx context.Context, conversationID, userID string, until time.Time) error
+	SetArchived(ctx context.Context, conversationID, userID string, archived bool) error
+	SetPinned(ctx context.Context, conversationID, userID string, pinned bool) error
+	ListInbox(ctx context.Context, userID string, includeArchived bool) ([]*InboxEntry, error)
+	OnMessage(ctx context.Context, msg *dbmysql.Message)
+}
+
+type inboxService struct {
+	chatRepo     repository.ChatRepository
+	memberRepo   repository.MemberRepository
+	settingsRepo repository.SettingsRepository
+	e2eRepo      repository.E2ERepository
+	notifier     notifpb.NotificationServiceClient
+	chatService  ChatService
+}
+
+// NewInboxService subscribes to new messages to unarchive conversations and send pushes.
+// A nil notifier disables push notifications
+func NewInboxService(
+	chatRepo repository.ChatRepository,
+	memberRepo repository.MemberRepository,
+	settingsRepo repository.SettingsRepository,
+	e2eRepo repository.E2ERepository,
+	notifier notifpb.NotificationServiceClient,
+	chatService ChatService,
+) InboxService {
+	s := &inboxService{
+		chatRepo:     chatRepo,
+		memberRepo:   memberRepo,
+		settingsRepo: settingsRepo,
+		e2eRepo:      e2eRepo,
+		notifier:     notifier,
+		chatService:  chatService,
+	}
+	chatService.Subscribe(s)
+	return s
+}
+
+// Mute silences pushes until the given time, a zero or past time unmutes
+func (s *inboxService) Mute(ctx context.Context, conversationID, userID string, until time.Time) error {
+	if err := s.checkMember(ctx, conversationID, userID); err != nil {
+		return err
+	}
+
+	setting := &dbmysql.ConversationSetting{ConversationID: conversationID, UserID: userID}
+	if until.After(time.Now()) {
+		until = until.UTC()
+		setting.MutedUntil = &until
+	}
+	return s.settingsRepo.UpsertSetting(ctx, setting, "muted_until")
+}
+
+func (s *inboxService) SetArchived(ctx context.Context, conversationID, userID string, archived bool) error {
+	if err := s.checkMember(ctx, conversationID, userID); err != nil {
+		return err
+	}
+
+	setting := &dbmysql.ConversationSetting{ConversationID: conversationID, UserID: userID, Archived: archived}
+	return s.settingsRepo.UpsertSetting(ctx, setting, "archived")
+}
+
+func (s *inboxService) SetPinned(ctx context.Context, conversationID, userID string, pinned bool) error {
+	if err := s.checkMember(ctx, conversationID, userID); err != nil {
+		return err
+	}
+
+	setting := &dbmysql.ConversationSetting{ConversationID: conversationID, UserID: userID, Pinned: pinned}
+	if pinned {
+		now := time.Now().UTC()
+		setting.PinnedAt = &now
+	}
+	return s.settingsRepo.UpsertSetting(ctx, setting, "pinned", "pinned_at")
+}
+
+// ListInbox returns pinned conversations first (most recently pinned on top), then the
+// rest by latest activity. Archived conversations are left out unless asked for
+func (s *inboxService) ListInbox(ctx context.Context, userID string, includeArchived bool) ([]*InboxEntry, error) {
+	if userID == "" {
+		return nil, errors.New("user ID is required")
+	}
+
+	conversationIDs, err := s.memberRepo.ListUserConversations(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(conversationIDs) == 0 {
+		return []*InboxEntry{}, nil
+	}
+
+	settings, err := s.settingsRepo.ListUserSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	settingByConversation := make(map[string]*dbmysql.ConversationSetting, len(settings))
+	for _, setting := range settings {
+		settingByConversation[setting.ConversationID] = setting
+	}
+
+	latest, err := s.chatRepo.LatestMessages(ctx, conversationIDs)
+	if err != nil {
+		return nil, err
+	}
+	latestByConversation := make(map[string]*dbmysql.Message, len(latest))
+	for _, msg := range latest {
+		latestByConversation[msg.ConversationID] = msg
+	}
+
+	encrypted, err := s.e2eRepo.ListE2E(ctx, conversationIDs)
+	if err != nil {
+		return nil, err
+	}
+	endToEnd := make(map[string]bool, len(encrypted))
+	for _, conversationID := range encrypted {
+		endToEnd[conversationID] = true
+	}
+
+	entries := make([]*InboxEntry, 0, len(conversationIDs))
+	for _, conversationID := range conversationIDs {
+		setting, ok := settingByConversation[conversationID]
+		if !ok {
+			setting = &dbmysql.ConversationSetting{ConversationID: conversationID, UserID: userID}
+		}
+		if setting.Archived && !includeArchived {
+			continue
+		}
+
+		entries = append(entries, &InboxEntry{
+			ConversationID: conversationID,
+			LastMessage:    latestByConversation[conversationID],
+			Setting:        setting,
+			EndToEnd:       endToEnd[conversationID],
+		})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.Setting.Pinned != b.Setting.Pinned {
+			return a.Setting.Pinned
+		}
+		if a.Setting.Pinned && a.Setting.PinnedAt != nil && b.Setting.PinnedAt != nil {
+			return a.Setting.PinnedAt.After(*b.Setting.PinnedAt)
+		}
+		return lastActivity(a).After(lastActivity(b))
+	})
+
+	return entries, nil
+}
+
+// OnMessage brings the conversation back from every member's archive and pushes the
+// message to members who have not muted it
+func (s *inboxService) OnMessage(ctx context.Context, msg *dbmysql.Message) {
+	if err := s.settingsRepo.UnarchiveConversation(ctx, msg.ConversationID); err != nil {
+		log.Printf("failed to unarchive conversation %s: %v", msg.ConversationID, err)
+	}
+
+	if s.notifier == nil {
+		return
+	}
+
+	pushed := *msg
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		s.pushMessage(ctx, &pushed)
+	}()
+}
+
+func (s *inboxService) pushMessage(ctx context.Context, msg *dbmysql.Message) {
+	members, err := s.memberRepo.ListMembers(ctx, msg.ConversationID)
+	if err != nil {
+		log.Printf("failed to list members of %s for push: %v", msg.ConversationID, err)
+		return
+	}
+
+	muted, err := s.settingsRepo.ListMutedUsers(ctx, msg.ConversationID, time.Now().UTC())
+	if err != nil {
+		log.Printf("failed to list muted members of %s: %v", msg.ConversationID, err)
+		return
+	}
+
+	// Never put ciphertext or anything derived from it into a push payload
+	body := preview(msg.Content)
+	endToEnd, err := s.isEndToEnd(ctx, msg.ConversationID)
+	if err != nil {
+		log.Printf("failed to check encryption of %s: %v", msg.ConversationID, err)
+		return
+	}
+	if endToEnd {
+		body = "Encrypted message"
+	}
+
+	for _, memberID := range members {
+		if memberID == msg.SenderID || contains(muted, memberID) {
+			continue
+		}
+
+		_, err := s.notifier.SendNotification(ctx, &notifpb.SendNotificationRequest{
+			UserId:  memberID,
+			Title:   "New message",
+			Message: body,
+			Type:    string(common.MessageType),
+			Data: map[string]string{
+				"conversation_id": msg.ConversationID,
+				"message_id":      fmt.Sprint(msg.MessageID),
+				"sender_id":       msg.SenderID,
+			},
+		})
+		if err != nil {
+			log.Printf("failed to push message %d to %s: %v", msg.MessageID, memberID, err)
+		}
+	}
+}
+
+func (s *inboxService) checkMember(ctx context.Context, conversationID, userID string) error {
+	if conversationID == "" || userID == "" {
+		return errors.New("conversation ID and user ID are required")
+	}
+
+	isMember, err := s.memberRepo.IsMember(ctx, conversationID, userID)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return ErrNotConversationMember
+	}
+	return nil
+}
+
+func (s *inboxService) isEndToEnd(ctx context.Context, conversationID string) (bool, error) {
+	err := s.chatService.CheckPlaintextAccess(ctx, conversationID)
+	if errors.Is(err, ErrE2EPlaintextRequired) {
+		return true, nil
+	}
+	return false, err
+}
+
+func lastActivity(entry *InboxEntry) time.Time {
+	if entry.LastMessage == nil {
+		return time.Time{}
+	}
+	return entry.LastMessage.SentAt
+}
+
+func preview(content string) string {
+	runes := []rune(content)
+	if len(runes) <= pushPreviewLength {
+		return content
+	}
+	return string(runes[:pushPreviewLength]) + "…"
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+
+	notifpb "gosocial/api/v1"
+	"gosocial/internal/chat/service/mocks"
+	"gosocial/internal/dbmysql"
+)
+
+// fakeNotifier captures pushes, any other notification RPC panics through the nil embedded client
+type fakeNotifier struct {
+	notifpb.NotificationServiceClient
+	sent chan *notifpb.SendNotificationRequest
+}
+
+func (f *fakeNotifier) SendNotification(ctx context.Context, in *notifpb.SendNotificationRequest, opts ...grpc.CallOption) (*notifpb.SendNotificationResponse, error) {
+	f.sent <- in
+	return &notifpb.SendNotificationResponse{Success: true}, nil
+}
+
+func TestInboxService_ListInbox(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockChatRepository(ctrl)
+	mockMemberRepo := mocks.NewMockMemberRepository(ctrl)
+	mockSettingsRepo := mocks.NewMockSettingsRepository(ctrl)
+	mockE2ERepo := mocks.NewMockE2ERepository(ctrl)
+	inbox := NewInboxService(mockRepo, mockMemberRepo, mockSettingsRepo, mockE2ERepo, nil, NewChatService(mockRepo))
+
+	now := time.Now().UTC()
+	pinnedAt := now.Add(-time.Hour)
+
+	mockMemberRepo.EXPECT().ListUserConversations(gomock.Any(), "user-1").
+		Return([]string{"old", "recent", "pinned", "archived"}, nil).Times(2)
+	mockSettingsRepo.EXPECT().ListUserSettings(gomock.Any(), "user-1").
+		Return([]*dbmysql.ConversationSetting{
+			{ConversationID: "pinned", UserID: "user-1", Pinned: true, PinnedAt: &pinnedAt},
+			{ConversationID: "archived", UserID: "user-1", Archived: true},
+		}, nil).Times(2)
+	mockRepo.EXPECT().LatestMessages(gomock.Any(), gomock.Any()).
+		Return([]*dbmysql.Message{
+			{ConversationID: "old", SentAt: now.Add(-48 * time.Hour)},
+			{ConversationID: "recent", SentAt: now.Add(-time.Minute)},
+			{ConversationID: "pinned", SentAt: now.Add(-72 * time.Hour)},
+			{ConversationID: "archived", SentAt: now},
+		}, nil).Times(2)
+	// One lookup covers the encryption of the whole inbox
+	mockE2ERepo.EXPECT().ListE2E(gomock.Any(), []string{"old", "recent", "pinned", "archived"}).
+		Return([]string{"recent"}, nil).Times(2)
+
+	entries, err := inbox.ListInbox(context.Background(), "user-1", false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pinned", "recent", "old"}, conversationIDs(entries))
+	assert.Equal(t, []bool{false, true, false}, []bool{entries[0].EndToEnd, entries[1].EndToEnd, entries[2].EndToEnd})
+
+	entries, err = inbox.ListInbox(context.Background(), "user-1", true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pinned", "archived", "recent", "old"}, conversationIDs(entries))
+}
+
+func TestInboxService_OnMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockChatRepository(ctrl)
+	mockMemberRepo := mocks.NewMockMemberRepository(ctrl)
+	mockSettingsRepo := mocks.NewMockSettingsRepository(ctrl)
+	mockE2ERepo := mocks.NewMockE2ERepository(ctrl)
+	notifier := &fakeNotifier{sent: make(chan *notifpb.SendNotificationRequest, 4)}
+	inbox := NewInboxService(mockRepo, mockMemberRepo, mockSettingsRepo, mockE2ERepo, notifier, NewChatService(mockRepo))
+
+	mockSettingsRepo.EXPECT().UnarchiveConversation(gomock.Any(), "conv-1").Return(nil)
+	mockMemberRepo.EXPECT().ListMembers(gomock.Any(), "conv-1").Return([]string{"1", "2", "3"}, nil)
+	mockSettingsRepo.EXPECT().ListMutedUsers(gomock.Any(), "conv-1", gomock.Any()).Return([]string{"3"}, nil)
+
+	inbox.OnMessage(context.Background(), &dbmysql.Message{
+		MessageID:      8,
+		ConversationID: "conv-1",
+		SenderID:       "1",
+		Content:        "standup in 5",
+	})
+
+	select {
+	case req := <-notifier.sent:
+		assert.Equal(t, "2", req.UserId)
+		assert.Equal(t, "standup in 5", req.Message)
+		assert.Equal(t, "conv-1", req.Data["conversation_id"])
+		assert.Equal(t, "8", req.Data["message_id"])
+	case <-time.After(2 * time.Second):
+		t.Fatal("push was not sent")
+	}
+
+	select {
+	case req := <-notifier.sent:
+		t.Fatalf("unexpected push to %s", req.UserId)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestInboxService_MuteRequiresMembership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockChatRepository(ctrl)
+	mockMemberRepo := mocks.NewMockMemberRepository(ctrl)
+	mockSettingsRepo := mocks.NewMockSettingsRepository(ctrl)
+	mockE2ERepo := mocks.NewMockE2ERepository(ctrl)
+	inbox := NewInboxService(mockRepo, mockMemberRepo, mockSettingsRepo, mockE2ERepo, nil, NewChatService(mockRepo))
+
+	mockMemberRepo.EXPECT().IsMember(gomock.Any(), "conv-1", "user-9").Return(false, nil)
+	err := inbox.Mute(context.Background(), "conv-1", "user-9", time.Now().Add(time.Hour))
+	assert.ErrorIs(t, err, ErrNotConversationMember)
+
+	until := time.Now().Add(8 * time.Hour)
+	mockMemberRepo.EXPECT().IsMember(gomock.Any(), "conv-1", "user-1").Return(true, nil)
+	mockSettingsRepo.EXPECT().UpsertSetting(gomock.Any(), gomock.Any(), "muted_until").
+		DoAndReturn(func(ctx context.Context, setting *dbmysql.ConversationSetting, columns ...string) error {
+			require.NotNil(t, setting.MutedUntil)
+			assert.True(t, setting.MutedUntil.Equal(until))
+			return nil
+		})
+	assert.NoError(t, inbox.Mute(context.Background(), "conv-1", "user-1", until))
+}
+
+func conversationIDs(entries []*InboxEntry) []string {
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, entry.ConversationID)
+	}
+	return ids
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"gosocial/internal/dbmysql"
+)
+
+type FilterAction string
+
+const (
+	FilterAllow  FilterAction = "allow"
+	FilterRedact FilterAction = "redact"
+	FilterReject FilterAction = "reject"
+	FilterFlag   FilterAction = "flag"
+)
+
+// FilterDecision is the outcome of one filter. Content is the replacement text for FilterRedact
+type FilterDecision struct {
+	Action  FilterAction
+	Reason  string
+	Content string
+}
+
+// MessageFilter inspects an inbound message before it is persisted
+type MessageFilter interface {
+	Name() string
+	Check(ctx context.Context, msg *dbmysql.Message) FilterDecision
+}
+
+// Allow is the decision of a filter that has nothing to say about a message
+func Allow() FilterDecision {
+	return FilterDecision{Action: FilterAllow}
+}
+
+type bannedWordsFilter struct {
+	pattern *regexp.Regexp
+	action  FilterAction
+}
+
+// NewBannedWordsFilter matches whole words case-insensitively. On a match it redacts
+// them with asterisks, rejects or flags the message depending on action
+func NewBannedWordsFilter(words []string, action FilterAction) MessageFilter {
+	quoted := make([]string, 0, len(words))
+	for _, word := range words {
+		if word = strings.TrimSpace(word); word != "" {
+			quoted = append(quoted, regexp.QuoteMeta(word))
+		}
+	}
+
+	f := &bannedWordsFilter{action: action}
+	if len(quoted) > 0 {
+		f.pattern = regexp.MustCompile(`(?i)\b(?:` + strings.Join(quoted, "|") + `)\b`)
+	}
+	return f
+}
+
+func (f *bannedWordsFilter) Name() string {
+	return "banned_words"
+}
+
+func (f *bannedWordsFilter) Check(ctx context.Context, msg *dbmysql.Message) FilterDecision {
+	if f.pattern == nil || !f.pattern.MatchString(msg.Content) {
+		return Allow()
+	}
+
+	decision := FilterDecision{Action: f.action, Reason: "message contains a banned word"}
+	if f.action == FilterRedact {
+		decision.Content = f.pattern.ReplaceAllStringFunc(msg.Content, func(word string) string {
+			return strings.Repeat("*", utf8.RuneCountInString(word))
+		})
+	}
+	return decision
+}
+
+var linkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+`)
+
+type linkFilter struct{}
+
+// NewLinkFilter rejects messages that contain a URL
+func NewLinkFilter() MessageFilter {
+	return linkFilter{}
+}
+
+func (linkFilter) Name() string {
+	return "links"
+}
+
+func (linkFilter) Check(ctx context.Context, msg *dbmysql.Message) FilterDecision {
+	if linkPattern.MatchString(msg.Content) {
+		return FilterDecision{Action: FilterReject, Reason: "links are not allowed in this conversation"}
+	}
+	return Allow()
+}
+
+type maxLengthFilter struct {
+	max int
+}
+
+// NewMaxLengthFilter rejects messages longer than max characters
+func NewMaxLengthFilter(max int) MessageFilter {
+	return maxLengthFilter{max: max}
+}
+
+func (maxLengthFilter) Name() string {
+	return "max_length"
+}
+
+func (f maxLengthFilter) Check(ctx context.Context, msg *dbmysql.Message) FilterDecision {
+	if f.max > 0 && utf8.RuneCountInString(msg.Content) > f.max {
+		return FilterDecision{Action: FilterReject, Reason: fmt.Sprintf("message is longer than %d characters", f.max)}
+	}
+	return Allow()
+}
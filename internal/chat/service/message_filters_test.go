@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gosocial/internal/dbmysql"
+)
+
+func TestMessageFilters(t *testing.T) {
+	tests := []struct {
+		name            string
+		filter          MessageFilter
+		content         string
+		expectedAction  FilterAction
+		expectedContent string
+	}{
+		{
+			name:           "banned word allowed when absent",
+			filter:         NewBannedWordsFilter([]string{"darn"}, FilterRedact),
+			content:        "hello there",
+			expectedAction: FilterAllow,
+		},
+		{
+			name:            "banned word redacted case-insensitively",
+			filter:          NewBannedWordsFilter([]string{"darn", "heck"}, FilterRedact),
+			content:         "Darn it, what the heck",
+			expectedAction:  FilterRedact,
+			expectedContent: "**** it, what the ****",
+		},
+		{
+			name:           "banned word only matches whole words",
+			filter:         NewBannedWordsFilter([]string{"ass"}, FilterReject),
+			content:        "pass the class",
+			expectedAction: FilterAllow,
+		},
+		{
+			name:           "banned word flagged",
+			filter:         NewBannedWordsFilter([]string{"scam"}, FilterFlag),
+			content:        "this is not a scam",
+			expectedAction: FilterFlag,
+		},
+		{
+			name:           "empty banned list",
+			filter:         NewBannedWordsFilter(nil, FilterReject),
+			content:        "anything",
+			expectedAction: FilterAllow,
+		},
+		{
+			name:           "link rejected",
+			filter:         NewLinkFilter(),
+			content:        "see https://example.com/x",
+			expectedAction: FilterReject,
+		},
+		{
+			name:           "www link rejected",
+			filter:         NewLinkFilter(),
+			content:        "go to www.example.com",
+			expectedAction: FilterReject,
+		},
+		{
+			name:           "plain text passes link filter",
+			filter:         NewLinkFilter(),
+			content:        "meet at 5.30",
+			expectedAction: FilterAllow,
+		},
+		{
+			name:           "max length counts characters not bytes",
+			filter:         NewMaxLengthFilter(5),
+			content:        "héllo",
+			expectedAction: FilterAllow,
+		},
+		{
+			name:           "max length exceeded",
+			filter:         NewMaxLengthFilter(5),
+			content:        strings.Repeat("a", 6),
+			expectedAction: FilterReject,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decision := tt.filter.Check(context.Background(), &dbmysql.Message{Content: tt.content})
+
+			assert.Equal(t, tt.expectedAction, decision.Action)
+			if tt.expectedAction != FilterAllow {
+				assert.NotEmpty(t, decision.Reason)
+			}
+			if tt.expectedContent != "" {
+				assert.Equal(t, tt.expectedContent, decision.Content)
+			}
+		})
+	}
+}
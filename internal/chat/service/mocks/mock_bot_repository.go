@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../repository/bot_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../repository/bot_repository.go -destination=mocks/mock_bot_repository.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mocks 
+
+import (
+	context "context"
+	dbmysql "gosocial/internal/dbmysql"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBotRepository is a mock of BotRepository interface.
+type MockBotRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBotRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBotRepositoryMockRecorder is the mock recorder for MockBotRepository.
+type MockBotRepositoryMockRecorder struct {
+	mock *MockBotRepository
+}
+
+// NewMockBotRepository creates a new mock instance.
+func NewMockBotRepository(ctrl *gomock.Controller) *MockBotRepository {
+	mock := &MockBotRepository{ctrl: ctrl}
+	mock.recorder = &MockBotRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBotRepository) EXPECT() *MockBotRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddToConversation mocks base method.
+func (m *MockBotRepository) AddToConversation(ctx context.Context, link *dbmysql.BotConversation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddToConversation", ctx, link)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddToConversation indicates an expected call of AddToConversation.
+func (mr *MockBotRepositoryMockRecorder) AddToConversation(ctx, link any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToConversation", reflect.TypeOf((*MockBotRepository)(nil).AddToConversation), ctx, link)
+}
+
+// CreateBot mocks base method.
+func (m *MockBotRepository) CreateBot(ctx context.Context, bot *dbmysql.Bot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBot", ctx, bot)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBot indicates an expected call of CreateBot.
+func (mr *MockBotRepositoryMockRecorder) CreateBot(ctx, bot any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBot", reflect.TypeOf((*MockBotRepository)(nil).CreateBot), ctx, bot)
+}
+
+// GetBotByID mocks base method.
+func (m *MockBotRepository) GetBotByID(ctx context.Context, botID uint) (*dbmysql.Bot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBotByID", ctx, botID)
+	ret0, _ := ret[0].(*dbmysql.Bot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBotByID indicates an expected call of GetBotByID.
+func (mr *MockBotRepositoryMockRecorder) GetBotByID(ctx, botID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBotByID", reflect.TypeOf((*MockBotRepository)(nil).GetBotByID), ctx, botID)
+}
+
+// GetBotByTokenHash mocks base method.
+func (m *MockBotRepository) GetBotByTokenHash(ctx context.Context, tokenHash string) (*dbmysql.Bot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBotByTokenHash", ctx, tokenHash)
+	ret0, _ := ret[0].(*dbmysql.Bot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBotByTokenHash indicates an expected call of GetBotByTokenHash.
+func (mr *MockBotRepositoryMockRecorder) GetBotByTokenHash(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBotByTokenHash", reflect.TypeOf((*MockBotRepository)(nil).GetBotByTokenHash), ctx, tokenHash)
+}
+
+// IsInConversation mocks base method.
+func (m *MockBotRepository) IsInConversation(ctx context.Context, botID uint, conversationID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsInConversation", ctx, botID, conversationID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsInConversation indicates an expected call of IsInConversation.
+func (mr *MockBotRepositoryMockRecorder) IsInConversation(ctx, botID, conversationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsInConversation", reflect.TypeOf((*MockBotRepository)(nil).IsInConversation), ctx, botID, conversationID)
+}
+
+// ListConversationBots mocks base method.
+func (m *MockBotRepository) ListConversationBots(ctx context.Context, conversationID string) ([]*dbmysql.Bot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConversationBots", ctx, conversationID)
+	ret0, _ := ret[0].([]*dbmysql.Bot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConversationBots indicates an expected call of ListConversationBots.
+func (mr *MockBotRepositoryMockRecorder) ListConversationBots(ctx, conversationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConversationBots", reflect.TypeOf((*MockBotRepository)(nil).ListConversationBots), ctx, conversationID)
+}
@@ -56,6 +56,51 @@ func (mr *MockChatRepositoryMockRecorder) FetchHistory(ctx, conversationID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchHistory", reflect.TypeOf((*MockChatRepository)(nil).FetchHistory), ctx, conversationID)
 }
 
+// GetMessage mocks base method.
+func (m *MockChatRepository) GetMessage(ctx context.Context, messageID uint) (*dbmysql.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessage", ctx, messageID)
+	ret0, _ := ret[0].(*dbmysql.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessage indicates an expected call of GetMessage.
+func (mr *MockChatRepositoryMockRecorder) GetMessage(ctx, messageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessage", reflect.TypeOf((*MockChatRepository)(nil).GetMessage), ctx, messageID)
+}
+
+// LatestMessages mocks base method.
+func (m *MockChatRepository) LatestMessages(ctx context.Context, conversationIDs []string) ([]*dbmysql.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestMessages", ctx, conversationIDs)
+	ret0, _ := ret[0].([]*dbmysql.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestMessages indicates an expected call of LatestMessages.
+func (mr *MockChatRepositoryMockRecorder) LatestMessages(ctx, conversationIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestMessages", reflect.TypeOf((*MockChatRepository)(nil).LatestMessages), ctx, conversationIDs)
+}
+
+// MarkRead mocks base method.
+func (m *MockChatRepository) MarkRead(ctx context.Context, conversationID, readerID string, upToMessageID uint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, conversationID, readerID, upToMessageID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockChatRepositoryMockRecorder) MarkRead(ctx, conversationID, readerID, upToMessageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockChatRepository)(nil).MarkRead), ctx, conversationID, readerID, upToMessageID)
+}
+
 // Save mocks base method.
 func (m *MockChatRepository) Save(ctx context.Context, msg *dbmysql.Message) error {
 	m.ctrl.T.Helper()
@@ -69,3 +114,32 @@ func (mr *MockChatRepositoryMockRecorder) Save(ctx, msg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockChatRepository)(nil).Save), ctx, msg)
 }
+
+// SearchMessages mocks base method.
+func (m *MockChatRepository) SearchMessages(ctx context.Context, conversationID, query string, limit int) ([]*dbmysql.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMessages", ctx, conversationID, query, limit)
+	ret0, _ := ret[0].([]*dbmysql.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchMessages indicates an expected call of SearchMessages.
+func (mr *MockChatRepositoryMockRecorder) SearchMessages(ctx, conversationID, query, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMessages", reflect.TypeOf((*MockChatRepository)(nil).SearchMessages), ctx, conversationID, query, limit)
+}
+
+// UpdateMessage mocks base method.
+func (m *MockChatRepository) UpdateMessage(ctx context.Context, msg *dbmysql.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMessage", ctx, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMessage indicates an expected call of UpdateMessage.
+func (mr *MockChatRepositoryMockRecorder) UpdateMessage(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMessage", reflect.TypeOf((*MockChatRepository)(nil).UpdateMessage), ctx, msg)
+}
@@ -0,0 +1,144 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../repository/e2e_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../repository/e2e_repository.go -destination=mocks/mock_e2e_repository.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mocks 
+
+import (
+	context "context"
+	dbmysql "gosocial/internal/dbmysql"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockE2ERepository is a mock of E2ERepository interface.
+type MockE2ERepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockE2ERepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockE2ERepositoryMockRecorder is the mock recorder for MockE2ERepository.
+type MockE2ERepositoryMockRecorder struct {
+	mock *MockE2ERepository
+}
+
+// NewMockE2ERepository creates a new mock instance.
+func NewMockE2ERepository(ctrl *gomock.Controller) *MockE2ERepository {
+	mock := &MockE2ERepository{ctrl: ctrl}
+	mock.recorder = &MockE2ERepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockE2ERepository) EXPECT() *MockE2ERepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddPrekeys mocks base method.
+func (m *MockE2ERepository) AddPrekeys(ctx context.Context, prekeys []*dbmysql.OneTimePrekey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPrekeys", ctx, prekeys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPrekeys indicates an expected call of AddPrekeys.
+func (mr *MockE2ERepositoryMockRecorder) AddPrekeys(ctx, prekeys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPrekeys", reflect.TypeOf((*MockE2ERepository)(nil).AddPrekeys), ctx, prekeys)
+}
+
+// ClaimPrekey mocks base method.
+func (m *MockE2ERepository) ClaimPrekey(ctx context.Context, deviceToken string) (*dbmysql.OneTimePrekey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimPrekey", ctx, deviceToken)
+	ret0, _ := ret[0].(*dbmysql.OneTimePrekey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimPrekey indicates an expected call of ClaimPrekey.
+func (mr *MockE2ERepositoryMockRecorder) ClaimPrekey(ctx, deviceToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimPrekey", reflect.TypeOf((*MockE2ERepository)(nil).ClaimPrekey), ctx, deviceToken)
+}
+
+// EnableConversation mocks base method.
+func (m *MockE2ERepository) EnableConversation(ctx context.Context, conversation *dbmysql.E2EConversation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableConversation", ctx, conversation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableConversation indicates an expected call of EnableConversation.
+func (mr *MockE2ERepositoryMockRecorder) EnableConversation(ctx, conversation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableConversation", reflect.TypeOf((*MockE2ERepository)(nil).EnableConversation), ctx, conversation)
+}
+
+// IsE2E mocks base method.
+func (m *MockE2ERepository) IsE2E(ctx context.Context, conversationID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsE2E", ctx, conversationID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsE2E indicates an expected call of IsE2E.
+func (mr *MockE2ERepositoryMockRecorder) IsE2E(ctx, conversationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsE2E", reflect.TypeOf((*MockE2ERepository)(nil).IsE2E), ctx, conversationID)
+}
+
+// ListE2E mocks base method.
+func (m *MockE2ERepository) ListE2E(ctx context.Context, conversationIDs []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListE2E", ctx, conversationIDs)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListE2E indicates an expected call of ListE2E.
+func (mr *MockE2ERepositoryMockRecorder) ListE2E(ctx, conversationIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListE2E", reflect.TypeOf((*MockE2ERepository)(nil).ListE2E), ctx, conversationIDs)
+}
+
+// ListKeyBundles mocks base method.
+func (m *MockE2ERepository) ListKeyBundles(ctx context.Context, userID string) ([]*dbmysql.DeviceKeyBundle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKeyBundles", ctx, userID)
+	ret0, _ := ret[0].([]*dbmysql.DeviceKeyBundle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKeyBundles indicates an expected call of ListKeyBundles.
+func (mr *MockE2ERepositoryMockRecorder) ListKeyBundles(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKeyBundles", reflect.TypeOf((*MockE2ERepository)(nil).ListKeyBundles), ctx, userID)
+}
+
+// UpsertKeyBundle mocks base method.
+func (m *MockE2ERepository) UpsertKeyBundle(ctx context.Context, bundle *dbmysql.DeviceKeyBundle) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertKeyBundle", ctx, bundle)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertKeyBundle indicates an expected call of UpsertKeyBundle.
+func (mr *MockE2ERepositoryMockRecorder) UpsertKeyBundle(ctx, bundle any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertKeyBundle", reflect.TypeOf((*MockE2ERepository)(nil).UpsertKeyBundle), ctx, bundle)
+}
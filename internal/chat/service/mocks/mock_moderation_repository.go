@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../repository/moderation_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../repository/moderation_repository.go -destination=mocks/mock_moderation_repository.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mocks 
+
+import (
+	context "context"
+	dbmysql "gosocial/internal/dbmysql"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockModerationRepository is a mock of ModerationRepository interface.
+type MockModerationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockModerationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockModerationRepositoryMockRecorder is the mock recorder for MockModerationRepository.
+type MockModerationRepositoryMockRecorder struct {
+	mock *MockModerationRepository
+}
+
+// NewMockModerationRepository creates a new mock instance.
+func NewMockModerationRepository(ctrl *gomock.Controller) *MockModerationRepository {
+	mock := &MockModerationRepository{ctrl: ctrl}
+	mock.recorder = &MockModerationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModerationRepository) EXPECT() *MockModerationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SaveDecisions mocks base method.
+func (m *MockModerationRepository) SaveDecisions(ctx context.Context, decisions []*dbmysql.ModerationDecision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveDecisions", ctx, decisions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveDecisions indicates an expected call of SaveDecisions.
+func (mr *MockModerationRepositoryMockRecorder) SaveDecisions(ctx, decisions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDecisions", reflect.TypeOf((*MockModerationRepository)(nil).SaveDecisions), ctx, decisions)
+}
@@ -0,0 +1,106 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../repository/settings_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../repository/settings_repository.go -destination=mocks/mock_settings_repository.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mocks 
+
+import (
+	context "context"
+	dbmysql "gosocial/internal/dbmysql"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSettingsRepository is a mock of SettingsRepository interface.
+type MockSettingsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSettingsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSettingsRepositoryMockRecorder is the mock recorder for MockSettingsRepository.
+type MockSettingsRepositoryMockRecorder struct {
+	mock *MockSettingsRepository
+}
+
+// NewMockSettingsRepository creates a new mock instance.
+func NewMockSettingsRepository(ctrl *gomock.Controller) *MockSettingsRepository {
+	mock := &MockSettingsRepository{ctrl: ctrl}
+	mock.recorder = &MockSettingsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSettingsRepository) EXPECT() *MockSettingsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListMutedUsers mocks base method.
+func (m *MockSettingsRepository) ListMutedUsers(ctx context.Context, conversationID string, now time.Time) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMutedUsers", ctx, conversationID, now)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMutedUsers indicates an expected call of ListMutedUsers.
+func (mr *MockSettingsRepositoryMockRecorder) ListMutedUsers(ctx, conversationID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMutedUsers", reflect.TypeOf((*MockSettingsRepository)(nil).ListMutedUsers), ctx, conversationID, now)
+}
+
+// ListUserSettings mocks base method.
+func (m *MockSettingsRepository) ListUserSettings(ctx context.Context, userID string) ([]*dbmysql.ConversationSetting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserSettings", ctx, userID)
+	ret0, _ := ret[0].([]*dbmysql.ConversationSetting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserSettings indicates an expected call of ListUserSettings.
+func (mr *MockSettingsRepositoryMockRecorder) ListUserSettings(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserSettings", reflect.TypeOf((*MockSettingsRepository)(nil).ListUserSettings), ctx, userID)
+}
+
+// UnarchiveConversation mocks base method.
+func (m *MockSettingsRepository) UnarchiveConversation(ctx context.Context, conversationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnarchiveConversation", ctx, conversationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnarchiveConversation indicates an expected call of UnarchiveConversation.
+func (mr *MockSettingsRepositoryMockRecorder) UnarchiveConversation(ctx, conversationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnarchiveConversation", reflect.TypeOf((*MockSettingsRepository)(nil).UnarchiveConversation), ctx, conversationID)
+}
+
+// UpsertSetting mocks base method.
+func (m *MockSettingsRepository) UpsertSetting(ctx context.Context, setting *dbmysql.ConversationSetting, columns ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, setting}
+	for _, a := range columns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpsertSetting", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertSetting indicates an expected call of UpsertSetting.
+func (mr *MockSettingsRepositoryMockRecorder) UpsertSetting(ctx, setting any, columns ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, setting}, columns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertSetting", reflect.TypeOf((*MockSettingsRepository)(nil).UpsertSetting), varargs...)
+}
@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../repository/sync_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../repository/sync_repository.go -destination=mocks/mock_sync_repository.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mocks 
+
+import (
+	context "context"
+	dbmysql "gosocial/internal/dbmysql"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSyncRepository is a mock of SyncRepository interface.
+type MockSyncRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSyncRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSyncRepositoryMockRecorder is the mock recorder for MockSyncRepository.
+type MockSyncRepositoryMockRecorder struct {
+	mock *MockSyncRepository
+}
+
+// NewMockSyncRepository creates a new mock instance.
+func NewMockSyncRepository(ctrl *gomock.Controller) *MockSyncRepository {
+	mock := &MockSyncRepository{ctrl: ctrl}
+	mock.recorder = &MockSyncRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSyncRepository) EXPECT() *MockSyncRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AppendEvent mocks base method.
+func (m *MockSyncRepository) AppendEvent(ctx context.Context, event *dbmysql.ChatEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendEvent indicates an expected call of AppendEvent.
+func (mr *MockSyncRepositoryMockRecorder) AppendEvent(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendEvent", reflect.TypeOf((*MockSyncRepository)(nil).AppendEvent), ctx, event)
+}
+
+// GetCursor mocks base method.
+func (m *MockSyncRepository) GetCursor(ctx context.Context, deviceToken string) (*dbmysql.DeviceSyncCursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCursor", ctx, deviceToken)
+	ret0, _ := ret[0].(*dbmysql.DeviceSyncCursor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCursor indicates an expected call of GetCursor.
+func (mr *MockSyncRepositoryMockRecorder) GetCursor(ctx, deviceToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCursor", reflect.TypeOf((*MockSyncRepository)(nil).GetCursor), ctx, deviceToken)
+}
+
+// GetDevice mocks base method.
+func (m *MockSyncRepository) GetDevice(ctx context.Context, deviceToken string) (*dbmysql.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDevice", ctx, deviceToken)
+	ret0, _ := ret[0].(*dbmysql.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDevice indicates an expected call of GetDevice.
+func (mr *MockSyncRepositoryMockRecorder) GetDevice(ctx, deviceToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevice", reflect.TypeOf((*MockSyncRepository)(nil).GetDevice), ctx, deviceToken)
+}
+
+// ListEventsSince mocks base method.
+func (m *MockSyncRepository) ListEventsSince(ctx context.Context, userID string, conversationIDs []string, sinceEventID uint64, limit int) ([]*dbmysql.ChatEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventsSince", ctx, userID, conversationIDs, sinceEventID, limit)
+	ret0, _ := ret[0].([]*dbmysql.ChatEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventsSince indicates an expected call of ListEventsSince.
+func (mr *MockSyncRepositoryMockRecorder) ListEventsSince(ctx, userID, conversationIDs, sinceEventID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventsSince", reflect.TypeOf((*MockSyncRepository)(nil).ListEventsSince), ctx, userID, conversationIDs, sinceEventID, limit)
+}
+
+// SaveCursor mocks base method.
+func (m *MockSyncRepository) SaveCursor(ctx context.Context, cursor *dbmysql.DeviceSyncCursor) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCursor", ctx, cursor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCursor indicates an expected call of SaveCursor.
+func (mr *MockSyncRepositoryMockRecorder) SaveCursor(ctx, cursor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCursor", reflect.TypeOf((*MockSyncRepository)(nil).SaveCursor), ctx, cursor)
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"gosocial/internal/chat/repository"
+	"gosocial/internal/config"
+	"gosocial/internal/dbmysql"
+)
+
+// Conversation types a filter chain can be configured for
+const (
+	ConversationTypeDirect = "direct"
+	ConversationTypeGroup  = "group"
+	ConversationTypeE2E    = "e2e"
+)
+
+var ErrMessageRejected = errors.New("message rejected")
+
+// MessageRejectedError carries the filter and reason of a rejection, it matches ErrMessageRejected
+type MessageRejectedError struct {
+	Filter string
+	Reason string
+}
+
+func (e *MessageRejectedError) Error() string {
+	return fmt.Sprintf("message rejected by %s filter: %s", e.Filter, e.Reason)
+}
+
+func (e *MessageRejectedError) Is(target error) bool {
+	return target == ErrMessageRejected
+}
+
+// ModerationResult holds the non-allow decisions taken for one message
+type ModerationResult struct {
+	ConversationType string
+	Decisions        []*dbmysql.ModerationDecision
+}
+
+// MessageModerator runs before a message is persisted and may rewrite or reject it.
+// Record is called once the message is saved so decisions can point at it
+type MessageModerator interface {
+	Moderate(ctx context.Context, msg *dbmysql.Message) (*ModerationResult, error)
+	Record(ctx context.Context, msg *dbmysql.Message, result *ModerationResult)
+}
+
+// Moderator runs the MessageFilter chain configured for the conversation's type
+type Moderator struct {
+	memberRepo     repository.MemberRepository
+	e2eRepo        repository.E2ERepository
+	moderationRepo repository.ModerationRepository
+
+	mu     sync.RWMutex
+	chains map[string][]MessageFilter
+}
+
+// NewModerator builds the chains from the built-in filters named in the config
+func NewModerator(
+	cfg *config.Config,
+	memberRepo repository.MemberRepository,
+	e2eRepo repository.E2ERepository,
+	moderationRepo repository.ModerationRepository,
+) *Moderator {
+	m := &Moderator{
+		memberRepo:     memberRepo,
+		e2eRepo:        e2eRepo,
+		moderationRepo: moderationRepo,
+		chains:         make(map[string][]MessageFilter),
+	}
+
+	bannedWordsAction := FilterAction(cfg.Moderation.BannedWordsAction)
+	if bannedWordsAction == FilterAllow || !knownAction(bannedWordsAction) {
+		log.Printf("unknown banned words action %q, redacting instead", cfg.Moderation.BannedWordsAction)
+		bannedWordsAction = FilterRedact
+	}
+
+	builtin := map[string]MessageFilter{
+		"banned_words": NewBannedWordsFilter(cfg.Moderation.BannedWords, bannedWordsAction),
+		"links":        NewLinkFilter(),
+		"max_length":   NewMaxLengthFilter(cfg.Moderation.MaxMessageLength),
+	}
+	for conversationType, names := range cfg.Moderation.Filters {
+		var filters []MessageFilter
+		for _, name := range names {
+			filter, ok := builtin[name]
+			if !ok {
+				log.Printf("unknown message filter %q for %s conversations, skipping", name, conversationType)
+				continue
+			}
+			filters = append(filters, filter)
+		}
+		m.SetChain(conversationType, filters...)
+	}
+
+	return m
+}
+
+// SetChain replaces the filters run for a conversation type, custom filters plug in here
+func (m *Moderator) SetChain(conversationType string, filters ...MessageFilter) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.chains[conversationType] = filters
+}
+
+// Moderate runs the chain in order. Redactions rewrite msg.Content for the filters that
+// follow, flags are kept for review and the first rejection stops the chain. A filter
+// deciding an unknown action fails the send rather than letting the message through
+func (m *Moderator) Moderate(ctx context.Context, msg *dbmysql.Message) (*ModerationResult, error) {
+	conversationType, err := m.conversationType(ctx, msg.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	chain := m.chains[conversationType]
+	m.mu.RUnlock()
+
+	result := &ModerationResult{ConversationType: conversationType}
+	for _, filter := range chain {
+		decision := filter.Check(ctx, msg)
+		if decision.Action == FilterAllow || decision.Action == "" {
+			continue
+		}
+		if !knownAction(decision.Action) {
+			return nil, fmt.Errorf("%s filter decided unknown action %q", filter.Name(), decision.Action)
+		}
+
+		log.Printf("moderation: %s filter decided %s for message from %s in %s (%s): %s",
+			filter.Name(), decision.Action, msg.SenderID, msg.ConversationID, conversationType, decision.Reason)
+
+		result.Decisions = append(result.Decisions, &dbmysql.ModerationDecision{
+			ConversationID:   msg.ConversationID,
+			SenderID:         msg.SenderID,
+			ConversationType: conversationType,
+			Filter:           filter.Name(),
+			Action:           string(decision.Action),
+			Reason:           decision.Reason,
+		})
+
+		switch decision.Action {
+		case FilterRedact:
+			msg.Content = decision.Content
+		case FilterReject:
+			m.save(ctx, result.Decisions)
+			return nil, &MessageRejectedError{Filter: filter.Name(), Reason: decision.Reason}
+		}
+	}
+
+	return result, nil
+}
+
+// Record stores the decisions of a saved message
+func (m *Moderator) Record(ctx context.Context, msg *dbmysql.Message, result *ModerationResult) {
+	if result == nil || len(result.Decisions) == 0 {
+		return
+	}
+	for _, decision := range result.Decisions {
+		decision.MessageID = &msg.MessageID
+	}
+	m.save(ctx, result.Decisions)
+}
+
+func (m *Moderator) save(ctx context.Context, decisions []*dbmysql.ModerationDecision) {
+	if err := m.moderationRepo.SaveDecisions(ctx, decisions); err != nil {
+		log.Printf("failed to store moderation decisions: %v", err)
+	}
+}
+
+// knownAction tells whether a filter action can be applied and stored
+func knownAction(action FilterAction) bool {
+	switch action {
+	case FilterAllow, FilterRedact, FilterReject, FilterFlag:
+		return true
+	}
+	return false
+}
+
+func (m *Moderator) conversationType(ctx context.Context, conversationID string) (string, error) {
+	isE2E, err := m.e2eRepo.IsE2E(ctx, conversationID)
+	if err != nil {
+		return "", err
+	}
+	if isE2E {
+		return ConversationTypeE2E, nil
+	}
+
+	members, err := m.memberRepo.ListMembers(ctx, conversationID)
+	if err != nil {
+		return "", err
+	}
+	if len(members) > 2 {
+		return ConversationTypeGroup, nil
+	}
+	return ConversationTypeDirect, nil
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"gosocial/internal/chat/service/mocks"
+	"gosocial/internal/config"
+	"gosocial/internal/dbmysql"
+)
+
+func newTestModerator(ctrl *gomock.Controller) (*Moderator, ChatService, *mocks.MockChatRepository, *mocks.MockMemberRepository, *mocks.MockE2ERepository, *mocks.MockModerationRepository) {
+	cfg := &config.Config{Moderation: config.ModerationConfig{
+		BannedWords:       []string{"darn", "scam"},
+		BannedWordsAction: "redact",
+		MaxMessageLength:  20,
+		Filters: map[string][]string{
+			ConversationTypeDirect: {"banned_words", "max_length"},
+			ConversationTypeGroup:  {"banned_words", "links", "max_length"},
+			ConversationTypeE2E:    {"max_length"},
+		},
+	}}
+
+	mockRepo := mocks.NewMockChatRepository(ctrl)
+	mockMemberRepo := mocks.NewMockMemberRepository(ctrl)
+	mockE2ERepo := mocks.NewMockE2ERepository(ctrl)
+	mockModerationRepo := mocks.NewMockModerationRepository(ctrl)
+
+	moderator := NewModerator(cfg, mockMemberRepo, mockE2ERepo, mockModerationRepo)
+	chatService := NewChatService(mockRepo)
+	chatService.SetModerator(moderator)
+	return moderator, chatService, mockRepo, mockMemberRepo, mockE2ERepo, mockModerationRepo
+}
+
+type flagQuestionsFilter struct{}
+
+func (flagQuestionsFilter) Name() string { return "questions" }
+
+func (flagQuestionsFilter) Check(ctx context.Context, msg *dbmysql.Message) FilterDecision {
+	if msg.Content[len(msg.Content)-1] == '?' {
+		return FilterDecision{Action: FilterFlag, Reason: "question"}
+	}
+	return Allow()
+}
+
+func TestModerator_SendMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	moderator, chatService, mockRepo, mockMemberRepo, mockE2ERepo, mockModerationRepo := newTestModerator(ctrl)
+	mockE2ERepo.EXPECT().IsE2E(gomock.Any(), "conv-e2e").Return(true, nil).AnyTimes()
+	mockE2ERepo.EXPECT().IsE2E(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+	mockMemberRepo.EXPECT().ListMembers(gomock.Any(), "direct").Return([]string{"1", "2"}, nil).AnyTimes()
+	mockMemberRepo.EXPECT().ListMembers(gomock.Any(), "group").Return([]string{"1", "2", "3"}, nil).AnyTimes()
+
+	t.Run("link allowed in direct chat", func(t *testing.T) {
+		mockRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+
+		msg, err := chatService.SendMessage(context.Background(), &dbmysql.Message{ConversationID: "direct", SenderID: "1", Content: "www.example.com"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "www.example.com", msg.Content)
+	})
+
+	t.Run("link rejected in group and logged without message", func(t *testing.T) {
+		mockModerationRepo.EXPECT().SaveDecisions(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, decisions []*dbmysql.ModerationDecision) error {
+				require.Len(t, decisions, 1)
+				assert.Equal(t, "links", decisions[0].Filter)
+				assert.Equal(t, "reject", decisions[0].Action)
+				assert.Equal(t, ConversationTypeGroup, decisions[0].ConversationType)
+				assert.Nil(t, decisions[0].MessageID)
+				return nil
+			})
+
+		_, err := chatService.SendMessage(context.Background(), &dbmysql.Message{ConversationID: "group", SenderID: "1", Content: "www.example.com"})
+
+		assert.ErrorIs(t, err, ErrMessageRejected)
+		var rejected *MessageRejectedError
+		require.ErrorAs(t, err, &rejected)
+		assert.Equal(t, "links are not allowed in this conversation", rejected.Reason)
+	})
+
+	t.Run("redacted then flagged by a custom filter", func(t *testing.T) {
+		moderator.SetChain(ConversationTypeDirect, NewBannedWordsFilter([]string{"darn"}, FilterRedact), flagQuestionsFilter{})
+
+		mockRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, msg *dbmysql.Message) error {
+				assert.Equal(t, "is it **** late?", msg.Content)
+				msg.MessageID = 12
+				return nil
+			})
+		mockModerationRepo.EXPECT().SaveDecisions(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, decisions []*dbmysql.ModerationDecision) error {
+				require.Len(t, decisions, 2)
+				assert.Equal(t, "redact", decisions[0].Action)
+				assert.Equal(t, "flag", decisions[1].Action)
+				assert.Equal(t, uint(12), *decisions[1].MessageID)
+				return nil
+			})
+
+		_, err := chatService.SendMessage(context.Background(), &dbmysql.Message{ConversationID: "direct", SenderID: "1", Content: "is it darn late?"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("unknown action of a custom filter fails the send", func(t *testing.T) {
+		moderator.SetChain(ConversationTypeDirect, NewBannedWordsFilter([]string{"darn"}, "block"))
+
+		_, err := chatService.SendMessage(context.Background(), &dbmysql.Message{ConversationID: "direct", SenderID: "1", Content: "darn"})
+		assert.EqualError(t, err, `banned_words filter decided unknown action "block"`)
+	})
+
+	t.Run("e2e chain only sees ciphertext length", func(t *testing.T) {
+		mockRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+
+		_, err := chatService.SendMessage(context.Background(), &dbmysql.Message{ConversationID: "conv-e2e", SenderID: "1", Content: "darn"})
+		assert.NoError(t, err)
+	})
+}
+
+func TestNewModerator_UnknownBannedWordsActionRedacts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockE2ERepo := mocks.NewMockE2ERepository(ctrl)
+	mockMemberRepo := mocks.NewMockMemberRepository(ctrl)
+	cfg := &config.Config{Moderation: config.ModerationConfig{
+		BannedWords:       []string{"darn"},
+		BannedWordsAction: "block",
+		Filters:           map[string][]string{ConversationTypeDirect: {"banned_words"}},
+	}}
+	moderator := NewModerator(cfg, mockMemberRepo, mockE2ERepo, mocks.NewMockModerationRepository(ctrl))
+
+	mockE2ERepo.EXPECT().IsE2E(gomock.Any(), "direct").Return(false, nil)
+	mockMemberRepo.EXPECT().ListMembers(gomock.Any(), "direct").Return([]string{"1", "2"}, nil)
+
+	msg := &dbmysql.Message{ConversationID: "direct", SenderID: "1", Content: "oh darn"}
+	result, err := moderator.Moderate(context.Background(), msg)
+
+	require.NoError(t, err)
+	assert.Equal(t, "oh ****", msg.Content)
+	require.Len(t, result.Decisions, 1)
+	assert.Equal(t, "redact", result.Decisions[0].Action)
+}
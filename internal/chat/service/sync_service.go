@@ -0,0 +1,338 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"gorm.io/gorm"
+
+	"gosocial/internal/chat/repository"
+	"gosocial/internal/dbmysql"
+)
+
+// Kinds of change recorded in the chat event log, message creation reuses EventMessageCreated
+const (
+	EventMessageEdited  = "message.edited"
+	EventMessageDeleted = "message.deleted"
+	EventReceiptRead    = "receipt.read"
+	EventMemberAdded    = "member.added"
+	EventMemberRemoved  = "member.removed"
+
+	defaultSyncLimit = 100
+	maxSyncLimit     = 500
+)
+
+var (
+	ErrDeviceNotFound        = errors.New("device not found")
+	ErrDeviceNotOwned        = errors.New("device does not belong to this user")
+	ErrNotConversationMember = errors.New("user is not a member of this conversation")
+)
+
+// SyncResult is one page of changes for a device
+type SyncResult struct {
+	Events     []*dbmysql.ChatEvent
+	NextCursor uint64
+	HasMore    bool
+}
+
+// SyncService keeps the chat change log and conversation membership, and lets each
+// device of a user catch up on changes from its own cursor. Only members can post
+// into a conversation, membership comes from CreateConversation and AddMember
+type SyncService interface {
+	CreateConversation(ctx context.Context, creatorID string, memberIDs []string) (string, error)
+	AddMember(ctx context.Context, conversationID, userID, actorID string) error
+	RemoveMember(ctx context.Context, conversationID, userID, actorID string) error
+	SyncChanges(ctx context.Context, userID, deviceToken string, sinceCursor *uint64, limit int) (*SyncResult, error)
+	OnMessage(ctx context.Context, msg *dbmysql.Message)
+	OnChange(ctx context.Context, event *dbmysql.ChatEvent)
+	CheckSend(ctx context.Context, msg *dbmysql.Message) error
+	CheckAccess(ctx context.Context, conversationID, userID string) error
+	CheckPlaintextAccess(ctx context.Context, conversationID string) error
+}
+
+type syncService struct {
+	syncRepo    repository.SyncRepository
+	memberRepo  repository.MemberRepository
+	botRepo     repository.BotRepository
+	chatService ChatService
+}
+
+// NewSyncService subscribes to the chat service so every message change lands in the event log,
+// and registers as a conversation policy so only members can send
+func NewSyncService(syncRepo repository.SyncRepository, memberRepo repository.MemberRepository, botRepo repository.BotRepository, chatService ChatService) SyncService {
+	s := &syncService{
+		syncRepo:    syncRepo,
+		memberRepo:  memberRepo,
+		botRepo:     botRepo,
+		chatService: chatService,
+	}
+	chatService.Subscribe(s)
+	chatService.AddPolicy(s)
+	return s
+}
+
+// CreateConversation starts a conversation of the creator and the given members and returns its ID
+func (s *syncService) CreateConversation(ctx context.Context, creatorID string, memberIDs []string) (string, error) {
+	if creatorID == "" {
+		return "", errors.New("creator ID is required")
+	}
+
+	conversationID, err := randomHex(16)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.addMember(ctx, conversationID, creatorID, creatorID); err != nil {
+		return "", err
+	}
+	for _, memberID := range memberIDs {
+		if memberID == "" || memberID == creatorID {
+			continue
+		}
+		if err := s.addMember(ctx, conversationID, memberID, creatorID); err != nil {
+			return "", err
+		}
+	}
+
+	return conversationID, nil
+}
+
+// AddMember adds userID to the conversation, only existing members can add people
+func (s *syncService) AddMember(ctx context.Context, conversationID, userID, actorID string) error {
+	if conversationID == "" || userID == "" || actorID == "" {
+		return errors.New("conversation ID, user ID and actor ID are required")
+	}
+
+	isMember, err := s.memberRepo.IsMember(ctx, conversationID, actorID)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return ErrNotConversationMember
+	}
+
+	// A newcomer could not read the existing ciphertext and the conversation would stop being 1:1
+	err = s.chatService.CheckPlaintextAccess(ctx, conversationID)
+	if errors.Is(err, ErrE2EPlaintextRequired) {
+		return ErrE2ENotOneToOne
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.addMember(ctx, conversationID, userID, actorID)
+}
+
+// RemoveMember lets a member leave, or another member remove them
+func (s *syncService) RemoveMember(ctx context.Context, conversationID, userID, actorID string) error {
+	if conversationID == "" || userID == "" || actorID == "" {
+		return errors.New("conversation ID, user ID and actor ID are required")
+	}
+
+	if actorID != userID {
+		isMember, err := s.memberRepo.IsMember(ctx, conversationID, actorID)
+		if err != nil {
+			return err
+		}
+		if !isMember {
+			return ErrNotConversationMember
+		}
+	}
+
+	removed, err := s.memberRepo.RemoveMember(ctx, conversationID, userID)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return ErrNotConversationMember
+	}
+
+	return s.syncRepo.AppendEvent(ctx, &dbmysql.ChatEvent{
+		ConversationID: conversationID,
+		Kind:           EventMemberRemoved,
+		ActorID:        actorID,
+		SubjectID:      userID,
+	})
+}
+
+// SyncChanges returns the changes across all of the user's conversations after sinceCursor and
+// stores the new cursor for the device. A nil sinceCursor resumes from the device's stored cursor,
+// a zero one syncs everything again, for instance after the device lost its local data
+func (s *syncService) SyncChanges(ctx context.Context, userID, deviceToken string, sinceCursor *uint64, limit int) (*SyncResult, error) {
+	if userID == "" {
+		return nil, errors.New("user ID is required")
+	}
+	if deviceToken == "" {
+		return nil, errors.New("device token is required")
+	}
+
+	if err := checkDeviceOwner(ctx, s.syncRepo, userID, deviceToken); err != nil {
+		return nil, err
+	}
+
+	var since uint64
+	if sinceCursor != nil {
+		since = *sinceCursor
+	} else {
+		cursor, err := s.syncRepo.GetCursor(ctx, deviceToken)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		if cursor != nil {
+			since = cursor.LastEventID
+		}
+	}
+
+	if limit <= 0 {
+		limit = defaultSyncLimit
+	}
+	if limit > maxSyncLimit {
+		limit = maxSyncLimit
+	}
+
+	conversationIDs, err := s.memberRepo.ListUserConversations(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra row to know whether another page follows
+	events, err := s.syncRepo.ListEventsSince(ctx, userID, conversationIDs, since, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SyncResult{NextCursor: since}
+	if len(events) > limit {
+		events = events[:limit]
+		result.HasMore = true
+	}
+	if len(events) > 0 {
+		result.NextCursor = events[len(events)-1].EventID
+	}
+	result.Events = events
+
+	err = s.syncRepo.SaveCursor(ctx, &dbmysql.DeviceSyncCursor{
+		DeviceToken: deviceToken,
+		UserID:      userID,
+		LastEventID: result.NextCursor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// OnMessage logs the new message
+func (s *syncService) OnMessage(ctx context.Context, msg *dbmysql.Message) {
+	s.OnChange(ctx, &dbmysql.ChatEvent{
+		ConversationID: msg.ConversationID,
+		Kind:           EventMessageCreated,
+		MessageID:      &msg.MessageID,
+		ActorID:        msg.SenderID,
+	})
+}
+
+func (s *syncService) OnChange(ctx context.Context, event *dbmysql.ChatEvent) {
+	if err := s.syncRepo.AppendEvent(ctx, event); err != nil {
+		log.Printf("failed to record %s event for conversation %s: %v", event.Kind, event.ConversationID, err)
+	}
+}
+
+// CheckSend only lets members post, bots are checked against the conversations they were added to
+func (s *syncService) CheckSend(ctx context.Context, msg *dbmysql.Message) error {
+	if IsBotSender(msg.SenderID) {
+		botID, ok := botIDOf(msg.SenderID)
+		if !ok {
+			return ErrBotNotInConversation
+		}
+		added, err := s.botRepo.IsInConversation(ctx, botID, msg.ConversationID)
+		if err != nil {
+			return err
+		}
+		if !added {
+			return ErrBotNotInConversation
+		}
+		return nil
+	}
+
+	return s.checkMember(ctx, msg.ConversationID, msg.SenderID)
+}
+
+// checkMember adopts the senders of conversations that predate membership before refusing anyone
+func (s *syncService) checkMember(ctx context.Context, conversationID, userID string) error {
+	isMember, err := s.memberRepo.IsMember(ctx, conversationID, userID)
+	if err != nil {
+		return err
+	}
+	if isMember {
+		return nil
+	}
+
+	adopted, err := s.memberRepo.AdoptSenders(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+	if adopted > 0 {
+		isMember, err = s.memberRepo.IsMember(ctx, conversationID, userID)
+		if err != nil {
+			return err
+		}
+	}
+	if !isMember {
+		return ErrNotConversationMember
+	}
+	return nil
+}
+
+// CheckAccess holds readers and receipts to the same membership as senders
+func (s *syncService) CheckAccess(ctx context.Context, conversationID, userID string) error {
+	return s.checkMember(ctx, conversationID, userID)
+}
+
+func (s *syncService) CheckPlaintextAccess(ctx context.Context, conversationID string) error {
+	return nil
+}
+
+func (s *syncService) addMember(ctx context.Context, conversationID, userID, actorID string) error {
+	added, err := s.memberRepo.AddMember(ctx, &dbmysql.ConversationMember{
+		ConversationID: conversationID,
+		UserID:         userID,
+	})
+	if err != nil || !added {
+		return err
+	}
+
+	return s.syncRepo.AppendEvent(ctx, &dbmysql.ChatEvent{
+		ConversationID: conversationID,
+		Kind:           EventMemberAdded,
+		ActorID:        actorID,
+		SubjectID:      userID,
+	})
+}
+
+// checkDeviceOwner makes sure the device is registered to userID, device user IDs are numeric
+func checkDeviceOwner(ctx context.Context, repo repository.SyncRepository, userID, deviceToken string) error {
+	device, err := repo.GetDevice(ctx, deviceToken)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrDeviceNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if fmt.Sprint(device.UserID) != userID {
+		return ErrDeviceNotOwned
+	}
+	return nil
+}
+
+func contains(values []string, target string) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,348 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"gosocial/internal/chat/service/mocks"
+	"gosocial/internal/dbmysql"
+)
+
+func newTestSyncService(ctrl *gomock.Controller) (SyncService, ChatService, *mocks.MockChatRepository, *mocks.MockSyncRepository, *mocks.MockMemberRepository, *mocks.MockBotRepository) {
+	mockRepo := mocks.NewMockChatRepository(ctrl)
+	mockSyncRepo := mocks.NewMockSyncRepository(ctrl)
+	mockMemberRepo := mocks.NewMockMemberRepository(ctrl)
+	mockBotRepo := mocks.NewMockBotRepository(ctrl)
+	chatService := NewChatService(mockRepo)
+	return NewSyncService(mockSyncRepo, mockMemberRepo, mockBotRepo, chatService), chatService, mockRepo, mockSyncRepo, mockMemberRepo, mockBotRepo
+}
+
+func cursorAt(eventID uint64) *uint64 {
+	return &eventID
+}
+
+func TestSyncService_SyncChanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	syncService, _, _, mockSyncRepo, mockMemberRepo, _ := newTestSyncService(ctrl)
+
+	tests := []struct {
+		name           string
+		userID         string
+		deviceToken    string
+		sinceCursor    *uint64
+		limit          int
+		mockSetup      func()
+		expectError    error
+		expectedCount  int
+		expectedCursor uint64
+		expectedMore   bool
+	}{
+		{
+			name:        "resumes from stored device cursor",
+			userID:      "42",
+			deviceToken: "phone-token",
+			mockSetup: func() {
+				mockSyncRepo.EXPECT().GetDevice(gomock.Any(), "phone-token").
+					Return(&dbmysql.Device{DeviceToken: "phone-token", UserID: 42}, nil)
+				mockSyncRepo.EXPECT().GetCursor(gomock.Any(), "phone-token").
+					Return(&dbmysql.DeviceSyncCursor{DeviceToken: "phone-token", UserID: "42", LastEventID: 10}, nil)
+				mockMemberRepo.EXPECT().ListUserConversations(gomock.Any(), "42").
+					Return([]string{"conv-1", "conv-2"}, nil)
+				mockSyncRepo.EXPECT().ListEventsSince(gomock.Any(), "42", []string{"conv-1", "conv-2"}, uint64(10), defaultSyncLimit+1).
+					Return([]*dbmysql.ChatEvent{
+						{EventID: 11, ConversationID: "conv-1", Kind: EventMessageCreated},
+						{EventID: 14, ConversationID: "conv-2", Kind: EventMessageDeleted},
+					}, nil)
+				mockSyncRepo.EXPECT().SaveCursor(gomock.Any(), &dbmysql.DeviceSyncCursor{
+					DeviceToken: "phone-token", UserID: "42", LastEventID: 14,
+				}).Return(nil)
+			},
+			expectedCount:  2,
+			expectedCursor: 14,
+		},
+		{
+			name:        "first sync of a new device with more pages",
+			userID:      "42",
+			deviceToken: "web-token",
+			limit:       1,
+			mockSetup: func() {
+				mockSyncRepo.EXPECT().GetDevice(gomock.Any(), "web-token").
+					Return(&dbmysql.Device{DeviceToken: "web-token", UserID: 42}, nil)
+				mockSyncRepo.EXPECT().GetCursor(gomock.Any(), "web-token").
+					Return(nil, gorm.ErrRecordNotFound)
+				mockMemberRepo.EXPECT().ListUserConversations(gomock.Any(), "42").
+					Return([]string{"conv-1"}, nil)
+				mockSyncRepo.EXPECT().ListEventsSince(gomock.Any(), "42", []string{"conv-1"}, uint64(0), 2).
+					Return([]*dbmysql.ChatEvent{{EventID: 1}, {EventID: 2}}, nil)
+				mockSyncRepo.EXPECT().SaveCursor(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedCount:  1,
+			expectedCursor: 1,
+			expectedMore:   true,
+		},
+		{
+			name:        "explicit cursor skips the stored one",
+			userID:      "42",
+			deviceToken: "phone-token",
+			sinceCursor: cursorAt(20),
+			mockSetup: func() {
+				mockSyncRepo.EXPECT().GetDevice(gomock.Any(), "phone-token").
+					Return(&dbmysql.Device{DeviceToken: "phone-token", UserID: 42}, nil)
+				mockMemberRepo.EXPECT().ListUserConversations(gomock.Any(), "42").Return(nil, nil)
+				mockSyncRepo.EXPECT().ListEventsSince(gomock.Any(), "42", gomock.Nil(), uint64(20), defaultSyncLimit+1).
+					Return(nil, nil)
+				mockSyncRepo.EXPECT().SaveCursor(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedCursor: 20,
+		},
+		{
+			name:        "zero cursor syncs from the beginning",
+			userID:      "42",
+			deviceToken: "phone-token",
+			sinceCursor: cursorAt(0),
+			mockSetup: func() {
+				mockSyncRepo.EXPECT().GetDevice(gomock.Any(), "phone-token").
+					Return(&dbmysql.Device{DeviceToken: "phone-token", UserID: 42}, nil)
+				mockMemberRepo.EXPECT().ListUserConversations(gomock.Any(), "42").Return([]string{"conv-1"}, nil)
+				mockSyncRepo.EXPECT().ListEventsSince(gomock.Any(), "42", []string{"conv-1"}, uint64(0), defaultSyncLimit+1).
+					Return([]*dbmysql.ChatEvent{{EventID: 3}}, nil)
+				mockSyncRepo.EXPECT().SaveCursor(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedCount:  1,
+			expectedCursor: 3,
+		},
+		{
+			name:        "device of another user",
+			userID:      "42",
+			deviceToken: "other-token",
+			mockSetup: func() {
+				mockSyncRepo.EXPECT().GetDevice(gomock.Any(), "other-token").
+					Return(&dbmysql.Device{DeviceToken: "other-token", UserID: 7}, nil)
+			},
+			expectError: ErrDeviceNotOwned,
+		},
+		{
+			name:        "unknown device",
+			userID:      "42",
+			deviceToken: "missing",
+			mockSetup: func() {
+				mockSyncRepo.EXPECT().GetDevice(gomock.Any(), "missing").Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectError: ErrDeviceNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+
+			result, err := syncService.SyncChanges(context.Background(), tt.userID, tt.deviceToken, tt.sinceCursor, tt.limit)
+
+			if tt.expectError != nil {
+				assert.ErrorIs(t, err, tt.expectError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, result.Events, tt.expectedCount)
+			assert.Equal(t, tt.expectedCursor, result.NextCursor)
+			assert.Equal(t, tt.expectedMore, result.HasMore)
+		})
+	}
+}
+
+func TestSyncService_RecordsMessageChanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_, chatService, mockRepo, mockSyncRepo, mockMemberRepo, _ := newTestSyncService(ctrl)
+
+	var kinds []string
+	mockSyncRepo.EXPECT().AppendEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, event *dbmysql.ChatEvent) error {
+			kinds = append(kinds, event.Kind)
+			return nil
+		}).AnyTimes()
+
+	mockRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, msg *dbmysql.Message) error {
+			msg.MessageID = 5
+			return nil
+		})
+	mockMemberRepo.EXPECT().IsMember(gomock.Any(), "conv-1", "user-1").Return(true, nil)
+
+	_, err := chatService.SendMessage(context.Background(), &dbmysql.Message{
+		ConversationID: "conv-1",
+		SenderID:       "user-1",
+		Content:        "hello",
+	})
+	require.NoError(t, err)
+
+	mockRepo.EXPECT().GetMessage(gomock.Any(), uint(5)).
+		Return(&dbmysql.Message{MessageID: 5, ConversationID: "conv-1", SenderID: "user-1", Content: "hello", Status: "delivered"}, nil).
+		Times(2)
+	mockRepo.EXPECT().UpdateMessage(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	mockMemberRepo.EXPECT().IsMember(gomock.Any(), "conv-1", "user-2").Return(true, nil)
+	mockRepo.EXPECT().MarkRead(gomock.Any(), "conv-1", "user-2", uint(5)).Return(int64(1), nil)
+
+	_, err = chatService.EditMessage(context.Background(), 5, "user-1", "hello again")
+	require.NoError(t, err)
+	require.NoError(t, chatService.MarkRead(context.Background(), "conv-1", "user-2", 5))
+	_, err = chatService.DeleteMessage(context.Background(), 5, "user-1")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		EventMessageCreated,
+		EventMessageEdited,
+		EventReceiptRead,
+		EventMessageDeleted,
+	}, kinds)
+}
+
+func TestSyncService_AddMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	syncService, _, _, mockSyncRepo, mockMemberRepo, _ := newTestSyncService(ctrl)
+
+	t.Run("non member cannot add people", func(t *testing.T) {
+		mockMemberRepo.EXPECT().IsMember(gomock.Any(), "conv-1", "user-2").Return(false, nil)
+
+		err := syncService.AddMember(context.Background(), "conv-1", "user-3", "user-2")
+		assert.ErrorIs(t, err, ErrNotConversationMember)
+	})
+
+	t.Run("member adds someone", func(t *testing.T) {
+		mockMemberRepo.EXPECT().IsMember(gomock.Any(), "conv-1", "user-1").Return(true, nil)
+		mockMemberRepo.EXPECT().AddMember(gomock.Any(), gomock.Any()).Return(true, nil)
+		mockSyncRepo.EXPECT().AppendEvent(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, event *dbmysql.ChatEvent) error {
+				assert.Equal(t, EventMemberAdded, event.Kind)
+				assert.Equal(t, "user-1", event.ActorID)
+				assert.Equal(t, "user-3", event.SubjectID)
+				return nil
+			})
+
+		assert.NoError(t, syncService.AddMember(context.Background(), "conv-1", "user-3", "user-1"))
+	})
+
+	t.Run("remove logs a membership change", func(t *testing.T) {
+		mockMemberRepo.EXPECT().RemoveMember(gomock.Any(), "conv-1", "user-3").Return(true, nil)
+		mockSyncRepo.EXPECT().AppendEvent(gomock.Any(), gomock.Any()).Return(errors.New("db down"))
+
+		err := syncService.RemoveMember(context.Background(), "conv-1", "user-3", "user-3")
+		assert.EqualError(t, err, "db down")
+	})
+}
+
+func TestSyncService_OnlyMembersSend(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	syncService, chatService, _, mockSyncRepo, mockMemberRepo, _ := newTestSyncService(ctrl)
+
+	var members []string
+	mockMemberRepo.EXPECT().AddMember(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, member *dbmysql.ConversationMember) (bool, error) {
+			members = append(members, member.UserID)
+			return true, nil
+		}).Times(2)
+	mockSyncRepo.EXPECT().AppendEvent(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	conversationID, err := syncService.CreateConversation(context.Background(), "user-1", []string{"user-2", "user-1"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, conversationID)
+	assert.Equal(t, []string{"user-1", "user-2"}, members)
+
+	// Sending is not a way in
+	mockMemberRepo.EXPECT().IsMember(gomock.Any(), conversationID, "user-3").Return(false, nil)
+	mockMemberRepo.EXPECT().AdoptSenders(gomock.Any(), conversationID).Return(int64(0), nil)
+	_, err = chatService.SendMessage(context.Background(), &dbmysql.Message{
+		ConversationID: conversationID,
+		SenderID:       "user-3",
+		Content:        "let me in",
+	})
+	assert.ErrorIs(t, err, ErrNotConversationMember)
+
+	// Neither are read receipts
+	mockMemberRepo.EXPECT().IsMember(gomock.Any(), conversationID, "user-3").Return(false, nil)
+	mockMemberRepo.EXPECT().AdoptSenders(gomock.Any(), conversationID).Return(int64(0), nil)
+	err = chatService.MarkRead(context.Background(), conversationID, "user-3", 1)
+	assert.ErrorIs(t, err, ErrNotConversationMember)
+
+	// Nor reading the conversation
+	mockMemberRepo.EXPECT().IsMember(gomock.Any(), conversationID, "user-3").Return(false, nil).Times(2)
+	mockMemberRepo.EXPECT().AdoptSenders(gomock.Any(), conversationID).Return(int64(0), nil).Times(2)
+	_, err = chatService.GetMessageHistory(context.Background(), conversationID, "user-3")
+	assert.ErrorIs(t, err, ErrNotConversationMember)
+	_, err = chatService.SearchMessages(context.Background(), conversationID, "user-3", "hello", 10)
+	assert.ErrorIs(t, err, ErrNotConversationMember)
+}
+
+func TestSyncService_SendIntoConversationFromBeforeMembership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_, chatService, mockRepo, mockSyncRepo, mockMemberRepo, _ := newTestSyncService(ctrl)
+
+	gomock.InOrder(
+		mockMemberRepo.EXPECT().IsMember(gomock.Any(), "old-conv", "user-1").Return(false, nil),
+		mockMemberRepo.EXPECT().AdoptSenders(gomock.Any(), "old-conv").Return(int64(2), nil),
+		mockMemberRepo.EXPECT().IsMember(gomock.Any(), "old-conv", "user-1").Return(true, nil),
+	)
+	mockRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+	mockSyncRepo.EXPECT().AppendEvent(gomock.Any(), gomock.Any()).Return(nil)
+
+	_, err := chatService.SendMessage(context.Background(), &dbmysql.Message{
+		ConversationID: "old-conv",
+		SenderID:       "user-1",
+		Content:        "still here",
+	})
+	assert.NoError(t, err)
+}
+
+func TestSyncService_BotsSendOnlyWhereAdded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_, chatService, mockRepo, mockSyncRepo, _, mockBotRepo := newTestSyncService(ctrl)
+
+	t.Run("bot outside the conversation", func(t *testing.T) {
+		mockBotRepo.EXPECT().IsInConversation(gomock.Any(), uint(1), "conv-1").Return(false, nil)
+		_, err := chatService.SendMessage(context.Background(), &dbmysql.Message{
+			ConversationID: "conv-1",
+			SenderID:       BotSenderID(1),
+			Content:        "spoofed",
+		})
+		assert.ErrorIs(t, err, ErrBotNotInConversation)
+	})
+
+	t.Run("malformed bot sender", func(t *testing.T) {
+		_, err := chatService.SendMessage(context.Background(), &dbmysql.Message{
+			ConversationID: "conv-1",
+			SenderID:       "bot:admin",
+			Content:        "spoofed",
+		})
+		assert.ErrorIs(t, err, ErrBotNotInConversation)
+	})
+
+	t.Run("bot added to the conversation", func(t *testing.T) {
+		mockBotRepo.EXPECT().IsInConversation(gomock.Any(), uint(1), "conv-1").Return(true, nil)
+		mockRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+		mockSyncRepo.EXPECT().AppendEvent(gomock.Any(), gomock.Any()).Return(nil)
+		_, err := chatService.SendMessage(context.Background(), &dbmysql.Message{
+			ConversationID: "conv-1",
+			SenderID:       BotSenderID(1),
+			Content:        "hello",
+		})
+		assert.NoError(t, err)
+	})
+}
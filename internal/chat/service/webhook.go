@@ -0,0 +1,114 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+const (
+	// EventMessageCreated is sent to bots when a message lands in one of their conversations
+	EventMessageCreated = "message.created"
+
+	// SignatureHeader carries "sha256=<hex hmac of body>" keyed with the bot's webhook secret
+	SignatureHeader = "X-GoSocial-Signature"
+	EventHeader     = "X-GoSocial-Event"
+)
+
+// ErrWebhookAddressNotAllowed is returned for webhooks that point into internal networks
+var ErrWebhookAddressNotAllowed = errors.New("webhook address is not publicly routable")
+
+// WebhookEvent is the JSON body POSTed to a bot's webhook URL
+type WebhookEvent struct {
+	Event          string    `json:"event"`
+	BotID          uint      `json:"bot_id"`
+	MessageID      uint      `json:"message_id"`
+	ConversationID string    `json:"conversation_id"`
+	SenderID       string    `json:"sender_id"`
+	Content        string    `json:"content"`
+	SentAt         time.Time `json:"sent_at"`
+}
+
+// WebhookSender delivers signed events to bot webhook URLs
+type WebhookSender interface {
+	Send(ctx context.Context, url, secret string, event *WebhookEvent) error
+}
+
+type httpWebhookSender struct {
+	client *http.Client
+}
+
+// NewWebhookSender checks every address it dials, after DNS resolution and on redirects,
+// so bot owners cannot point webhooks at loopback or internal services
+func NewWebhookSender() WebhookSender {
+	dialer := &net.Dialer{
+		Timeout: 5 * time.Second,
+		Control: refuseInternalAddress,
+	}
+	return &httpWebhookSender{
+		client: &http.Client{
+			Timeout:   10 * time.Second,
+			Transport: &http.Transport{DialContext: dialer.DialContext},
+		},
+	}
+}
+
+func refuseInternalAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !isPublicIP(ip) {
+		return ErrWebhookAddressNotAllowed
+	}
+	return nil
+}
+
+func isPublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsUnspecified() &&
+		!ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() && !ip.IsMulticast()
+}
+
+func (w *httpWebhookSender) Send(ctx context.Context, url, secret string, event *WebhookEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, event.Event)
+	req.Header.Set(SignatureHeader, "sha256="+SignPayload(secret, body))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook delivery failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// SignPayload returns the hex encoded HMAC-SHA256 of body, receivers recompute it to verify the sender
+func SignPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
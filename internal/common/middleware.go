@@ -2,6 +2,8 @@ package common
 
 import (
 	"context"
+	"crypto/subtle"
+	"os"
 	"strings"
 
 	"google.golang.org/grpc"
@@ -15,6 +17,24 @@ var publicMethods = map[string]bool {
 	"/api.v1.UserService/Login" : true,
 }
 
+// serviceMethods are only served to other gosocial services, they authenticate with the SERVICE_TOKEN
+// shared secret instead of a user JWT
+var serviceMethods = map[string]bool{
+	"/api.v1.UserService/ListBlockers":   true,
+	"/api.v1.UserService/ListFriends":    true,
+	"/api.v1.UserService/ResolveHandles": true,
+	"/api.v1.UserService/GetHandles":     true,
+}
+
+// userServiceMethods are service methods users may still call with their JWT, a call is
+// taken as a service call only when it carries the service token header
+var userServiceMethods = map[string]bool{
+	"/api.v1.UserService/ListFriends": true,
+}
+
+// serviceTokenHeader carries the SERVICE_TOKEN of calls between services
+const serviceTokenHeader = "x-service-token"
+
 
 //grpc.UnaryServerInterceptor is middleware, for inspect incoming requests, enforce auth, log activity, modify context and then finally returns context and req to handler
 //it returns a function signature, expected by gRPC for all request responses
@@ -31,42 +51,119 @@ func AuthInterceptor() grpc.UnaryServerInterceptor {
 		if publicMethods[info.FullMethod] {
 			return handler(ctx, req)
 		}
-
-		//extract auth header
-		//extracting metadata from incoming context
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "missing metadata")
-		}
-		// taking authorizarization metadata having token
-		vals := md["authorization"]
-		if len(vals) == 0 {
-			return nil, status.Error(codes.Unauthenticated, "authorization required!!")
+		if serviceMethods[info.FullMethod] && (!userServiceMethods[info.FullMethod] || carriesServiceToken(ctx)) {
+			if err := authenticateService(ctx); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
 		}
 
-		// vals[0] = Bearer <token>
-		// len(parts) = 2 
-		// parse and validate format
-		parts := strings.Fields(vals[0])
-		if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
-   				 return nil, status.Error(codes.Unauthenticated, "invalid auth header")
+		ctx, err := authenticate(ctx)
+		if err != nil {
+			return nil, err
 		}
-		tokenString := parts[1]
+
+		return handler(ctx, req)
+	}
+}
 
 
-		//validating jwt
-		Claims, err := ValidToken(tokenString)
+// AuthStreamInterceptor does the same for streaming RPCs, handlers read the caller from stream.Context()
+func AuthStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if publicMethods[info.FullMethod] {
+			return handler(srv, ss)
+		}
+		ctx, err := authenticate(ss.Context())
 		if err != nil {
-			return nil, status.Error(codes.Unauthenticated, "invalid or expired token!!")
+			return err
 		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
 
+// authenticatedStream swaps the stream's context for the one carrying the caller
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
 
-		//inject user identity into context
-		ctx = context.WithValue(ctx, "user_id", Claims.UserID)
-		ctx = context.WithValue(ctx, "handle", Claims.Handle)
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
 
-		return handler(ctx, req)
+// authenticate validates the Bearer token of the incoming metadata and returns ctx with the caller in it
+func authenticate(ctx context.Context) (context.Context, error) {
+	//extract auth header
+	//extracting metadata from incoming context
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	// taking authorizarization metadata having token
+	vals := md["authorization"]
+	if len(vals) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "authorization required!!")
 	}
+
+	// vals[0] = Bearer <token>
+	// len(parts) = 2 
+	// parse and validate format
+	parts := strings.Fields(vals[0])
+	if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
+		return nil, status.Error(codes.Unauthenticated, "invalid auth header")
+	}
+	tokenString := parts[1]
+
+
+	//validating jwt
+	Claims, err := ValidToken(tokenString)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid or expired token!!")
+	}
+
+
+	//inject user identity into context
+	return ContextWithUser(ctx, Claims.UserID, Claims.Handle), nil
+}
+
+// authenticateService checks that the incoming metadata carries this deployment's SERVICE_TOKEN.
+// Without a configured token no call is trusted as a service call
+func authenticateService(ctx context.Context) error {
+	token := os.Getenv("SERVICE_TOKEN")
+	md, _ := metadata.FromIncomingContext(ctx)
+	vals := md[serviceTokenHeader]
+	if token == "" || len(vals) == 0 || subtle.ConstantTimeCompare([]byte(vals[0]), []byte(token)) != 1 {
+		return status.Error(codes.PermissionDenied, "only other services can call this method")
+	}
+	return nil
+}
+
+func carriesServiceToken(ctx context.Context) bool {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return len(md[serviceTokenHeader]) > 0
+}
+
+// ServiceTokenInterceptor sends the SERVICE_TOKEN with every call of a client one service keeps to another
+func ServiceTokenInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if token := os.Getenv("SERVICE_TOKEN"); token != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, serviceTokenHeader, token)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// ContextWithUser puts the caller into the context the way handlers expect it
+func ContextWithUser(ctx context.Context, userID uint64, handle string) context.Context {
+	ctx = context.WithValue(ctx, "user_id", userID)
+	return context.WithValue(ctx, "handle", handle)
+}
+
+// UserIDFromContext returns the caller the auth interceptors put in the context
+func UserIDFromContext(ctx context.Context) (uint64, bool) {
+	userID, ok := ctx.Value("user_id").(uint64)
+	return userID, ok
 }
 
 
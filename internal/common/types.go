@@ -11,6 +11,9 @@ const (
 	PostReactionType  NotificationType = "post_reaction"
 	MessageType       NotificationType = "message"
 	StoryReactionType NotificationType = "story_reaction"
+	CommentType       NotificationType = "comment"
+	MentionType       NotificationType = "mention"
+	PublishedType     NotificationType = "content_published"
 	SystemType        NotificationType = "system"
 )
 
@@ -5,6 +5,7 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
@@ -24,6 +25,10 @@ type Config struct {
 
 	MongoDB MongoDBConfig
 
+	Moderation ModerationConfig `json:"moderation"`
+
+	Feed FeedConfig `json:"feed"`
+
 	//MySQL   MySQLConfig
 }
 
@@ -34,6 +39,7 @@ type ServerConfig struct {
 	NotifServicePort string
 	MediaServicePort string
 	MediaBaseURL     string
+	BotHTTPPort      string
 }
 
 type DatabaseConfig struct {
@@ -81,6 +87,29 @@ type LoggingConfig struct {
 	OutputPath string `json:"output"` // e.g., "stdout", "logfile.log"
 }
 
+type ModerationConfig struct {
+	BannedWords       []string            `json:"banned_words"`
+	BannedWordsAction string              `json:"banned_words_action"` // "redact", "reject" or "flag"
+	MaxMessageLength  int                 `json:"max_message_length"`  // In characters
+	Filters           map[string][]string `json:"filters"`             // Conversation type -> ordered filter names
+}
+
+type FeedConfig struct {
+	MaterializedTimelines bool          `json:"materialized_timelines"`  // Fan out on write instead of aggregating at read time
+	TimelineBackfillLimit int           `json:"timeline_backfill_limit"` // Posts copied per side when a friendship is accepted
+	Ranking               RankingConfig `json:"ranking"`
+	ReactionNotifyWindow  int           `json:"reaction_notify_window"` // Seconds reactions on one content are batched into a single notification
+}
+
+// RankingConfig weighs the signals of the ranked timeline, every signal is scaled to roughly 0..1 before weighting
+type RankingConfig struct {
+	RecencyWeight      float64            `json:"recency_weight"`
+	RecencyHalfLifeHrs float64            `json:"recency_half_life_hours"` // Age at which the recency signal halves
+	ReactionWeight     float64            `json:"reaction_weight"`
+	AffinityWeight     float64            `json:"affinity_weight"`
+	TypeBoosts         map[string]float64 `json:"type_boosts"` // Content type (POST, REEL, STORY) -> added score
+}
+
 type MongoDBConfig struct {
 	Host     string
 	Port     string
@@ -166,6 +195,33 @@ func LoadConfig() *Config {
 			NotifServicePort: getEnv("NOTIF_SERVICE_PORT", "7004"),
 			MediaServicePort: getEnv("MEDIA_SERVER_PORT", "8080"),
 			MediaBaseURL:     getEnv("MEDIA_BASE_URL", "http://localhost:8080/media"),
+			BotHTTPPort:      getEnv("BOT_HTTP_PORT", "8081"),
+		},
+		Moderation: ModerationConfig{
+			BannedWords:       getEnvAsList("MODERATION_BANNED_WORDS", nil),
+			BannedWordsAction: getEnv("MODERATION_BANNED_WORDS_ACTION", "redact"),
+			MaxMessageLength:  getEnvAsInt("MODERATION_MAX_MESSAGE_LENGTH", 4000),
+			Filters: map[string][]string{
+				"direct": getEnvAsList("MODERATION_DIRECT_FILTERS", []string{"banned_words", "max_length"}),
+				"group":  getEnvAsList("MODERATION_GROUP_FILTERS", []string{"banned_words", "links", "max_length"}),
+				"e2e":    getEnvAsList("MODERATION_E2E_FILTERS", []string{"max_length"}),
+			},
+		},
+		Feed: FeedConfig{
+			MaterializedTimelines: getEnv("FEED_MATERIALIZED_TIMELINES", "false") == "true",
+			TimelineBackfillLimit: getEnvAsInt("FEED_TIMELINE_BACKFILL_LIMIT", 50),
+			ReactionNotifyWindow:  getEnvAsInt("FEED_REACTION_NOTIFY_WINDOW", 60),
+			Ranking: RankingConfig{
+				RecencyWeight:      getEnvAsFloat("FEED_RANK_RECENCY_WEIGHT", 1.0),
+				RecencyHalfLifeHrs: getEnvAsFloat("FEED_RANK_RECENCY_HALF_LIFE_HOURS", 6),
+				ReactionWeight:     getEnvAsFloat("FEED_RANK_REACTION_WEIGHT", 0.5),
+				AffinityWeight:     getEnvAsFloat("FEED_RANK_AFFINITY_WEIGHT", 0.8),
+				TypeBoosts: map[string]float64{
+					"POST":  getEnvAsFloat("FEED_RANK_POST_BOOST", 0),
+					"REEL":  getEnvAsFloat("FEED_RANK_REEL_BOOST", 0.2),
+					"STORY": getEnvAsFloat("FEED_RANK_STORY_BOOST", 0.1),
+				},
+			},
 		},
 	}
 }
@@ -215,3 +271,30 @@ func getEnvAsInt(key string, fallback int) int {
 	}
 	return val
 }
+
+func getEnvAsFloat(key string, fallback float64) float64 {
+	valStr := os.Getenv(key)
+	if valStr == "" {
+		return fallback
+	}
+	val, err := strconv.ParseFloat(valStr, 64)
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+// getEnvAsList reads a comma separated list, blank entries are dropped
+func getEnvAsList(key string, fallback []string) []string {
+	valStr := os.Getenv(key)
+	if valStr == "" {
+		return fallback
+	}
+	var values []string
+	for _, v := range strings.Split(valStr, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
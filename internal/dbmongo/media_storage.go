@@ -54,9 +54,10 @@ func (ms *MediaStorage) UploadFile(ctx context.Context, filename, mimeType, uplo
 
 	defer stream.Close()
 
-	// Copy file content
+	// Copy file content, a failed copy must not leave a partial file behind
 	size, err := io.Copy(stream, content)
 	if err != nil {
+		_ = stream.Abort()
 		return nil, fmt.Errorf("file copy failed: %w", err)
 	}
 
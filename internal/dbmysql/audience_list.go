@@ -0,0 +1,21 @@
+package dbmysql
+
+import "time"
+
+// AudienceList is a named group of the owner's friends, like Close Friends, that content can be shared with.
+// Names are unique per owner
+type AudienceList struct {
+	ListID    int64     `gorm:"primaryKey;autoIncrement;column:list_id"`
+	OwnerID   int64     `gorm:"column:owner_id;uniqueIndex:idx_audience_lists_owner_name,priority:1;not null"`
+	Name      string    `gorm:"column:name;uniqueIndex:idx_audience_lists_owner_name,priority:2;size:50;not null"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
+
+// AudienceListMember puts a user in a list, membership is checked on every read so removing a member
+// also hides what was shared with the list before
+type AudienceListMember struct {
+	ListID  int64     `gorm:"primaryKey;column:list_id"`
+	UserID  int64     `gorm:"primaryKey;column:user_id;index"`
+	AddedAt time.Time `gorm:"column:added_at"`
+}
@@ -0,0 +1,25 @@
+package dbmysql
+
+import (
+	"time"
+)
+
+// Bot is an automated account that can post into conversations it was added to
+type Bot struct {
+	BotID         uint      `gorm:"column:bot_id;primaryKey;autoIncrement" json:"bot_id"`
+	OwnerID       string    `gorm:"column:owner_id;index;size:36;not null" json:"owner_id"`
+	Name          string    `gorm:"column:name;size:100;not null" json:"name"`
+	TokenHash     string    `gorm:"column:token_hash;size:64;uniqueIndex;not null" json:"-"`
+	WebhookURL    string    `gorm:"column:webhook_url;size:500" json:"webhook_url"`
+	WebhookSecret string    `gorm:"column:webhook_secret;size:64" json:"-"`
+	CreatedAt     time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+	UpdatedAt     time.Time `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+}
+
+// BotConversation links a bot to a conversation it may read from and post into
+type BotConversation struct {
+	BotID          uint      `gorm:"column:bot_id;primaryKey" json:"bot_id"`
+	ConversationID string    `gorm:"column:conversation_id;primaryKey;size:36" json:"conversation_id"`
+	AddedBy        string    `gorm:"column:added_by;size:36" json:"added_by"`
+	AddedAt        time.Time `gorm:"column:added_at;autoCreateTime" json:"added_at"`
+}
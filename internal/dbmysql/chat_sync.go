@@ -0,0 +1,35 @@
+package dbmysql
+
+import (
+	"time"
+)
+
+// ChatEvent is an append-only log entry of a change in a conversation. The
+// auto-increment EventID doubles as the sync cursor handed out to devices.
+// Content is not stored with the event, it is read from the message so edits
+// and deletes also reach the events logged before them.
+type ChatEvent struct {
+	EventID        uint64    `gorm:"column:event_id;primaryKey;autoIncrement" json:"event_id"`
+	ConversationID string    `gorm:"column:conversation_id;index;size:36;not null" json:"conversation_id"`
+	Kind           string    `gorm:"column:kind;size:32;not null" json:"kind"`
+	MessageID      *uint     `gorm:"column:message_id" json:"message_id,omitempty"`
+	ActorID        string    `gorm:"column:actor_id;size:36" json:"actor_id"`
+	SubjectID      string    `gorm:"column:subject_id;index;size:36" json:"subject_id,omitempty"`
+	Content        string    `gorm:"column:content;->;-:migration" json:"content,omitempty"`
+	CreatedAt      time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+}
+
+// ConversationMember records that a user takes part in a conversation
+type ConversationMember struct {
+	ConversationID string    `gorm:"column:conversation_id;primaryKey;size:36" json:"conversation_id"`
+	UserID         string    `gorm:"column:user_id;primaryKey;index;size:36" json:"user_id"`
+	JoinedAt       time.Time `gorm:"column:joined_at;autoCreateTime" json:"joined_at"`
+}
+
+// DeviceSyncCursor stores the last chat event a device has synced up to
+type DeviceSyncCursor struct {
+	DeviceToken string    `gorm:"column:device_token;primaryKey;size:255" json:"device_token"`
+	UserID      string    `gorm:"column:user_id;index;size:36;not null" json:"user_id"`
+	LastEventID uint64    `gorm:"column:last_event_id;not null;default:0" json:"last_event_id"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+}
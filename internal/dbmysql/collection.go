@@ -0,0 +1,20 @@
+package dbmysql
+
+import "time"
+
+// Collection is a named, private group of contents a user saved, names are unique per owner
+type Collection struct {
+	CollectionID int64     `gorm:"primaryKey;autoIncrement;column:collection_id"`
+	OwnerID      int64     `gorm:"column:owner_id;uniqueIndex:idx_collections_owner_name,priority:1;not null"`
+	Name         string    `gorm:"column:name;uniqueIndex:idx_collections_owner_name,priority:2;size:50;not null"`
+	CreatedAt    time.Time `gorm:"column:created_at"`
+	UpdatedAt    time.Time `gorm:"column:updated_at"`
+}
+
+// SavedItem places one content in a collection, SaveID orders the items by when they were saved
+type SavedItem struct {
+	SaveID       int64     `gorm:"primaryKey;autoIncrement;column:save_id"`
+	CollectionID int64     `gorm:"column:collection_id;uniqueIndex:idx_saved_items_collection_content,priority:1;not null"`
+	ContentID    int64     `gorm:"column:content_id;uniqueIndex:idx_saved_items_collection_content,priority:2;index;not null"`
+	SavedAt      time.Time `gorm:"column:saved_at"`
+}
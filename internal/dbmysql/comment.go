@@ -0,0 +1,15 @@
+package dbmysql
+
+import "time"
+
+// Comment on a content, ParentID is set for replies and always points at a top-level comment
+type Comment struct {
+	CommentID int64      `gorm:"primaryKey;autoIncrement;column:comment_id"`
+	ContentID int64      `gorm:"column:content_id;index;not null"`
+	AuthorID  int64      `gorm:"column:author_id;not null"`
+	ParentID  *int64     `gorm:"column:parent_id;index"`
+	Text      string     `gorm:"column:text;type:text;not null"`
+	CreatedAt time.Time  `gorm:"column:created_at"`
+	UpdatedAt time.Time  `gorm:"column:updated_at"`
+	EditedAt  *time.Time `gorm:"column:edited_at"`
+}
@@ -6,17 +6,26 @@ import (
 
 // content.go
 type Content struct {
-	ContentID   int64      `gorm:"primaryKey;autoIncrement;column:content_id"`
-	AuthorID    int64      `gorm:"column:author_id"`
-	Type        string     `gorm:"type:ENUM('POST','STORY','REEL');column:type"`
-	TextContent *string    `gorm:"column:text_content"`
-	MediaRefID  *int64     `gorm:"column:media_ref_id"`
-	Privacy     string     `gorm:"type:ENUM('public','friends','private');column:privacy"`
-	Expiration  *time.Time `gorm:"column:expiration"`
-	Duration    *int       `gorm:"column:duration"`
-	CreatedAt   time.Time  `gorm:"column:created_at"`
-	UpdatedAt   time.Time  `gorm:"column:updated_at"`
+	ContentID       int64      `gorm:"primaryKey;autoIncrement;column:content_id"`
+	AuthorID        int64      `gorm:"column:author_id;index:idx_contents_timeline,priority:1"`
+	Type            string     `gorm:"type:ENUM('POST','STORY','REEL');column:type"`
+	TextContent     *string    `gorm:"column:text_content"`
+	MediaRefID      *int64     `gorm:"column:media_ref_id"`
+	Privacy         string     `gorm:"type:ENUM('public','friends','list','private');column:privacy"`
+	AudienceListID  *int64     `gorm:"column:audience_list_id;index"` // the list a list-privacy content is shared with
+	Expiration      *time.Time `gorm:"column:expiration"`
+	Duration        *int       `gorm:"column:duration"`
+	ArchivedAt      *time.Time `gorm:"column:archived_at"`             // set when an expired story moves to its author's archive
+	SharedContentID *int64     `gorm:"column:shared_content_id;index"` // the original of a repost or quote post, kept after the original is deleted
+	EditedAt        *time.Time `gorm:"column:edited_at"`               // last time the author changed the text or privacy
+	PublishAt       *time.Time `gorm:"column:publish_at;index"`        // set while scheduled content waits to be published, cleared once it is
+	CreatedAt       time.Time  `gorm:"column:created_at;index:idx_contents_timeline,priority:2"`
+	UpdatedAt       time.Time  `gorm:"column:updated_at;index:idx_contents_draft,priority:2"`
 
-	User     User     `gorm:"foreignKey:AuthorID"`
-	MediaRef MediaRef `gorm:"references:MediaRefID"` // no foreignKey here, fk is inferred from MediaRefID field
+	// unpublished work only its author sees, abandoned drafts are found by UpdatedAt
+	Draft bool `gorm:"column:draft;not null;default:false;index:idx_contents_draft,priority:1"`
+
+	User     User           `gorm:"foreignKey:AuthorID"`
+	MediaRef MediaRef       `gorm:"references:MediaRefID"` // no foreignKey here, fk is inferred from MediaRefID field
+	Media    []ContentMedia `gorm:"foreignKey:ContentID"`  // carousel items, created with the content; MediaRefID holds the first
 }
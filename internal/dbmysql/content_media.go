@@ -0,0 +1,8 @@
+package dbmysql
+
+// ContentMedia places one media item in a carousel post, items are ordered by Position starting at 1
+type ContentMedia struct {
+	ContentID  int64 `gorm:"primaryKey;autoIncrement:false;column:content_id"`
+	Position   int   `gorm:"primaryKey;autoIncrement:false;column:position"`
+	MediaRefID int64 `gorm:"column:media_ref_id;not null"`
+}
@@ -0,0 +1,13 @@
+package dbmysql
+
+import "time"
+
+// ContentRevision keeps the text and privacy a content had before an edit, ReplacedAt is when the edit happened
+type ContentRevision struct {
+	RevisionID     int64     `gorm:"primaryKey;autoIncrement;column:revision_id"`
+	ContentID      int64     `gorm:"column:content_id;index;not null"`
+	TextContent    *string   `gorm:"column:text_content"`
+	Privacy        string    `gorm:"type:ENUM('public','friends','list','private');column:privacy"`
+	AudienceListID *int64    `gorm:"column:audience_list_id"`
+	ReplacedAt     time.Time `gorm:"column:replaced_at"`
+}
@@ -0,0 +1,21 @@
+package dbmysql
+
+import (
+	"time"
+)
+
+// ConversationSetting holds one participant's inbox preferences for a conversation
+type ConversationSetting struct {
+	ConversationID string     `gorm:"column:conversation_id;primaryKey;size:36" json:"conversation_id"`
+	UserID         string     `gorm:"column:user_id;primaryKey;index;size:36" json:"user_id"`
+	MutedUntil     *time.Time `gorm:"column:muted_until" json:"muted_until,omitempty"`
+	Archived       bool       `gorm:"column:archived;not null;default:false" json:"archived"`
+	Pinned         bool       `gorm:"column:pinned;not null;default:false" json:"pinned"`
+	PinnedAt       *time.Time `gorm:"column:pinned_at" json:"pinned_at,omitempty"`
+	UpdatedAt      time.Time  `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+}
+
+// IsMuted reports whether the conversation is muted at the given time
+func (s *ConversationSetting) IsMuted(now time.Time) bool {
+	return s.MutedUntil != nil && s.MutedUntil.After(now)
+}
@@ -0,0 +1,35 @@
+package dbmysql
+
+import (
+	"time"
+)
+
+// DeviceKeyBundle is the public key material a device publishes to the E2E key directory.
+// DeviceID is a public handle derived from the device token so the token itself is never shared
+type DeviceKeyBundle struct {
+	DeviceToken           string    `gorm:"column:device_token;primaryKey;size:255" json:"-"`
+	DeviceID              string    `gorm:"column:device_id;size:32;uniqueIndex;not null" json:"device_id"`
+	UserID                string    `gorm:"column:user_id;index;size:36;not null" json:"user_id"`
+	IdentityKey           []byte    `gorm:"column:identity_key;type:blob;not null" json:"identity_key"`
+	SignedPrekeyID        uint32    `gorm:"column:signed_prekey_id;not null" json:"signed_prekey_id"`
+	SignedPrekey          []byte    `gorm:"column:signed_prekey;type:blob;not null" json:"signed_prekey"`
+	SignedPrekeySignature []byte    `gorm:"column:signed_prekey_signature;type:blob;not null" json:"signed_prekey_signature"`
+	UpdatedAt             time.Time `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+}
+
+// OneTimePrekey is handed out to at most one session initiator and then deleted
+type OneTimePrekey struct {
+	ID          uint      `gorm:"column:id;primaryKey;autoIncrement" json:"-"`
+	DeviceToken string    `gorm:"column:device_token;index;size:255;not null" json:"-"`
+	KeyID       uint32    `gorm:"column:key_id;not null" json:"key_id"`
+	PublicKey   []byte    `gorm:"column:public_key;type:blob;not null" json:"public_key"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+}
+
+// E2EConversation marks a 1:1 conversation as end-to-end encrypted, from then on
+// messages.content only holds ciphertext the server relays but cannot read
+type E2EConversation struct {
+	ConversationID string    `gorm:"column:conversation_id;primaryKey;size:36" json:"conversation_id"`
+	EnabledBy      string    `gorm:"column:enabled_by;size:36;not null" json:"enabled_by"`
+	EnabledAt      time.Time `gorm:"column:enabled_at;autoCreateTime" json:"enabled_at"`
+}
@@ -0,0 +1,17 @@
+package dbmysql
+
+import "time"
+
+// Hashtag is a normalized tag, lowercased and without the leading '#'
+type Hashtag struct {
+	HashtagID int64     `gorm:"primaryKey;autoIncrement;column:hashtag_id"`
+	Tag       string    `gorm:"column:tag;size:100;uniqueIndex;not null"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+// ContentHashtag tags one content, CreatedAt is the content's creation time and drives trending windows
+type ContentHashtag struct {
+	ContentID int64     `gorm:"primaryKey;autoIncrement:false;column:content_id"`
+	HashtagID int64     `gorm:"primaryKey;autoIncrement:false;column:hashtag_id;index:idx_content_hashtags_usage,priority:1"`
+	CreatedAt time.Time `gorm:"column:created_at;index:idx_content_hashtags_usage,priority:2"`
+}
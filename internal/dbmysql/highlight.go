@@ -0,0 +1,20 @@
+package dbmysql
+
+import "time"
+
+// Highlight is a named collection of archived stories on its owner's profile, ordered by Position
+type Highlight struct {
+	HighlightID int64     `gorm:"primaryKey;autoIncrement;column:highlight_id"`
+	OwnerID     int64     `gorm:"column:owner_id;index;not null"`
+	Title       string    `gorm:"column:title;size:50;not null"`
+	Position    int       `gorm:"column:position;not null"`
+	CreatedAt   time.Time `gorm:"column:created_at"`
+	UpdatedAt   time.Time `gorm:"column:updated_at"`
+}
+
+// HighlightStory places one story in a highlight, stories are ordered by Position
+type HighlightStory struct {
+	HighlightID int64 `gorm:"primaryKey;autoIncrement:false;column:highlight_id"`
+	StoryID     int64 `gorm:"primaryKey;autoIncrement:false;column:story_id;index"`
+	Position    int   `gorm:"column:position;not null"`
+}
@@ -0,0 +1,15 @@
+package dbmysql
+
+import "time"
+
+// Mention is an @handle in a content's text resolved to a user.
+// Offset and Length count runes and cover the '@', Handle keeps the text as written
+type Mention struct {
+	MentionID       int64     `gorm:"primaryKey;autoIncrement;column:mention_id"`
+	ContentID       int64     `gorm:"column:content_id;index;not null"`
+	MentionedUserID int64     `gorm:"column:mentioned_user_id;index:idx_mentions_user,priority:1;not null"`
+	Handle          string    `gorm:"column:handle;size:50;not null"`
+	Offset          int       `gorm:"column:offset;not null"`
+	Length          int       `gorm:"column:length;not null"`
+	CreatedAt       time.Time `gorm:"column:created_at;index:idx_mentions_user,priority:2"`
+}
@@ -0,0 +1,19 @@
+package dbmysql
+
+import (
+	"time"
+)
+
+// ModerationDecision records a non-allow outcome of a chat message filter. Flagged
+// decisions form the review queue, rejected messages are never saved so MessageID is nil
+type ModerationDecision struct {
+	ID               uint      `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	MessageID        *uint     `gorm:"column:message_id;index" json:"message_id,omitempty"`
+	ConversationID   string    `gorm:"column:conversation_id;index;size:36;not null" json:"conversation_id"`
+	SenderID         string    `gorm:"column:sender_id;size:36;not null" json:"sender_id"`
+	ConversationType string    `gorm:"column:conversation_type;size:16;not null" json:"conversation_type"`
+	Filter           string    `gorm:"column:filter;size:50;not null" json:"filter"`
+	Action           string    `gorm:"column:action;type:enum('redact','reject','flag');index;not null" json:"action"`
+	Reason           string    `gorm:"column:reason;size:255" json:"reason"`
+	CreatedAt        time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+}
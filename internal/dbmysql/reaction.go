@@ -5,8 +5,8 @@ import "time"
 type Reaction struct {
 	ID        int64     `gorm:"primaryKey;autoIncrement;column:id"`
 	UserID    int64     `gorm:"column:user_id"`
-	ContentID int64     `gorm:"column:content_id"`
-	Type      string    `gorm:"column:type"` // like, love, laugh, etc.
+	ContentID int64     `gorm:"column:content_id;index:idx_reactions_content_type,priority:1"`
+	Type      string    `gorm:"column:type;index:idx_reactions_content_type,priority:2"` // like, love, laugh, etc.
 	CreatedAt time.Time `gorm:"column:created_at"`
 
 	user    User    `gorm:"foreignKey:UserID;references:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
@@ -0,0 +1,11 @@
+package dbmysql
+
+import "time"
+
+// ReelView records that ViewerID watched a reel, at most once per viewer
+type ReelView struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement;column:id"`
+	ReelID    int64     `gorm:"column:reel_id;not null;uniqueIndex:idx_reel_views_viewer,priority:1"`
+	ViewerID  int64     `gorm:"column:viewer_id;not null;uniqueIndex:idx_reel_views_viewer,priority:2"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
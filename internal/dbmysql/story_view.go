@@ -0,0 +1,11 @@
+package dbmysql
+
+import "time"
+
+// StoryView records that ViewerID watched a story, at most once per viewer
+type StoryView struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement;column:id"`
+	StoryID   int64     `gorm:"column:story_id;not null;uniqueIndex:idx_story_views_viewer,priority:1"`
+	ViewerID  int64     `gorm:"column:viewer_id;not null;uniqueIndex:idx_story_views_viewer,priority:2"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
@@ -0,0 +1,12 @@
+package dbmysql
+
+import "time"
+
+// TimelineEntry is one content pushed into the materialized home timeline of OwnerID.
+// CreatedAt mirrors the content so a page can be read from this table alone.
+type TimelineEntry struct {
+	OwnerID   int64     `gorm:"primaryKey;autoIncrement:false;column:owner_id;index:idx_timeline_entries_page,priority:1"`
+	ContentID int64     `gorm:"primaryKey;autoIncrement:false;column:content_id;index"`
+	AuthorID  int64     `gorm:"column:author_id"`
+	CreatedAt time.Time `gorm:"column:created_at;index:idx_timeline_entries_page,priority:2"`
+}
@@ -5,12 +5,14 @@ package di
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/google/wire"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"gorm.io/gorm"
 
+	notifpb "gosocial/api/v1"
 	userpb "gosocial/api/v1/user"
 	"gosocial/internal/config"
 	"gosocial/internal/dbmongo"
@@ -59,6 +61,7 @@ func InitializeUserHandler() (*UserApp, error) {
 // CHATS
 type ChatApp struct {
 	Handler *handler.ChatHandler
+	BotHTTP *handler.BotHTTPHandler
 	DB      *gorm.DB
 	Config  *config.Config
 }
@@ -67,11 +70,32 @@ var ChatProviderSet = wire.NewSet(
 	config.LoadConfig,
 	dbmysql.NewMySQL,
 	repository.NewChatRepository,
-	service.NewChatService,
+	repository.NewBotRepository,
+	repository.NewSyncRepository,
+	repository.NewMemberRepository,
+	repository.NewSettingsRepository,
+	repository.NewE2ERepository,
+	repository.NewModerationRepository,
+	ProvideNotificationServiceClient,
+	service.NewModerator,
+	ProvideChatService,
+	service.NewWebhookSender,
+	service.NewBotService,
+	service.NewSyncService,
+	service.NewInboxService,
+	service.NewE2EService,
 	handler.NewChatHandler,
+	handler.NewBotHTTPHandler,
 	wire.Struct(new(ChatApp), "*"), // Wire creates ChatApp with all fields
 )
 
+// Provide ChatService with the moderation filter chain installed
+func ProvideChatService(r repository.ChatRepository, moderator *service.Moderator) service.ChatService {
+	chatService := service.NewChatService(r)
+	chatService.SetModerator(moderator)
+	return chatService
+}
+
 // InitializeChatService now returns ChatApp with both handler and DB
 func InitializeChatService() (*ChatApp, func(), error) {
 	wire.Build(ChatProviderSet)
@@ -99,6 +123,7 @@ func ProvideUserServiceClient(cfg *config.Config) (userpb.UserServiceClient, fun
 	conn, err := grpc.Dial(
 		fmt.Sprintf("localhost:%s", cfg.Server.UserServicePort),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(common.ServiceTokenInterceptor()),
 	)
 	if err != nil {
 		return nil, nil, err
@@ -112,12 +137,38 @@ func ProvideUserServiceClient(cfg *config.Config) (userpb.UserServiceClient, fun
 	return client, cleanup, nil
 }
 
-// Provide FeedService
+// Provide Notification Service Client
+func ProvideNotificationServiceClient(cfg *config.Config) (notifpb.NotificationServiceClient, func(), error) {
+	conn, err := grpc.Dial(
+		fmt.Sprintf("localhost:%s", cfg.Server.NotifServicePort),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client := notifpb.NewNotificationServiceClient(conn)
+	cleanup := func() {
+		conn.Close()
+	}
+
+	return client, cleanup, nil
+}
+
+// Provide FeedService, with the configured ranker, engagement notifications and materialized timelines when enabled
 func ProvideFeedService(
 	repo *feed.FeedRepository,
 	userClient userpb.UserServiceClient,
+	notifClient notifpb.NotificationServiceClient,
+	cfg *config.Config,
 ) *feed.FeedService {
-	return feed.NewFeedService(repo, repo, repo, userClient)
+	feedService := feed.NewFeedService(repo, repo, repo, repo, repo, repo, repo, repo, repo, repo, repo, userClient)
+	feedService.SetRanker(feed.NewScoringRanker(repo, cfg.Feed.Ranking))
+	feedService.SetNotifier(feed.NewEngagementNotifier(notifClient, userClient, time.Duration(cfg.Feed.ReactionNotifyWindow)*time.Second))
+	if cfg.Feed.MaterializedTimelines {
+		feedService.SetTimelineStore(repo, cfg.Feed.TimelineBackfillLimit)
+	}
+	return feedService
 }
 
 // Provide FeedHandlers
@@ -16,6 +16,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"gorm.io/gorm"
+	"gosocial/api/v1"
 	user2 "gosocial/api/v1/user"
 	"gosocial/internal/chat/handler"
 	"gosocial/internal/chat/repository"
@@ -28,6 +29,7 @@ import (
 	"gosocial/internal/notif"
 	"gosocial/internal/user"
 	"log"
+	"time"
 )
 
 // Injectors from wire.go:
@@ -59,14 +61,33 @@ func InitializeChatService() (*ChatApp, func(), error) {
 		return nil, nil, err
 	}
 	chatRepository := repository.NewChatRepository(db)
-	chatService := service.NewChatService(chatRepository)
-	chatHandler := handler.NewChatHandler(chatService)
+	memberRepository := repository.NewMemberRepository(db)
+	e2eRepository := repository.NewE2ERepository(db)
+	moderationRepository := repository.NewModerationRepository(db)
+	moderator := service.NewModerator(configConfig, memberRepository, e2eRepository, moderationRepository)
+	chatService := ProvideChatService(chatRepository, moderator)
+	botRepository := repository.NewBotRepository(db)
+	webhookSender := service.NewWebhookSender()
+	botService := service.NewBotService(botRepository, memberRepository, chatService, webhookSender)
+	syncRepository := repository.NewSyncRepository(db)
+	syncService := service.NewSyncService(syncRepository, memberRepository, botRepository, chatService)
+	settingsRepository := repository.NewSettingsRepository(db)
+	notificationServiceClient, cleanup, err := ProvideNotificationServiceClient(configConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	inboxService := service.NewInboxService(chatRepository, memberRepository, settingsRepository, e2eRepository, notificationServiceClient, chatService)
+	e2eService := service.NewE2EService(e2eRepository, syncRepository, memberRepository, botRepository, chatService)
+	chatHandler := handler.NewChatHandler(chatService, botService, syncService, inboxService, e2eService)
+	botHTTPHandler := handler.NewBotHTTPHandler(botService)
 	chatApp := &ChatApp{
 		Handler: chatHandler,
+		BotHTTP: botHTTPHandler,
 		DB:      db,
 		Config:  configConfig,
 	}
 	return chatApp, func() {
+		cleanup()
 	}, nil
 }
 
@@ -87,7 +108,12 @@ func InitializeFeedService() (*FeedApp, func(), error) {
 	if err != nil {
 		return nil, nil, err
 	}
-	feedService := ProvideFeedService(feedRepository, userServiceClient)
+	notificationServiceClient, cleanup2, err := ProvideNotificationServiceClient(configConfig)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	feedService := ProvideFeedService(feedRepository, userServiceClient, notificationServiceClient, configConfig)
 	feedHandlers := ProvideFeedHandlers(feedService)
 	feedApp := &FeedApp{
 		Handler:      feedHandlers,
@@ -97,6 +123,7 @@ func InitializeFeedService() (*FeedApp, func(), error) {
 		MediaStorage: mediaStorage,
 	}
 	return feedApp, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -144,11 +171,19 @@ var UserSet = wire.NewSet(config.LoadConfig, dbmysql.NewMySQL, user.NewDeviceRep
 // CHATS
 type ChatApp struct {
 	Handler *handler.ChatHandler
+	BotHTTP *handler.BotHTTPHandler
 	DB      *gorm.DB
 	Config  *config.Config
 }
 
-var ChatProviderSet = wire.NewSet(config.LoadConfig, dbmysql.NewMySQL, repository.NewChatRepository, service.NewChatService, handler.NewChatHandler, wire.Struct(new(ChatApp), "*"))
+var ChatProviderSet = wire.NewSet(config.LoadConfig, dbmysql.NewMySQL, repository.NewChatRepository, repository.NewBotRepository, repository.NewSyncRepository, repository.NewMemberRepository, repository.NewSettingsRepository, repository.NewE2ERepository, repository.NewModerationRepository, ProvideNotificationServiceClient, service.NewModerator, ProvideChatService, service.NewWebhookSender, service.NewBotService, service.NewSyncService, service.NewInboxService, service.NewE2EService, handler.NewChatHandler, handler.NewBotHTTPHandler, wire.Struct(new(ChatApp), "*"))
+
+// Provide ChatService with the moderation filter chain installed
+func ProvideChatService(r repository.ChatRepository, moderator *service.Moderator) service.ChatService {
+	chatService := service.NewChatService(r)
+	chatService.SetModerator(moderator)
+	return chatService
+}
 
 // FEED SERVICE
 type FeedApp struct {
@@ -166,7 +201,7 @@ func ProvideFeedRepository(db *gorm.DB, mediaStorage *dbmongo.MediaStorage) *fee
 
 // Provide User Service Client
 func ProvideUserServiceClient(cfg *config.Config) (user2.UserServiceClient, func(), error) {
-	conn, err := grpc.Dial(fmt.Sprintf("localhost:%s", cfg.Server.UserServicePort), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%s", cfg.Server.UserServicePort), grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithUnaryInterceptor(common.ServiceTokenInterceptor()))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -179,12 +214,35 @@ func ProvideUserServiceClient(cfg *config.Config) (user2.UserServiceClient, func
 	return client, cleanup, nil
 }
 
-// Provide FeedService
+// Provide Notification Service Client
+func ProvideNotificationServiceClient(cfg *config.Config) (v1.NotificationServiceClient, func(), error) {
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%s", cfg.Server.NotifServicePort), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client := v1.NewNotificationServiceClient(conn)
+	cleanup := func() {
+		conn.Close()
+	}
+
+	return client, cleanup, nil
+}
+
+// Provide FeedService, with the configured ranker, engagement notifications and materialized timelines when enabled
 func ProvideFeedService(
 	repo *feed.FeedRepository,
 	userClient user2.UserServiceClient,
+	notifClient v1.NotificationServiceClient,
+	cfg *config.Config,
 ) *feed.FeedService {
-	return feed.NewFeedService(repo, repo, repo, userClient)
+	feedService := feed.NewFeedService(repo, repo, repo, repo, repo, repo, repo, repo, repo, repo, repo, userClient)
+	feedService.SetRanker(feed.NewScoringRanker(repo, cfg.Feed.Ranking))
+	feedService.SetNotifier(feed.NewEngagementNotifier(notifClient, userClient, time.Duration(cfg.Feed.ReactionNotifyWindow)*time.Second))
+	if cfg.Feed.MaterializedTimelines {
+		feedService.SetTimelineStore(repo, cfg.Feed.TimelineBackfillLimit)
+	}
+	return feedService
 }
 
 // Provide FeedHandlers
@@ -197,6 +255,7 @@ func ProvideFeedHandlers(feedService *feed.FeedService) *feed.FeedHandlers {
 // Provider Set
 var FeedProviderSet = wire.NewSet(config.LoadConfig, dbmysql.NewMySQL, dbmongo.NewMongoConnection, dbmongo.NewMediaStorage, ProvideFeedRepository,
 	ProvideUserServiceClient,
+	ProvideNotificationServiceClient,
 	ProvideFeedService,
 	ProvideFeedHandlers, wire.Struct(new(FeedApp), "*"),
 )
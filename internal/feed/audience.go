@@ -0,0 +1,219 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gosocial/internal/dbmysql"
+)
+
+const (
+	MaxAudienceListNameLength = 50
+	MaxAudienceListsPerUser   = 20
+	MaxAudienceListMembers    = 500
+)
+
+var (
+	ErrInvalidAudienceList   = errors.New("an audience list name is 1 to 50 characters")
+	ErrDuplicateAudienceList = errors.New("an audience list with this name already exists")
+	ErrTooManyAudienceLists  = errors.New("a user can have at most 20 audience lists")
+	ErrTooManyListMembers    = errors.New("an audience list has at most 500 members")
+	ErrNotAudienceListOwner  = errors.New("audience lists are private to their owner")
+	ErrNotAFriend            = errors.New("only friends can be added to an audience list")
+	ErrInvalidAudience       = errors.New("list privacy needs one of the author's audience lists")
+)
+
+// AudienceListSummary is a list with its members in the order they were added
+type AudienceListSummary struct {
+	List      dbmysql.AudienceList
+	MemberIDs []int64
+}
+
+// CreateAudienceList creates a named list of the owner's friends, content with list privacy is visible to its members only
+func (s *FeedService) CreateAudienceList(ctx context.Context, ownerID int64, name string, memberIDs []int64) (*dbmysql.AudienceList, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > MaxAudienceListNameLength {
+		return nil, ErrInvalidAudienceList
+	}
+
+	lists, err := s.audienceRepo.ListAudienceLists(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range lists {
+		if l.Name == name {
+			return nil, ErrDuplicateAudienceList
+		}
+	}
+	if len(lists) >= MaxAudienceListsPerUser {
+		return nil, ErrTooManyAudienceLists
+	}
+	if err := s.checkAudienceMembers(ctx, ownerID, nil, memberIDs); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	list := &dbmysql.AudienceList{OwnerID: ownerID, Name: name, CreatedAt: now, UpdatedAt: now}
+	if err := s.audienceRepo.CreateAudienceList(ctx, list); err != nil {
+		return nil, err
+	}
+	if err := s.audienceRepo.AddAudienceListMembers(ctx, list.ListID, memberIDs); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// ListAudienceLists returns the owner's lists in the order they were created
+func (s *FeedService) ListAudienceLists(ctx context.Context, ownerID int64) ([]AudienceListSummary, error) {
+	lists, err := s.audienceRepo.ListAudienceLists(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	summaries := make([]AudienceListSummary, 0, len(lists))
+	for _, l := range lists {
+		memberIDs, err := s.audienceRepo.ListAudienceListMembers(ctx, l.ListID)
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, AudienceListSummary{List: l, MemberIDs: memberIDs})
+	}
+	return summaries, nil
+}
+
+// AddAudienceListMembers adds friends of the owner to the list, they see what was shared with it before too
+func (s *FeedService) AddAudienceListMembers(ctx context.Context, ownerID, listID int64, userIDs []int64) error {
+	if _, err := s.ownAudienceList(ctx, ownerID, listID); err != nil {
+		return err
+	}
+	current, err := s.audienceRepo.ListAudienceListMembers(ctx, listID)
+	if err != nil {
+		return err
+	}
+	if err := s.checkAudienceMembers(ctx, ownerID, current, userIDs); err != nil {
+		return err
+	}
+	return s.audienceRepo.AddAudienceListMembers(ctx, listID, userIDs)
+}
+
+// RemoveAudienceListMembers takes users out of the list. Membership is checked whenever list content is read,
+// so they lose access to everything shared with the list at once
+func (s *FeedService) RemoveAudienceListMembers(ctx context.Context, ownerID, listID int64, userIDs []int64) error {
+	if _, err := s.ownAudienceList(ctx, ownerID, listID); err != nil {
+		return err
+	}
+	return s.audienceRepo.RemoveAudienceListMembers(ctx, listID, userIDs)
+}
+
+// DeleteAudienceList deletes the list, content shared with it stays visible to its author only
+func (s *FeedService) DeleteAudienceList(ctx context.Context, ownerID, listID int64) error {
+	if _, err := s.ownAudienceList(ctx, ownerID, listID); err != nil {
+		return err
+	}
+	return s.audienceRepo.DeleteAudienceList(ctx, listID)
+}
+
+func (s *FeedService) ownAudienceList(ctx context.Context, ownerID, listID int64) (*dbmysql.AudienceList, error) {
+	list, err := s.audienceRepo.GetAudienceList(ctx, listID)
+	if err != nil {
+		return nil, err
+	}
+	if list.OwnerID != ownerID {
+		return nil, ErrNotAudienceListOwner
+	}
+	return list, nil
+}
+
+// checkAudienceMembers makes sure the users to add are friends of the owner and fit in the list next to current
+func (s *FeedService) checkAudienceMembers(ctx context.Context, ownerID int64, current, userIDs []int64) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	members := make(map[int64]bool, len(current)+len(userIDs))
+	for _, id := range current {
+		members[id] = true
+	}
+	for _, id := range userIDs {
+		members[id] = true
+	}
+	if len(members) > MaxAudienceListMembers {
+		return ErrTooManyListMembers
+	}
+
+	friendIDs, err := s.GetUserFriendIDs(ctx, ownerID)
+	if err != nil {
+		return err
+	}
+	friends := make(map[int64]bool, len(friendIDs))
+	for _, id := range friendIDs {
+		friends[id] = true
+	}
+	for _, id := range userIDs {
+		if !friends[id] {
+			return ErrNotAFriend
+		}
+	}
+	return nil
+}
+
+// checkAudience makes sure list content points at one of its author's lists, other privacies drop the list
+func (s *FeedService) checkAudience(ctx context.Context, content *dbmysql.Content) error {
+	if content.Privacy != "list" {
+		content.AudienceListID = nil
+		return nil
+	}
+	if content.AudienceListID == nil {
+		return ErrInvalidAudience
+	}
+	list, err := s.audienceRepo.GetAudienceList(ctx, *content.AudienceListID)
+	if err != nil || list.OwnerID != content.AuthorID {
+		return ErrInvalidAudience
+	}
+	return nil
+}
+
+// audienceMembers returns the users list content is shared with, nothing once its list is gone
+func (s *FeedService) audienceMembers(ctx context.Context, content *dbmysql.Content) ([]int64, error) {
+	if content.AudienceListID == nil {
+		return nil, nil
+	}
+	return s.audienceRepo.ListAudienceListMembers(ctx, *content.AudienceListID)
+}
+
+// listMembership remembers whether the viewer is in each audience list while filtering a batch of content
+type listMembership struct {
+	s        *FeedService
+	viewerID int64
+	member   map[int64]bool
+}
+
+func (s *FeedService) newListMembership(viewerID int64) *listMembership {
+	return &listMembership{s: s, viewerID: viewerID, member: map[int64]bool{}}
+}
+
+// canSee tells whether list content is shared with the viewer
+func (m *listMembership) canSee(ctx context.Context, content *dbmysql.Content) (bool, error) {
+	if content.AudienceListID == nil {
+		return false, nil
+	}
+	listID := *content.AudienceListID
+	if member, ok := m.member[listID]; ok {
+		return member, nil
+	}
+	member, err := m.s.audienceRepo.IsAudienceListMember(ctx, listID, m.viewerID)
+	if err != nil {
+		return false, err
+	}
+	m.member[listID] = member
+	return member, nil
+}
+
+// audienceListRef turns an audience list ID from a request into the content field, 0 means none
+func audienceListRef(listID int64) *int64 {
+	if listID <= 0 {
+		return nil
+	}
+	return &listID
+}
@@ -0,0 +1,219 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	userpb "gosocial/api/v1/user"
+
+	"google.golang.org/grpc"
+	"gorm.io/gorm"
+)
+
+// author 1 is friends with 2 and 4, user 3 is a stranger
+func newAudienceService() (*FeedService, *fakeContentRepo, *fakeAudienceRepo) {
+	friends := map[int64][]int64{1: {2, 4}, 2: {1}, 4: {1}}
+	uc := &fakeUserClient{
+		ListFn: func(ctx context.Context, in *userpb.UserID, _ ...grpc.CallOption) (*userpb.FriendList, error) {
+			list := &userpb.FriendList{}
+			for _, id := range friends[in.UserId] {
+				list.Friends = append(list.Friends, &userpb.Friend{UserId: id})
+			}
+			return list, nil
+		},
+	}
+	svc := newTestFeedService(uc)
+	return svc, svc.contentRepo.(*fakeContentRepo), svc.audienceRepo.(*fakeAudienceRepo)
+}
+
+func TestAudience_ListManagement(t *testing.T) {
+	svc, _, _ := newAudienceService()
+	ctx := context.Background()
+
+	if _, err := svc.CreateAudienceList(ctx, 1, "  ", nil); !errors.Is(err, ErrInvalidAudienceList) {
+		t.Fatalf("expected ErrInvalidAudienceList for a blank name, got %v", err)
+	}
+	if _, err := svc.CreateAudienceList(ctx, 1, "Close Friends", []int64{2, 3}); !errors.Is(err, ErrNotAFriend) {
+		t.Fatalf("expected ErrNotAFriend for a stranger, got %v", err)
+	}
+	list, err := svc.CreateAudienceList(ctx, 1, " Close Friends ", []int64{2})
+	if err != nil || list.Name != "Close Friends" {
+		t.Fatalf("CreateAudienceList mismatch: %+v err=%v", list, err)
+	}
+	if _, err := svc.CreateAudienceList(ctx, 1, "Close Friends", nil); !errors.Is(err, ErrDuplicateAudienceList) {
+		t.Fatalf("expected ErrDuplicateAudienceList, got %v", err)
+	}
+
+	if err := svc.AddAudienceListMembers(ctx, 2, list.ListID, []int64{1}); !errors.Is(err, ErrNotAudienceListOwner) {
+		t.Fatalf("expected ErrNotAudienceListOwner, got %v", err)
+	}
+	if err := svc.AddAudienceListMembers(ctx, 1, 99, []int64{4}); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("expected not found for an unknown list, got %v", err)
+	}
+	if err := svc.AddAudienceListMembers(ctx, 1, list.ListID, []int64{4, 2}); err != nil {
+		t.Fatalf("AddAudienceListMembers err: %v", err)
+	}
+	lists, err := svc.ListAudienceLists(ctx, 1)
+	if err != nil || len(lists) != 1 || fmt.Sprint(lists[0].MemberIDs) != "[2 4]" {
+		t.Fatalf("ListAudienceLists mismatch: %+v err=%v", lists, err)
+	}
+
+	if err := svc.RemoveAudienceListMembers(ctx, 1, list.ListID, []int64{4}); err != nil {
+		t.Fatalf("RemoveAudienceListMembers err: %v", err)
+	}
+	lists, _ = svc.ListAudienceLists(ctx, 1)
+	if fmt.Sprint(lists[0].MemberIDs) != "[2]" {
+		t.Fatalf("expected only member 2 left, got %v", lists[0].MemberIDs)
+	}
+
+	if err := svc.DeleteAudienceList(ctx, 2, list.ListID); !errors.Is(err, ErrNotAudienceListOwner) {
+		t.Fatalf("expected ErrNotAudienceListOwner on delete, got %v", err)
+	}
+	if err := svc.DeleteAudienceList(ctx, 1, list.ListID); err != nil {
+		t.Fatalf("DeleteAudienceList err: %v", err)
+	}
+	if lists, _ := svc.ListAudienceLists(ctx, 1); len(lists) != 0 {
+		t.Fatalf("expected no lists after delete, got %+v", lists)
+	}
+
+	for i := 0; i < MaxAudienceListsPerUser; i++ {
+		if _, err := svc.CreateAudienceList(ctx, 1, fmt.Sprintf("list %d", i), nil); err != nil {
+			t.Fatalf("CreateAudienceList %d err: %v", i, err)
+		}
+	}
+	if _, err := svc.CreateAudienceList(ctx, 1, "one too many", nil); !errors.Is(err, ErrTooManyAudienceLists) {
+		t.Fatalf("expected ErrTooManyAudienceLists, got %v", err)
+	}
+}
+
+func TestAudience_ListContentFollowsMembership(t *testing.T) {
+	svc, _, _ := newAudienceService()
+	ctx := context.Background()
+
+	list, _ := svc.CreateAudienceList(ctx, 1, "Close Friends", []int64{2})
+	other, _ := svc.CreateAudienceList(ctx, 2, "Mine", nil)
+
+	if _, err := svc.CreatePost(ctx, 1, "hi", nil, "", "", "list", 0, nil); !errors.Is(err, ErrInvalidAudience) {
+		t.Fatalf("expected ErrInvalidAudience without a list, got %v", err)
+	}
+	if _, err := svc.CreatePost(ctx, 1, "hi", nil, "", "", "list", other.ListID, nil); !errors.Is(err, ErrInvalidAudience) {
+		t.Fatalf("expected ErrInvalidAudience for someone else's list, got %v", err)
+	}
+	id, err := svc.CreatePost(ctx, 1, "close friends only", nil, "", "", "list", list.ListID, nil)
+	if err != nil {
+		t.Fatalf("CreatePost err: %v", err)
+	}
+	// a list given with another privacy is dropped
+	publicID, _ := svc.CreatePost(ctx, 1, "everyone", nil, "", "", "public", list.ListID, nil)
+	if c, _, _ := svc.GetContent(ctx, publicID); c.AudienceListID != nil {
+		t.Fatalf("public content should not keep an audience list, got %v", *c.AudienceListID)
+	}
+
+	visibleTo := func(viewer int64) (content, profile, timeline bool) {
+		_, _, err := svc.GetVisibleContent(ctx, viewer, id)
+		content = err == nil
+		if err != nil && !errors.Is(err, ErrContentNotVisible) {
+			t.Fatalf("viewer %d: unexpected error %v", viewer, err)
+		}
+		contents, _, err := svc.GetUserContent(ctx, viewer, 1)
+		if err != nil {
+			t.Fatalf("GetUserContent err: %v", err)
+		}
+		for _, c := range contents {
+			profile = profile || c.ContentID == id
+		}
+		page, err := svc.GetTimeline(ctx, viewer, TimelineQuery{})
+		if err != nil {
+			t.Fatalf("GetTimeline err: %v", err)
+		}
+		for _, c := range page.Contents {
+			timeline = timeline || c.ContentID == id
+		}
+		return
+	}
+
+	for _, c := range []struct {
+		viewer int64
+		want   bool
+	}{{1, true}, {2, true}, {4, false}, {3, false}} {
+		content, profile, _ := visibleTo(c.viewer)
+		if content != c.want || (c.viewer != 1 && profile != c.want) {
+			t.Errorf("viewer %d: content=%v profile=%v, want %v", c.viewer, content, profile, c.want)
+		}
+	}
+	if _, _, timeline := visibleTo(2); !timeline {
+		t.Fatalf("member should see list content in their timeline")
+	}
+	if _, _, timeline := visibleTo(4); timeline {
+		t.Fatalf("friend outside the list should not see list content in their timeline")
+	}
+
+	// removing a member revokes access to what was shared before
+	if err := svc.RemoveAudienceListMembers(ctx, 1, list.ListID, []int64{2}); err != nil {
+		t.Fatalf("RemoveAudienceListMembers err: %v", err)
+	}
+	if content, profile, timeline := visibleTo(2); content || profile || timeline {
+		t.Fatalf("removed member still sees list content: content=%v profile=%v timeline=%v", content, profile, timeline)
+	}
+	if content, _, _ := visibleTo(1); !content {
+		t.Fatalf("author should keep seeing their list content")
+	}
+}
+
+func TestAudience_FanOutReachesMembersOnly(t *testing.T) {
+	svc, cRepo, _ := newAudienceService()
+	store := newFakeTimelineStore(cRepo)
+	svc.SetTimelineStore(store, 10)
+	ctx := context.Background()
+
+	list, _ := svc.CreateAudienceList(ctx, 1, "Close Friends", []int64{2})
+	id, err := svc.CreateStory(ctx, 1, []byte("img"), "image", "s.png", 60, "list", list.ListID)
+	if err != nil {
+		t.Fatalf("CreateStory err: %v", err)
+	}
+	if !store.has(1, id) || !store.has(2, id) || store.has(4, id) {
+		t.Fatalf("list story should reach its author and list members only, entries=%v", store.entries)
+	}
+
+	if err := svc.RemoveAudienceListMembers(ctx, 1, list.ListID, []int64{2}); err != nil {
+		t.Fatalf("RemoveAudienceListMembers err: %v", err)
+	}
+	page, err := svc.GetTimeline(ctx, 2, TimelineQuery{})
+	if err != nil || len(page.Contents) != 0 {
+		t.Fatalf("a stale timeline entry should not show list content to a removed member, got %+v err=%v", page, err)
+	}
+}
+
+func TestAudience_UpdateAndShareListContent(t *testing.T) {
+	svc, _, _ := newAudienceService()
+	ctx := context.Background()
+
+	list, _ := svc.CreateAudienceList(ctx, 1, "Close Friends", []int64{2})
+	id, _ := svc.CreatePost(ctx, 1, "hello", nil, "", "", "friends", 0, nil)
+
+	listPrivacy := "list"
+	if _, err := svc.UpdateContent(ctx, 1, id, ContentUpdate{Privacy: &listPrivacy}); !errors.Is(err, ErrInvalidAudience) {
+		t.Fatalf("expected ErrInvalidAudience without a list, got %v", err)
+	}
+	updated, err := svc.UpdateContent(ctx, 1, id, ContentUpdate{Privacy: &listPrivacy, AudienceListID: &list.ListID})
+	if err != nil || updated.AudienceListID == nil || *updated.AudienceListID != list.ListID {
+		t.Fatalf("UpdateContent mismatch: %+v err=%v", updated, err)
+	}
+	if _, _, err := svc.GetVisibleContent(ctx, 4, id); !errors.Is(err, ErrContentNotVisible) {
+		t.Fatalf("friend outside the list should lose access, got %v", err)
+	}
+
+	// a member's share of list content stays private unless they pick a narrower audience
+	if _, err := svc.ShareContent(ctx, 2, id, "", "friends"); !errors.Is(err, ErrSharePrivacy) {
+		t.Fatalf("expected ErrSharePrivacy, got %v", err)
+	}
+	shareID, err := svc.ShareContent(ctx, 2, id, "", "")
+	if err != nil {
+		t.Fatalf("ShareContent err: %v", err)
+	}
+	if share, _, _ := svc.GetContent(ctx, shareID); share.Privacy != "private" {
+		t.Fatalf("share of list content should default to private, got %q", share.Privacy)
+	}
+}
@@ -0,0 +1,143 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	"gosocial/internal/common"
+	"gosocial/internal/dbmysql"
+)
+
+const MaxPostMedia = 10
+
+var ErrInvalidMedia = errors.New("a post holds 1 to 10 images or videos, each with data and a name")
+
+// MediaUpload is one attachment of a carousel post, Type is image or video
+type MediaUpload struct {
+	Data []byte
+	Name string
+	Type string
+}
+
+// MediaItem is one media of a content as clients get it
+type MediaItem struct {
+	URL  string
+	Type string
+}
+
+// CreateCarouselPost creates a post holding the media in the given order. Every file is uploaded before the
+// post is saved, and the uploads are removed again if anything fails
+func (s *FeedService) CreateCarouselPost(ctx context.Context, authorID int64, text string, media []MediaUpload, privacy string, audienceListID int64, publishAt *time.Time) (int64, error) {
+	if len(media) == 0 || len(media) > MaxPostMedia {
+		return 0, ErrInvalidMedia
+	}
+	for _, m := range media {
+		if len(m.Data) == 0 || m.Name == "" || !common.MediaFileType(m.Type).IsValid() {
+			return 0, ErrInvalidMedia
+		}
+	}
+
+	var uploaded []int64
+	cleanup := func() {
+		for _, id := range uploaded {
+			if err := s.mediaRepo.DeleteMedia(ctx, id); err != nil {
+				log.Printf("failed to delete media %d of a failed post: %v", id, err)
+			}
+		}
+	}
+	content := &dbmysql.Content{
+		AuthorID:       authorID,
+		Type:           "POST",
+		Privacy:        privacy,
+		AudienceListID: audienceListRef(audienceListID),
+		PublishAt:      publishAt,
+	}
+	if text != "" {
+		content.TextContent = &text
+	}
+	// refuse a bad audience or publish time before anything is uploaded
+	if err := s.checkAudience(ctx, content); err != nil {
+		return 0, err
+	}
+	if publishAt != nil {
+		if err := checkPublishAt(content, time.Now()); err != nil {
+			return 0, err
+		}
+	}
+	for i, m := range media {
+		ref := &dbmysql.MediaRef{
+			Type:       m.Type,
+			FileName:   m.Name,
+			UploadedBy: strconv.FormatInt(authorID, 10),
+		}
+		if err := s.mediaRepo.CreateMediaRef(ctx, ref, m.Data); err != nil {
+			cleanup()
+			return 0, err
+		}
+		id := int64(ref.MediaRefID)
+		uploaded = append(uploaded, id)
+		content.Media = append(content.Media, dbmysql.ContentMedia{Position: i + 1, MediaRefID: id})
+	}
+	// the first item stands for the post wherever a single media URL is shown
+	content.MediaRefID = &uploaded[0]
+
+	id, err := s.CreateContent(ctx, content, nil, "", "")
+	if err != nil {
+		cleanup()
+		return 0, err
+	}
+	return id, nil
+}
+
+// ListMedia returns the ordered media of each content, content without carousel items has its single media if any
+func (s *FeedService) ListMedia(ctx context.Context, contents []dbmysql.Content) (map[int64][]MediaItem, error) {
+	ids := make([]int64, 0, len(contents))
+	for _, c := range contents {
+		ids = append(ids, c.ContentID)
+	}
+	carousels, err := s.contentRepo.ListContentMedia(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	var refIDs []int64
+	for _, c := range contents {
+		refIDs = append(refIDs, contentMediaRefIDs(&c, carousels[c.ContentID])...)
+	}
+	refs, err := s.mediaRepo.ListMediaRefsByIDs(ctx, refIDs)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]dbmysql.MediaRef, len(refs))
+	for _, ref := range refs {
+		byID[int64(ref.MediaRefID)] = ref
+	}
+
+	media := make(map[int64][]MediaItem, len(contents))
+	for _, c := range contents {
+		for _, id := range contentMediaRefIDs(&c, carousels[c.ContentID]) {
+			if ref, ok := byID[id]; ok {
+				media[c.ContentID] = append(media[c.ContentID], MediaItem{URL: GetMediaURL(ref.FileID), Type: ref.Type})
+			}
+		}
+	}
+	return media, nil
+}
+
+// contentMediaRefIDs lists the media of a content in order, the carousel items or else its single media
+func contentMediaRefIDs(content *dbmysql.Content, carousel []dbmysql.ContentMedia) []int64 {
+	if len(carousel) > 0 {
+		ids := make([]int64, 0, len(carousel))
+		for _, m := range carousel {
+			ids = append(ids, m.MediaRefID)
+		}
+		return ids
+	}
+	if content.MediaRefID != nil {
+		return []int64{*content.MediaRefID}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gosocial/internal/dbmysql"
+)
+
+func uploads(names ...string) []MediaUpload {
+	var media []MediaUpload
+	for i, name := range names {
+		kind := "image"
+		if i%2 == 1 {
+			kind = "video"
+		}
+		media = append(media, MediaUpload{Data: []byte(name), Name: name, Type: kind})
+	}
+	return media
+}
+
+func TestCarousel_CreateListDelete(t *testing.T) {
+	svc, cRepo, _ := newCommentService()
+	mRepo := svc.mediaRepo.(*fakeMediaRepo)
+	ctx := context.Background()
+
+	id, err := svc.CreateCarouselPost(ctx, 1, "trip", uploads("a.jpg", "b.mp4", "c.jpg"), "public", 0, nil)
+	if err != nil {
+		t.Fatalf("CreateCarouselPost err: %v", err)
+	}
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 1, Type: "POST", Privacy: "public"})
+	single, _ := svc.CreatePost(ctx, 1, "", []byte("d"), "d.jpg", "image", "public", 0, nil)
+
+	contents := []dbmysql.Content{cRepo.m[id], cRepo.m[2], cRepo.m[single]}
+	media, err := svc.ListMedia(ctx, contents)
+	if err != nil {
+		t.Fatalf("ListMedia err: %v", err)
+	}
+	if got := media[id]; len(got) != 3 || got[0].Type != "image" || got[1].Type != "video" {
+		t.Fatalf("carousel should list its media in order, got %+v", got)
+	}
+	if *cRepo.m[id].MediaRefID != 1 {
+		t.Fatalf("the first item should stand for the post, got %d", *cRepo.m[id].MediaRefID)
+	}
+	if len(media[2]) != 0 || len(media[single]) != 1 {
+		t.Fatalf("unexpected media for text and single-media posts: %+v", media)
+	}
+
+	if err := svc.DeleteContent(ctx, 1, id); err != nil {
+		t.Fatalf("DeleteContent err: %v", err)
+	}
+	if len(mRepo.meta) != 1 {
+		t.Fatalf("deleting the carousel should delete all its files, %d left", len(mRepo.meta))
+	}
+}
+
+func TestCarousel_InvalidOrFailedUploads(t *testing.T) {
+	svc, cRepo, _ := newCommentService()
+	mRepo := svc.mediaRepo.(*fakeMediaRepo)
+	ctx := context.Background()
+
+	tooMany := uploads("1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11")
+	cases := []struct {
+		name  string
+		media []MediaUpload
+	}{
+		{"no media", nil},
+		{"more than ten", tooMany},
+		{"missing data", []MediaUpload{{Name: "a.jpg", Type: "image"}}},
+		{"unknown type", []MediaUpload{{Data: []byte("a"), Name: "a.pdf", Type: "document"}}},
+	}
+	for _, c := range cases {
+		if _, err := svc.CreateCarouselPost(ctx, 1, "", c.media, "public", 0, nil); !errors.Is(err, ErrInvalidMedia) {
+			t.Errorf("%s: expected ErrInvalidMedia, got %v", c.name, err)
+		}
+	}
+
+	mRepo.failName = "c.jpg"
+	if _, err := svc.CreateCarouselPost(ctx, 1, "", uploads("a.jpg", "b.mp4", "c.jpg"), "public", 0, nil); err == nil {
+		t.Fatalf("expected the failed upload to fail the post")
+	}
+	if len(mRepo.meta) != 0 || len(cRepo.m) != 0 {
+		t.Fatalf("a failed post should leave no files or content, got %d files and %d contents", len(mRepo.meta), len(cRepo.m))
+	}
+}
@@ -0,0 +1,210 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gosocial/internal/dbmysql"
+)
+
+const (
+	// content saved without naming a collection goes here
+	DefaultCollectionName   = "Saved"
+	MaxCollectionNameLength = 50
+	MaxCollectionsPerUser   = 100
+	DefaultSavedPageSize    = 20
+	MaxSavedPageSize        = 100
+)
+
+var (
+	ErrInvalidCollection  = errors.New("a collection name is at most 50 characters")
+	ErrTooManyCollections = errors.New("a user can have at most 100 collections")
+	ErrNotSaveable        = errors.New("only posts and reels can be saved")
+	ErrNotCollectionOwner = errors.New("collections are private to their owner")
+)
+
+// CollectionSummary is a collection with the number of items in it
+type CollectionSummary struct {
+	Collection dbmysql.Collection
+	ItemCount  int64
+}
+
+// SavedView is a saved item as its owner sees it now. Content is nil once the owner may no longer see the
+// saved content, so the item shows as a placeholder instead of leaking it
+type SavedView struct {
+	Item     dbmysql.SavedItem
+	Content  *dbmysql.Content
+	MediaURL string
+}
+
+type SavedPage struct {
+	Items      []SavedView
+	NextCursor string
+}
+
+// SaveContent adds a post or reel the user may see to one of their collections, an empty name picks the
+// default one. Collections are created on first use and saving twice is a no-op
+func (s *FeedService) SaveContent(ctx context.Context, userID, contentID int64, collectionName string) (*dbmysql.Collection, error) {
+	name := strings.TrimSpace(collectionName)
+	if name == "" {
+		name = DefaultCollectionName
+	}
+	if utf8.RuneCountInString(name) > MaxCollectionNameLength {
+		return nil, ErrInvalidCollection
+	}
+
+	content, err := s.visibleContent(ctx, userID, contentID)
+	if err != nil {
+		return nil, err
+	}
+	if content.Type != "POST" && content.Type != "REEL" {
+		return nil, ErrNotSaveable
+	}
+
+	collections, err := s.collectionRepo.ListCollections(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	exists := false
+	for _, c := range collections {
+		if c.Name == name {
+			exists = true
+			break
+		}
+	}
+	if !exists && len(collections) >= MaxCollectionsPerUser {
+		return nil, ErrTooManyCollections
+	}
+
+	collection, err := s.collectionRepo.GetOrCreateCollection(ctx, userID, name)
+	if err != nil {
+		return nil, err
+	}
+	item := &dbmysql.SavedItem{CollectionID: collection.CollectionID, ContentID: contentID, SavedAt: time.Now()}
+	if err := s.collectionRepo.SaveItem(ctx, item); err != nil {
+		return nil, err
+	}
+	return collection, nil
+}
+
+// UnsaveContent removes the content from one of the user's collections, or from all of them when collectionID is 0
+func (s *FeedService) UnsaveContent(ctx context.Context, userID, contentID, collectionID int64) error {
+	var ids []int64
+	if collectionID != 0 {
+		if _, err := s.ownCollection(ctx, userID, collectionID); err != nil {
+			return err
+		}
+		ids = []int64{collectionID}
+	} else {
+		collections, err := s.collectionRepo.ListCollections(ctx, userID)
+		if err != nil {
+			return err
+		}
+		for _, c := range collections {
+			ids = append(ids, c.CollectionID)
+		}
+	}
+	return s.collectionRepo.UnsaveItem(ctx, ids, contentID)
+}
+
+// ListCollections returns the user's collections in the order they were created
+func (s *FeedService) ListCollections(ctx context.Context, userID int64) ([]CollectionSummary, error) {
+	collections, err := s.collectionRepo.ListCollections(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, 0, len(collections))
+	for _, c := range collections {
+		ids = append(ids, c.CollectionID)
+	}
+	counts, err := s.collectionRepo.CountSavedItems(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make([]CollectionSummary, 0, len(collections))
+	for _, c := range collections {
+		summaries = append(summaries, CollectionSummary{Collection: c, ItemCount: counts[c.CollectionID]})
+	}
+	return summaries, nil
+}
+
+// ListSavedContent pages through a collection of the user, most recently saved first. Saved content the user
+// may no longer see comes back without its Content
+func (s *FeedService) ListSavedContent(ctx context.Context, userID, collectionID int64, cursor string, pageSize int) (*SavedPage, error) {
+	beforeID, err := decodeIDCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	if pageSize <= 0 {
+		pageSize = DefaultSavedPageSize
+	}
+	if pageSize > MaxSavedPageSize {
+		pageSize = MaxSavedPageSize
+	}
+
+	if _, err := s.ownCollection(ctx, userID, collectionID); err != nil {
+		return nil, err
+	}
+	items, err := s.collectionRepo.ListSavedItems(ctx, collectionID, beforeID, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &SavedPage{}
+	if len(items) > pageSize {
+		items = items[:pageSize]
+		page.NextCursor = encodeIDCursor(items[pageSize-1].SaveID)
+	}
+
+	ids := make([]int64, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ContentID)
+	}
+	contents, err := s.contentRepo.ListContentsByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	var visible []dbmysql.Content
+	for _, content := range contents {
+		ok, err := s.canView(ctx, userID, &content)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			visible = append(visible, content)
+		}
+	}
+	urls, err := s.mediaURLs(ctx, visible)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]int, len(visible))
+	for i, content := range visible {
+		byID[content.ContentID] = i
+	}
+
+	for _, item := range items {
+		view := SavedView{Item: item}
+		if i, ok := byID[item.ContentID]; ok {
+			view.Content = &visible[i]
+			view.MediaURL = urls[i]
+		}
+		page.Items = append(page.Items, view)
+	}
+	return page, nil
+}
+
+func (s *FeedService) ownCollection(ctx context.Context, userID, collectionID int64) (*dbmysql.Collection, error) {
+	collection, err := s.collectionRepo.GetCollectionByID(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	if collection.OwnerID != userID {
+		return nil, ErrNotCollectionOwner
+	}
+	return collection, nil
+}
@@ -0,0 +1,108 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"gosocial/internal/dbmysql"
+)
+
+func TestCollections_SaveAndList(t *testing.T) {
+	svc, cRepo, _ := newCommentService()
+	ctx := context.Background()
+
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 1, Type: "POST", Privacy: "public"})
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 1, Type: "REEL", Privacy: "friends"})
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 1, Type: "STORY", Privacy: "public"})
+
+	cases := []struct {
+		name       string
+		user       int64
+		content    int64
+		collection string
+		want       error
+	}{
+		{"story", 2, 3, "", ErrNotSaveable},
+		{"friends-only reel by a stranger", 3, 2, "", ErrContentNotVisible},
+		{"name too long", 2, 1, strings.Repeat("a", MaxCollectionNameLength+1), ErrInvalidCollection},
+	}
+	for _, c := range cases {
+		if _, err := svc.SaveContent(ctx, c.user, c.content, c.collection); !errors.Is(err, c.want) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.want, err)
+		}
+	}
+
+	saved, err := svc.SaveContent(ctx, 2, 1, "  ")
+	if err != nil || saved.Name != DefaultCollectionName {
+		t.Fatalf("empty name should save into the default collection, got %+v err=%v", saved, err)
+	}
+	if _, err := svc.SaveContent(ctx, 2, 2, DefaultCollectionName); err != nil {
+		t.Fatalf("SaveContent err: %v", err)
+	}
+	// saving twice keeps a single item
+	if _, err := svc.SaveContent(ctx, 2, 1, ""); err != nil {
+		t.Fatalf("SaveContent err: %v", err)
+	}
+	recipes, _ := svc.SaveContent(ctx, 2, 1, "Recipes")
+
+	collections, err := svc.ListCollections(ctx, 2)
+	if err != nil || len(collections) != 2 {
+		t.Fatalf("ListCollections mismatch: %+v err=%v", collections, err)
+	}
+	if collections[0].Collection.Name != DefaultCollectionName || collections[0].ItemCount != 2 || collections[1].ItemCount != 1 {
+		t.Fatalf("unexpected collections %+v", collections)
+	}
+
+	page, err := svc.ListSavedContent(ctx, 2, saved.CollectionID, "", 1)
+	if err != nil || len(page.Items) != 1 || page.Items[0].Item.ContentID != 2 || page.NextCursor == "" {
+		t.Fatalf("first page should hold the latest save, got %+v err=%v", page, err)
+	}
+	page, err = svc.ListSavedContent(ctx, 2, saved.CollectionID, page.NextCursor, 1)
+	if err != nil || len(page.Items) != 1 || page.Items[0].Item.ContentID != 1 || page.NextCursor != "" {
+		t.Fatalf("second page mismatch: %+v err=%v", page, err)
+	}
+
+	// collections are private
+	if _, err := svc.ListSavedContent(ctx, 3, saved.CollectionID, "", 0); !errors.Is(err, ErrNotCollectionOwner) {
+		t.Fatalf("expected ErrNotCollectionOwner, got %v", err)
+	}
+	if err := svc.UnsaveContent(ctx, 3, 1, recipes.CollectionID); !errors.Is(err, ErrNotCollectionOwner) {
+		t.Fatalf("expected ErrNotCollectionOwner, got %v", err)
+	}
+
+	// without a collection the content leaves all of them
+	if err := svc.UnsaveContent(ctx, 2, 1, 0); err != nil {
+		t.Fatalf("UnsaveContent err: %v", err)
+	}
+	collections, _ = svc.ListCollections(ctx, 2)
+	if collections[0].ItemCount != 1 || collections[1].ItemCount != 0 {
+		t.Fatalf("content should leave every collection, got %+v", collections)
+	}
+}
+
+func TestCollections_InvisibleContentBecomesPlaceholder(t *testing.T) {
+	svc, cRepo, _ := newCommentService()
+	ctx := context.Background()
+
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 1, Type: "POST", Privacy: "public", TextContent: sptr("a")})
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 1, Type: "POST", Privacy: "public", TextContent: sptr("b")})
+	collection, _ := svc.SaveContent(ctx, 3, 1, "")
+	_, _ = svc.SaveContent(ctx, 3, 2, "")
+
+	if err := svc.UpdateContentPrivacy(ctx, 1, 1, "friends"); err != nil {
+		t.Fatalf("UpdateContentPrivacy err: %v", err)
+	}
+	if err := svc.DeleteContent(ctx, 1, 2); err != nil {
+		t.Fatalf("DeleteContent err: %v", err)
+	}
+
+	page, err := svc.ListSavedContent(ctx, 3, collection.CollectionID, "", 0)
+	if err != nil || len(page.Items) != 1 {
+		t.Fatalf("deleted content should drop out, got %+v err=%v", page, err)
+	}
+	if item := page.Items[0]; item.Item.ContentID != 1 || item.Content != nil {
+		t.Fatalf("content that is no longer visible should be a placeholder, got %+v", item)
+	}
+}
@@ -0,0 +1,200 @@
+package feed
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gosocial/internal/dbmysql"
+)
+
+const (
+	MaxCommentLength       = 2000
+	DefaultCommentPageSize = 20
+	MaxCommentPageSize     = 100
+)
+
+var (
+	ErrInvalidComment      = errors.New("comment must be between 1 and 2000 characters")
+	ErrContentNotVisible   = errors.New("content is not visible to this user")
+	ErrInvalidReplyParent  = errors.New("replies must target a top-level comment on the same content")
+	ErrNotCommentAuthor    = errors.New("only the comment author can edit this comment")
+	ErrCannotDeleteComment = errors.New("only the comment author or the content author can delete this comment")
+)
+
+// CommentPage is one page of comments, ReplyCounts is only filled for top-level comments
+type CommentPage struct {
+	Comments    []dbmysql.Comment
+	ReplyCounts map[int64]int64
+	NextCursor  string
+}
+
+// AddComment comments on a content, or replies to a top-level comment when parentID is set
+func (s *FeedService) AddComment(ctx context.Context, authorID, contentID, parentID int64, text string) (*dbmysql.Comment, error) {
+	text, err := validateCommentText(text)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 1: The commenter must be able to see the content
+	content, err := s.visibleContent(ctx, authorID, contentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2: Replies only go one level deep
+	comment := &dbmysql.Comment{
+		ContentID: contentID,
+		AuthorID:  authorID,
+		Text:      text,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	if parentID > 0 {
+		parent, err := s.commentRepo.GetCommentByID(ctx, parentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent.ContentID != contentID || parent.ParentID != nil {
+			return nil, ErrInvalidReplyParent
+		}
+		comment.ParentID = &parentID
+	}
+
+	if err := s.commentRepo.CreateComment(ctx, comment); err != nil {
+		return nil, err
+	}
+
+	// Step 3: Tell the content author, never about their own comments
+	if s.notifier != nil && content.AuthorID != authorID {
+		s.notifier.CommentAdded(content, comment)
+	}
+	return comment, nil
+}
+
+// ListComments pages through the top-level comments of a content, or the replies of parentID, oldest first
+func (s *FeedService) ListComments(ctx context.Context, viewerID, contentID, parentID int64, cursor string, pageSize int) (*CommentPage, error) {
+	afterID, err := decodeIDCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	if pageSize <= 0 {
+		pageSize = DefaultCommentPageSize
+	}
+	if pageSize > MaxCommentPageSize {
+		pageSize = MaxCommentPageSize
+	}
+
+	if _, err := s.visibleContent(ctx, viewerID, contentID); err != nil {
+		return nil, err
+	}
+
+	var parent *int64
+	if parentID > 0 {
+		parent = &parentID
+	}
+	comments, err := s.commentRepo.ListComments(ctx, contentID, parent, afterID, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &CommentPage{}
+	if len(comments) > pageSize {
+		comments = comments[:pageSize]
+		page.NextCursor = encodeIDCursor(comments[pageSize-1].CommentID)
+	}
+	page.Comments = comments
+
+	if parent == nil {
+		ids := make([]int64, 0, len(comments))
+		for _, c := range comments {
+			ids = append(ids, c.CommentID)
+		}
+		page.ReplyCounts, err = s.commentRepo.CountReplies(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return page, nil
+}
+
+func (s *FeedService) EditComment(ctx context.Context, editorID, commentID int64, text string) (*dbmysql.Comment, error) {
+	text, err := validateCommentText(text)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.commentRepo.GetCommentByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.AuthorID != editorID {
+		return nil, ErrNotCommentAuthor
+	}
+
+	now := time.Now()
+	if err := s.commentRepo.UpdateCommentText(ctx, commentID, text, now); err != nil {
+		return nil, err
+	}
+	comment.Text = text
+	comment.EditedAt = &now
+	comment.UpdatedAt = now
+	return comment, nil
+}
+
+// DeleteComment removes a comment and its replies, allowed for the comment author and the content author
+func (s *FeedService) DeleteComment(ctx context.Context, requesterID, commentID int64) error {
+	comment, err := s.commentRepo.GetCommentByID(ctx, commentID)
+	if err != nil {
+		return err
+	}
+
+	if comment.AuthorID != requesterID {
+		content, err := s.contentRepo.GetContentByID(ctx, comment.ContentID)
+		if err != nil {
+			return err
+		}
+		if content.AuthorID != requesterID {
+			return ErrCannotDeleteComment
+		}
+	}
+
+	return s.commentRepo.DeleteComment(ctx, commentID)
+}
+
+// CountComments returns the number of comments of each content, including replies
+func (s *FeedService) CountComments(ctx context.Context, contentIDs []int64) (map[int64]int64, error) {
+	return s.commentRepo.CountComments(ctx, contentIDs)
+}
+
+func validateCommentText(text string) (string, error) {
+	text = strings.TrimSpace(text)
+	if text == "" || utf8.RuneCountInString(text) > MaxCommentLength {
+		return "", ErrInvalidComment
+	}
+	return text, nil
+}
+
+// id cursors carry the id of the last row of a page, for rows ordered by id
+func encodeIDCursor(commentID int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(commentID, 10)))
+}
+
+func decodeIDCursor(cursor string) (int64, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, ErrInvalidCursor
+	}
+	id, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, ErrInvalidCursor
+	}
+	return id, nil
+}
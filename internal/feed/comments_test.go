@@ -0,0 +1,156 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	userpb "gosocial/api/v1/user"
+	"gosocial/internal/dbmysql"
+
+	"google.golang.org/grpc"
+)
+
+// author 1 is friends with 2, user 3 is a stranger
+func newCommentService() (*FeedService, *fakeContentRepo, *fakeCommentRepo) {
+	uc := &fakeUserClient{
+		ListFn: func(ctx context.Context, in *userpb.UserID, _ ...grpc.CallOption) (*userpb.FriendList, error) {
+			if in.UserId == 1 {
+				return &userpb.FriendList{Friends: []*userpb.Friend{{UserId: 2}}}, nil
+			}
+			return &userpb.FriendList{}, nil
+		},
+	}
+	svc := newTestFeedService(uc)
+	return svc, svc.contentRepo.(*fakeContentRepo), svc.commentRepo.(*fakeCommentRepo)
+}
+
+func TestComments_VisibilityFollowsContentPrivacy(t *testing.T) {
+	svc, cRepo, _ := newCommentService()
+	ctx := context.Background()
+
+	for _, privacy := range []string{"public", "friends", "private"} {
+		_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 1, Type: "POST", Privacy: privacy})
+	}
+
+	cases := []struct {
+		viewer, content int64
+		visible         bool
+	}{
+		{3, 1, true},  // public
+		{2, 2, true},  // friends, viewer is a friend
+		{3, 2, false}, // friends, stranger
+		{2, 3, false}, // private, friend
+		{1, 3, true},  // private, author
+	}
+	for _, c := range cases {
+		_, err := svc.AddComment(ctx, c.viewer, c.content, 0, "nice")
+		if c.visible && err != nil {
+			t.Errorf("viewer %d on content %d: unexpected err %v", c.viewer, c.content, err)
+		}
+		if !c.visible && !errors.Is(err, ErrContentNotVisible) {
+			t.Errorf("viewer %d on content %d: expected ErrContentNotVisible, got %v", c.viewer, c.content, err)
+		}
+		if _, err := svc.ListComments(ctx, c.viewer, c.content, 0, "", 0); c.visible != (err == nil) {
+			t.Errorf("viewer %d listing content %d: visible=%v err=%v", c.viewer, c.content, c.visible, err)
+		}
+	}
+}
+
+func TestComments_RepliesAndPagination(t *testing.T) {
+	svc, cRepo, _ := newCommentService()
+	ctx := context.Background()
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 1, Type: "REEL", Privacy: "public"})
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 1, Type: "POST", Privacy: "public"})
+
+	if _, err := svc.AddComment(ctx, 2, 1, 0, "   "); !errors.Is(err, ErrInvalidComment) {
+		t.Fatalf("expected ErrInvalidComment for blank text, got %v", err)
+	}
+	if _, err := svc.AddComment(ctx, 2, 1, 0, strings.Repeat("a", MaxCommentLength+1)); !errors.Is(err, ErrInvalidComment) {
+		t.Fatalf("expected ErrInvalidComment for long text, got %v", err)
+	}
+
+	var top []int64
+	for i := 0; i < 3; i++ {
+		c, err := svc.AddComment(ctx, 2, 1, 0, " first ")
+		if err != nil {
+			t.Fatalf("AddComment err: %v", err)
+		}
+		top = append(top, c.CommentID)
+	}
+	reply, err := svc.AddComment(ctx, 3, 1, top[0], "reply")
+	if err != nil || reply.ParentID == nil || *reply.ParentID != top[0] {
+		t.Fatalf("reply not linked: %+v err=%v", reply, err)
+	}
+	if _, err := svc.AddComment(ctx, 3, 1, reply.CommentID, "nested"); !errors.Is(err, ErrInvalidReplyParent) {
+		t.Fatalf("expected ErrInvalidReplyParent for a reply to a reply, got %v", err)
+	}
+	if _, err := svc.AddComment(ctx, 3, 2, top[0], "elsewhere"); !errors.Is(err, ErrInvalidReplyParent) {
+		t.Fatalf("expected ErrInvalidReplyParent for a parent on other content, got %v", err)
+	}
+
+	first, err := svc.ListComments(ctx, 3, 1, 0, "", 2)
+	if err != nil || len(first.Comments) != 2 || first.NextCursor == "" {
+		t.Fatalf("unexpected first page %+v err=%v", first, err)
+	}
+	if first.Comments[0].Text != "first" || first.ReplyCounts[top[0]] != 1 {
+		t.Fatalf("text should be trimmed and reply counted: %+v", first)
+	}
+	second, err := svc.ListComments(ctx, 3, 1, 0, first.NextCursor, 2)
+	if err != nil || len(second.Comments) != 1 || second.Comments[0].CommentID != top[2] || second.NextCursor != "" {
+		t.Fatalf("unexpected second page %+v err=%v", second, err)
+	}
+
+	replies, err := svc.ListComments(ctx, 3, 1, top[0], "", 0)
+	if err != nil || len(replies.Comments) != 1 || replies.Comments[0].CommentID != reply.CommentID {
+		t.Fatalf("unexpected replies %+v err=%v", replies, err)
+	}
+
+	if _, err := svc.ListComments(ctx, 3, 1, 0, "%%", 0); !errors.Is(err, ErrInvalidCursor) {
+		t.Fatalf("expected ErrInvalidCursor, got %v", err)
+	}
+
+	counts, _ := svc.CountComments(ctx, []int64{1, 2})
+	if counts[1] != 4 || counts[2] != 0 {
+		t.Fatalf("comment counts should include replies: %v", counts)
+	}
+}
+
+func TestComments_EditAndDeletePermissions(t *testing.T) {
+	svc, cRepo, cmRepo := newCommentService()
+	ctx := context.Background()
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 1, Type: "POST", Privacy: "public"})
+
+	c, _ := svc.AddComment(ctx, 2, 1, 0, "hello")
+	reply, _ := svc.AddComment(ctx, 3, 1, c.CommentID, "hi")
+
+	if _, err := svc.EditComment(ctx, 1, c.CommentID, "edited"); !errors.Is(err, ErrNotCommentAuthor) {
+		t.Fatalf("content author must not edit others' comments, got %v", err)
+	}
+	edited, err := svc.EditComment(ctx, 2, c.CommentID, "edited")
+	if err != nil || edited.Text != "edited" || edited.EditedAt == nil {
+		t.Fatalf("EditComment mismatch: %+v err=%v", edited, err)
+	}
+
+	if err := svc.DeleteComment(ctx, 3, c.CommentID); !errors.Is(err, ErrCannotDeleteComment) {
+		t.Fatalf("expected ErrCannotDeleteComment, got %v", err)
+	}
+	// the content author can delete any comment, replies go with it
+	if err := svc.DeleteComment(ctx, 1, c.CommentID); err != nil {
+		t.Fatalf("DeleteComment err: %v", err)
+	}
+	if _, ok := cmRepo.m[reply.CommentID]; ok {
+		t.Fatalf("replies should be deleted with their parent")
+	}
+
+	own, _ := svc.AddComment(ctx, 3, 1, 0, "mine")
+	if err := svc.DeleteComment(ctx, 3, own.CommentID); err != nil {
+		t.Fatalf("comment author should delete own comment: %v", err)
+	}
+
+	_, _ = svc.AddComment(ctx, 2, 1, 0, "left over")
+	if err := svc.DeleteContent(ctx, 1, 1); err != nil || len(cmRepo.m) != 0 {
+		t.Fatalf("deleting content should delete its comments: err=%v left=%d", err, len(cmRepo.m))
+	}
+}
@@ -0,0 +1,300 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	"gosocial/internal/common"
+	"gosocial/internal/dbmysql"
+)
+
+// MaxDraftAge is how long a draft may stay unchanged before it is deleted together with its media
+const MaxDraftAge = 30 * 24 * time.Hour
+
+var (
+	ErrInvalidDraft     = errors.New("a draft is a post with up to 10 different uploaded media or a reel with at most one video")
+	ErrIncompleteDraft  = errors.New("a post needs text or media and a reel needs a video and a duration to be published")
+	ErrNotADraft        = errors.New("content is not a draft")
+	ErrUnpublishedDraft = errors.New("drafts are changed with UpdateDraft until they are published")
+)
+
+// Draft is the whole of a draft as its author last saved it. MediaRefIDs are media sent through UploadMedia,
+// in order; a draft holds on to them until it is published or deleted
+type Draft struct {
+	Type           string // POST or REEL, a draft keeps the type it was saved with
+	Text           string // a post's text or a reel's caption
+	MediaRefIDs    []int64
+	DurationSec    int
+	Privacy        string
+	AudienceListID int64 // the audience list of list privacy, 0 for other privacies
+}
+
+// Drafts are content rows with Draft set. Only their author sees them, they are neither indexed nor fanned out
+// until PublishDraft, and abandoned ones are deleted by the draft cleaner
+
+// SaveDraft stores a new draft of the author
+func (s *FeedService) SaveDraft(ctx context.Context, authorID int64, draft Draft) (*dbmysql.Content, error) {
+	if draft.Type != "POST" && draft.Type != "REEL" {
+		return nil, ErrInvalidDraft
+	}
+	now := time.Now()
+	content := &dbmysql.Content{
+		AuthorID:  authorID,
+		Type:      draft.Type,
+		Draft:     true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.applyDraft(ctx, content, draft); err != nil {
+		return nil, err
+	}
+	if err := s.contentRepo.CreateContent(ctx, content); err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+// ListDrafts pages through the author's drafts, newest first
+func (s *FeedService) ListDrafts(ctx context.Context, authorID int64, query TimelineQuery) (*TimelinePage, error) {
+	cursor, err := DecodeCursor(query.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := clampPageSize(query.PageSize)
+
+	contents, err := s.contentRepo.ListDrafts(ctx, authorID, cursor, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &TimelinePage{}
+	if len(contents) > pageSize {
+		contents = contents[:pageSize]
+		page.NextCursor = cursorOf(contents[pageSize-1]).Encode()
+	}
+	page.Contents = contents
+	page.MediaURLs, err = s.mediaURLs(ctx, contents)
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// UpdateDraft replaces the author's draft with what they saved now, media left out of it are deleted
+func (s *FeedService) UpdateDraft(ctx context.Context, authorID, contentID int64, draft Draft) (*dbmysql.Content, error) {
+	content, err := s.draft(ctx, authorID, contentID)
+	if err != nil {
+		return nil, err
+	}
+	carousel, err := s.contentRepo.ListContentMedia(ctx, []int64{contentID})
+	if err != nil {
+		return nil, err
+	}
+	previous := contentMediaRefIDs(content, carousel[contentID])
+
+	if err := s.applyDraft(ctx, content, draft); err != nil {
+		return nil, err
+	}
+	content.UpdatedAt = time.Now()
+	// a concurrent publish or discard may have got to it in the meantime
+	updated, err := s.contentRepo.UpdateDraft(ctx, content)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, ErrNotADraft
+	}
+
+	kept := make(map[int64]bool, len(draft.MediaRefIDs))
+	for _, id := range draft.MediaRefIDs {
+		kept[id] = true
+	}
+	for _, id := range previous {
+		if kept[id] {
+			continue
+		}
+		if err := s.mediaRepo.DeleteMedia(ctx, id); err != nil {
+			log.Printf("failed to delete media %d dropped from draft %d: %v", id, contentID, err)
+		}
+	}
+	return content, nil
+}
+
+// PublishDraft turns the author's draft into a post or reel, right away or at publishAt like scheduled content
+func (s *FeedService) PublishDraft(ctx context.Context, authorID, contentID int64, publishAt *time.Time) (*dbmysql.Content, error) {
+	content, err := s.draft(ctx, authorID, contentID)
+	if err != nil {
+		return nil, err
+	}
+	if !draftComplete(content) {
+		return nil, ErrIncompleteDraft
+	}
+	// the audience list may have been deleted since the draft was saved
+	if err := s.checkAudience(ctx, content); err != nil {
+		return nil, err
+	}
+	createdAt := time.Now()
+	if publishAt != nil {
+		content.PublishAt = publishAt
+		if err := checkPublishAt(content, createdAt); err != nil {
+			return nil, err
+		}
+		createdAt = *publishAt
+	}
+
+	published, err := s.contentRepo.PublishDraft(ctx, contentID, createdAt, publishAt)
+	if err != nil {
+		return nil, err
+	}
+	if !published {
+		return nil, ErrNotADraft
+	}
+	content.Draft = false
+	content.CreatedAt = createdAt
+	if publishAt == nil {
+		s.distribute(ctx, content)
+	}
+	return content, nil
+}
+
+// DiscardDraft deletes the author's draft and its media
+func (s *FeedService) DiscardDraft(ctx context.Context, authorID, contentID int64) error {
+	if _, err := s.draft(ctx, authorID, contentID); err != nil {
+		return err
+	}
+	return s.DeleteContent(ctx, authorID, contentID)
+}
+
+// draft loads the author's content and checks that it is still a draft
+func (s *FeedService) draft(ctx context.Context, authorID, contentID int64) (*dbmysql.Content, error) {
+	content, err := s.ownContent(ctx, authorID, contentID)
+	if err != nil {
+		return nil, err
+	}
+	if !content.Draft {
+		return nil, ErrNotADraft
+	}
+	return content, nil
+}
+
+// applyDraft sets the fields and media of a draft content from what its author saved. The media must be the
+// author's uploads and not in use by any other content
+func (s *FeedService) applyDraft(ctx context.Context, content *dbmysql.Content, draft Draft) error {
+	maxMedia := MaxPostMedia
+	if content.Type == "REEL" {
+		maxMedia = 1
+	}
+	if len(draft.MediaRefIDs) > maxMedia || draft.DurationSec < 0 {
+		return ErrInvalidDraft
+	}
+	if _, ok := privacyRank[draft.Privacy]; !ok {
+		return ErrInvalidPrivacy
+	}
+	if err := s.checkDraftMedia(ctx, content, draft.MediaRefIDs); err != nil {
+		return err
+	}
+
+	content.TextContent = nil
+	if draft.Text != "" {
+		content.TextContent = &draft.Text
+	}
+	content.Duration = nil
+	if content.Type == "REEL" && draft.DurationSec > 0 {
+		content.Duration = &draft.DurationSec
+	}
+	content.Privacy = draft.Privacy
+	content.AudienceListID = audienceListRef(draft.AudienceListID)
+
+	// like carousel posts the first media stands for the draft, the items are only kept when there are several
+	content.MediaRefID = nil
+	content.Media = nil
+	if len(draft.MediaRefIDs) > 0 {
+		first := draft.MediaRefIDs[0]
+		content.MediaRefID = &first
+	}
+	if len(draft.MediaRefIDs) > 1 {
+		for i, id := range draft.MediaRefIDs {
+			content.Media = append(content.Media, dbmysql.ContentMedia{Position: i + 1, MediaRefID: id})
+		}
+	}
+	return s.checkAudience(ctx, content)
+}
+
+// checkDraftMedia refuses media that are repeated, missing, uploaded by someone else or used by another content.
+// A reel's media must be a video
+func (s *FeedService) checkDraftMedia(ctx context.Context, content *dbmysql.Content, mediaRefIDs []int64) error {
+	if len(mediaRefIDs) == 0 {
+		return nil
+	}
+	seen := make(map[int64]bool, len(mediaRefIDs))
+	for _, id := range mediaRefIDs {
+		if seen[id] {
+			return ErrInvalidDraft
+		}
+		seen[id] = true
+	}
+
+	refs, err := s.mediaRepo.ListMediaRefsByIDs(ctx, mediaRefIDs)
+	if err != nil {
+		return err
+	}
+	if len(refs) != len(mediaRefIDs) {
+		return ErrMediaNotFound
+	}
+	uploader := strconv.FormatInt(content.AuthorID, 10)
+	for _, ref := range refs {
+		if ref.UploadedBy != uploader {
+			return ErrNotMediaOwner
+		}
+		if content.Type == "REEL" && ref.Type != string(common.MediaFileTypeVideo) {
+			return ErrInvalidDraft
+		}
+	}
+	for _, id := range mediaRefIDs {
+		users, err := s.contentRepo.ListContentByMedia(ctx, id)
+		if err != nil {
+			return err
+		}
+		for _, c := range users {
+			if c.ContentID != content.ContentID {
+				return ErrMediaInUse
+			}
+		}
+	}
+	return nil
+}
+
+// draftComplete tells whether a draft has what creating the post or reel directly would require
+func draftComplete(content *dbmysql.Content) bool {
+	if content.Type == "REEL" {
+		return content.MediaRefID != nil && content.Duration != nil
+	}
+	return content.MediaRefID != nil || safeString(content.TextContent) != ""
+}
+
+func (s *FeedService) startDraftCleaner() {
+	ticker := time.NewTicker(time.Hour)
+	for {
+		<-ticker.C
+
+		s.cleanupAbandonedDrafts(context.Background(), time.Now())
+	}
+}
+
+// cleanupAbandonedDrafts deletes the drafts nobody changed for MaxDraftAge, with their media
+func (s *FeedService) cleanupAbandonedDrafts(ctx context.Context, now time.Time) {
+	abandoned, err := s.contentRepo.ListAbandonedDrafts(ctx, now.Add(-MaxDraftAge))
+	if err != nil {
+		log.Printf("failed to fetch abandoned drafts: %v", err)
+		return
+	}
+
+	for _, draft := range abandoned {
+		if err := s.DeleteContent(ctx, draft.AuthorID, draft.ContentID); err != nil {
+			log.Printf("failed to delete abandoned draft %d: %v", draft.ContentID, err)
+		}
+	}
+}
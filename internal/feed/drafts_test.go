@@ -0,0 +1,231 @@
+package feed
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"gosocial/internal/dbmysql"
+)
+
+// upload stores a file of the uploader and returns its media ID
+func upload(t *testing.T, svc *FeedService, uploaderID int64, name, mediaType string) int64 {
+	t.Helper()
+	media, err := svc.UploadMedia(context.Background(), uploaderID, name, mediaType, bytes.NewReader([]byte(name)))
+	if err != nil {
+		t.Fatalf("UploadMedia err: %v", err)
+	}
+	return int64(media.MediaRefID)
+}
+
+func TestDrafts_OnlyTheAuthorSeesThem(t *testing.T) {
+	svc, cRepo, _ := newCommentService()
+	ctx := context.Background()
+
+	image := upload(t, svc, 1, "a.jpg", "image")
+	draft, err := svc.SaveDraft(ctx, 1, Draft{Type: "POST", Text: "half done #soon", MediaRefIDs: []int64{image}, Privacy: "public"})
+	if err != nil {
+		t.Fatalf("SaveDraft err: %v", err)
+	}
+	if !cRepo.m[draft.ContentID].Draft {
+		t.Fatal("saved content should be a draft")
+	}
+
+	if _, _, err := svc.GetVisibleContent(ctx, 2, draft.ContentID); !errors.Is(err, ErrContentNotVisible) {
+		t.Fatalf("friend should not see a draft, got %v", err)
+	}
+	if contents, _, _ := svc.GetUserContent(ctx, 1, 1); len(contents) != 0 {
+		t.Fatalf("drafts should stay off the author's profile, got %v", contentIDs(contents))
+	}
+	if page, _ := svc.GetTimeline(ctx, 1, TimelineQuery{}); len(page.Contents) != 0 {
+		t.Fatalf("drafts should stay out of timelines, got %v", contentIDs(page.Contents))
+	}
+	if page, _ := svc.GetHashtagFeed(ctx, "soon", TimelineQuery{}); len(page.Contents) != 0 {
+		t.Fatalf("drafts should not be tagged, got %v", contentIDs(page.Contents))
+	}
+	if _, err := svc.UpdateContent(ctx, 1, draft.ContentID, ContentUpdate{}); !errors.Is(err, ErrUnpublishedDraft) {
+		t.Fatalf("expected ErrUnpublishedDraft, got %v", err)
+	}
+
+	// others cannot tell a draft exists
+	if _, err := svc.PublishDraft(ctx, 2, draft.ContentID, nil); !errors.Is(err, ErrContentNotVisible) {
+		t.Fatalf("expected ErrContentNotVisible, got %v", err)
+	}
+	if page, _ := svc.ListDrafts(ctx, 2, TimelineQuery{}); len(page.Contents) != 0 {
+		t.Fatalf("others have no drafts, got %v", contentIDs(page.Contents))
+	}
+}
+
+func TestDrafts_SaveValidation(t *testing.T) {
+	svc, _, _ := newCommentService()
+	ctx := context.Background()
+
+	image := upload(t, svc, 1, "a.jpg", "image")
+	video := upload(t, svc, 1, "b.mp4", "video")
+	theirs := upload(t, svc, 2, "c.jpg", "image")
+	posted, _ := svc.CreateFromUpload(ctx, UploadedContent{AuthorID: 1, Type: "POST", MediaRefID: upload(t, svc, 1, "d.jpg", "image"), Privacy: "public"})
+	used := *svc.contentRepo.(*fakeContentRepo).m[posted].MediaRefID
+
+	cases := []struct {
+		name  string
+		draft Draft
+		want  error
+	}{
+		{"story", Draft{Type: "STORY", Privacy: "public"}, ErrInvalidDraft},
+		{"bad privacy", Draft{Type: "POST", Privacy: "everyone"}, ErrInvalidPrivacy},
+		{"list without a list", Draft{Type: "POST", Privacy: "list"}, ErrInvalidAudience},
+		{"repeated media", Draft{Type: "POST", MediaRefIDs: []int64{image, image}, Privacy: "public"}, ErrInvalidDraft},
+		{"reel from an image", Draft{Type: "REEL", MediaRefIDs: []int64{image}, Privacy: "public"}, ErrInvalidDraft},
+		{"reel with two videos", Draft{Type: "REEL", MediaRefIDs: []int64{video, video + 100}, Privacy: "public"}, ErrInvalidDraft},
+		{"unknown media", Draft{Type: "POST", MediaRefIDs: []int64{99}, Privacy: "public"}, ErrMediaNotFound},
+		{"someone else's upload", Draft{Type: "POST", MediaRefIDs: []int64{theirs}, Privacy: "public"}, ErrNotMediaOwner},
+		{"media of a post", Draft{Type: "POST", MediaRefIDs: []int64{used}, Privacy: "public"}, ErrMediaInUse},
+	}
+	for _, c := range cases {
+		if _, err := svc.SaveDraft(ctx, 1, c.draft); !errors.Is(err, c.want) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.want, err)
+		}
+	}
+
+	// an empty draft is fine until it is published
+	empty, err := svc.SaveDraft(ctx, 1, Draft{Type: "REEL", Privacy: "friends"})
+	if err != nil {
+		t.Fatalf("SaveDraft empty reel err: %v", err)
+	}
+	if _, err := svc.PublishDraft(ctx, 1, empty.ContentID, nil); !errors.Is(err, ErrIncompleteDraft) {
+		t.Fatalf("expected ErrIncompleteDraft, got %v", err)
+	}
+	if _, err := svc.UpdateDraft(ctx, 1, empty.ContentID, Draft{MediaRefIDs: []int64{video}, DurationSec: 12, Privacy: "friends"}); err != nil {
+		t.Fatalf("UpdateDraft err: %v", err)
+	}
+	if _, err := svc.PublishDraft(ctx, 1, empty.ContentID, nil); err != nil {
+		t.Fatalf("PublishDraft complete reel err: %v", err)
+	}
+}
+
+func TestDrafts_UpdateListAndPublish(t *testing.T) {
+	svc, cRepo, _ := newCommentService()
+	ctx := context.Background()
+	mRepo := svc.mediaRepo.(*fakeMediaRepo)
+
+	a, b, c := upload(t, svc, 1, "a.jpg", "image"), upload(t, svc, 1, "b.jpg", "image"), upload(t, svc, 1, "c.mp4", "video")
+	draft, _ := svc.SaveDraft(ctx, 1, Draft{Type: "POST", Text: "trip", MediaRefIDs: []int64{a, b}, Privacy: "friends"})
+	other, _ := svc.SaveDraft(ctx, 1, Draft{Type: "POST", Text: "other", Privacy: "friends"})
+	newer := cRepo.m[other.ContentID]
+	newer.CreatedAt = newer.CreatedAt.Add(time.Minute)
+	cRepo.m[other.ContentID] = newer
+
+	first, err := svc.ListDrafts(ctx, 1, TimelineQuery{PageSize: 1})
+	if err != nil {
+		t.Fatalf("ListDrafts err: %v", err)
+	}
+	if got := contentIDs(first.Contents); fmt.Sprint(got) != fmt.Sprint([]int64{other.ContentID}) || first.NextCursor == "" {
+		t.Fatalf("first page: want the newest draft, got %v cursor=%q", got, first.NextCursor)
+	}
+	second, _ := svc.ListDrafts(ctx, 1, TimelineQuery{Cursor: first.NextCursor, PageSize: 1})
+	if got := contentIDs(second.Contents); fmt.Sprint(got) != fmt.Sprint([]int64{draft.ContentID}) || second.NextCursor != "" {
+		t.Fatalf("second page: want [%d], got %v cursor=%q", draft.ContentID, got, second.NextCursor)
+	}
+
+	// reordering keeps the media, dropping one deletes it
+	updated, err := svc.UpdateDraft(ctx, 1, draft.ContentID, Draft{Text: "trip #beach", MediaRefIDs: []int64{c, a}, Privacy: "public"})
+	if err != nil {
+		t.Fatalf("UpdateDraft err: %v", err)
+	}
+	if safeString(updated.TextContent) != "trip #beach" || updated.Privacy != "public" || *updated.MediaRefID != c {
+		t.Fatalf("UpdateDraft mismatch: %+v", updated)
+	}
+	if _, ok := mRepo.meta[b]; ok {
+		t.Fatal("media dropped from a draft should be deleted")
+	}
+	if _, ok := mRepo.meta[a]; !ok {
+		t.Fatal("media kept in a draft should stay")
+	}
+	media, _ := svc.ListMedia(ctx, []dbmysql.Content{cRepo.m[draft.ContentID]})
+	if len(media[draft.ContentID]) != 2 || media[draft.ContentID][0].Type != "video" {
+		t.Fatalf("draft media should follow the new order, got %+v", media[draft.ContentID])
+	}
+
+	published, err := svc.PublishDraft(ctx, 1, draft.ContentID, nil)
+	if err != nil {
+		t.Fatalf("PublishDraft err: %v", err)
+	}
+	if published.Draft || cRepo.m[draft.ContentID].Draft || time.Since(published.CreatedAt) > time.Minute {
+		t.Fatalf("published draft should be content created now, got %+v", cRepo.m[draft.ContentID])
+	}
+	contents, _, _ := svc.GetUserContent(ctx, 2, 1)
+	if got := contentIDs(contents); fmt.Sprint(got) != fmt.Sprint([]int64{draft.ContentID}) {
+		t.Fatalf("published draft should reach the profile, got %v", got)
+	}
+	if page, _ := svc.GetHashtagFeed(ctx, "beach", TimelineQuery{}); len(page.Contents) != 1 {
+		t.Fatalf("published draft should be tagged, got %v", contentIDs(page.Contents))
+	}
+	if _, err := svc.PublishDraft(ctx, 1, draft.ContentID, nil); !errors.Is(err, ErrNotADraft) {
+		t.Fatalf("expected ErrNotADraft, got %v", err)
+	}
+	if _, err := svc.UpdateDraft(ctx, 1, draft.ContentID, Draft{Privacy: "public"}); !errors.Is(err, ErrNotADraft) {
+		t.Fatalf("expected ErrNotADraft, got %v", err)
+	}
+
+	// a draft can be scheduled instead
+	publishAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	scheduled, err := svc.PublishDraft(ctx, 1, other.ContentID, &publishAt)
+	if err != nil {
+		t.Fatalf("PublishDraft scheduled err: %v", err)
+	}
+	if !scheduled.CreatedAt.Equal(publishAt) || cRepo.m[other.ContentID].PublishAt == nil {
+		t.Fatalf("scheduled draft should wait for its publish time, got %+v", cRepo.m[other.ContentID])
+	}
+	if page, _ := svc.ListScheduledContent(ctx, 1, TimelineQuery{}); len(page.Contents) != 1 {
+		t.Fatalf("scheduled draft should be listed as scheduled, got %v", contentIDs(page.Contents))
+	}
+}
+
+func TestDrafts_DiscardAndAbandon(t *testing.T) {
+	svc, cRepo, _ := newCommentService()
+	ctx := context.Background()
+	mRepo := svc.mediaRepo.(*fakeMediaRepo)
+
+	a, b, c := upload(t, svc, 1, "a.jpg", "image"), upload(t, svc, 1, "b.jpg", "image"), upload(t, svc, 1, "c.jpg", "image")
+	discarded, _ := svc.SaveDraft(ctx, 1, Draft{Type: "POST", MediaRefIDs: []int64{a}, Privacy: "public"})
+	abandoned, _ := svc.SaveDraft(ctx, 1, Draft{Type: "POST", MediaRefIDs: []int64{b}, Privacy: "public"})
+	recent, _ := svc.SaveDraft(ctx, 1, Draft{Type: "POST", MediaRefIDs: []int64{c}, Privacy: "public"})
+	post, _ := svc.CreatePost(ctx, 1, "live", nil, "", "", "public", 0, nil)
+
+	if err := svc.DiscardDraft(ctx, 1, post); !errors.Is(err, ErrNotADraft) {
+		t.Fatalf("published content cannot be discarded, got %v", err)
+	}
+	if err := svc.DiscardDraft(ctx, 2, discarded.ContentID); !errors.Is(err, ErrContentNotVisible) {
+		t.Fatalf("expected ErrContentNotVisible, got %v", err)
+	}
+	if err := svc.DiscardDraft(ctx, 1, discarded.ContentID); err != nil {
+		t.Fatalf("DiscardDraft err: %v", err)
+	}
+	if _, ok := cRepo.m[discarded.ContentID]; ok {
+		t.Fatal("discarded draft should be deleted")
+	}
+	if _, ok := mRepo.meta[a]; ok {
+		t.Fatal("media of a discarded draft should be deleted")
+	}
+
+	old := cRepo.m[abandoned.ContentID]
+	old.UpdatedAt = time.Now().Add(-MaxDraftAge - time.Hour)
+	cRepo.m[abandoned.ContentID] = old
+	svc.cleanupAbandonedDrafts(ctx, time.Now())
+
+	if _, ok := cRepo.m[abandoned.ContentID]; ok {
+		t.Fatal("abandoned draft should be deleted")
+	}
+	if _, ok := mRepo.meta[b]; ok {
+		t.Fatal("media of an abandoned draft should be deleted")
+	}
+	if _, ok := cRepo.m[recent.ContentID]; !ok {
+		t.Fatal("recently changed drafts should stay")
+	}
+	if _, ok := cRepo.m[post]; !ok {
+		t.Fatal("published content is not a draft to clean up")
+	}
+}
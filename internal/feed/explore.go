@@ -0,0 +1,174 @@
+package feed
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	userpb "gosocial/api/v1/user"
+	"gosocial/internal/dbmysql"
+)
+
+const (
+	// an explore session serves at most this many reels, its cursor carries every one of them
+	MaxExploreSessionReels = 500
+
+	// explore ranks the newest public reels of the window
+	exploreWindow         = 7 * 24 * time.Hour
+	exploreCandidateLimit = 500
+
+	// a reaction counts as much as three views, popularity halves every day
+	exploreReactionWeight = 3
+	exploreViewWeight     = 1
+	exploreHalfLife       = 24 * time.Hour
+)
+
+var ErrNotAReel = errors.New("content is not a reel")
+
+// MarkReelViewed records that the viewer watched a reel, only the first view counts and the author's own views are not recorded
+func (s *FeedService) MarkReelViewed(ctx context.Context, viewerID, reelID int64) error {
+	reel, err := s.visibleContent(ctx, viewerID, reelID)
+	if err != nil {
+		return err
+	}
+	if reel.Type != "REEL" {
+		return ErrNotAReel
+	}
+	if reel.AuthorID == viewerID {
+		return nil
+	}
+
+	return s.reelViewRepo.RecordReelView(ctx, &dbmysql.ReelView{
+		ReelID:    reelID,
+		ViewerID:  viewerID,
+		CreatedAt: time.Now(),
+	})
+}
+
+// GetExploreReels pages through the public reels of users who are neither the viewer's friends nor blocked them,
+// most popular first. Popularity grows with reactions and views and decays with age. Rankings shift between pages,
+// so instead of a position the cursor carries the reels the session has served and later pages leave them out
+func (s *FeedService) GetExploreReels(ctx context.Context, viewerID int64, query TimelineQuery) (*TimelinePage, error) {
+	seen, err := decodeExploreCursor(query.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := clampPageSize(query.PageSize)
+
+	friendIDs, err := s.GetUserFriendIDs(ctx, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	blockerIDs, err := s.getBlockerIDs(ctx, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	excluded := append(append([]int64{viewerID}, friendIDs...), blockerIDs...)
+
+	now := time.Now()
+	candidates, err := s.contentRepo.ListExploreReels(ctx, excluded, seen, now.Add(-exploreWindow), exploreCandidateLimit)
+	if err != nil {
+		return nil, err
+	}
+	reels, err := s.rankExploreReels(ctx, candidates, now)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &TimelinePage{}
+	if len(reels) > pageSize {
+		reels = reels[:pageSize]
+		if len(seen)+pageSize < MaxExploreSessionReels {
+			for _, r := range reels {
+				seen = append(seen, r.ContentID)
+			}
+			page.NextCursor = encodeExploreCursor(seen)
+		}
+	}
+	page.Contents = reels
+	page.MediaURLs, err = s.mediaURLs(ctx, reels)
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// rankExploreReels orders reels by exploreScore, ties keep the newest first
+func (s *FeedService) rankExploreReels(ctx context.Context, reels []dbmysql.Content, now time.Time) ([]dbmysql.Content, error) {
+	ids := make([]int64, 0, len(reels))
+	for _, r := range reels {
+		ids = append(ids, r.ContentID)
+	}
+	reactions, err := s.reactionRepo.CountReactions(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	views, err := s.reelViewRepo.CountReelViews(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	scores := make(map[int64]float64, len(reels))
+	for _, r := range reels {
+		scores[r.ContentID] = exploreScore(r, reactions[r.ContentID], views[r.ContentID], now)
+	}
+	ranked := append([]dbmysql.Content(nil), reels...)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return scores[ranked[i].ContentID] > scores[ranked[j].ContentID]
+	})
+	return ranked, nil
+}
+
+// exploreScore is the reel's popularity decayed by its age, a reel nobody engaged with yet still scores by recency
+func exploreScore(reel dbmysql.Content, reactions, views int64, now time.Time) float64 {
+	popularity := 1 + exploreReactionWeight*float64(reactions) + exploreViewWeight*float64(views)
+	ageHrs := math.Max(now.Sub(reel.CreatedAt).Hours(), 0)
+	return popularity * math.Exp2(-ageHrs/exploreHalfLife.Hours())
+}
+
+// getBlockerIDs returns the users who blocked userID
+func (s *FeedService) getBlockerIDs(ctx context.Context, userID int64) ([]int64, error) {
+	resp, err := s.UserClient.ListBlockers(ctx, &userpb.UserID{UserId: userID})
+	if err != nil {
+		return nil, fmt.Errorf("ListBlockers failed: %w", err)
+	}
+	return resp.UserIds, nil
+}
+
+func encodeExploreCursor(seen []int64) string {
+	ids := make([]string, 0, len(seen))
+	for _, id := range seen {
+		ids = append(ids, strconv.FormatInt(id, 10))
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(strings.Join(ids, ",")))
+}
+
+// decodeExploreCursor returns the reels served so far in the session, an empty cursor starts a new one
+func decodeExploreCursor(cursor string) ([]int64, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	fields := strings.Split(string(raw), ",")
+	if len(fields) > MaxExploreSessionReels {
+		return nil, ErrInvalidCursor
+	}
+	seen := make([]int64, 0, len(fields))
+	for _, f := range fields {
+		id, err := strconv.ParseInt(f, 10, 64)
+		if err != nil || id <= 0 {
+			return nil, ErrInvalidCursor
+		}
+		seen = append(seen, id)
+	}
+	return seen, nil
+}
@@ -0,0 +1,155 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	userpb "gosocial/api/v1/user"
+	"gosocial/internal/dbmysql"
+
+	"google.golang.org/grpc"
+)
+
+// viewer 1 is friends with 2 and blocked by 4, users 3 and 5 are strangers
+func newExploreService() (*FeedService, *fakeContentRepo, *fakeReactionRepo, *fakeReelViewRepo) {
+	friends := map[int64][]int64{1: {2}, 2: {1}}
+	uc := &fakeUserClient{
+		ListFn: func(ctx context.Context, in *userpb.UserID, _ ...grpc.CallOption) (*userpb.FriendList, error) {
+			list := &userpb.FriendList{}
+			for _, id := range friends[in.UserId] {
+				list.Friends = append(list.Friends, &userpb.Friend{UserId: id})
+			}
+			return list, nil
+		},
+		BlockersFn: func(ctx context.Context, in *userpb.UserID, _ ...grpc.CallOption) (*userpb.UserIDList, error) {
+			if in.UserId == 1 {
+				return &userpb.UserIDList{UserIds: []int64{4}}, nil
+			}
+			return &userpb.UserIDList{}, nil
+		},
+	}
+	svc := newTestFeedService(uc)
+	return svc, svc.contentRepo.(*fakeContentRepo), svc.reactionRepo.(*fakeReactionRepo), svc.reelViewRepo.(*fakeReelViewRepo)
+}
+
+func addReel(repo *fakeContentRepo, authorID int64, privacy string, age time.Duration) int64 {
+	_ = repo.CreateContent(context.Background(), &dbmysql.Content{AuthorID: authorID, Type: "REEL", Privacy: privacy, CreatedAt: time.Now().Add(-age)})
+	return repo.next - 1
+}
+
+func TestExplore_ReelsFromNonFriendsByPopularity(t *testing.T) {
+	svc, cRepo, rRepo, rvRepo := newExploreService()
+	ctx := context.Background()
+
+	fresh := addReel(cRepo, 3, "public", 0)
+	popular := addReel(cRepo, 5, "public", 2*time.Hour)
+	viewed := addReel(cRepo, 5, "public", 30*time.Hour)
+	addReel(cRepo, 2, "public", 0)               // friend
+	addReel(cRepo, 4, "public", 0)               // blocked the viewer
+	addReel(cRepo, 1, "public", 0)               // the viewer's own
+	addReel(cRepo, 3, "friends", 0)              // not public
+	addReel(cRepo, 3, "public", 10*24*time.Hour) // outside the window
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 3, Type: "POST", Privacy: "public"})
+
+	for _, user := range []int64{6, 7, 8} {
+		_ = rRepo.AddReaction(ctx, &dbmysql.Reaction{UserID: user, ContentID: popular, Type: "like"})
+	}
+	for _, user := range []int64{6, 7} {
+		_ = rvRepo.RecordReelView(ctx, &dbmysql.ReelView{ReelID: viewed, ViewerID: user})
+	}
+
+	first, err := svc.GetExploreReels(ctx, 1, TimelineQuery{PageSize: 2})
+	if err != nil {
+		t.Fatalf("GetExploreReels err: %v", err)
+	}
+	if got := contentIDs(first.Contents); fmt.Sprint(got) != fmt.Sprint([]int64{popular, viewed}) || first.NextCursor == "" {
+		t.Fatalf("first page: want [%d %d] and a cursor, got %v cursor=%q", popular, viewed, got, first.NextCursor)
+	}
+
+	// engagement between pages does not bring served reels back
+	for _, user := range []int64{9, 10, 11} {
+		_ = rRepo.AddReaction(ctx, &dbmysql.Reaction{UserID: user, ContentID: viewed, Type: "like"})
+	}
+	second, err := svc.GetExploreReels(ctx, 1, TimelineQuery{Cursor: first.NextCursor, PageSize: 2})
+	if err != nil {
+		t.Fatalf("GetExploreReels second page err: %v", err)
+	}
+	if got := contentIDs(second.Contents); fmt.Sprint(got) != fmt.Sprint([]int64{fresh}) || second.NextCursor != "" {
+		t.Fatalf("second page: want [%d] and no cursor, got %v cursor=%q", fresh, got, second.NextCursor)
+	}
+
+	// a blocked author's reels stay visible to everyone they did not block
+	others, _ := svc.GetExploreReels(ctx, 3, TimelineQuery{})
+	if len(others.Contents) != 5 {
+		t.Fatalf("stranger 3 should see the public reels of 1, 2, 4 and 5, got %v", contentIDs(others.Contents))
+	}
+}
+
+func TestExplore_SessionCursor(t *testing.T) {
+	svc, cRepo, _, _ := newExploreService()
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		addReel(cRepo, 3, "public", time.Duration(i)*time.Hour)
+	}
+
+	if _, err := svc.GetExploreReels(ctx, 1, TimelineQuery{Cursor: "%%"}); !errors.Is(err, ErrInvalidCursor) {
+		t.Fatalf("expected ErrInvalidCursor, got %v", err)
+	}
+	tooLong := make([]int64, MaxExploreSessionReels+1)
+	for i := range tooLong {
+		tooLong[i] = int64(1000 + i)
+	}
+	if _, err := svc.GetExploreReels(ctx, 1, TimelineQuery{Cursor: encodeExploreCursor(tooLong)}); !errors.Is(err, ErrInvalidCursor) {
+		t.Fatalf("expected ErrInvalidCursor past the session limit, got %v", err)
+	}
+
+	// a session that would grow past the limit ends instead
+	nearlyFull := tooLong[:MaxExploreSessionReels-1]
+	page, err := svc.GetExploreReels(ctx, 1, TimelineQuery{Cursor: encodeExploreCursor(nearlyFull), PageSize: 1})
+	if err != nil || len(page.Contents) != 1 || page.NextCursor != "" {
+		t.Fatalf("full session should end: %+v err=%v", page, err)
+	}
+}
+
+func TestExplore_MarkReelViewed(t *testing.T) {
+	svc, cRepo, _, rvRepo := newExploreService()
+	ctx := context.Background()
+
+	reel := addReel(cRepo, 3, "public", 0)
+	hidden := addReel(cRepo, 3, "friends", 0)
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 3, Type: "POST", Privacy: "public"})
+	post := cRepo.next - 1
+
+	if err := svc.MarkReelViewed(ctx, 1, post); !errors.Is(err, ErrNotAReel) {
+		t.Fatalf("expected ErrNotAReel, got %v", err)
+	}
+	if err := svc.MarkReelViewed(ctx, 1, hidden); !errors.Is(err, ErrContentNotVisible) {
+		t.Fatalf("expected ErrContentNotVisible, got %v", err)
+	}
+	for _, viewer := range []int64{1, 1, 2, 3} {
+		if err := svc.MarkReelViewed(ctx, viewer, reel); err != nil {
+			t.Fatalf("MarkReelViewed err: %v", err)
+		}
+	}
+	if counts, _ := rvRepo.CountReelViews(ctx, []int64{reel}); counts[reel] != 2 {
+		t.Fatalf("repeat and author views should not count, got %d views", counts[reel])
+	}
+
+	if err := svc.DeleteContent(ctx, 3, reel); err != nil {
+		t.Fatalf("DeleteContent err: %v", err)
+	}
+	if len(rvRepo.views) != 0 {
+		t.Fatalf("deleting a reel should delete its views, left %+v", rvRepo.views)
+	}
+}
+
+func contentIDs(contents []dbmysql.Content) []int64 {
+	ids := make([]int64, 0, len(contents))
+	for _, c := range contents {
+		ids = append(ids, c.ContentID)
+	}
+	return ids
+}
@@ -2,13 +2,17 @@ package feed
 
 import (
 	"context"
-	//"time"
+	"errors"
+	"io"
+	"time"
 
 	feedpb "gosocial/api/v1/feed" // alias the generated package
+	"gosocial/internal/dbmysql"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 type FeedHandlers struct {
@@ -20,6 +24,27 @@ func (h *FeedHandlers) CreatePost(ctx context.Context, req *feedpb.CreatePostReq
 	if req.AuthorId <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "invalid author ID")
 	}
+	if err := authorize(ctx, req.AuthorId); err != nil {
+		return nil, err
+	}
+	publishAt, err := publishTime(req.PublishAt)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Attachments) > 0 {
+		return h.createCarouselPost(ctx, req, publishAt)
+	}
+	if req.MediaRefId > 0 {
+		return h.createFromUpload(ctx, len(req.MediaData) > 0, UploadedContent{
+			AuthorID:       req.AuthorId,
+			Type:           "POST",
+			Text:           req.Text,
+			MediaRefID:     req.MediaRefId,
+			Privacy:        req.Privacy,
+			AudienceListID: req.AudienceListId,
+			PublishAt:      publishAt,
+		})
+	}
 	if req.Text == "" && len(req.MediaData) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "post must have text or file data")
 	}
@@ -44,7 +69,12 @@ func (h *FeedHandlers) CreatePost(ctx context.Context, req *feedpb.CreatePostReq
 		req.MediaName,
 		req.MediaType,
 		req.Privacy,
+		req.AudienceListId,
+		publishAt,
 	)
+	if errors.Is(err, ErrInvalidAudience) || errors.Is(err, ErrInvalidPublishTime) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create post: %v", err)
 	}
@@ -65,10 +95,157 @@ func (h *FeedHandlers) CreatePost(ctx context.Context, req *feedpb.CreatePostReq
 	}, nil
 }
 
+// createCarouselPost creates a post from the attachments of the request in order
+func (h *FeedHandlers) createCarouselPost(ctx context.Context, req *feedpb.CreatePostRequest, publishAt *time.Time) (*feedpb.FeedResponse, error) {
+	if len(req.MediaData) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "media data and attachments cannot be combined")
+	}
+	if req.Privacy == "" {
+		return nil, status.Error(codes.InvalidArgument, "privacy setting must be specified")
+	}
+	media := make([]MediaUpload, 0, len(req.Attachments))
+	for _, a := range req.Attachments {
+		media = append(media, MediaUpload{Data: a.GetData(), Name: a.GetName(), Type: a.GetType()})
+	}
+
+	postID, err := h.FeedSvc.CreateCarouselPost(ctx, req.AuthorId, req.Text, media, req.Privacy, req.AudienceListId, publishAt)
+	if errors.Is(err, ErrInvalidMedia) || errors.Is(err, ErrInvalidAudience) || errors.Is(err, ErrInvalidPublishTime) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create post: %v", err)
+	}
+
+	resp := &feedpb.FeedResponse{
+		ContentId: postID,
+		Message:   "Post created successfully",
+	}
+	if _, mediaURL, err := h.FeedSvc.GetContent(ctx, postID); err == nil {
+		resp.MediaUrl = mediaURL
+	}
+	return resp, nil
+}
+
+// publishTime reads the optional publish time of a post or reel, unset publishes right away
+func publishTime(ts *timestamppb.Timestamp) (*time.Time, error) {
+	if ts == nil {
+		return nil, nil
+	}
+	if err := ts.CheckValid(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid publish time: %v", err)
+	}
+	publishAt := ts.AsTime()
+	return &publishAt, nil
+}
+
+// createFromUpload creates content around media sent earlier through UploadMedia, hasData tells
+// whether the request also carried inline media
+func (h *FeedHandlers) createFromUpload(ctx context.Context, hasData bool, upload UploadedContent) (*feedpb.FeedResponse, error) {
+	if hasData {
+		return nil, status.Error(codes.InvalidArgument, "media data and an uploaded media ID cannot be combined")
+	}
+	if upload.Privacy == "" {
+		return nil, status.Error(codes.InvalidArgument, "privacy setting must be specified")
+	}
+
+	contentID, err := h.FeedSvc.CreateFromUpload(ctx, upload)
+	switch {
+	case errors.Is(err, ErrInvalidMedia), errors.Is(err, ErrInvalidAudience), errors.Is(err, ErrInvalidPublishTime):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrMediaNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrNotMediaOwner):
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, ErrMediaInUse):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "failed to create content: %v", err)
+	}
+
+	resp := &feedpb.FeedResponse{
+		ContentId: contentID,
+		Message:   "Content created successfully",
+	}
+	if _, mediaURL, err := h.FeedSvc.GetContent(ctx, contentID); err == nil {
+		resp.MediaUrl = mediaURL
+	}
+	return resp, nil
+}
+
+// UploadMedia streams the chunks into media storage as they arrive and returns the new media ID
+func (h *FeedHandlers) UploadMedia(stream feedpb.FeedService_UploadMediaServer) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Error(codes.InvalidArgument, "upload sent no chunks")
+	}
+	if err != nil {
+		return err
+	}
+	if err := authorize(stream.Context(), first.UploaderId); err != nil {
+		return err
+	}
+
+	media, err := h.FeedSvc.UploadMedia(stream.Context(), first.UploaderId, first.FileName, first.MediaType, &chunkReader{stream: stream, buf: first.Data})
+	switch {
+	case errors.Is(err, ErrInvalidUpload):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrUploadTooLarge):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case err != nil:
+		return status.Errorf(codes.Internal, "failed to upload media: %v", err)
+	}
+
+	return stream.SendAndClose(&feedpb.UploadMediaResponse{
+		MediaRefId: int64(media.MediaRefID),
+		SizeBytes:  media.Size,
+		MediaUrl:   GetMediaURL(media.FileID),
+	})
+}
+
+// chunkReader reads the file data of an upload chunk by chunk, io.EOF from the stream ends the file
+type chunkReader struct {
+	stream feedpb.FeedService_UploadMediaServer
+	buf    []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		chunk, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = chunk.Data
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
 func (h *FeedHandlers) CreateReel(ctx context.Context, req *feedpb.CreateReelRequest) (*feedpb.FeedResponse, error) {
 	if req.AuthorId <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "invalid author ID")
 	}
+	if err := authorize(ctx, req.AuthorId); err != nil {
+		return nil, err
+	}
+	publishAt, err := publishTime(req.PublishAt)
+	if err != nil {
+		return nil, err
+	}
+	if req.MediaRefId > 0 {
+		if req.DurationSecs <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "duration must be greater than 0")
+		}
+		return h.createFromUpload(ctx, len(req.MediaData) > 0, UploadedContent{
+			AuthorID:    req.AuthorId,
+			Type:        "REEL",
+			Text:        req.Caption,
+			MediaRefID:  req.MediaRefId,
+			DurationSec: int(req.DurationSecs),
+			Privacy:     req.Privacy,
+			PublishAt:   publishAt,
+		})
+	}
 	if req.Caption == "" && len(req.MediaData) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "reel must have caption or file data")
 	}
@@ -94,7 +271,11 @@ func (h *FeedHandlers) CreateReel(ctx context.Context, req *feedpb.CreateReelReq
 		req.MediaName,
 		int(req.DurationSecs),
 		req.Privacy,
+		publishAt,
 	)
+	if errors.Is(err, ErrInvalidPublishTime) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create reel: %v", err)
 	}
@@ -120,6 +301,22 @@ func (h *FeedHandlers) CreateStory(ctx context.Context, req *feedpb.CreateStoryR
 	if req.AuthorId <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "invalid author ID")
 	}
+	if err := authorize(ctx, req.AuthorId); err != nil {
+		return nil, err
+	}
+	if req.MediaRefId > 0 {
+		if req.DurationSecs <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "duration must be greater than 0")
+		}
+		return h.createFromUpload(ctx, len(req.MediaData) > 0, UploadedContent{
+			AuthorID:       req.AuthorId,
+			Type:           "STORY",
+			MediaRefID:     req.MediaRefId,
+			DurationSec:    int(req.DurationSecs),
+			Privacy:        req.Privacy,
+			AudienceListID: req.AudienceListId,
+		})
+	}
 	if req.MediaName == "" {
 		return nil, status.Error(codes.InvalidArgument, "media name must be specified")
 	}
@@ -139,7 +336,11 @@ func (h *FeedHandlers) CreateStory(ctx context.Context, req *feedpb.CreateStoryR
 		req.MediaName,
 		int(req.DurationSecs),
 		req.Privacy,
+		req.AudienceListId,
 	)
+	if errors.Is(err, ErrInvalidAudience) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create story: %v", err)
 	}
@@ -161,14 +362,15 @@ func (h *FeedHandlers) CreateStory(ctx context.Context, req *feedpb.CreateStoryR
 }
 
 func (h *FeedHandlers) ReactToContent(ctx context.Context, req *feedpb.ReactionRequest) (*feedpb.FeedStatusResponse, error) {
-	err := h.FeedSvc.ReactToContent(ctx, req.UserId, req.ContentId, req.Type)
-
 	if req.UserId <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
 	}
+	if err := authorize(ctx, req.UserId); err != nil {
+		return nil, err
+	}
 
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "%v", err)
+	if err := h.FeedSvc.ReactToContent(ctx, req.UserId, req.ContentId, req.Type); err != nil {
+		return nil, interactionError("failed to react", err)
 	}
 
 	return &feedpb.FeedStatusResponse{
@@ -180,11 +382,15 @@ func (h *FeedHandlers) GetReactions(ctx context.Context, req *feedpb.ContentID)
 	if req.ContentId <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "invalid content ID")
 	}
+	viewerID, err := callerFor(ctx, req.ViewerId)
+	if err != nil {
+		return nil, err
+	}
 
 	// Call the service method to get reactions
-	reactions, err := h.FeedSvc.GetReactions(ctx, req.ContentId)
+	reactions, err := h.FeedSvc.GetReactions(ctx, viewerID, req.ContentId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get reactions: %v", err)
+		return nil, interactionError("failed to get reactions", err)
 	}
 
 	// Convert reactions to protobuf format
@@ -202,10 +408,41 @@ func (h *FeedHandlers) GetReactions(ctx context.Context, req *feedpb.ContentID)
 	}, nil
 }
 
+func (h *FeedHandlers) ListReactors(ctx context.Context, req *feedpb.ListReactorsRequest) (*feedpb.ReactorList, error) {
+	if req.ContentId <= 0 || req.ViewerId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid content or viewer ID")
+	}
+	if err := authorize(ctx, req.ViewerId); err != nil {
+		return nil, err
+	}
+	if req.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page size must not be negative")
+	}
+
+	page, err := h.FeedSvc.ListReactors(ctx, req.ViewerId, req.ContentId, req.Type, req.Cursor, int(req.PageSize))
+	if err != nil {
+		return nil, interactionError("failed to list reactors", err)
+	}
+
+	var pbReactors []*feedpb.Reactor
+	for _, r := range page.Reactors {
+		pbReactors = append(pbReactors, &feedpb.Reactor{
+			UserId:    r.UserID,
+			Handle:    r.Handle,
+			Type:      r.Type,
+			ReactedAt: timestamppb.New(r.ReactedAt),
+		})
+	}
+	return &feedpb.ReactorList{Reactors: pbReactors, NextCursor: page.NextCursor}, nil
+}
+
 func (h *FeedHandlers) DeleteReaction(ctx context.Context, req *feedpb.DeleteReactionRequest) (*feedpb.FeedStatusResponse, error) {
 	if req.UserId <= 0 || req.ContentId <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "invalid user ID or content ID")
 	}
+	if err := authorize(ctx, req.UserId); err != nil {
+		return nil, err
+	}
 	err := h.FeedSvc.DeleteReaction(ctx, req.UserId, req.ContentId)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "%v", err)
@@ -219,9 +456,16 @@ func (h *FeedHandlers) GetMediaRef(ctx context.Context, req *feedpb.ContentID) (
 	if req.ContentId <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "invalid media reference ID")
 	}
+	viewerID, err := callerFor(ctx, req.ViewerId)
+	if err != nil {
+		return nil, err
+	}
 
 	// Call the service method to get media reference
-	mediaRef, _, err := h.FeedSvc.GetMediaRef(ctx, req.ContentId)
+	mediaRef, _, err := h.FeedSvc.GetMediaRef(ctx, viewerID, req.ContentId)
+	if errors.Is(err, ErrMediaNotFound) || errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, "media not found")
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get media reference: %v", err)
 	}
@@ -238,16 +482,35 @@ func (h *FeedHandlers) GetContent(ctx context.Context, req *feedpb.ContentID) (*
 		return nil, status.Error(codes.InvalidArgument, "invalid content ID")
 	}
 
-	// Call the service method to get content
-	content, url, err := h.FeedSvc.GetContent(ctx, req.ContentId)
+	viewerID, err := callerFor(ctx, req.ViewerId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the service method to get content, only if the caller may see it
+	content, url, err := h.FeedSvc.GetVisibleContent(ctx, viewerID, req.ContentId)
+	if errors.Is(err, ErrContentNotVisible) || errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, "content not found")
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get content: %v", err)
 	}
 
+	reactions, err := h.FeedSvc.SummarizeReactions(ctx, viewerID, []int64{content.ContentID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get content reactions: %v", err)
+	}
+	shares, err := h.FeedSvc.CountShares(ctx, []int64{content.ContentID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get content shares: %v", err)
+	}
+
 	return &feedpb.FeedResponse{
-		ContentId: content.ContentID,
-		MediaUrl:  url,
-		Message:   *content.TextContent,
+		ContentId:  content.ContentID,
+		MediaUrl:   url,
+		Message:    safeString(content.TextContent),
+		Reactions:  toProtoReactionSummary(reactions[content.ContentID]),
+		ShareCount: shares[content.ContentID],
 	}, nil
 }
 
@@ -255,11 +518,14 @@ func (h *FeedHandlers) DeleteContent(ctx context.Context, req *feedpb.ContentID)
 	if req.ContentId <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "invalid content ID")
 	}
-
-	// Call the service method to delete content
-	err := h.FeedSvc.DeleteContent(ctx, req.ContentId)
+	requesterID, err := callerFor(ctx, req.ViewerId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete content: %v", err)
+		return nil, err
+	}
+
+	// Call the service method to delete content, only its author may
+	if err := h.FeedSvc.DeleteContent(ctx, requesterID, req.ContentId); err != nil {
+		return nil, interactionError("failed to delete content", err)
 	}
 
 	return &feedpb.FeedStatusResponse{
@@ -267,6 +533,101 @@ func (h *FeedHandlers) DeleteContent(ctx context.Context, req *feedpb.ContentID)
 	}, nil
 }
 
+func (h *FeedHandlers) UpdateContentPrivacy(ctx context.Context, req *feedpb.UpdateContentPrivacyRequest) (*feedpb.FeedStatusResponse, error) {
+	if req.ContentId <= 0 || req.RequesterId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid content ID or requester ID")
+	}
+	if err := authorize(ctx, req.RequesterId); err != nil {
+		return nil, err
+	}
+
+	err := h.FeedSvc.UpdateContentPrivacy(ctx, req.RequesterId, req.ContentId, req.Privacy)
+	if err != nil {
+		return nil, interactionError("failed to update privacy", err)
+	}
+
+	return &feedpb.FeedStatusResponse{
+		Message: "Privacy updated successfully",
+	}, nil
+}
+
+func (h *FeedHandlers) UpdateContent(ctx context.Context, req *feedpb.UpdateContentRequest) (*feedpb.ContentResponse, error) {
+	if req.ContentId <= 0 || req.EditorId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid content ID or editor ID")
+	}
+	if err := authorize(ctx, req.EditorId); err != nil {
+		return nil, err
+	}
+
+	content, err := h.FeedSvc.UpdateContent(ctx, req.EditorId, req.ContentId, ContentUpdate{Text: req.Text, Privacy: req.Privacy, AudienceListID: req.AudienceListId})
+	if err != nil {
+		return nil, interactionError("failed to update content", err)
+	}
+	_, url, err := h.FeedSvc.GetContent(ctx, content.ContentID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get content: %v", err)
+	}
+	pbContents, err := h.toTimelineContents(ctx, req.EditorId, []dbmysql.Content{*content}, []string{url})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to build content: %v", err)
+	}
+	return &feedpb.ContentResponse{Content: pbContents[0]}, nil
+}
+
+func (h *FeedHandlers) ListRevisions(ctx context.Context, req *feedpb.ListRevisionsRequest) (*feedpb.RevisionList, error) {
+	if req.ContentId <= 0 || req.ViewerId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid content ID or viewer ID")
+	}
+	if err := authorize(ctx, req.ViewerId); err != nil {
+		return nil, err
+	}
+
+	page, err := h.FeedSvc.ListRevisions(ctx, req.ViewerId, req.ContentId, req.Cursor, int(req.PageSize))
+	if err != nil {
+		return nil, interactionError("failed to list revisions", err)
+	}
+	resp := &feedpb.RevisionList{NextCursor: page.NextCursor}
+	for _, r := range page.Revisions {
+		revision := &feedpb.Revision{
+			RevisionId: r.RevisionID,
+			Text:       safeString(r.TextContent),
+			Privacy:    r.Privacy,
+			ReplacedAt: timestamppb.New(r.ReplacedAt),
+		}
+		if r.AudienceListID != nil {
+			revision.AudienceListId = *r.AudienceListID
+		}
+		resp.Revisions = append(resp.Revisions, revision)
+	}
+	return resp, nil
+}
+
+func (h *FeedHandlers) FriendshipAccepted(ctx context.Context, req *feedpb.FriendshipRequest) (*feedpb.FeedStatusResponse, error) {
+	if req.UserId <= 0 || req.FriendId <= 0 || req.UserId == req.FriendId {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID or friend ID")
+	}
+	// either side of the new friendship may report it
+	callerID, err := callerFor(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	if callerID != req.UserId && callerID != req.FriendId {
+		return nil, status.Error(codes.PermissionDenied, "only one of the new friends can report a friendship")
+	}
+
+	err = h.FeedSvc.OnFriendshipAccepted(ctx, req.UserId, req.FriendId)
+	switch {
+	case errors.Is(err, ErrNotFriends):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "failed to backfill timelines: %v", err)
+	}
+
+	return &feedpb.FeedStatusResponse{
+		Message: "Timelines updated successfully",
+	}, nil
+}
+
 func safeString(s *string) string {
 	if s == nil {
 		return ""
@@ -274,33 +635,38 @@ func safeString(s *string) string {
 	return *s
 }
 
-func (h *FeedHandlers) GetTimeline(ctx context.Context, req *feedpb.UserID) (*feedpb.TimelineResponse, error) {
+func (h *FeedHandlers) GetTimeline(ctx context.Context, req *feedpb.GetTimelineRequest) (*feedpb.TimelineResponse, error) {
 	if req.UserId <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
 	}
+	if err := authorize(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	if req.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page size must not be negative")
+	}
 
 	// Call the service method to get timeline
-	contents, urls, err := h.FeedSvc.GetTimeline(ctx, req.UserId)
+	page, err := h.FeedSvc.GetTimeline(ctx, req.UserId, TimelineQuery{
+		Cursor:   req.Cursor,
+		PageSize: int(req.PageSize),
+		Ranking:  req.Ranking,
+	})
+	if errors.Is(err, ErrInvalidCursor) || errors.Is(err, ErrInvalidRanking) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get timeline: %v", err)
 	}
 
-	var pbContents []*feedpb.TimelineContent
-
-	for i, content := range contents {
-		pbContents = append(pbContents, &feedpb.TimelineContent{
-			ContentId: content.ContentID,
-			AuthorId:  content.AuthorID,
-			Type:      content.Type,
-			Text:      safeString(content.TextContent),
-			MediaUrl:  urls[i],
-			Privacy:   content.Privacy,
-			CreatedAt: timestamppb.New(content.CreatedAt),
-		})
+	pbContents, err := h.toTimelineContents(ctx, req.UserId, page.Contents, page.MediaURLs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get timeline: %v", err)
 	}
 
 	return &feedpb.TimelineResponse{
-		Contents: pbContents,
+		Contents:   pbContents,
+		NextCursor: page.NextCursor,
 	}, nil
 }
 
@@ -308,6 +674,9 @@ func (h *FeedHandlers) GetUserContent(ctx context.Context, req *feedpb.GetUserCo
 	if req.RequesterId <= 0 || req.TargetUserId <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "invalid user IDs")
 	}
+	if err := authorize(ctx, req.RequesterId); err != nil {
+		return nil, err
+	}
 
 	// Call the service method to get user content
 	contents, urls, err := h.FeedSvc.GetUserContent(ctx, req.RequesterId, req.TargetUserId)
@@ -315,21 +684,994 @@ func (h *FeedHandlers) GetUserContent(ctx context.Context, req *feedpb.GetUserCo
 		return nil, status.Errorf(codes.Internal, "failed to get user content: %v", err)
 	}
 
-	var pbContents []*feedpb.TimelineContent
+	pbContents, err := h.toTimelineContents(ctx, req.RequesterId, contents, urls)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user content: %v", err)
+	}
+
+	highlights, err := h.FeedSvc.ListHighlights(ctx, req.RequesterId, req.TargetUserId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get highlights: %v", err)
+	}
+	pbHighlights, err := h.toProtoHighlights(ctx, req.RequesterId, highlights)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get highlights: %v", err)
+	}
+
+	return &feedpb.TimelineResponse{
+		Contents:   pbContents,
+		Highlights: pbHighlights,
+	}, nil
+}
 
+// toTimelineContents converts contents and their media URLs, adding comment counts, reaction summaries
+// and story seen-state in one batch each
+func (h *FeedHandlers) toTimelineContents(ctx context.Context, viewerID int64, contents []dbmysql.Content, urls []string) ([]*feedpb.TimelineContent, error) {
+	ids := make([]int64, 0, len(contents))
+	for _, content := range contents {
+		ids = append(ids, content.ContentID)
+	}
+	commentCounts, err := h.FeedSvc.CountComments(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	reactions, err := h.FeedSvc.SummarizeReactions(ctx, viewerID, ids)
+	if err != nil {
+		return nil, err
+	}
+	seen, err := h.FeedSvc.StoriesSeen(ctx, viewerID, contents)
+	if err != nil {
+		return nil, err
+	}
+	mentions, err := h.FeedSvc.ListMentions(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	shareCounts, err := h.FeedSvc.CountShares(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	shared, err := h.FeedSvc.ResolveShared(ctx, viewerID, contents)
+	if err != nil {
+		return nil, err
+	}
+	media, err := h.FeedSvc.ListMedia(ctx, contents)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbContents []*feedpb.TimelineContent
 	for i, content := range contents {
 		pbContents = append(pbContents, &feedpb.TimelineContent{
-			ContentId: content.ContentID,
-			AuthorId:  content.AuthorID,
-			Type:      content.Type,
-			Text:      safeString(content.TextContent),
-			MediaUrl:  urls[i],
-			Privacy:   content.Privacy,
-			CreatedAt: timestamppb.New(content.CreatedAt),
+			ContentId:    content.ContentID,
+			AuthorId:     content.AuthorID,
+			Type:         content.Type,
+			Text:         safeString(content.TextContent),
+			MediaUrl:     urls[i],
+			Privacy:      content.Privacy,
+			CreatedAt:    timestamppb.New(content.CreatedAt),
+			CommentCount: commentCounts[content.ContentID],
+			Reactions:    toProtoReactionSummary(reactions[content.ContentID]),
+			Seen:         seen[content.ContentID],
+			Mentions:     toProtoMentions(mentions[content.ContentID]),
+			ShareCount:   shareCounts[content.ContentID],
 		})
+		for _, m := range media[content.ContentID] {
+			pbContents[i].Media = append(pbContents[i].Media, &feedpb.MediaItem{Url: m.URL, Type: m.Type})
+		}
+		if content.EditedAt != nil {
+			pbContents[i].EditedAt = timestamppb.New(*content.EditedAt)
+		}
+		if content.SharedContentID != nil {
+			pbContents[i].Shared = toProtoShared(*content.SharedContentID, shared[*content.SharedContentID])
+		}
 	}
+	return pbContents, nil
+}
 
-	return &feedpb.TimelineResponse{
-		Contents: pbContents,
+// toProtoShared renders the original of a share without its own engagement, nil marks it unavailable
+func toProtoShared(originalID int64, original *SharedOriginal) *feedpb.SharedContent {
+	pb := &feedpb.SharedContent{ContentId: originalID}
+	if original == nil {
+		return pb
+	}
+	pb.Available = true
+	pb.Content = &feedpb.TimelineContent{
+		ContentId: original.Content.ContentID,
+		AuthorId:  original.Content.AuthorID,
+		Type:      original.Content.Type,
+		Text:      safeString(original.Content.TextContent),
+		MediaUrl:  original.MediaURL,
+		Privacy:   original.Content.Privacy,
+		CreatedAt: timestamppb.New(original.Content.CreatedAt),
+	}
+	return pb
+}
+
+func toProtoMentions(mentions []dbmysql.Mention) []*feedpb.Mention {
+	var pbMentions []*feedpb.Mention
+	for _, m := range mentions {
+		pbMentions = append(pbMentions, &feedpb.Mention{
+			UserId: m.MentionedUserID,
+			Handle: m.Handle,
+			Offset: int32(m.Offset),
+			Length: int32(m.Length),
+		})
+	}
+	return pbMentions
+}
+
+// --------- COMMENTS ---------
+
+func (h *FeedHandlers) AddComment(ctx context.Context, req *feedpb.AddCommentRequest) (*feedpb.CommentResponse, error) {
+	if req.ContentId <= 0 || req.AuthorId <= 0 || req.ParentId < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid content, author or parent ID")
+	}
+	if err := authorize(ctx, req.AuthorId); err != nil {
+		return nil, err
+	}
+
+	comment, err := h.FeedSvc.AddComment(ctx, req.AuthorId, req.ContentId, req.ParentId, req.Text)
+	if err != nil {
+		return nil, interactionError("failed to add comment", err)
+	}
+	return &feedpb.CommentResponse{Comment: toProtoComment(comment, 0)}, nil
+}
+
+func (h *FeedHandlers) ListComments(ctx context.Context, req *feedpb.ListCommentsRequest) (*feedpb.CommentList, error) {
+	if req.ContentId <= 0 || req.ViewerId <= 0 || req.ParentId < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid content, viewer or parent ID")
+	}
+	if err := authorize(ctx, req.ViewerId); err != nil {
+		return nil, err
+	}
+	if req.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page size must not be negative")
+	}
+
+	page, err := h.FeedSvc.ListComments(ctx, req.ViewerId, req.ContentId, req.ParentId, req.Cursor, int(req.PageSize))
+	if err != nil {
+		return nil, interactionError("failed to list comments", err)
+	}
+
+	pbComments := make([]*feedpb.Comment, 0, len(page.Comments))
+	for i := range page.Comments {
+		pbComments = append(pbComments, toProtoComment(&page.Comments[i], page.ReplyCounts[page.Comments[i].CommentID]))
+	}
+	return &feedpb.CommentList{
+		Comments:   pbComments,
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
+func (h *FeedHandlers) EditComment(ctx context.Context, req *feedpb.EditCommentRequest) (*feedpb.CommentResponse, error) {
+	if req.CommentId <= 0 || req.EditorId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid comment ID or editor ID")
+	}
+	if err := authorize(ctx, req.EditorId); err != nil {
+		return nil, err
+	}
+
+	comment, err := h.FeedSvc.EditComment(ctx, req.EditorId, req.CommentId, req.Text)
+	if err != nil {
+		return nil, interactionError("failed to edit comment", err)
+	}
+	return &feedpb.CommentResponse{Comment: toProtoComment(comment, 0)}, nil
+}
+
+func (h *FeedHandlers) DeleteComment(ctx context.Context, req *feedpb.DeleteCommentRequest) (*feedpb.FeedStatusResponse, error) {
+	if req.CommentId <= 0 || req.RequesterId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid comment ID or requester ID")
+	}
+	if err := authorize(ctx, req.RequesterId); err != nil {
+		return nil, err
+	}
+
+	if err := h.FeedSvc.DeleteComment(ctx, req.RequesterId, req.CommentId); err != nil {
+		return nil, interactionError("failed to delete comment", err)
+	}
+	return &feedpb.FeedStatusResponse{
+		Message: "Comment deleted successfully",
 	}, nil
 }
+
+// interactionError maps comment, reaction, story view and content errors to gRPC codes, content the caller
+// may not see is not found
+func interactionError(action string, err error) error {
+	switch {
+	case errors.Is(err, ErrInvalidComment), errors.Is(err, ErrInvalidReplyParent), errors.Is(err, ErrInvalidCursor), errors.Is(err, ErrNotAStory), errors.Is(err, ErrNotAReel),
+		errors.Is(err, ErrNotShareable), errors.Is(err, ErrSharePrivacy), errors.Is(err, ErrInvalidPrivacy), errors.Is(err, ErrInvalidContentUpdate),
+		errors.Is(err, ErrInvalidAudience):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrNotCommentAuthor), errors.Is(err, ErrCannotDeleteComment), errors.Is(err, ErrNotContentOwner):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, ErrUnpublishedDraft):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ErrContentNotVisible), errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", action, err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", action, err)
+}
+
+func toProtoComment(comment *dbmysql.Comment, replyCount int64) *feedpb.Comment {
+	pb := &feedpb.Comment{
+		CommentId:  comment.CommentID,
+		ContentId:  comment.ContentID,
+		AuthorId:   comment.AuthorID,
+		Text:       comment.Text,
+		ReplyCount: replyCount,
+		CreatedAt:  timestamppb.New(comment.CreatedAt),
+	}
+	if comment.ParentID != nil {
+		pb.ParentId = *comment.ParentID
+	}
+	if comment.EditedAt != nil {
+		pb.EditedAt = timestamppb.New(*comment.EditedAt)
+	}
+	return pb
+}
+
+// --------- STORY VIEWS ---------
+
+func (h *FeedHandlers) MarkStoryViewed(ctx context.Context, req *feedpb.StoryViewRequest) (*feedpb.FeedStatusResponse, error) {
+	if req.StoryId <= 0 || req.ViewerId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid story or viewer ID")
+	}
+	if err := authorize(ctx, req.ViewerId); err != nil {
+		return nil, err
+	}
+
+	if err := h.FeedSvc.MarkStoryViewed(ctx, req.ViewerId, req.StoryId); err != nil {
+		return nil, interactionError("failed to mark story viewed", err)
+	}
+	return &feedpb.FeedStatusResponse{Message: "Story marked as viewed"}, nil
+}
+
+func (h *FeedHandlers) ListStoryViewers(ctx context.Context, req *feedpb.ListStoryViewersRequest) (*feedpb.StoryViewerList, error) {
+	if req.StoryId <= 0 || req.RequesterId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid story or requester ID")
+	}
+	if err := authorize(ctx, req.RequesterId); err != nil {
+		return nil, err
+	}
+	if req.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page size must not be negative")
+	}
+
+	page, err := h.FeedSvc.ListStoryViewers(ctx, req.RequesterId, req.StoryId, req.Cursor, int(req.PageSize))
+	if err != nil {
+		return nil, interactionError("failed to list story viewers", err)
+	}
+
+	var pbViewers []*feedpb.StoryViewer
+	for _, v := range page.Viewers {
+		pbViewers = append(pbViewers, &feedpb.StoryViewer{
+			UserId:   v.UserID,
+			Handle:   v.Handle,
+			ViewedAt: timestamppb.New(v.ViewedAt),
+		})
+	}
+	return &feedpb.StoryViewerList{Viewers: pbViewers, NextCursor: page.NextCursor}, nil
+}
+
+// --------- STORY ARCHIVE & HIGHLIGHTS ---------
+
+func (h *FeedHandlers) ListStoryArchive(ctx context.Context, req *feedpb.ListStoryArchiveRequest) (*feedpb.TimelineResponse, error) {
+	if req.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+	if err := authorize(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	if req.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page size must not be negative")
+	}
+
+	page, err := h.FeedSvc.ListStoryArchive(ctx, req.UserId, TimelineQuery{Cursor: req.Cursor, PageSize: int(req.PageSize)})
+	if errors.Is(err, ErrInvalidCursor) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list story archive: %v", err)
+	}
+
+	pbContents, err := h.toTimelineContents(ctx, req.UserId, page.Contents, page.MediaURLs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list story archive: %v", err)
+	}
+	return &feedpb.TimelineResponse{Contents: pbContents, NextCursor: page.NextCursor}, nil
+}
+
+func (h *FeedHandlers) CreateHighlight(ctx context.Context, req *feedpb.CreateHighlightRequest) (*feedpb.HighlightResponse, error) {
+	if req.OwnerId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid owner ID")
+	}
+	if err := authorize(ctx, req.OwnerId); err != nil {
+		return nil, err
+	}
+
+	highlight, err := h.FeedSvc.CreateHighlight(ctx, req.OwnerId, req.Title, req.StoryIds)
+	if err != nil {
+		return nil, highlightError("failed to create highlight", err)
+	}
+	return &feedpb.HighlightResponse{Highlight: toProtoHighlight(highlight)}, nil
+}
+
+func (h *FeedHandlers) UpdateHighlight(ctx context.Context, req *feedpb.UpdateHighlightRequest) (*feedpb.HighlightResponse, error) {
+	if req.HighlightId <= 0 || req.RequesterId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid highlight or requester ID")
+	}
+	if err := authorize(ctx, req.RequesterId); err != nil {
+		return nil, err
+	}
+
+	highlight, err := h.FeedSvc.UpdateHighlight(ctx, req.RequesterId, req.HighlightId, req.Title, req.StoryIds)
+	if err != nil {
+		return nil, highlightError("failed to update highlight", err)
+	}
+	return &feedpb.HighlightResponse{Highlight: toProtoHighlight(highlight)}, nil
+}
+
+func (h *FeedHandlers) DeleteHighlight(ctx context.Context, req *feedpb.DeleteHighlightRequest) (*feedpb.FeedStatusResponse, error) {
+	if req.HighlightId <= 0 || req.RequesterId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid highlight or requester ID")
+	}
+	if err := authorize(ctx, req.RequesterId); err != nil {
+		return nil, err
+	}
+
+	if err := h.FeedSvc.DeleteHighlight(ctx, req.RequesterId, req.HighlightId); err != nil {
+		return nil, highlightError("failed to delete highlight", err)
+	}
+	return &feedpb.FeedStatusResponse{Message: "Highlight deleted successfully"}, nil
+}
+
+func (h *FeedHandlers) ReorderHighlights(ctx context.Context, req *feedpb.ReorderHighlightsRequest) (*feedpb.FeedStatusResponse, error) {
+	if req.OwnerId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid owner ID")
+	}
+	if err := authorize(ctx, req.OwnerId); err != nil {
+		return nil, err
+	}
+
+	if err := h.FeedSvc.ReorderHighlights(ctx, req.OwnerId, req.HighlightIds); err != nil {
+		return nil, highlightError("failed to reorder highlights", err)
+	}
+	return &feedpb.FeedStatusResponse{Message: "Highlights reordered successfully"}, nil
+}
+
+// toProtoHighlights converts highlights, the stories of all highlights are decorated in one batch
+func (h *FeedHandlers) toProtoHighlights(ctx context.Context, viewerID int64, highlights []HighlightView) ([]*feedpb.Highlight, error) {
+	var stories []dbmysql.Content
+	var urls []string
+	for _, hl := range highlights {
+		stories = append(stories, hl.Stories...)
+		urls = append(urls, hl.MediaURLs...)
+	}
+	pbStories, err := h.toTimelineContents(ctx, viewerID, stories, urls)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbHighlights []*feedpb.Highlight
+	for _, hl := range highlights {
+		pb := toProtoHighlight(&hl.Highlight)
+		pb.Stories, pbStories = pbStories[:len(hl.Stories)], pbStories[len(hl.Stories):]
+		pbHighlights = append(pbHighlights, pb)
+	}
+	return pbHighlights, nil
+}
+
+func highlightError(action string, err error) error {
+	switch {
+	case errors.Is(err, ErrInvalidHighlight), errors.Is(err, ErrNotArchivedStory), errors.Is(err, ErrInvalidHighlightOrder):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrNotHighlightOwner):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", action, err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", action, err)
+}
+
+func toProtoHighlight(highlight *dbmysql.Highlight) *feedpb.Highlight {
+	return &feedpb.Highlight{
+		HighlightId: highlight.HighlightID,
+		OwnerId:     highlight.OwnerID,
+		Title:       highlight.Title,
+		Position:    int32(highlight.Position),
+		CreatedAt:   timestamppb.New(highlight.CreatedAt),
+	}
+}
+
+func toProtoReactionSummary(summary ReactionSummary) *feedpb.ReactionSummary {
+	return &feedpb.ReactionSummary{
+		Counts:         summary.Counts,
+		Total:          summary.Total,
+		ViewerReaction: summary.ViewerReaction,
+	}
+}
+
+// --------- HASHTAGS ---------
+
+func (h *FeedHandlers) GetHashtagFeed(ctx context.Context, req *feedpb.HashtagFeedRequest) (*feedpb.TimelineResponse, error) {
+	if req.ViewerId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid viewer ID")
+	}
+	if err := authorize(ctx, req.ViewerId); err != nil {
+		return nil, err
+	}
+	if req.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page size must not be negative")
+	}
+
+	page, err := h.FeedSvc.GetHashtagFeed(ctx, req.Tag, TimelineQuery{Cursor: req.Cursor, PageSize: int(req.PageSize)})
+	if errors.Is(err, ErrInvalidHashtag) || errors.Is(err, ErrInvalidCursor) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get hashtag feed: %v", err)
+	}
+
+	pbContents, err := h.toTimelineContents(ctx, req.ViewerId, page.Contents, page.MediaURLs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get hashtag feed: %v", err)
+	}
+	return &feedpb.TimelineResponse{Contents: pbContents, NextCursor: page.NextCursor}, nil
+}
+
+func (h *FeedHandlers) GetTrendingHashtags(ctx context.Context, req *feedpb.TrendingHashtagsRequest) (*feedpb.TrendingHashtagList, error) {
+	if req.WindowSeconds < 0 || req.Limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "window and limit must not be negative")
+	}
+	if _, err := callerFor(ctx, 0); err != nil {
+		return nil, err
+	}
+
+	// clamp in seconds first, a huge window would overflow time.Duration
+	seconds := req.WindowSeconds
+	if seconds > int64(MaxTrendingWindow/time.Second) {
+		seconds = int64(MaxTrendingWindow / time.Second)
+	}
+	window := clampTrendingWindow(time.Duration(seconds) * time.Second)
+	trending, err := h.FeedSvc.GetTrendingHashtags(ctx, window, int(req.Limit))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get trending hashtags: %v", err)
+	}
+
+	resp := &feedpb.TrendingHashtagList{WindowSeconds: int64(window / time.Second)}
+	for _, t := range trending {
+		resp.Hashtags = append(resp.Hashtags, &feedpb.TrendingHashtag{
+			Tag:          t.Tag,
+			Uses:         t.Uses,
+			PreviousUses: t.PreviousUses,
+			Score:        t.Score,
+		})
+	}
+	return resp, nil
+}
+
+// --------- MENTIONS ---------
+
+func (h *FeedHandlers) GetMentionsFeed(ctx context.Context, req *feedpb.MentionsFeedRequest) (*feedpb.TimelineResponse, error) {
+	if req.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+	if err := authorize(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	if req.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page size must not be negative")
+	}
+
+	page, err := h.FeedSvc.GetMentionsFeed(ctx, req.UserId, TimelineQuery{Cursor: req.Cursor, PageSize: int(req.PageSize)})
+	if errors.Is(err, ErrInvalidCursor) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get mentions feed: %v", err)
+	}
+
+	pbContents, err := h.toTimelineContents(ctx, req.UserId, page.Contents, page.MediaURLs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get mentions feed: %v", err)
+	}
+	return &feedpb.TimelineResponse{Contents: pbContents, NextCursor: page.NextCursor}, nil
+}
+
+// --------- SHARES ---------
+
+func (h *FeedHandlers) ShareContent(ctx context.Context, req *feedpb.ShareContentRequest) (*feedpb.FeedResponse, error) {
+	if req.SharerId <= 0 || req.ContentId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid sharer or content ID")
+	}
+	if err := authorize(ctx, req.SharerId); err != nil {
+		return nil, err
+	}
+
+	shareID, err := h.FeedSvc.ShareContent(ctx, req.SharerId, req.ContentId, req.Commentary, req.Privacy)
+	if err != nil {
+		return nil, interactionError("failed to share content", err)
+	}
+	return &feedpb.FeedResponse{
+		ContentId: shareID,
+		Message:   "Content shared successfully",
+	}, nil
+}
+
+// --------- COLLECTIONS ---------
+
+func (h *FeedHandlers) SaveContent(ctx context.Context, req *feedpb.SaveContentRequest) (*feedpb.CollectionResponse, error) {
+	if req.UserId <= 0 || req.ContentId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid user or content ID")
+	}
+	if err := authorize(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	collection, err := h.FeedSvc.SaveContent(ctx, req.UserId, req.ContentId, req.Collection)
+	if err != nil {
+		return nil, collectionError("failed to save content", err)
+	}
+	return &feedpb.CollectionResponse{Collection: &feedpb.Collection{
+		CollectionId: collection.CollectionID,
+		Name:         collection.Name,
+		CreatedAt:    timestamppb.New(collection.CreatedAt),
+	}}, nil
+}
+
+func (h *FeedHandlers) UnsaveContent(ctx context.Context, req *feedpb.UnsaveContentRequest) (*feedpb.FeedStatusResponse, error) {
+	if req.UserId <= 0 || req.ContentId <= 0 || req.CollectionId < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid user, content or collection ID")
+	}
+	if err := authorize(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	if err := h.FeedSvc.UnsaveContent(ctx, req.UserId, req.ContentId, req.CollectionId); err != nil {
+		return nil, collectionError("failed to unsave content", err)
+	}
+	return &feedpb.FeedStatusResponse{Message: "Content unsaved successfully"}, nil
+}
+
+func (h *FeedHandlers) ListCollections(ctx context.Context, req *feedpb.UserID) (*feedpb.CollectionList, error) {
+	if req.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+	if err := authorize(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	summaries, err := h.FeedSvc.ListCollections(ctx, req.UserId)
+	if err != nil {
+		return nil, collectionError("failed to list collections", err)
+	}
+	resp := &feedpb.CollectionList{}
+	for _, summary := range summaries {
+		resp.Collections = append(resp.Collections, &feedpb.Collection{
+			CollectionId: summary.Collection.CollectionID,
+			Name:         summary.Collection.Name,
+			ItemCount:    summary.ItemCount,
+			CreatedAt:    timestamppb.New(summary.Collection.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+func (h *FeedHandlers) ListSavedContent(ctx context.Context, req *feedpb.ListSavedContentRequest) (*feedpb.SavedContentList, error) {
+	if req.UserId <= 0 || req.CollectionId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid user or collection ID")
+	}
+	if err := authorize(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	page, err := h.FeedSvc.ListSavedContent(ctx, req.UserId, req.CollectionId, req.Cursor, int(req.PageSize))
+	if err != nil {
+		return nil, collectionError("failed to list saved content", err)
+	}
+
+	var contents []dbmysql.Content
+	var urls []string
+	for _, item := range page.Items {
+		if item.Content != nil {
+			contents = append(contents, *item.Content)
+			urls = append(urls, item.MediaURL)
+		}
+	}
+	pbContents, err := h.toTimelineContents(ctx, req.UserId, contents, urls)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to build saved content: %v", err)
+	}
+
+	resp := &feedpb.SavedContentList{NextCursor: page.NextCursor}
+	next := 0
+	for _, item := range page.Items {
+		pb := &feedpb.SavedItem{
+			ContentId: item.Item.ContentID,
+			SavedAt:   timestamppb.New(item.Item.SavedAt),
+		}
+		if item.Content != nil {
+			pb.Available = true
+			pb.Content = pbContents[next]
+			next++
+		}
+		resp.Items = append(resp.Items, pb)
+	}
+	return resp, nil
+}
+
+func collectionError(action string, err error) error {
+	switch {
+	case errors.Is(err, ErrInvalidCollection), errors.Is(err, ErrNotSaveable), errors.Is(err, ErrInvalidCursor):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrTooManyCollections):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ErrNotCollectionOwner):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, ErrContentNotVisible), errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", action, err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", action, err)
+}
+
+// --------- AUDIENCE LISTS ---------
+
+func (h *FeedHandlers) CreateAudienceList(ctx context.Context, req *feedpb.CreateAudienceListRequest) (*feedpb.AudienceListResponse, error) {
+	if req.OwnerId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid owner ID")
+	}
+	if err := authorize(ctx, req.OwnerId); err != nil {
+		return nil, err
+	}
+
+	list, err := h.FeedSvc.CreateAudienceList(ctx, req.OwnerId, req.Name, req.MemberIds)
+	if err != nil {
+		return nil, audienceError("failed to create audience list", err)
+	}
+	return &feedpb.AudienceListResponse{List: &feedpb.AudienceList{
+		ListId:    list.ListID,
+		Name:      list.Name,
+		MemberIds: req.MemberIds,
+		CreatedAt: timestamppb.New(list.CreatedAt),
+	}}, nil
+}
+
+func (h *FeedHandlers) ListAudienceLists(ctx context.Context, req *feedpb.UserID) (*feedpb.AudienceListList, error) {
+	if req.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+	if err := authorize(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	summaries, err := h.FeedSvc.ListAudienceLists(ctx, req.UserId)
+	if err != nil {
+		return nil, audienceError("failed to list audience lists", err)
+	}
+	resp := &feedpb.AudienceListList{}
+	for _, summary := range summaries {
+		resp.Lists = append(resp.Lists, &feedpb.AudienceList{
+			ListId:    summary.List.ListID,
+			Name:      summary.List.Name,
+			MemberIds: summary.MemberIDs,
+			CreatedAt: timestamppb.New(summary.List.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+func (h *FeedHandlers) AddAudienceListMembers(ctx context.Context, req *feedpb.AudienceListMembersRequest) (*feedpb.FeedStatusResponse, error) {
+	if req.OwnerId <= 0 || req.ListId <= 0 || len(req.UserIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid owner ID, list ID or users")
+	}
+	if err := authorize(ctx, req.OwnerId); err != nil {
+		return nil, err
+	}
+
+	if err := h.FeedSvc.AddAudienceListMembers(ctx, req.OwnerId, req.ListId, req.UserIds); err != nil {
+		return nil, audienceError("failed to add audience list members", err)
+	}
+	return &feedpb.FeedStatusResponse{Message: "Members added successfully"}, nil
+}
+
+func (h *FeedHandlers) RemoveAudienceListMembers(ctx context.Context, req *feedpb.AudienceListMembersRequest) (*feedpb.FeedStatusResponse, error) {
+	if req.OwnerId <= 0 || req.ListId <= 0 || len(req.UserIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid owner ID, list ID or users")
+	}
+	if err := authorize(ctx, req.OwnerId); err != nil {
+		return nil, err
+	}
+
+	if err := h.FeedSvc.RemoveAudienceListMembers(ctx, req.OwnerId, req.ListId, req.UserIds); err != nil {
+		return nil, audienceError("failed to remove audience list members", err)
+	}
+	return &feedpb.FeedStatusResponse{Message: "Members removed successfully"}, nil
+}
+
+func (h *FeedHandlers) DeleteAudienceList(ctx context.Context, req *feedpb.DeleteAudienceListRequest) (*feedpb.FeedStatusResponse, error) {
+	if req.OwnerId <= 0 || req.ListId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid owner ID or list ID")
+	}
+	if err := authorize(ctx, req.OwnerId); err != nil {
+		return nil, err
+	}
+
+	if err := h.FeedSvc.DeleteAudienceList(ctx, req.OwnerId, req.ListId); err != nil {
+		return nil, audienceError("failed to delete audience list", err)
+	}
+	return &feedpb.FeedStatusResponse{Message: "Audience list deleted successfully"}, nil
+}
+
+func audienceError(action string, err error) error {
+	switch {
+	case errors.Is(err, ErrInvalidAudienceList), errors.Is(err, ErrNotAFriend):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrDuplicateAudienceList):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, ErrTooManyAudienceLists), errors.Is(err, ErrTooManyListMembers):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ErrNotAudienceListOwner):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", action, err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", action, err)
+}
+
+func (h *FeedHandlers) MarkReelViewed(ctx context.Context, req *feedpb.ReelViewRequest) (*feedpb.FeedStatusResponse, error) {
+	if req.ReelId <= 0 || req.ViewerId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid reel or viewer ID")
+	}
+	if err := authorize(ctx, req.ViewerId); err != nil {
+		return nil, err
+	}
+
+	if err := h.FeedSvc.MarkReelViewed(ctx, req.ViewerId, req.ReelId); err != nil {
+		return nil, interactionError("failed to mark reel viewed", err)
+	}
+	return &feedpb.FeedStatusResponse{Message: "Reel marked as viewed"}, nil
+}
+
+func (h *FeedHandlers) GetExploreReels(ctx context.Context, req *feedpb.ExploreReelsRequest) (*feedpb.TimelineResponse, error) {
+	if req.ViewerId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid viewer ID")
+	}
+	if err := authorize(ctx, req.ViewerId); err != nil {
+		return nil, err
+	}
+	if req.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page size must not be negative")
+	}
+
+	page, err := h.FeedSvc.GetExploreReels(ctx, req.ViewerId, TimelineQuery{Cursor: req.Cursor, PageSize: int(req.PageSize)})
+	if errors.Is(err, ErrInvalidCursor) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get explore reels: %v", err)
+	}
+
+	pbContents, err := h.toTimelineContents(ctx, req.ViewerId, page.Contents, page.MediaURLs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get explore reels: %v", err)
+	}
+	return &feedpb.TimelineResponse{Contents: pbContents, NextCursor: page.NextCursor}, nil
+}
+
+func (h *FeedHandlers) ListScheduledContent(ctx context.Context, req *feedpb.ListScheduledContentRequest) (*feedpb.TimelineResponse, error) {
+	if req.AuthorId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid author ID")
+	}
+	if err := authorize(ctx, req.AuthorId); err != nil {
+		return nil, err
+	}
+	if req.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page size must not be negative")
+	}
+
+	page, err := h.FeedSvc.ListScheduledContent(ctx, req.AuthorId, TimelineQuery{Cursor: req.Cursor, PageSize: int(req.PageSize)})
+	if errors.Is(err, ErrInvalidCursor) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list scheduled content: %v", err)
+	}
+
+	pbContents, err := h.toTimelineContents(ctx, req.AuthorId, page.Contents, page.MediaURLs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list scheduled content: %v", err)
+	}
+	return &feedpb.TimelineResponse{Contents: pbContents, NextCursor: page.NextCursor}, nil
+}
+
+func (h *FeedHandlers) RescheduleContent(ctx context.Context, req *feedpb.RescheduleContentRequest) (*feedpb.ContentResponse, error) {
+	if req.ContentId <= 0 || req.AuthorId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid content or author ID")
+	}
+	if req.PublishAt == nil {
+		return nil, status.Error(codes.InvalidArgument, "publish time must be specified")
+	}
+	if err := authorize(ctx, req.AuthorId); err != nil {
+		return nil, err
+	}
+	publishAt, err := publishTime(req.PublishAt)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := h.FeedSvc.RescheduleContent(ctx, req.AuthorId, req.ContentId, *publishAt)
+	if err != nil {
+		return nil, scheduleError("failed to reschedule content", err)
+	}
+	return h.toContentResponse(ctx, req.AuthorId, content)
+}
+
+func (h *FeedHandlers) CancelScheduledContent(ctx context.Context, req *feedpb.CancelScheduledContentRequest) (*feedpb.FeedStatusResponse, error) {
+	if req.ContentId <= 0 || req.AuthorId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid content or author ID")
+	}
+	if err := authorize(ctx, req.AuthorId); err != nil {
+		return nil, err
+	}
+
+	if err := h.FeedSvc.CancelScheduledContent(ctx, req.AuthorId, req.ContentId); err != nil {
+		return nil, scheduleError("failed to cancel scheduled content", err)
+	}
+	return &feedpb.FeedStatusResponse{Message: "Scheduled content cancelled"}, nil
+}
+
+func scheduleError(action string, err error) error {
+	switch {
+	case errors.Is(err, ErrInvalidPublishTime):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrNotScheduled):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return interactionError(action, err)
+}
+
+func (h *FeedHandlers) SaveDraft(ctx context.Context, req *feedpb.SaveDraftRequest) (*feedpb.ContentResponse, error) {
+	if req.AuthorId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid author ID")
+	}
+	if err := authorize(ctx, req.AuthorId); err != nil {
+		return nil, err
+	}
+	if req.Privacy == "" {
+		return nil, status.Error(codes.InvalidArgument, "privacy setting must be specified")
+	}
+
+	content, err := h.FeedSvc.SaveDraft(ctx, req.AuthorId, Draft{
+		Type:           req.Type,
+		Text:           req.Text,
+		MediaRefIDs:    req.MediaRefIds,
+		DurationSec:    int(req.DurationSecs),
+		Privacy:        req.Privacy,
+		AudienceListID: req.AudienceListId,
+	})
+	if err != nil {
+		return nil, draftError("failed to save draft", err)
+	}
+	return h.toContentResponse(ctx, req.AuthorId, content)
+}
+
+func (h *FeedHandlers) ListDrafts(ctx context.Context, req *feedpb.ListDraftsRequest) (*feedpb.TimelineResponse, error) {
+	if req.AuthorId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid author ID")
+	}
+	if err := authorize(ctx, req.AuthorId); err != nil {
+		return nil, err
+	}
+	if req.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page size must not be negative")
+	}
+
+	page, err := h.FeedSvc.ListDrafts(ctx, req.AuthorId, TimelineQuery{Cursor: req.Cursor, PageSize: int(req.PageSize)})
+	if errors.Is(err, ErrInvalidCursor) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list drafts: %v", err)
+	}
+
+	pbContents, err := h.toTimelineContents(ctx, req.AuthorId, page.Contents, page.MediaURLs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list drafts: %v", err)
+	}
+	return &feedpb.TimelineResponse{Contents: pbContents, NextCursor: page.NextCursor}, nil
+}
+
+func (h *FeedHandlers) UpdateDraft(ctx context.Context, req *feedpb.UpdateDraftRequest) (*feedpb.ContentResponse, error) {
+	if req.ContentId <= 0 || req.AuthorId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid content or author ID")
+	}
+	if err := authorize(ctx, req.AuthorId); err != nil {
+		return nil, err
+	}
+	if req.Privacy == "" {
+		return nil, status.Error(codes.InvalidArgument, "privacy setting must be specified")
+	}
+
+	content, err := h.FeedSvc.UpdateDraft(ctx, req.AuthorId, req.ContentId, Draft{
+		Text:           req.Text,
+		MediaRefIDs:    req.MediaRefIds,
+		DurationSec:    int(req.DurationSecs),
+		Privacy:        req.Privacy,
+		AudienceListID: req.AudienceListId,
+	})
+	if err != nil {
+		return nil, draftError("failed to update draft", err)
+	}
+	return h.toContentResponse(ctx, req.AuthorId, content)
+}
+
+func (h *FeedHandlers) PublishDraft(ctx context.Context, req *feedpb.PublishDraftRequest) (*feedpb.FeedResponse, error) {
+	if req.ContentId <= 0 || req.AuthorId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid content or author ID")
+	}
+	if err := authorize(ctx, req.AuthorId); err != nil {
+		return nil, err
+	}
+	publishAt, err := publishTime(req.PublishAt)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := h.FeedSvc.PublishDraft(ctx, req.AuthorId, req.ContentId, publishAt)
+	if err != nil {
+		return nil, draftError("failed to publish draft", err)
+	}
+	resp := &feedpb.FeedResponse{
+		ContentId: content.ContentID,
+		Message:   "Draft published successfully",
+	}
+	if publishAt != nil {
+		resp.Message = "Draft scheduled successfully"
+	}
+	if _, mediaURL, err := h.FeedSvc.GetContent(ctx, content.ContentID); err == nil {
+		resp.MediaUrl = mediaURL
+	}
+	return resp, nil
+}
+
+func (h *FeedHandlers) DiscardDraft(ctx context.Context, req *feedpb.DiscardDraftRequest) (*feedpb.FeedStatusResponse, error) {
+	if req.ContentId <= 0 || req.AuthorId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid content or author ID")
+	}
+	if err := authorize(ctx, req.AuthorId); err != nil {
+		return nil, err
+	}
+
+	if err := h.FeedSvc.DiscardDraft(ctx, req.AuthorId, req.ContentId); err != nil {
+		return nil, draftError("failed to discard draft", err)
+	}
+	return &feedpb.FeedStatusResponse{Message: "Draft discarded"}, nil
+}
+
+// toContentResponse builds the response of a single content as its viewer sees it
+func (h *FeedHandlers) toContentResponse(ctx context.Context, authorID int64, content *dbmysql.Content) (*feedpb.ContentResponse, error) {
+	_, url, err := h.FeedSvc.GetContent(ctx, content.ContentID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get content: %v", err)
+	}
+	pbContents, err := h.toTimelineContents(ctx, authorID, []dbmysql.Content{*content}, []string{url})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to build content: %v", err)
+	}
+	return &feedpb.ContentResponse{Content: pbContents[0]}, nil
+}
+
+func draftError(action string, err error) error {
+	switch {
+	case errors.Is(err, ErrInvalidDraft), errors.Is(err, ErrIncompleteDraft), errors.Is(err, ErrInvalidPublishTime):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrMediaNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrNotMediaOwner):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, ErrNotADraft), errors.Is(err, ErrMediaInUse):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return interactionError(action, err)
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"gosocial/internal/dbmongo"
 	"gosocial/internal/dbmysql"
 	"io"
@@ -25,8 +26,27 @@ type Content interface {
 	CreateContent(ctx context.Context, content *dbmysql.Content) error
 	GetContentByID(ctx context.Context, id int64) (*dbmysql.Content, error)
 	ListUserContent(ctx context.Context, userID int64) ([]dbmysql.Content, error)
+	ListTimeline(ctx context.Context, viewerID int64, authorIDs []int64, cursor *TimelineCursor, limit int) ([]dbmysql.Content, error)
+	UpdateContent(ctx context.Context, content *dbmysql.Content, revision *dbmysql.ContentRevision) error
+	ListRevisions(ctx context.Context, contentID, afterID int64, limit int) ([]dbmysql.ContentRevision, error)
 	DeleteContent(ctx context.Context, id int64) error
 	ListExpiredStories(ctx context.Context, now time.Time) ([]dbmysql.Content, error)
+	ArchiveContent(ctx context.Context, id int64, at time.Time) error
+	ListArchivedStories(ctx context.Context, authorID int64, cursor *TimelineCursor, limit int) ([]dbmysql.Content, error)
+	ListContentsByIDs(ctx context.Context, ids []int64) ([]dbmysql.Content, error)
+	CountShares(ctx context.Context, contentIDs []int64) (map[int64]int64, error)
+	ListContentMedia(ctx context.Context, contentIDs []int64) (map[int64][]dbmysql.ContentMedia, error)
+	IsMediaAttached(ctx context.Context, mediaRefID int64) (bool, error)
+	ListContentByMedia(ctx context.Context, mediaRefID int64) ([]dbmysql.Content, error)
+	ListExploreReels(ctx context.Context, excludeAuthorIDs, excludeIDs []int64, since time.Time, limit int) ([]dbmysql.Content, error)
+	ListScheduledContent(ctx context.Context, authorID int64, cursor *TimelineCursor, limit int) ([]dbmysql.Content, error)
+	ListDueContent(ctx context.Context, now time.Time) ([]dbmysql.Content, error)
+	RescheduleContent(ctx context.Context, id int64, publishAt time.Time) (bool, error)
+	PublishContent(ctx context.Context, id int64) (bool, error)
+	ListDrafts(ctx context.Context, authorID int64, cursor *TimelineCursor, limit int) ([]dbmysql.Content, error)
+	ListAbandonedDrafts(ctx context.Context, before time.Time) ([]dbmysql.Content, error)
+	UpdateDraft(ctx context.Context, content *dbmysql.Content) (bool, error)
+	PublishDraft(ctx context.Context, id int64, createdAt time.Time, publishAt *time.Time) (bool, error)
 }
 
 func (r *FeedRepository) CreateContent(ctx context.Context, content *dbmysql.Content) error {
@@ -42,26 +62,313 @@ func (r *FeedRepository) GetContentByID(ctx context.Context, id int64) (*dbmysql
 func (r *FeedRepository) ListUserContent(ctx context.Context, userID int64) ([]dbmysql.Content, error) {
 	var contents []dbmysql.Content
 	err := r.db.WithContext(ctx).
-		Where("author_id = ?", userID).
+		Where("author_id = ? AND archived_at IS NULL AND publish_at IS NULL AND draft = FALSE", userID).
 		Order("created_at DESC").
 		Find(&contents).Error
 	return contents, err
 }
 
+// ListTimeline returns the newest content of the given authors the viewer may see, starting after cursor.
+// Authors are expected to be the viewer and their friends, so only private content of others and list content
+// of lists the viewer is not in is hidden.
+func (r *FeedRepository) ListTimeline(ctx context.Context, viewerID int64, authorIDs []int64, cursor *TimelineCursor, limit int) ([]dbmysql.Content, error) {
+	var contents []dbmysql.Content
+	query := r.db.WithContext(ctx).
+		Where("author_id IN ? AND archived_at IS NULL AND publish_at IS NULL AND draft = FALSE", authorIDs).
+		Where("(author_id = ? OR privacy IN ? OR (privacy = ? AND audience_list_id IN (?)))", viewerID, []string{"public", "friends"}, "list", r.audienceListsOf(viewerID))
+	if cursor != nil {
+		query = query.Where("(created_at < ? OR (created_at = ? AND content_id < ?))", cursor.CreatedAt, cursor.CreatedAt, cursor.ContentID)
+	}
+	err := query.
+		Order("created_at DESC, content_id DESC").
+		Limit(limit).
+		Find(&contents).Error
+	return contents, err
+}
+
+// UpdateContent saves the edited text, privacy and audience list together with the revision they replace
+func (r *FeedRepository) UpdateContent(ctx context.Context, content *dbmysql.Content, revision *dbmysql.ContentRevision) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(revision).Error; err != nil {
+			return err
+		}
+		return tx.Model(&dbmysql.Content{}).
+			Where("content_id = ?", content.ContentID).
+			Updates(map[string]interface{}{
+				"text_content":     content.TextContent,
+				"privacy":          content.Privacy,
+				"audience_list_id": content.AudienceListID,
+				"edited_at":        content.EditedAt,
+				"updated_at":       content.UpdatedAt,
+			}).Error
+	})
+}
+
+// ListContentMedia returns the carousel items of each content in order, content with a single legacy MediaRefID has none
+func (r *FeedRepository) ListContentMedia(ctx context.Context, contentIDs []int64) (map[int64][]dbmysql.ContentMedia, error) {
+	byContent := make(map[int64][]dbmysql.ContentMedia, len(contentIDs))
+	if len(contentIDs) == 0 {
+		return byContent, nil
+	}
+	var media []dbmysql.ContentMedia
+	if err := r.db.WithContext(ctx).
+		Where("content_id IN ?", contentIDs).
+		Order("content_id, position").
+		Find(&media).Error; err != nil {
+		return nil, err
+	}
+	for _, m := range media {
+		byContent[m.ContentID] = append(byContent[m.ContentID], m)
+	}
+	return byContent, nil
+}
+
+// IsMediaAttached reports whether a content already uses the media, directly or as a carousel item
+func (r *FeedRepository) IsMediaAttached(ctx context.Context, mediaRefID int64) (bool, error) {
+	var direct, carousel int64
+	if err := r.db.WithContext(ctx).Model(&dbmysql.Content{}).Where("media_ref_id = ?", mediaRefID).Count(&direct).Error; err != nil {
+		return false, err
+	}
+	if err := r.db.WithContext(ctx).Model(&dbmysql.ContentMedia{}).Where("media_ref_id = ?", mediaRefID).Count(&carousel).Error; err != nil {
+		return false, err
+	}
+	return direct+carousel > 0, nil
+}
+
+// ListContentByMedia returns the contents using the media, directly or as a carousel item
+func (r *FeedRepository) ListContentByMedia(ctx context.Context, mediaRefID int64) ([]dbmysql.Content, error) {
+	var contents []dbmysql.Content
+	carousel := r.db.Model(&dbmysql.ContentMedia{}).Select("content_id").Where("media_ref_id = ?", mediaRefID)
+	err := r.db.WithContext(ctx).
+		Where("media_ref_id = ? OR content_id IN (?)", mediaRefID, carousel).
+		Find(&contents).Error
+	return contents, err
+}
+
+// ListExploreReels returns the newest public reels created since, leaving out the excluded authors and reels
+func (r *FeedRepository) ListExploreReels(ctx context.Context, excludeAuthorIDs, excludeIDs []int64, since time.Time, limit int) ([]dbmysql.Content, error) {
+	var contents []dbmysql.Content
+	query := r.db.WithContext(ctx).
+		Where("type = ? AND privacy = ? AND archived_at IS NULL AND publish_at IS NULL AND draft = FALSE AND created_at >= ?", "REEL", "public", since)
+	if len(excludeAuthorIDs) > 0 {
+		query = query.Where("author_id NOT IN ?", excludeAuthorIDs)
+	}
+	if len(excludeIDs) > 0 {
+		query = query.Where("content_id NOT IN ?", excludeIDs)
+	}
+	err := query.
+		Order("created_at DESC, content_id DESC").
+		Limit(limit).
+		Find(&contents).Error
+	return contents, err
+}
+
+// ListScheduledContent returns the author's content waiting to be published, soonest first, starting after cursor.
+// Scheduled content is created at its publish time, so the cursor walks created_at upwards
+func (r *FeedRepository) ListScheduledContent(ctx context.Context, authorID int64, cursor *TimelineCursor, limit int) ([]dbmysql.Content, error) {
+	var contents []dbmysql.Content
+	query := r.db.WithContext(ctx).
+		Where("author_id = ? AND publish_at IS NOT NULL", authorID)
+	if cursor != nil {
+		query = query.Where("(created_at > ? OR (created_at = ? AND content_id > ?))", cursor.CreatedAt, cursor.CreatedAt, cursor.ContentID)
+	}
+	err := query.
+		Order("created_at ASC, content_id ASC").
+		Limit(limit).
+		Find(&contents).Error
+	return contents, err
+}
+
+// ListDueContent returns the scheduled content whose publish time is not after now
+func (r *FeedRepository) ListDueContent(ctx context.Context, now time.Time) ([]dbmysql.Content, error) {
+	var contents []dbmysql.Content
+	err := r.db.WithContext(ctx).
+		Where("publish_at IS NOT NULL AND publish_at <= ?", now).
+		Order("publish_at ASC, content_id ASC").
+		Find(&contents).Error
+	return contents, err
+}
+
+// RescheduleContent moves scheduled content to a new publish time, false when it is no longer scheduled
+func (r *FeedRepository) RescheduleContent(ctx context.Context, id int64, publishAt time.Time) (bool, error) {
+	res := r.db.WithContext(ctx).
+		Model(&dbmysql.Content{}).
+		Where("content_id = ? AND publish_at IS NOT NULL", id).
+		Updates(map[string]interface{}{"publish_at": publishAt, "created_at": publishAt})
+	return res.RowsAffected > 0, res.Error
+}
+
+// PublishContent clears the publish time of scheduled content, false when it was already published or is gone
+func (r *FeedRepository) PublishContent(ctx context.Context, id int64) (bool, error) {
+	res := r.db.WithContext(ctx).
+		Model(&dbmysql.Content{}).
+		Where("content_id = ? AND publish_at IS NOT NULL", id).
+		Update("publish_at", nil)
+	return res.RowsAffected > 0, res.Error
+}
+
+// ListDrafts returns the author's newest drafts, starting after cursor
+func (r *FeedRepository) ListDrafts(ctx context.Context, authorID int64, cursor *TimelineCursor, limit int) ([]dbmysql.Content, error) {
+	var contents []dbmysql.Content
+	query := r.db.WithContext(ctx).
+		Where("author_id = ? AND draft = TRUE", authorID)
+	if cursor != nil {
+		query = query.Where("(created_at < ? OR (created_at = ? AND content_id < ?))", cursor.CreatedAt, cursor.CreatedAt, cursor.ContentID)
+	}
+	err := query.
+		Order("created_at DESC, content_id DESC").
+		Limit(limit).
+		Find(&contents).Error
+	return contents, err
+}
+
+// ListAbandonedDrafts returns the drafts last changed before the given time
+func (r *FeedRepository) ListAbandonedDrafts(ctx context.Context, before time.Time) ([]dbmysql.Content, error) {
+	var contents []dbmysql.Content
+	err := r.db.WithContext(ctx).
+		Where("draft = TRUE AND updated_at < ?", before).
+		Find(&contents).Error
+	return contents, err
+}
+
+// UpdateDraft replaces the fields and media of a draft, false when it is no longer a draft
+func (r *FeedRepository) UpdateDraft(ctx context.Context, content *dbmysql.Content) (bool, error) {
+	updated := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&dbmysql.Content{}).
+			Where("content_id = ? AND draft = TRUE", content.ContentID).
+			Updates(map[string]interface{}{
+				"text_content":     content.TextContent,
+				"media_ref_id":     content.MediaRefID,
+				"privacy":          content.Privacy,
+				"audience_list_id": content.AudienceListID,
+				"duration":         content.Duration,
+				"updated_at":       content.UpdatedAt,
+			})
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+		if err := tx.Delete(&dbmysql.ContentMedia{}, "content_id = ?", content.ContentID).Error; err != nil {
+			return err
+		}
+		for i := range content.Media {
+			content.Media[i].ContentID = content.ContentID
+		}
+		if len(content.Media) > 0 {
+			if err := tx.Create(&content.Media).Error; err != nil {
+				return err
+			}
+		}
+		updated = true
+		return nil
+	})
+	return updated, err
+}
+
+// PublishDraft turns a draft into content created at createdAt, still scheduled when publishAt is set.
+// False when it is no longer a draft
+func (r *FeedRepository) PublishDraft(ctx context.Context, id int64, createdAt time.Time, publishAt *time.Time) (bool, error) {
+	res := r.db.WithContext(ctx).
+		Model(&dbmysql.Content{}).
+		Where("content_id = ? AND draft = TRUE", id).
+		Updates(map[string]interface{}{"draft": false, "publish_at": publishAt, "created_at": createdAt, "updated_at": time.Now()})
+	return res.RowsAffected > 0, res.Error
+}
+
+// ListRevisions returns the revisions of a content oldest first, starting after afterID
+func (r *FeedRepository) ListRevisions(ctx context.Context, contentID, afterID int64, limit int) ([]dbmysql.ContentRevision, error) {
+	var revisions []dbmysql.ContentRevision
+	err := r.db.WithContext(ctx).
+		Where("content_id = ? AND revision_id > ?", contentID, afterID).
+		Order("revision_id ASC").
+		Limit(limit).
+		Find(&revisions).Error
+	return revisions, err
+}
+
 func (r *FeedRepository) DeleteContent(ctx context.Context, id int64) error {
-	return r.db.WithContext(ctx).Delete(&dbmysql.Content{}, "content_id = ?", id).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&dbmysql.ContentRevision{}, "content_id = ?", id).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&dbmysql.ContentMedia{}, "content_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&dbmysql.Content{}, "content_id = ?", id).Error
+	})
+}
+
+func (r *FeedRepository) ArchiveContent(ctx context.Context, id int64, at time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&dbmysql.Content{}).
+		Where("content_id = ?", id).
+		Updates(map[string]interface{}{"archived_at": at, "updated_at": at}).Error
+}
+
+// ListArchivedStories returns the newest archived stories of an author, starting after cursor
+func (r *FeedRepository) ListArchivedStories(ctx context.Context, authorID int64, cursor *TimelineCursor, limit int) ([]dbmysql.Content, error) {
+	var stories []dbmysql.Content
+	query := r.db.WithContext(ctx).
+		Where("author_id = ? AND type = ? AND archived_at IS NOT NULL", authorID, "STORY")
+	if cursor != nil {
+		query = query.Where("(created_at < ? OR (created_at = ? AND content_id < ?))", cursor.CreatedAt, cursor.CreatedAt, cursor.ContentID)
+	}
+	err := query.
+		Order("created_at DESC, content_id DESC").
+		Limit(limit).
+		Find(&stories).Error
+	return stories, err
+}
+
+// ListContentsByIDs returns the contents that still exist among ids, in no particular order
+func (r *FeedRepository) ListContentsByIDs(ctx context.Context, ids []int64) ([]dbmysql.Content, error) {
+	var contents []dbmysql.Content
+	if len(ids) == 0 {
+		return contents, nil
+	}
+	err := r.db.WithContext(ctx).Where("content_id IN ?", ids).Find(&contents).Error
+	return contents, err
+}
+
+// CountShares counts the reposts and quote posts of each content
+func (r *FeedRepository) CountShares(ctx context.Context, contentIDs []int64) (map[int64]int64, error) {
+	counts := make(map[int64]int64, len(contentIDs))
+	if len(contentIDs) == 0 {
+		return counts, nil
+	}
+	var rows []struct {
+		ID    int64
+		Total int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&dbmysql.Content{}).
+		Select("shared_content_id AS id, COUNT(*) AS total").
+		Where("shared_content_id IN ?", contentIDs).
+		Group("shared_content_id").
+		Scan(&rows).Error
+	for _, row := range rows {
+		counts[row.ID] = row.Total
+	}
+	return counts, err
 }
 
 // --------- MEDIA REF ---------
 type MediaRef interface {
 	CreateMediaRef(ctx context.Context, media *dbmysql.MediaRef, fileData []byte) error
+	UploadMediaRef(ctx context.Context, media *dbmysql.MediaRef, content io.Reader) error
 	GetMediaRefByID(ctx context.Context, id int64) (*dbmysql.MediaRef, []byte, error)
+	ListMediaRefsByIDs(ctx context.Context, ids []int64) ([]dbmysql.MediaRef, error)
 	DeleteMedia(ctx context.Context, mediaRefID int64) error
 }
 
 func (r *FeedRepository) CreateMediaRef(ctx context.Context, media *dbmysql.MediaRef, fileData []byte) error {
+	return r.UploadMediaRef(ctx, media, bytes.NewReader(fileData))
+}
+
+// UploadMediaRef streams the file into GridFS as it is read, then saves its metadata
+func (r *FeedRepository) UploadMediaRef(ctx context.Context, media *dbmysql.MediaRef, content io.Reader) error {
 	// Step 1: Upload file to GridFS
-	mediaFile, err := r.gridClient.UploadFile(ctx, media.FileName, "application/octet-stream", fmt.Sprint(media.UploadedBy), bytes.NewReader(fileData))
+	mediaFile, err := r.gridClient.UploadFile(ctx, media.FileName, "application/octet-stream", fmt.Sprint(media.UploadedBy), content)
 	if err != nil {
 		return err
 	}
@@ -99,6 +406,16 @@ func (r *FeedRepository) GetMediaRefByID(ctx context.Context, id int64) (*dbmysq
 	return &media, fileData, nil
 }
 
+// ListMediaRefsByIDs loads only the SQL metadata of the given media, file contents stay in GridFS
+func (r *FeedRepository) ListMediaRefsByIDs(ctx context.Context, ids []int64) ([]dbmysql.MediaRef, error) {
+	var media []dbmysql.MediaRef
+	if len(ids) == 0 {
+		return media, nil
+	}
+	err := r.db.WithContext(ctx).Where("media_ref_id IN ?", ids).Find(&media).Error
+	return media, err
+}
+
 func (r *FeedRepository) DeleteMedia(ctx context.Context, mediaRefID int64) error {
 	// Step 1: Get file path from SQL
 	var media dbmysql.MediaRef
@@ -121,6 +438,11 @@ type Reactions interface {
 	AddReaction(ctx context.Context, reaction *dbmysql.Reaction) error
 	GetReactionsForContent(ctx context.Context, contentID int64) ([]dbmysql.Reaction, error)
 	DeleteReaction(ctx context.Context, userID, contentID int64) error
+	CountReactions(ctx context.Context, contentIDs []int64) (map[int64]int64, error)
+	CountInteractions(ctx context.Context, viewerID int64, authorIDs []int64) (map[int64]int64, error)
+	CountReactionsByType(ctx context.Context, contentIDs []int64) (map[int64]map[string]int64, error)
+	GetViewerReactions(ctx context.Context, viewerID int64, contentIDs []int64) (map[int64]string, error)
+	ListReactors(ctx context.Context, contentID int64, reactionType string, afterID int64, limit int) ([]dbmysql.Reaction, error)
 }
 
 func (r *FeedRepository) AddReaction(ctx context.Context, reaction *dbmysql.Reaction) error {
@@ -141,10 +463,820 @@ func (r *FeedRepository) DeleteReaction(ctx context.Context, userID, contentID i
 		Delete(&dbmysql.Reaction{}).Error
 }
 
+// CountReactions returns the number of reactions of each content, contents without reactions are absent
+func (r *FeedRepository) CountReactions(ctx context.Context, contentIDs []int64) (map[int64]int64, error) {
+	var rows []struct {
+		ContentID int64
+		Total     int64
+	}
+	counts := make(map[int64]int64, len(contentIDs))
+	if len(contentIDs) == 0 {
+		return counts, nil
+	}
+	err := r.db.WithContext(ctx).
+		Model(&dbmysql.Reaction{}).
+		Select("content_id, COUNT(*) AS total").
+		Where("content_id IN ?", contentIDs).
+		Group("content_id").
+		Scan(&rows).Error
+	for _, row := range rows {
+		counts[row.ContentID] = row.Total
+	}
+	return counts, err
+}
+
+// CountInteractions returns how many times the viewer reacted to content of each author
+func (r *FeedRepository) CountInteractions(ctx context.Context, viewerID int64, authorIDs []int64) (map[int64]int64, error) {
+	var rows []struct {
+		AuthorID int64
+		Total    int64
+	}
+	counts := make(map[int64]int64, len(authorIDs))
+	if len(authorIDs) == 0 {
+		return counts, nil
+	}
+	err := r.db.WithContext(ctx).
+		Model(&dbmysql.Reaction{}).
+		Select("contents.author_id, COUNT(*) AS total").
+		Joins("JOIN contents ON contents.content_id = reactions.content_id").
+		Where("reactions.user_id = ? AND contents.author_id IN ?", viewerID, authorIDs).
+		Group("contents.author_id").
+		Scan(&rows).Error
+	for _, row := range rows {
+		counts[row.AuthorID] = row.Total
+	}
+	return counts, err
+}
+
+// CountReactionsByType returns the reaction counts of each content keyed by reaction type
+func (r *FeedRepository) CountReactionsByType(ctx context.Context, contentIDs []int64) (map[int64]map[string]int64, error) {
+	var rows []struct {
+		ContentID int64
+		Type      string
+		Total     int64
+	}
+	counts := make(map[int64]map[string]int64, len(contentIDs))
+	if len(contentIDs) == 0 {
+		return counts, nil
+	}
+	err := r.db.WithContext(ctx).
+		Model(&dbmysql.Reaction{}).
+		Select("content_id, type, COUNT(*) AS total").
+		Where("content_id IN ?", contentIDs).
+		Group("content_id, type").
+		Scan(&rows).Error
+	for _, row := range rows {
+		if counts[row.ContentID] == nil {
+			counts[row.ContentID] = map[string]int64{}
+		}
+		counts[row.ContentID][row.Type] = row.Total
+	}
+	return counts, err
+}
+
+// GetViewerReactions returns the viewer's reaction type on each content they reacted to
+func (r *FeedRepository) GetViewerReactions(ctx context.Context, viewerID int64, contentIDs []int64) (map[int64]string, error) {
+	var reactions []dbmysql.Reaction
+	types := make(map[int64]string, len(contentIDs))
+	if len(contentIDs) == 0 {
+		return types, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND content_id IN ?", viewerID, contentIDs).
+		Find(&reactions).Error
+	for _, reaction := range reactions {
+		types[reaction.ContentID] = reaction.Type
+	}
+	return types, err
+}
+
+// ListReactors pages through the reactions of a content by id, reactionType filters when set
+func (r *FeedRepository) ListReactors(ctx context.Context, contentID int64, reactionType string, afterID int64, limit int) ([]dbmysql.Reaction, error) {
+	var reactions []dbmysql.Reaction
+	query := r.db.WithContext(ctx).Where("content_id = ? AND id > ?", contentID, afterID)
+	if reactionType != "" {
+		query = query.Where("type = ?", reactionType)
+	}
+	err := query.Order("id ASC").Limit(limit).Find(&reactions).Error
+	return reactions, err
+}
+
 func (r *FeedRepository) ListExpiredStories(ctx context.Context, now time.Time) ([]dbmysql.Content, error) {
 	var stories []dbmysql.Content
 	err := r.db.WithContext(ctx).
-		Where("type = ? AND expiration IS NOT NULL AND expiration <= ?", "STORY", now).
+		Where("type = ? AND expiration IS NOT NULL AND expiration <= ? AND archived_at IS NULL", "STORY", now).
 		Find(&stories).Error
 	return stories, err
 }
+
+// --------- COMMENTS ---------
+type Comments interface {
+	CreateComment(ctx context.Context, comment *dbmysql.Comment) error
+	GetCommentByID(ctx context.Context, id int64) (*dbmysql.Comment, error)
+	ListComments(ctx context.Context, contentID int64, parentID *int64, afterID int64, limit int) ([]dbmysql.Comment, error)
+	UpdateCommentText(ctx context.Context, id int64, text string, editedAt time.Time) error
+	DeleteComment(ctx context.Context, id int64) error
+	DeleteCommentsForContent(ctx context.Context, contentID int64) error
+	CountComments(ctx context.Context, contentIDs []int64) (map[int64]int64, error)
+	CountReplies(ctx context.Context, commentIDs []int64) (map[int64]int64, error)
+}
+
+func (r *FeedRepository) CreateComment(ctx context.Context, comment *dbmysql.Comment) error {
+	return r.db.WithContext(ctx).Create(comment).Error
+}
+
+func (r *FeedRepository) GetCommentByID(ctx context.Context, id int64) (*dbmysql.Comment, error) {
+	var comment dbmysql.Comment
+	if err := r.db.WithContext(ctx).First(&comment, "comment_id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// ListComments returns top-level comments when parentID is nil, otherwise the replies of parentID, oldest first
+func (r *FeedRepository) ListComments(ctx context.Context, contentID int64, parentID *int64, afterID int64, limit int) ([]dbmysql.Comment, error) {
+	var comments []dbmysql.Comment
+	query := r.db.WithContext(ctx).Where("content_id = ? AND comment_id > ?", contentID, afterID)
+	if parentID == nil {
+		query = query.Where("parent_id IS NULL")
+	} else {
+		query = query.Where("parent_id = ?", *parentID)
+	}
+	err := query.Order("comment_id ASC").Limit(limit).Find(&comments).Error
+	return comments, err
+}
+
+func (r *FeedRepository) UpdateCommentText(ctx context.Context, id int64, text string, editedAt time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&dbmysql.Comment{}).
+		Where("comment_id = ?", id).
+		Updates(map[string]interface{}{"text": text, "edited_at": editedAt, "updated_at": editedAt}).Error
+}
+
+// DeleteComment removes a comment together with its replies
+func (r *FeedRepository) DeleteComment(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).Delete(&dbmysql.Comment{}, "comment_id = ? OR parent_id = ?", id, id).Error
+}
+
+func (r *FeedRepository) DeleteCommentsForContent(ctx context.Context, contentID int64) error {
+	return r.db.WithContext(ctx).Delete(&dbmysql.Comment{}, "content_id = ?", contentID).Error
+}
+
+// CountComments counts comments and replies of each content, contents without comments are absent
+func (r *FeedRepository) CountComments(ctx context.Context, contentIDs []int64) (map[int64]int64, error) {
+	return r.countCommentsBy(ctx, "content_id", contentIDs)
+}
+
+// CountReplies counts the replies of each top-level comment
+func (r *FeedRepository) CountReplies(ctx context.Context, commentIDs []int64) (map[int64]int64, error) {
+	return r.countCommentsBy(ctx, "parent_id", commentIDs)
+}
+
+func (r *FeedRepository) countCommentsBy(ctx context.Context, column string, ids []int64) (map[int64]int64, error) {
+	var rows []struct {
+		ID    int64
+		Total int64
+	}
+	counts := make(map[int64]int64, len(ids))
+	if len(ids) == 0 {
+		return counts, nil
+	}
+	err := r.db.WithContext(ctx).
+		Model(&dbmysql.Comment{}).
+		Select(column+" AS id, COUNT(*) AS total").
+		Where(column+" IN ?", ids).
+		Group(column).
+		Scan(&rows).Error
+	for _, row := range rows {
+		counts[row.ID] = row.Total
+	}
+	return counts, err
+}
+
+// --------- STORY VIEWS ---------
+type StoryViews interface {
+	RecordStoryView(ctx context.Context, view *dbmysql.StoryView) error
+	ListStoryViews(ctx context.Context, storyID, afterID int64, limit int) ([]dbmysql.StoryView, error)
+	ListViewedStories(ctx context.Context, viewerID int64, storyIDs []int64) (map[int64]bool, error)
+	DeleteStoryViews(ctx context.Context, storyID int64) error
+}
+
+// RecordStoryView inserts a view, repeated views by the same viewer are ignored
+func (r *FeedRepository) RecordStoryView(ctx context.Context, view *dbmysql.StoryView) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(view).Error
+}
+
+func (r *FeedRepository) ListStoryViews(ctx context.Context, storyID, afterID int64, limit int) ([]dbmysql.StoryView, error) {
+	var views []dbmysql.StoryView
+	err := r.db.WithContext(ctx).
+		Where("story_id = ? AND id > ?", storyID, afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&views).Error
+	return views, err
+}
+
+// ListViewedStories returns which of the stories the viewer has watched
+func (r *FeedRepository) ListViewedStories(ctx context.Context, viewerID int64, storyIDs []int64) (map[int64]bool, error) {
+	var viewed []int64
+	seen := make(map[int64]bool, len(storyIDs))
+	if len(storyIDs) == 0 {
+		return seen, nil
+	}
+	err := r.db.WithContext(ctx).
+		Model(&dbmysql.StoryView{}).
+		Where("viewer_id = ? AND story_id IN ?", viewerID, storyIDs).
+		Pluck("story_id", &viewed).Error
+	for _, id := range viewed {
+		seen[id] = true
+	}
+	return seen, err
+}
+
+func (r *FeedRepository) DeleteStoryViews(ctx context.Context, storyID int64) error {
+	return r.db.WithContext(ctx).Delete(&dbmysql.StoryView{}, "story_id = ?", storyID).Error
+}
+
+// --------- REEL VIEWS ---------
+type ReelViews interface {
+	RecordReelView(ctx context.Context, view *dbmysql.ReelView) error
+	CountReelViews(ctx context.Context, reelIDs []int64) (map[int64]int64, error)
+	DeleteReelViews(ctx context.Context, reelID int64) error
+}
+
+// RecordReelView inserts a view, repeated views by the same viewer are ignored
+func (r *FeedRepository) RecordReelView(ctx context.Context, view *dbmysql.ReelView) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(view).Error
+}
+
+// CountReelViews counts the distinct viewers per reel, reels without views are left out
+func (r *FeedRepository) CountReelViews(ctx context.Context, reelIDs []int64) (map[int64]int64, error) {
+	counts := make(map[int64]int64, len(reelIDs))
+	if len(reelIDs) == 0 {
+		return counts, nil
+	}
+	var rows []struct {
+		ReelID int64
+		Views  int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&dbmysql.ReelView{}).
+		Select("reel_id, COUNT(*) AS views").
+		Where("reel_id IN ?", reelIDs).
+		Group("reel_id").
+		Scan(&rows).Error
+	for _, row := range rows {
+		counts[row.ReelID] = row.Views
+	}
+	return counts, err
+}
+
+func (r *FeedRepository) DeleteReelViews(ctx context.Context, reelID int64) error {
+	return r.db.WithContext(ctx).Delete(&dbmysql.ReelView{}, "reel_id = ?", reelID).Error
+}
+
+// --------- MATERIALIZED TIMELINES ---------
+type TimelineStore interface {
+	PushToTimelines(ctx context.Context, ownerIDs []int64, content *dbmysql.Content) error
+	RemoveFromTimelines(ctx context.Context, contentID int64) error
+	BackfillTimeline(ctx context.Context, ownerID, authorID int64, limit int) error
+	ListMaterializedTimeline(ctx context.Context, ownerID int64, cursor *TimelineCursor, limit int) ([]dbmysql.Content, error)
+}
+
+func (r *FeedRepository) PushToTimelines(ctx context.Context, ownerIDs []int64, content *dbmysql.Content) error {
+	if len(ownerIDs) == 0 {
+		return nil
+	}
+	entries := make([]dbmysql.TimelineEntry, 0, len(ownerIDs))
+	for _, ownerID := range ownerIDs {
+		entries = append(entries, dbmysql.TimelineEntry{
+			OwnerID:   ownerID,
+			ContentID: content.ContentID,
+			AuthorID:  content.AuthorID,
+			CreatedAt: content.CreatedAt,
+		})
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		CreateInBatches(entries, 500).Error
+}
+
+func (r *FeedRepository) RemoveFromTimelines(ctx context.Context, contentID int64) error {
+	return r.db.WithContext(ctx).Delete(&dbmysql.TimelineEntry{}, "content_id = ?", contentID).Error
+}
+
+// BackfillTimeline copies the latest non-private content of authorID into the timeline of ownerID
+func (r *FeedRepository) BackfillTimeline(ctx context.Context, ownerID, authorID int64, limit int) error {
+	var recent []dbmysql.Content
+	err := r.db.WithContext(ctx).
+		Where("author_id = ? AND privacy IN ? AND archived_at IS NULL AND publish_at IS NULL AND draft = FALSE", authorID, []string{"public", "friends"}).
+		Order("created_at DESC, content_id DESC").
+		Limit(limit).
+		Find(&recent).Error
+	if err != nil {
+		return err
+	}
+	for i := range recent {
+		if err := r.PushToTimelines(ctx, []int64{ownerID}, &recent[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListMaterializedTimeline reads a page of the owner's timeline entries, privacy is checked again
+// against the content in case a change has not been fanned out yet
+func (r *FeedRepository) ListMaterializedTimeline(ctx context.Context, ownerID int64, cursor *TimelineCursor, limit int) ([]dbmysql.Content, error) {
+	var contents []dbmysql.Content
+	query := r.db.WithContext(ctx).
+		Joins("JOIN timeline_entries ON timeline_entries.content_id = contents.content_id").
+		Where("timeline_entries.owner_id = ? AND contents.archived_at IS NULL", ownerID).
+		Where("(contents.author_id = ? OR contents.privacy IN ? OR (contents.privacy = ? AND contents.audience_list_id IN (?)))", ownerID, []string{"public", "friends"}, "list", r.audienceListsOf(ownerID))
+	if cursor != nil {
+		query = query.Where("(contents.created_at < ? OR (contents.created_at = ? AND contents.content_id < ?))", cursor.CreatedAt, cursor.CreatedAt, cursor.ContentID)
+	}
+	err := query.
+		Order("contents.created_at DESC, contents.content_id DESC").
+		Limit(limit).
+		Find(&contents).Error
+	return contents, err
+}
+
+// --------- HIGHLIGHTS ---------
+type Highlights interface {
+	CreateHighlight(ctx context.Context, highlight *dbmysql.Highlight, storyIDs []int64) error
+	GetHighlightByID(ctx context.Context, id int64) (*dbmysql.Highlight, error)
+	UpdateHighlight(ctx context.Context, id int64, title string, storyIDs []int64) error
+	DeleteHighlight(ctx context.Context, id int64) error
+	ListHighlights(ctx context.Context, ownerID int64) ([]dbmysql.Highlight, error)
+	ReorderHighlights(ctx context.Context, ownerID int64, highlightIDs []int64) error
+	ListHighlightStories(ctx context.Context, highlightIDs []int64) (map[int64][]dbmysql.Content, error)
+	RemoveStoryFromHighlights(ctx context.Context, storyID int64) error
+	HighlightedStories(ctx context.Context, storyIDs []int64) (map[int64]bool, error)
+}
+
+// CreateHighlight appends the highlight after the owner's existing ones
+func (r *FeedRepository) CreateHighlight(ctx context.Context, highlight *dbmysql.Highlight, storyIDs []int64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var last int
+		if err := tx.Model(&dbmysql.Highlight{}).
+			Where("owner_id = ?", highlight.OwnerID).
+			Select("COALESCE(MAX(position), 0)").
+			Scan(&last).Error; err != nil {
+			return err
+		}
+		highlight.Position = last + 1
+		if err := tx.Create(highlight).Error; err != nil {
+			return err
+		}
+		return createHighlightStories(tx, highlight.HighlightID, storyIDs)
+	})
+}
+
+func (r *FeedRepository) GetHighlightByID(ctx context.Context, id int64) (*dbmysql.Highlight, error) {
+	var highlight dbmysql.Highlight
+	err := r.db.WithContext(ctx).First(&highlight, "highlight_id = ?", id).Error
+	return &highlight, err
+}
+
+// UpdateHighlight renames a highlight and replaces its stories
+func (r *FeedRepository) UpdateHighlight(ctx context.Context, id int64, title string, storyIDs []int64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&dbmysql.Highlight{}).
+			Where("highlight_id = ?", id).
+			Updates(map[string]interface{}{"title": title, "updated_at": time.Now()}).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&dbmysql.HighlightStory{}, "highlight_id = ?", id).Error; err != nil {
+			return err
+		}
+		return createHighlightStories(tx, id, storyIDs)
+	})
+}
+
+func (r *FeedRepository) DeleteHighlight(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&dbmysql.HighlightStory{}, "highlight_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&dbmysql.Highlight{}, "highlight_id = ?", id).Error
+	})
+}
+
+func (r *FeedRepository) ListHighlights(ctx context.Context, ownerID int64) ([]dbmysql.Highlight, error) {
+	var highlights []dbmysql.Highlight
+	err := r.db.WithContext(ctx).
+		Where("owner_id = ?", ownerID).
+		Order("position ASC, highlight_id ASC").
+		Find(&highlights).Error
+	return highlights, err
+}
+
+// ReorderHighlights sets the position of each highlight to its index in highlightIDs
+func (r *FeedRepository) ReorderHighlights(ctx context.Context, ownerID int64, highlightIDs []int64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i, id := range highlightIDs {
+			if err := tx.Model(&dbmysql.Highlight{}).
+				Where("highlight_id = ? AND owner_id = ?", id, ownerID).
+				Update("position", i+1).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ListHighlightStories returns the stories of each highlight in their highlight order
+func (r *FeedRepository) ListHighlightStories(ctx context.Context, highlightIDs []int64) (map[int64][]dbmysql.Content, error) {
+	stories := make(map[int64][]dbmysql.Content, len(highlightIDs))
+	if len(highlightIDs) == 0 {
+		return stories, nil
+	}
+
+	var entries []dbmysql.HighlightStory
+	if err := r.db.WithContext(ctx).
+		Where("highlight_id IN ?", highlightIDs).
+		Order("position ASC").
+		Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	storyIDs := make([]int64, 0, len(entries))
+	for _, e := range entries {
+		storyIDs = append(storyIDs, e.StoryID)
+	}
+
+	var contents []dbmysql.Content
+	if len(storyIDs) > 0 {
+		if err := r.db.WithContext(ctx).Where("content_id IN ?", storyIDs).Find(&contents).Error; err != nil {
+			return nil, err
+		}
+	}
+	byID := make(map[int64]dbmysql.Content, len(contents))
+	for _, c := range contents {
+		byID[c.ContentID] = c
+	}
+	for _, e := range entries {
+		if c, ok := byID[e.StoryID]; ok {
+			stories[e.HighlightID] = append(stories[e.HighlightID], c)
+		}
+	}
+	return stories, nil
+}
+
+func (r *FeedRepository) RemoveStoryFromHighlights(ctx context.Context, storyID int64) error {
+	return r.db.WithContext(ctx).Delete(&dbmysql.HighlightStory{}, "story_id = ?", storyID).Error
+}
+
+// HighlightedStories tells which of the stories are in at least one highlight
+func (r *FeedRepository) HighlightedStories(ctx context.Context, storyIDs []int64) (map[int64]bool, error) {
+	highlighted := make(map[int64]bool, len(storyIDs))
+	if len(storyIDs) == 0 {
+		return highlighted, nil
+	}
+	var ids []int64
+	if err := r.db.WithContext(ctx).
+		Model(&dbmysql.HighlightStory{}).
+		Where("story_id IN ?", storyIDs).
+		Distinct().
+		Pluck("story_id", &ids).Error; err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		highlighted[id] = true
+	}
+	return highlighted, nil
+}
+
+func createHighlightStories(tx *gorm.DB, highlightID int64, storyIDs []int64) error {
+	entries := make([]dbmysql.HighlightStory, 0, len(storyIDs))
+	for i, id := range storyIDs {
+		entries = append(entries, dbmysql.HighlightStory{HighlightID: highlightID, StoryID: id, Position: i + 1})
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+	return tx.Create(&entries).Error
+}
+
+// --------- HASHTAGS ---------
+type Hashtags interface {
+	TagContent(ctx context.Context, contentID int64, tags []string, at time.Time) error
+	ListHashtagContent(ctx context.Context, tag string, cursor *TimelineCursor, limit int) ([]dbmysql.Content, error)
+	CountHashtagUses(ctx context.Context, since, until time.Time, tags []string) (map[string]int64, error)
+	DeleteContentHashtags(ctx context.Context, contentID int64) error
+}
+
+// TagContent links the content to its tags, creating the tags that are new
+func (r *FeedRepository) TagContent(ctx context.Context, contentID int64, tags []string, at time.Time) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		hashtags := make([]dbmysql.Hashtag, 0, len(tags))
+		for _, tag := range tags {
+			hashtags = append(hashtags, dbmysql.Hashtag{Tag: tag, CreatedAt: at})
+		}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&hashtags).Error; err != nil {
+			return err
+		}
+
+		var ids []int64
+		if err := tx.Model(&dbmysql.Hashtag{}).Where("tag IN ?", tags).Pluck("hashtag_id", &ids).Error; err != nil {
+			return err
+		}
+		entries := make([]dbmysql.ContentHashtag, 0, len(ids))
+		for _, id := range ids {
+			entries = append(entries, dbmysql.ContentHashtag{ContentID: contentID, HashtagID: id, CreatedAt: at})
+		}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&entries).Error
+	})
+}
+
+// ListHashtagContent returns the newest public content with the tag, starting after cursor
+func (r *FeedRepository) ListHashtagContent(ctx context.Context, tag string, cursor *TimelineCursor, limit int) ([]dbmysql.Content, error) {
+	var contents []dbmysql.Content
+	query := r.db.WithContext(ctx).
+		Joins("JOIN content_hashtags ON content_hashtags.content_id = contents.content_id").
+		Joins("JOIN hashtags ON hashtags.hashtag_id = content_hashtags.hashtag_id").
+		Where("hashtags.tag = ? AND contents.privacy = ? AND contents.archived_at IS NULL", tag, "public")
+	if cursor != nil {
+		query = query.Where("(contents.created_at < ? OR (contents.created_at = ? AND contents.content_id < ?))", cursor.CreatedAt, cursor.CreatedAt, cursor.ContentID)
+	}
+	err := query.
+		Order("contents.created_at DESC, contents.content_id DESC").
+		Limit(limit).
+		Find(&contents).Error
+	return contents, err
+}
+
+// CountHashtagUses counts the public content tagged within [since, until) per tag. A nil tags counts every tag
+func (r *FeedRepository) CountHashtagUses(ctx context.Context, since, until time.Time, tags []string) (map[string]int64, error) {
+	var rows []struct {
+		Tag  string
+		Uses int64
+	}
+	query := r.db.WithContext(ctx).
+		Table("content_hashtags").
+		Select("hashtags.tag AS tag, COUNT(*) AS uses").
+		Joins("JOIN hashtags ON hashtags.hashtag_id = content_hashtags.hashtag_id").
+		Joins("JOIN contents ON contents.content_id = content_hashtags.content_id").
+		Where("content_hashtags.created_at >= ? AND content_hashtags.created_at < ?", since, until).
+		Where("contents.privacy = ? AND contents.archived_at IS NULL", "public")
+	if tags != nil {
+		if len(tags) == 0 {
+			return map[string]int64{}, nil
+		}
+		query = query.Where("hashtags.tag IN ?", tags)
+	}
+	if err := query.Group("hashtags.tag").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	uses := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		uses[row.Tag] = row.Uses
+	}
+	return uses, nil
+}
+
+func (r *FeedRepository) DeleteContentHashtags(ctx context.Context, contentID int64) error {
+	return r.db.WithContext(ctx).Delete(&dbmysql.ContentHashtag{}, "content_id = ?", contentID).Error
+}
+
+// --------- MENTIONS ---------
+type Mentions interface {
+	CreateMentions(ctx context.Context, mentions []dbmysql.Mention) error
+	ListMentions(ctx context.Context, contentIDs []int64) (map[int64][]dbmysql.Mention, error)
+	ListMentionedContent(ctx context.Context, userID int64, friendIDs []int64, cursor *TimelineCursor, limit int) ([]dbmysql.Content, error)
+	DeleteMentions(ctx context.Context, contentID int64) error
+}
+
+func (r *FeedRepository) CreateMentions(ctx context.Context, mentions []dbmysql.Mention) error {
+	if len(mentions) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Create(&mentions).Error
+}
+
+// ListMentions returns the mentions of each content in text order
+func (r *FeedRepository) ListMentions(ctx context.Context, contentIDs []int64) (map[int64][]dbmysql.Mention, error) {
+	byContent := make(map[int64][]dbmysql.Mention, len(contentIDs))
+	if len(contentIDs) == 0 {
+		return byContent, nil
+	}
+	var mentions []dbmysql.Mention
+	if err := r.db.WithContext(ctx).
+		Where("content_id IN ?", contentIDs).
+		Order("content_id, `offset`").
+		Find(&mentions).Error; err != nil {
+		return nil, err
+	}
+	for _, m := range mentions {
+		byContent[m.ContentID] = append(byContent[m.ContentID], m)
+	}
+	return byContent, nil
+}
+
+// ListMentionedContent returns the newest content mentioning the user that the user may see, starting after cursor.
+// friendIDs are the user's friends, whose friends-only content is visible to them, list content needs the user in the list
+func (r *FeedRepository) ListMentionedContent(ctx context.Context, userID int64, friendIDs []int64, cursor *TimelineCursor, limit int) ([]dbmysql.Content, error) {
+	var contents []dbmysql.Content
+	mentioned := r.db.Model(&dbmysql.Mention{}).Select("content_id").Where("mentioned_user_id = ?", userID)
+	query := r.db.WithContext(ctx).
+		Where("content_id IN (?) AND archived_at IS NULL", mentioned).
+		Where("(author_id = ? OR privacy = ? OR (privacy = ? AND author_id IN ?) OR (privacy = ? AND audience_list_id IN (?)))", userID, "public", "friends", friendIDs, "list", r.audienceListsOf(userID))
+	if cursor != nil {
+		query = query.Where("(created_at < ? OR (created_at = ? AND content_id < ?))", cursor.CreatedAt, cursor.CreatedAt, cursor.ContentID)
+	}
+	err := query.
+		Order("created_at DESC, content_id DESC").
+		Limit(limit).
+		Find(&contents).Error
+	return contents, err
+}
+
+func (r *FeedRepository) DeleteMentions(ctx context.Context, contentID int64) error {
+	return r.db.WithContext(ctx).Delete(&dbmysql.Mention{}, "content_id = ?", contentID).Error
+}
+
+// --------- COLLECTIONS ---------
+type Collections interface {
+	GetOrCreateCollection(ctx context.Context, ownerID int64, name string) (*dbmysql.Collection, error)
+	GetCollectionByID(ctx context.Context, id int64) (*dbmysql.Collection, error)
+	ListCollections(ctx context.Context, ownerID int64) ([]dbmysql.Collection, error)
+	CountSavedItems(ctx context.Context, collectionIDs []int64) (map[int64]int64, error)
+	SaveItem(ctx context.Context, item *dbmysql.SavedItem) error
+	UnsaveItem(ctx context.Context, collectionIDs []int64, contentID int64) error
+	ListSavedItems(ctx context.Context, collectionID, beforeID int64, limit int) ([]dbmysql.SavedItem, error)
+	DeleteSavedContent(ctx context.Context, contentID int64) error
+}
+
+// GetOrCreateCollection returns the owner's collection with that name, creating it on first use
+func (r *FeedRepository) GetOrCreateCollection(ctx context.Context, ownerID int64, name string) (*dbmysql.Collection, error) {
+	now := time.Now()
+	collection := dbmysql.Collection{OwnerID: ownerID, Name: name, CreatedAt: now, UpdatedAt: now}
+	err := r.db.WithContext(ctx).
+		Where("owner_id = ? AND name = ?", ownerID, name).
+		FirstOrCreate(&collection).Error
+	return &collection, err
+}
+
+func (r *FeedRepository) GetCollectionByID(ctx context.Context, id int64) (*dbmysql.Collection, error) {
+	var collection dbmysql.Collection
+	err := r.db.WithContext(ctx).First(&collection, "collection_id = ?", id).Error
+	return &collection, err
+}
+
+func (r *FeedRepository) ListCollections(ctx context.Context, ownerID int64) ([]dbmysql.Collection, error) {
+	var collections []dbmysql.Collection
+	err := r.db.WithContext(ctx).
+		Where("owner_id = ?", ownerID).
+		Order("created_at ASC, collection_id ASC").
+		Find(&collections).Error
+	return collections, err
+}
+
+func (r *FeedRepository) CountSavedItems(ctx context.Context, collectionIDs []int64) (map[int64]int64, error) {
+	counts := make(map[int64]int64, len(collectionIDs))
+	if len(collectionIDs) == 0 {
+		return counts, nil
+	}
+	var rows []struct {
+		CollectionID int64
+		Count        int64
+	}
+	if err := r.db.WithContext(ctx).
+		Model(&dbmysql.SavedItem{}).
+		Select("collection_id, COUNT(*) AS count").
+		Where("collection_id IN ?", collectionIDs).
+		Group("collection_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		counts[row.CollectionID] = row.Count
+	}
+	return counts, nil
+}
+
+// SaveItem adds the content to the collection, saving it twice keeps the first save
+func (r *FeedRepository) SaveItem(ctx context.Context, item *dbmysql.SavedItem) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(item).Error
+}
+
+func (r *FeedRepository) UnsaveItem(ctx context.Context, collectionIDs []int64, contentID int64) error {
+	if len(collectionIDs) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Delete(&dbmysql.SavedItem{}, "collection_id IN ? AND content_id = ?", collectionIDs, contentID).Error
+}
+
+// ListSavedItems returns the most recently saved items of a collection, starting before beforeID when it is set
+func (r *FeedRepository) ListSavedItems(ctx context.Context, collectionID, beforeID int64, limit int) ([]dbmysql.SavedItem, error) {
+	var items []dbmysql.SavedItem
+	query := r.db.WithContext(ctx).Where("collection_id = ?", collectionID)
+	if beforeID > 0 {
+		query = query.Where("save_id < ?", beforeID)
+	}
+	err := query.
+		Order("save_id DESC").
+		Limit(limit).
+		Find(&items).Error
+	return items, err
+}
+
+func (r *FeedRepository) DeleteSavedContent(ctx context.Context, contentID int64) error {
+	return r.db.WithContext(ctx).Delete(&dbmysql.SavedItem{}, "content_id = ?", contentID).Error
+}
+
+// --------- AUDIENCE LISTS ---------
+type AudienceLists interface {
+	CreateAudienceList(ctx context.Context, list *dbmysql.AudienceList) error
+	GetAudienceList(ctx context.Context, id int64) (*dbmysql.AudienceList, error)
+	ListAudienceLists(ctx context.Context, ownerID int64) ([]dbmysql.AudienceList, error)
+	DeleteAudienceList(ctx context.Context, id int64) error
+	AddAudienceListMembers(ctx context.Context, listID int64, userIDs []int64) error
+	RemoveAudienceListMembers(ctx context.Context, listID int64, userIDs []int64) error
+	ListAudienceListMembers(ctx context.Context, listID int64) ([]int64, error)
+	IsAudienceListMember(ctx context.Context, listID, userID int64) (bool, error)
+}
+
+func (r *FeedRepository) CreateAudienceList(ctx context.Context, list *dbmysql.AudienceList) error {
+	return r.db.WithContext(ctx).Create(list).Error
+}
+
+func (r *FeedRepository) GetAudienceList(ctx context.Context, id int64) (*dbmysql.AudienceList, error) {
+	var list dbmysql.AudienceList
+	err := r.db.WithContext(ctx).First(&list, "list_id = ?", id).Error
+	return &list, err
+}
+
+func (r *FeedRepository) ListAudienceLists(ctx context.Context, ownerID int64) ([]dbmysql.AudienceList, error) {
+	var lists []dbmysql.AudienceList
+	err := r.db.WithContext(ctx).
+		Where("owner_id = ?", ownerID).
+		Order("created_at ASC, list_id ASC").
+		Find(&lists).Error
+	return lists, err
+}
+
+// DeleteAudienceList removes the list and its members, content shared with it is left to its author alone
+func (r *FeedRepository) DeleteAudienceList(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&dbmysql.AudienceListMember{}, "list_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&dbmysql.AudienceList{}, "list_id = ?", id).Error
+	})
+}
+
+// AddAudienceListMembers adds the users to the list, users already in it keep their membership
+func (r *FeedRepository) AddAudienceListMembers(ctx context.Context, listID int64, userIDs []int64) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	now := time.Now()
+	members := make([]dbmysql.AudienceListMember, 0, len(userIDs))
+	for _, id := range userIDs {
+		members = append(members, dbmysql.AudienceListMember{ListID: listID, UserID: id, AddedAt: now})
+	}
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&members).Error
+}
+
+func (r *FeedRepository) RemoveAudienceListMembers(ctx context.Context, listID int64, userIDs []int64) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Delete(&dbmysql.AudienceListMember{}, "list_id = ? AND user_id IN ?", listID, userIDs).Error
+}
+
+// ListAudienceListMembers returns the members of a list in the order they were added
+func (r *FeedRepository) ListAudienceListMembers(ctx context.Context, listID int64) ([]int64, error) {
+	var userIDs []int64
+	err := r.db.WithContext(ctx).
+		Model(&dbmysql.AudienceListMember{}).
+		Where("list_id = ?", listID).
+		Order("added_at ASC, user_id ASC").
+		Pluck("user_id", &userIDs).Error
+	return userIDs, err
+}
+
+func (r *FeedRepository) IsAudienceListMember(ctx context.Context, listID, userID int64) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&dbmysql.AudienceListMember{}).
+		Where("list_id = ? AND user_id = ?", listID, userID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// audienceListsOf is a subquery of the lists the user is in, for matching list content at read time
+func (r *FeedRepository) audienceListsOf(userID int64) *gorm.DB {
+	return r.db.Model(&dbmysql.AudienceListMember{}).Select("list_id").Where("user_id = ?", userID)
+}
@@ -2,8 +2,10 @@ package feed
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"sort"
+	"io"
+	"log"
 	"strconv"
 	"time"
 
@@ -18,43 +20,156 @@ func GetMediaURL(fileName string) string {
 	return fmt.Sprintf("%s%s", MediaBaseURL, fileName)
 }
 
+var (
+	ErrNotContentOwner = errors.New("only the author can change this content")
+	ErrInvalidPrivacy  = errors.New("privacy must be public, friends, list or private")
+	ErrNotFriends      = errors.New("the two users are not friends")
+)
+
 // all functions in this file are higher-order functions that call the core service methods
 type FeedUsecase interface {
-	CreatePost(ctx context.Context, authorID int64, text string, fileData []byte, fileName string, mediaType string, privacy string) (int64, error)
-	CreateReel(ctx context.Context, authorID int64, caption string, fileData []byte, fileName string, durationSecs int, privacy string) (int64, error)
-	CreateStory(ctx context.Context, authorID int64, fileData []byte, mediaType string, mediaName string, durationSec int, privacy string) (int64, error)
+	CreatePost(ctx context.Context, authorID int64, text string, fileData []byte, fileName string, mediaType string, privacy string, audienceListID int64, publishAt *time.Time) (int64, error)
+	CreateReel(ctx context.Context, authorID int64, caption string, fileData []byte, fileName string, durationSecs int, privacy string, publishAt *time.Time) (int64, error)
+	CreateStory(ctx context.Context, authorID int64, fileData []byte, mediaType string, mediaName string, durationSec int, privacy string, audienceListID int64) (int64, error)
 	ReactToContent(ctx context.Context, userID, contentID int64, reactionType string) error
-	GetReactions(ctx context.Context, contentID int64) ([]dbmysql.Reaction, error)
+	GetReactions(ctx context.Context, viewerID, contentID int64) ([]dbmysql.Reaction, error)
 	DeleteReaction(ctx context.Context, userID, contentID int64) error
-	GetTimeline(ctx context.Context, userID int64) ([]dbmysql.Content, []string, error)
+	SummarizeReactions(ctx context.Context, viewerID int64, contentIDs []int64) (map[int64]ReactionSummary, error)
+	ListReactors(ctx context.Context, viewerID, contentID int64, reactionType, cursor string, pageSize int) (*ReactorPage, error)
+	GetTimeline(ctx context.Context, userID int64, query TimelineQuery) (*TimelinePage, error)
 	GetUserContent(ctx context.Context, requesterID, targetUserID int64) ([]dbmysql.Content, []string, error)
 
-	GetMediaRef(ctx context.Context, id int64) (*dbmysql.MediaRef, []byte, error)
+	GetMediaRef(ctx context.Context, viewerID, id int64) (*dbmysql.MediaRef, []byte, error)
 
 	GetContent(ctx context.Context, id int64) (*dbmysql.Content, string, error)
-	DeleteContent(ctx context.Context, id int64) error
+	GetVisibleContent(ctx context.Context, viewerID, id int64) (*dbmysql.Content, string, error)
+	DeleteContent(ctx context.Context, requesterID, id int64) error
+	UpdateContentPrivacy(ctx context.Context, requesterID, contentID int64, privacy string) error
+	UpdateContent(ctx context.Context, editorID, contentID int64, update ContentUpdate) (*dbmysql.Content, error)
+	ListRevisions(ctx context.Context, viewerID, contentID int64, cursor string, pageSize int) (*RevisionPage, error)
+	OnFriendshipAccepted(ctx context.Context, userID, friendID int64) error
+
+	AddComment(ctx context.Context, authorID, contentID, parentID int64, text string) (*dbmysql.Comment, error)
+	ListComments(ctx context.Context, viewerID, contentID, parentID int64, cursor string, pageSize int) (*CommentPage, error)
+	EditComment(ctx context.Context, editorID, commentID int64, text string) (*dbmysql.Comment, error)
+	DeleteComment(ctx context.Context, requesterID, commentID int64) error
+	CountComments(ctx context.Context, contentIDs []int64) (map[int64]int64, error)
+
+	MarkStoryViewed(ctx context.Context, viewerID, storyID int64) error
+	ListStoryViewers(ctx context.Context, requesterID, storyID int64, cursor string, pageSize int) (*StoryViewerPage, error)
+	StoriesSeen(ctx context.Context, viewerID int64, contents []dbmysql.Content) (map[int64]bool, error)
+
+	ListStoryArchive(ctx context.Context, authorID int64, query TimelineQuery) (*TimelinePage, error)
+	CreateHighlight(ctx context.Context, ownerID int64, title string, storyIDs []int64) (*dbmysql.Highlight, error)
+	UpdateHighlight(ctx context.Context, requesterID, highlightID int64, title string, storyIDs []int64) (*dbmysql.Highlight, error)
+	DeleteHighlight(ctx context.Context, requesterID, highlightID int64) error
+	ReorderHighlights(ctx context.Context, ownerID int64, highlightIDs []int64) error
+	ListHighlights(ctx context.Context, viewerID, ownerID int64) ([]HighlightView, error)
+
+	GetHashtagFeed(ctx context.Context, tag string, query TimelineQuery) (*TimelinePage, error)
+	GetTrendingHashtags(ctx context.Context, window time.Duration, limit int) ([]TrendingHashtag, error)
+
+	GetMentionsFeed(ctx context.Context, userID int64, query TimelineQuery) (*TimelinePage, error)
+	ListMentions(ctx context.Context, contentIDs []int64) (map[int64][]dbmysql.Mention, error)
+
+	ShareContent(ctx context.Context, sharerID, originalID int64, commentary, privacy string) (int64, error)
+	CountShares(ctx context.Context, contentIDs []int64) (map[int64]int64, error)
+	ResolveShared(ctx context.Context, viewerID int64, contents []dbmysql.Content) (map[int64]*SharedOriginal, error)
+
+	CreateCarouselPost(ctx context.Context, authorID int64, text string, media []MediaUpload, privacy string, audienceListID int64, publishAt *time.Time) (int64, error)
+	UploadMedia(ctx context.Context, uploaderID int64, fileName, mediaType string, r io.Reader) (*dbmysql.MediaRef, error)
+	CreateFromUpload(ctx context.Context, upload UploadedContent) (int64, error)
+	ListMedia(ctx context.Context, contents []dbmysql.Content) (map[int64][]MediaItem, error)
+
+	SaveContent(ctx context.Context, userID, contentID int64, collectionName string) (*dbmysql.Collection, error)
+	UnsaveContent(ctx context.Context, userID, contentID, collectionID int64) error
+	ListCollections(ctx context.Context, userID int64) ([]CollectionSummary, error)
+	ListSavedContent(ctx context.Context, userID, collectionID int64, cursor string, pageSize int) (*SavedPage, error)
+
+	CreateAudienceList(ctx context.Context, ownerID int64, name string, memberIDs []int64) (*dbmysql.AudienceList, error)
+	ListAudienceLists(ctx context.Context, ownerID int64) ([]AudienceListSummary, error)
+	AddAudienceListMembers(ctx context.Context, ownerID, listID int64, userIDs []int64) error
+	RemoveAudienceListMembers(ctx context.Context, ownerID, listID int64, userIDs []int64) error
+	DeleteAudienceList(ctx context.Context, ownerID, listID int64) error
+
+	MarkReelViewed(ctx context.Context, viewerID, reelID int64) error
+	GetExploreReels(ctx context.Context, viewerID int64, query TimelineQuery) (*TimelinePage, error)
+
+	ListScheduledContent(ctx context.Context, authorID int64, query TimelineQuery) (*TimelinePage, error)
+	RescheduleContent(ctx context.Context, authorID, contentID int64, publishAt time.Time) (*dbmysql.Content, error)
+	CancelScheduledContent(ctx context.Context, authorID, contentID int64) error
+
+	SaveDraft(ctx context.Context, authorID int64, draft Draft) (*dbmysql.Content, error)
+	ListDrafts(ctx context.Context, authorID int64, query TimelineQuery) (*TimelinePage, error)
+	UpdateDraft(ctx context.Context, authorID, contentID int64, draft Draft) (*dbmysql.Content, error)
+	PublishDraft(ctx context.Context, authorID, contentID int64, publishAt *time.Time) (*dbmysql.Content, error)
+	DiscardDraft(ctx context.Context, authorID, contentID int64) error
 }
 
 type FeedService struct {
 	contentRepo    Content
 	mediaRepo      MediaRef
 	reactionRepo   Reactions
+	commentRepo    Comments
+	storyViewRepo  StoryViews
+	highlightRepo  Highlights
+	hashtagRepo    Hashtags
+	mentionRepo    Mentions
+	collectionRepo Collections
+	audienceRepo   AudienceLists
+	reelViewRepo   ReelViews
 	UserClient     userpb.UserServiceClient
 	cleanupStarted bool
+
+	// optional fan-out-on-write store, nil means timelines are aggregated at read time
+	timelines     TimelineStore
+	backfillLimit int
+
+	// orders ranked timeline pages, nil serves them chronologically
+	ranker Ranker
+
+	// tells authors about reactions and comments, nil sends nothing
+	notifier Notifier
 }
 
-func NewFeedService(c Content, m MediaRef, r Reactions, u userpb.UserServiceClient) *FeedService {
+func NewFeedService(c Content, m MediaRef, r Reactions, cm Comments, sv StoryViews, hl Highlights, ht Hashtags, mn Mentions, cl Collections, al AudienceLists, rv ReelViews, u userpb.UserServiceClient) *FeedService {
 	service := &FeedService{
-		contentRepo:  c,
-		mediaRepo:    m,
-		reactionRepo: r,
-		UserClient:   u,
+		contentRepo:    c,
+		mediaRepo:      m,
+		reactionRepo:   r,
+		commentRepo:    cm,
+		storyViewRepo:  sv,
+		highlightRepo:  hl,
+		hashtagRepo:    ht,
+		mentionRepo:    mn,
+		collectionRepo: cl,
+		audienceRepo:   al,
+		reelViewRepo:   rv,
+		UserClient:     u,
 	}
 	go service.startExpiredStoryCleaner()
+	go service.startScheduledPublisher()
+	go service.startDraftCleaner()
 
 	return service
 }
 
+// SetTimelineStore enables materialized home timelines, backfillLimit caps the posts copied on a new friendship
+func (s *FeedService) SetTimelineStore(store TimelineStore, backfillLimit int) {
+	s.timelines = store
+	s.backfillLimit = backfillLimit
+}
+
+// SetRanker replaces the Ranker used for ranking=ranked timelines
+func (s *FeedService) SetRanker(ranker Ranker) {
+	s.ranker = ranker
+}
+
+// SetNotifier enables reaction and comment notifications to content authors
+func (s *FeedService) SetNotifier(notifier Notifier) {
+	s.notifier = notifier
+}
+
 // --------- CONTENT ---------
 
 // CreateContent creates new content and uploads media if provided.
@@ -63,6 +178,22 @@ func (s *FeedService) CreateContent(ctx context.Context, content *dbmysql.Conten
 	content.CreatedAt = time.Now()
 	content.UpdatedAt = time.Now()
 
+	// Step 0: List content must target one of the author's audience lists, scheduled content is created at its publish time
+	if err := s.checkAudience(ctx, content); err != nil {
+		return 0, err
+	}
+	if content.PublishAt != nil {
+		if err := checkPublishAt(content, content.CreatedAt); err != nil {
+			return 0, err
+		}
+		content.CreatedAt = *content.PublishAt
+	}
+	// Stories run for their duration from the moment they go live
+	if content.Type == "STORY" && content.Duration != nil {
+		expiration := content.CreatedAt.Add(time.Duration(*content.Duration) * time.Second)
+		content.Expiration = &expiration
+	}
+
 	// Step 1: Upload media only if file is passed
 	if fileData != nil && len(fileData) > 0 {
 		// Create a temporary MediaRef struct with required fields
@@ -87,9 +218,30 @@ func (s *FeedService) CreateContent(ctx context.Context, content *dbmysql.Conten
 		return 0, err
 	}
 
+	// Step 3: Spread it unless it waits for its publish time
+	if content.PublishAt == nil {
+		s.distribute(ctx, content)
+	}
+
 	return content.ContentID, nil
 }
 
+// distribute indexes the hashtags and mentions of new content and pushes it into the materialized timelines,
+// the content is kept even if these fail
+func (s *FeedService) distribute(ctx context.Context, content *dbmysql.Content) {
+	if content.TextContent != nil {
+		if tags := ExtractHashtags(*content.TextContent); len(tags) > 0 {
+			if err := s.hashtagRepo.TagContent(ctx, content.ContentID, tags, content.CreatedAt); err != nil {
+				log.Printf("failed to tag content %d: %v", content.ContentID, err)
+			}
+		}
+	}
+	if err := s.recordMentions(ctx, content, nil); err != nil {
+		log.Printf("failed to record mentions of content %d: %v", content.ContentID, err)
+	}
+	s.fanOut(ctx, content)
+}
+
 // func (s *FeedService) GetContent(ctx context.Context, id int64) (*dbmysql.Content, error) {
 // 	return s.contentRepo.GetContentByID(ctx, id)
 // }
@@ -114,26 +266,126 @@ func (s *FeedService) GetContent(ctx context.Context, id int64) (*dbmysql.Conten
 	return content, mediaURL, nil
 }
 
+// GetVisibleContent is GetContent for a viewer, content they may not see fails with ErrContentNotVisible
+func (s *FeedService) GetVisibleContent(ctx context.Context, viewerID, id int64) (*dbmysql.Content, string, error) {
+	if _, err := s.visibleContent(ctx, viewerID, id); err != nil {
+		return nil, "", err
+	}
+	return s.GetContent(ctx, id)
+}
+
 func (s *FeedService) ListUserContent(ctx context.Context, userID int64) ([]dbmysql.Content, error) {
 	return s.contentRepo.ListUserContent(ctx, userID)
 }
 
-// DeleteContent deletes content and its associated media if present.
-
-func (s *FeedService) DeleteContent(ctx context.Context, id int64) error {
+// DeleteContent deletes content and its associated media if present, only its author may delete it
+func (s *FeedService) DeleteContent(ctx context.Context, requesterID, id int64) error {
 	// Step 1: Load content to get media_ref_id
-	content, err := s.contentRepo.GetContentByID(ctx, id)
+	content, err := s.ownContent(ctx, requesterID, id)
 	if err != nil {
 		return err
 	}
 
-	// Step 2: Delete associated media if present
-	if content.MediaRefID != nil {
-		_ = s.mediaRepo.DeleteMedia(ctx, *content.MediaRefID) // Don't fail content delete if this fails
+	// Step 2: Delete associated media if present, every carousel item included
+	carousel, err := s.contentRepo.ListContentMedia(ctx, []int64{id})
+	if err != nil {
+		return err
+	}
+	for _, mediaRefID := range contentMediaRefIDs(content, carousel[id]) {
+		_ = s.mediaRepo.DeleteMedia(ctx, mediaRefID) // Don't fail content delete if this fails
 	}
 
-	// Step 3: Delete content
-	return s.contentRepo.DeleteContent(ctx, id)
+	// Step 3: Delete comments, hashtags, mentions, saves, story and reel views and highlight entries, then the content
+	if err := s.commentRepo.DeleteCommentsForContent(ctx, id); err != nil {
+		return err
+	}
+	if err := s.hashtagRepo.DeleteContentHashtags(ctx, id); err != nil {
+		return err
+	}
+	if err := s.mentionRepo.DeleteMentions(ctx, id); err != nil {
+		return err
+	}
+	if err := s.collectionRepo.DeleteSavedContent(ctx, id); err != nil {
+		return err
+	}
+	if content.Type == "STORY" {
+		if err := s.storyViewRepo.DeleteStoryViews(ctx, id); err != nil {
+			return err
+		}
+		if err := s.highlightRepo.RemoveStoryFromHighlights(ctx, id); err != nil {
+			return err
+		}
+	}
+	if content.Type == "REEL" {
+		if err := s.reelViewRepo.DeleteReelViews(ctx, id); err != nil {
+			return err
+		}
+	}
+	if err := s.contentRepo.DeleteContent(ctx, id); err != nil {
+		return err
+	}
+
+	// Step 4: Drop it from the materialized timelines
+	if s.timelines != nil {
+		if err := s.timelines.RemoveFromTimelines(ctx, id); err != nil {
+			log.Printf("failed to remove content %d from timelines: %v", id, err)
+		}
+	}
+	return nil
+}
+
+// UpdateContentPrivacy changes who can see a content, it is an UpdateContent that only touches the privacy
+func (s *FeedService) UpdateContentPrivacy(ctx context.Context, requesterID, contentID int64, privacy string) error {
+	_, err := s.UpdateContent(ctx, requesterID, contentID, ContentUpdate{Privacy: &privacy})
+	return err
+}
+
+// fanOut pushes content into the author's timeline and, unless it is private, into every friend's.
+// List content only goes to the members of its list
+func (s *FeedService) fanOut(ctx context.Context, content *dbmysql.Content) {
+	if s.timelines == nil {
+		return
+	}
+
+	ownerIDs := []int64{content.AuthorID}
+	switch content.Privacy {
+	case "private":
+	case "list":
+		memberIDs, err := s.audienceMembers(ctx, content)
+		if err != nil {
+			log.Printf("fan-out of content %d limited to its author: %v", content.ContentID, err)
+		}
+		ownerIDs = append(ownerIDs, memberIDs...)
+	default:
+		friendIDs, err := s.GetUserFriendIDs(ctx, content.AuthorID)
+		if err != nil {
+			log.Printf("fan-out of content %d limited to its author: %v", content.ContentID, err)
+		}
+		ownerIDs = append(ownerIDs, friendIDs...)
+	}
+
+	if err := s.timelines.PushToTimelines(ctx, ownerIDs, content); err != nil {
+		log.Printf("failed to fan out content %d: %v", content.ContentID, err)
+	}
+}
+
+// OnFriendshipAccepted backfills the recent posts of each new friend into the other's timeline,
+// once the user service confirms the friendship
+func (s *FeedService) OnFriendshipAccepted(ctx context.Context, userID, friendID int64) error {
+	if s.timelines == nil {
+		return nil
+	}
+	friends, err := s.newViewPolicy(friendID).isFriendOf(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !friends {
+		return ErrNotFriends
+	}
+	if err := s.timelines.BackfillTimeline(ctx, userID, friendID, s.backfillLimit); err != nil {
+		return err
+	}
+	return s.timelines.BackfillTimeline(ctx, friendID, userID, s.backfillLimit)
 }
 
 // --------- MEDIA REF ---------
@@ -144,7 +396,22 @@ func (s *FeedService) CreateMediaRef(ctx context.Context, media *dbmysql.MediaRe
 	return int64(media.MediaRefID), err
 }
 
-func (s *FeedService) GetMediaRef(ctx context.Context, id int64) (*dbmysql.MediaRef, []byte, error) {
+// GetMediaRef downloads media for a viewer who uploaded it or may see a content using it, other media is not found
+func (s *FeedService) GetMediaRef(ctx context.Context, viewerID, id int64) (*dbmysql.MediaRef, []byte, error) {
+	refs, err := s.mediaRepo.ListMediaRefsByIDs(ctx, []int64{id})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(refs) == 0 {
+		return nil, nil, ErrMediaNotFound
+	}
+	visible, err := s.canViewMedia(ctx, viewerID, &refs[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	if !visible {
+		return nil, nil, ErrMediaNotFound
+	}
 	return s.mediaRepo.GetMediaRefByID(ctx, id)
 }
 
@@ -155,7 +422,11 @@ func (s *FeedService) AddReaction(ctx context.Context, reaction *dbmysql.Reactio
 	return s.reactionRepo.AddReaction(ctx, reaction)
 }
 
-func (s *FeedService) GetReactions(ctx context.Context, contentID int64) ([]dbmysql.Reaction, error) {
+// GetReactions lists the reactions to a content the viewer may see
+func (s *FeedService) GetReactions(ctx context.Context, viewerID, contentID int64) ([]dbmysql.Reaction, error) {
+	if _, err := s.visibleContent(ctx, viewerID, contentID); err != nil {
+		return nil, err
+	}
 	return s.reactionRepo.GetReactionsForContent(ctx, contentID)
 }
 
@@ -176,6 +447,25 @@ func (s *FeedService) GetUserFriendIDs(ctx context.Context, userID int64) ([]int
 	return friendIDs, nil
 }
 
+// maxHandlesPerLookup is the most user IDs the user service resolves in one GetHandles call
+const maxHandlesPerLookup = 100
+
+// GetUserHandles looks up the handles of userIDs in batches, unknown or inactive users are left out
+func (s *FeedService) GetUserHandles(ctx context.Context, userIDs []int64) (map[int64]string, error) {
+	handles := make(map[int64]string, len(userIDs))
+	for start := 0; start < len(userIDs); start += maxHandlesPerLookup {
+		end := min(start+maxHandlesPerLookup, len(userIDs))
+		resp, err := s.UserClient.GetHandles(ctx, &userpb.GetHandlesRequest{UserIds: userIDs[start:end]})
+		if err != nil {
+			return nil, fmt.Errorf("GetHandles failed: %w", err)
+		}
+		for id, handle := range resp.Handles {
+			handles[id] = handle
+		}
+	}
+	return handles, nil
+}
+
 func (s *FeedService) startExpiredStoryCleaner() {
 	if s.cleanupStarted {
 		return
@@ -186,20 +476,7 @@ func (s *FeedService) startExpiredStoryCleaner() {
 	for {
 		<-ticker.C
 
-		go func() {
-			now := time.Now()
-			expired, err := s.contentRepo.ListExpiredStories(context.Background(), now)
-			if err != nil {
-				fmt.Println("Failed to fetch expired stories:", err)
-				return
-			}
-
-			for _, story := range expired {
-				if err := s.DeleteContent(context.Background(), story.ContentID); err != nil {
-					fmt.Printf("Failed to delete expired story %d: %v\n", story.ContentID, err)
-				}
-			}
-		}()
+		go s.cleanupExpiredStories(context.Background(), time.Now())
 	}
 }
 
@@ -213,13 +490,17 @@ func (s *FeedService) CreatePost(
 	fileName string,
 	mediaType string,
 	privacy string,
+	audienceListID int64,
+	publishAt *time.Time,
 ) (int64, error) {
 
 	content := &dbmysql.Content{
-		AuthorID:    authorID,
-		Type:        "POST",
-		TextContent: &text,
-		Privacy:     privacy,
+		AuthorID:       authorID,
+		Type:           "POST",
+		TextContent:    &text,
+		Privacy:        privacy,
+		AudienceListID: audienceListRef(audienceListID),
+		PublishAt:      publishAt,
 	}
 
 	// Just reuse the existing core service logic
@@ -234,6 +515,7 @@ func (s *FeedService) CreateReel(
 	fileName string,
 	durationSecs int,
 	privacy string,
+	publishAt *time.Time,
 ) (int64, error) {
 
 	// Validate inputs if needed (e.g., ensure fileData is a video)
@@ -244,6 +526,7 @@ func (s *FeedService) CreateReel(
 		TextContent: &caption,
 		Privacy:     privacy,
 		Duration:    &durationSecs,
+		PublishAt:   publishAt,
 	}
 
 	return s.CreateContent(ctx, content, fileData, "video", fileName)
@@ -257,80 +540,164 @@ func (s *FeedService) CreateStory(
 	mediaName string,
 	durationSec int,
 	privacy string,
+	audienceListID int64,
 ) (int64, error) {
 	content := &dbmysql.Content{
-		AuthorID: authorID,
-		Type:     "STORY",
-		Privacy:  privacy,
+		AuthorID:       authorID,
+		Type:           "STORY",
+		Privacy:        privacy,
+		AudienceListID: audienceListRef(audienceListID),
 	}
 	duration := durationSec
 	content.Duration = &duration
-	expiration := content.CreatedAt.Add(time.Duration(durationSec) * time.Second)
-	content.Expiration = &expiration
 
 	// Call common content creation logic
 	return s.CreateContent(ctx, content, fileData, mediaType, mediaName)
 }
 
 func (s *FeedService) ReactToContent(ctx context.Context, userID, contentID int64, reactionType string) error {
-	// Step 1: Delete existing reaction if any
+	// Step 0: Only content the user may see can be reacted to
+	content, err := s.visibleContent(ctx, userID, contentID)
+	if err != nil {
+		return err
+	}
+
+	// Step 1: Remember whether this only changes an earlier reaction, those do not notify again
+	previous, err := s.reactionRepo.GetViewerReactions(ctx, userID, []int64{contentID})
+	if err != nil {
+		return err
+	}
+
+	// Step 2: Delete existing reaction if any
 	_ = s.DeleteReaction(ctx, userID, contentID) // Ignore error if not found
 
-	// Step 2: Add new reaction
+	// Step 3: Add new reaction
 	reaction := &dbmysql.Reaction{
 		UserID:    userID,
 		ContentID: contentID,
 		Type:      reactionType,
 		CreatedAt: time.Now(),
 	}
-	return s.AddReaction(ctx, reaction)
+	if err := s.AddReaction(ctx, reaction); err != nil {
+		return err
+	}
+
+	// Step 4: Tell the author, never about their own reactions
+	if _, changed := previous[contentID]; s.notifier != nil && !changed && content.AuthorID != userID {
+		s.notifier.ReactionAdded(content, reaction)
+	}
+	return nil
 }
 
-func (s *FeedService) GetTimeline(ctx context.Context, userID int64) ([]dbmysql.Content, []string, error) {
-	// Step 1: Get friend IDs
-	friendResp, err := s.UserClient.ListFriends(ctx, &userpb.UserID{UserId: userID})
+// GetTimeline returns one page of the user's own and friends' content, newest first.
+// Ranked timelines are served by getRankedTimeline
+func (s *FeedService) GetTimeline(ctx context.Context, userID int64, query TimelineQuery) (*TimelinePage, error) {
+	if query.Ranking != "" && query.Ranking != RankingChronological && query.Ranking != RankingRanked {
+		return nil, ErrInvalidRanking
+	}
+	if query.Ranking == RankingRanked && s.ranker != nil {
+		return s.getRankedTimeline(ctx, userID, query)
+	}
+	cursor, err := DecodeCursor(query.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := clampPageSize(query.PageSize)
+
+	// Step 1: One query for the page, plus one extra row to know if there is a next page
+	contents, err := s.listTimeline(ctx, userID, cursor, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &TimelinePage{}
+	if len(contents) > pageSize {
+		contents = contents[:pageSize]
+		page.NextCursor = cursorOf(contents[pageSize-1]).Encode()
+	}
+	page.Contents = contents
+
+	// Step 2: Media URLs in one batch
+	page.MediaURLs, err = s.mediaURLs(ctx, contents)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to fetch friends: %w", err)
+		return nil, err
 	}
+	return page, nil
+}
 
-	userIDs := []int64{userID}
-	for _, f := range friendResp.Friends {
-		userIDs = append(userIDs, f.UserId)
+// listTimeline reads the materialized timeline when enabled, otherwise aggregates the user's and friends' content
+func (s *FeedService) listTimeline(ctx context.Context, userID int64, cursor *TimelineCursor, limit int) ([]dbmysql.Content, error) {
+	if s.timelines != nil {
+		return s.listMaterializedTimeline(ctx, userID, cursor, limit)
 	}
 
-	// Step 2: Collect content
-	var allContent []dbmysql.Content
-	var allURLs []string
+	friendIDs, err := s.GetUserFriendIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	authorIDs := append([]int64{userID}, friendIDs...)
+	return s.contentRepo.ListTimeline(ctx, userID, authorIDs, cursor, limit)
+}
 
-	for _, uid := range userIDs {
-		contentList, err := s.contentRepo.ListUserContent(ctx, uid)
+// listMaterializedTimeline reads the user's timeline entries through the view policy, so entries fanned out
+// before a friendship ended are skipped, and refills the page from the following entries
+func (s *FeedService) listMaterializedTimeline(ctx context.Context, userID int64, cursor *TimelineCursor, limit int) ([]dbmysql.Content, error) {
+	policy := s.newViewPolicy(userID)
+	var visible []dbmysql.Content
+	for len(visible) < limit {
+		entries, err := s.timelines.ListMaterializedTimeline(ctx, userID, cursor, limit)
 		if err != nil {
-			continue
+			return nil, err
 		}
-		for _, c := range contentList {
-			allContent = append(allContent, c)
-
-			if c.MediaRefID != nil {
-				mediaMeta, _, err := s.mediaRepo.GetMediaRefByID(ctx, *c.MediaRefID)
-				if err == nil {
-					allURLs = append(allURLs, GetMediaURL(mediaMeta.FileID))
-				} else {
-					allURLs = append(allURLs, "")
-				}
-			} else {
-				allURLs = append(allURLs, "")
+		for i := range entries {
+			ok, err := policy.canView(ctx, &entries[i])
+			if err != nil {
+				return nil, err
+			}
+			if ok && len(visible) < limit {
+				visible = append(visible, entries[i])
 			}
 		}
+		if len(entries) < limit {
+			break
+		}
+		next := cursorOf(entries[len(entries)-1])
+		cursor = &next
+	}
+	return visible, nil
+}
+
+// mediaURLs resolves the media URL of each content from the media metadata, without downloading files
+func (s *FeedService) mediaURLs(ctx context.Context, contents []dbmysql.Content) ([]string, error) {
+	var ids []int64
+	for _, c := range contents {
+		if c.MediaRefID != nil {
+			ids = append(ids, *c.MediaRefID)
+		}
 	}
 
-	// Step 3: Sort
-	sort.SliceStable(allContent, func(i, j int) bool {
-		return allContent[i].CreatedAt.After(allContent[j].CreatedAt)
-	})
+	media, err := s.mediaRepo.ListMediaRefsByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	fileIDs := make(map[int64]string, len(media))
+	for _, m := range media {
+		fileIDs[int64(m.MediaRefID)] = m.FileID
+	}
 
-	return allContent, allURLs, nil
+	urls := make([]string, len(contents))
+	for i, c := range contents {
+		if c.MediaRefID == nil {
+			continue
+		}
+		if fileID, ok := fileIDs[*c.MediaRefID]; ok {
+			urls[i] = GetMediaURL(fileID)
+		}
+	}
+	return urls, nil
 }
 
+// GetUserContent returns the target user's content the requester may see, with media URLs
 func (s *FeedService) GetUserContent(ctx context.Context, requesterID, targetUserID int64) ([]dbmysql.Content, []string, error) {
 	// Step 1: Fetch all content
 	allContent, err := s.contentRepo.ListUserContent(ctx, targetUserID)
@@ -338,58 +705,16 @@ func (s *FeedService) GetUserContent(ctx context.Context, requesterID, targetUse
 		return nil, nil, err
 	}
 
-	// Step 2: Self view
-	if requesterID == targetUserID {
-		var urls []string
-		for _, c := range allContent {
-			if c.MediaRefID != nil {
-				mediaMeta, _, err := s.mediaRepo.GetMediaRefByID(ctx, *c.MediaRefID)
-				if err == nil {
-					urls = append(urls, GetMediaURL(mediaMeta.FileID))
-				} else {
-					urls = append(urls, "")
-				}
-			} else {
-				urls = append(urls, "")
-			}
-		}
-		return allContent, urls, nil
-	}
-
-	// Step 3: Friendship check
-	friendResp, err := s.UserClient.ListFriends(ctx, &userpb.UserID{UserId: targetUserID})
+	// Step 2: Keep what the requester may see, everything in a self view
+	filtered, err := s.filterVisible(ctx, requesterID, allContent)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to fetch friends: %w", err)
-	}
-
-	isFriend := false
-	for _, f := range friendResp.Friends {
-		if f.UserId == requesterID {
-			isFriend = true
-			break
-		}
+		return nil, nil, err
 	}
 
-	// Step 4: Filter + media URLs
-	var filtered []dbmysql.Content
-	var mediaURLs []string
-
-	for _, c := range allContent {
-		if c.Privacy == "public" || (c.Privacy == "friends" && isFriend) {
-			filtered = append(filtered, c)
-
-			if c.MediaRefID != nil {
-				mediaMeta, _, err := s.mediaRepo.GetMediaRefByID(ctx, *c.MediaRefID)
-				if err == nil {
-					mediaURLs = append(mediaURLs, GetMediaURL(mediaMeta.FileID))
-				} else {
-					mediaURLs = append(mediaURLs, "")
-				}
-			} else {
-				mediaURLs = append(mediaURLs, "")
-			}
-		}
+	// Step 3: Media URLs in one batch
+	mediaURLs, err := s.mediaURLs(ctx, filtered)
+	if err != nil {
+		return nil, nil, err
 	}
-
 	return filtered, mediaURLs, nil
 }
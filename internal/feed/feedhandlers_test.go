@@ -3,64 +3,343 @@ package feed
 import (
 	"context"
 	"errors"
+	"io"
+	"net"
 	"testing"
 	"time"
 
 	feedpb "gosocial/api/v1/feed"
+	"gosocial/internal/common"
 	"gosocial/internal/dbmysql"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 // ---------- Fake service that satisfies FeedUsecase ----------
 
 type fakeFeedSvc struct {
-	CreatePostFn     func(ctx context.Context, authorID int64, text string, fileData []byte, fileName string, mediaType string, privacy string) (int64, error)
-	CreateReelFn     func(ctx context.Context, authorID int64, caption string, fileData []byte, fileName string, durationSecs int, privacy string) (int64, error)
-	CreateStoryFn    func(ctx context.Context, authorID int64, fileData []byte, mediaType string, mediaName string, durationSec int, privacy string) (int64, error)
+	CreatePostFn     func(ctx context.Context, authorID int64, text string, fileData []byte, fileName string, mediaType string, privacy string, audienceListID int64, publishAt *time.Time) (int64, error)
+	CreateReelFn     func(ctx context.Context, authorID int64, caption string, fileData []byte, fileName string, durationSecs int, privacy string, publishAt *time.Time) (int64, error)
+	CreateStoryFn    func(ctx context.Context, authorID int64, fileData []byte, mediaType string, mediaName string, durationSec int, privacy string, audienceListID int64) (int64, error)
 	ReactToContentFn func(ctx context.Context, userID, contentID int64, reactionType string) error
-	GetReactionsFn   func(ctx context.Context, contentID int64) ([]dbmysql.Reaction, error)
+	GetReactionsFn   func(ctx context.Context, viewerID, contentID int64) ([]dbmysql.Reaction, error)
 	DeleteReactionFn func(ctx context.Context, userID, contentID int64) error
-	GetTimelineFn    func(ctx context.Context, userID int64) ([]dbmysql.Content, []string, error)
+	GetTimelineFn    func(ctx context.Context, userID int64, query TimelineQuery) (*TimelinePage, error)
 	GetUserContentFn func(ctx context.Context, requesterID, targetUserID int64) ([]dbmysql.Content, []string, error)
-	GetMediaRefFn    func(ctx context.Context, id int64) (*dbmysql.MediaRef, []byte, error)
+	GetMediaRefFn    func(ctx context.Context, viewerID, id int64) (*dbmysql.MediaRef, []byte, error)
 	GetContentFn     func(ctx context.Context, id int64) (*dbmysql.Content, string, error)
-	DeleteContentFn  func(ctx context.Context, id int64) error
+	DeleteContentFn  func(ctx context.Context, requesterID, id int64) error
+
+	UpdateContentPrivacyFn func(ctx context.Context, requesterID, contentID int64, privacy string) error
+	UpdateContentFn        func(ctx context.Context, editorID, contentID int64, update ContentUpdate) (*dbmysql.Content, error)
+	ListRevisionsFn        func(ctx context.Context, viewerID, contentID int64, cursor string, pageSize int) (*RevisionPage, error)
+	OnFriendshipAcceptedFn func(ctx context.Context, userID, friendID int64) error
+
+	AddCommentFn    func(ctx context.Context, authorID, contentID, parentID int64, text string) (*dbmysql.Comment, error)
+	ListCommentsFn  func(ctx context.Context, viewerID, contentID, parentID int64, cursor string, pageSize int) (*CommentPage, error)
+	EditCommentFn   func(ctx context.Context, editorID, commentID int64, text string) (*dbmysql.Comment, error)
+	DeleteCommentFn func(ctx context.Context, requesterID, commentID int64) error
+	CountCommentsFn func(ctx context.Context, contentIDs []int64) (map[int64]int64, error)
+
+	SummarizeReactionsFn func(ctx context.Context, viewerID int64, contentIDs []int64) (map[int64]ReactionSummary, error)
+	ListReactorsFn       func(ctx context.Context, viewerID, contentID int64, reactionType, cursor string, pageSize int) (*ReactorPage, error)
+
+	MarkStoryViewedFn  func(ctx context.Context, viewerID, storyID int64) error
+	ListStoryViewersFn func(ctx context.Context, requesterID, storyID int64, cursor string, pageSize int) (*StoryViewerPage, error)
+	StoriesSeenFn      func(ctx context.Context, viewerID int64, contents []dbmysql.Content) (map[int64]bool, error)
+
+	ListStoryArchiveFn  func(ctx context.Context, authorID int64, query TimelineQuery) (*TimelinePage, error)
+	CreateHighlightFn   func(ctx context.Context, ownerID int64, title string, storyIDs []int64) (*dbmysql.Highlight, error)
+	UpdateHighlightFn   func(ctx context.Context, requesterID, highlightID int64, title string, storyIDs []int64) (*dbmysql.Highlight, error)
+	DeleteHighlightFn   func(ctx context.Context, requesterID, highlightID int64) error
+	ReorderHighlightsFn func(ctx context.Context, ownerID int64, highlightIDs []int64) error
+	ListHighlightsFn    func(ctx context.Context, viewerID, ownerID int64) ([]HighlightView, error)
+
+	GetHashtagFeedFn      func(ctx context.Context, tag string, q TimelineQuery) (*TimelinePage, error)
+	GetTrendingHashtagsFn func(ctx context.Context, window time.Duration, limit int) ([]TrendingHashtag, error)
+
+	GetMentionsFeedFn func(ctx context.Context, userID int64, q TimelineQuery) (*TimelinePage, error)
+	ListMentionsFn    func(ctx context.Context, ids []int64) (map[int64][]dbmysql.Mention, error)
+
+	ShareContentFn  func(ctx context.Context, sharerID, originalID int64, commentary, privacy string) (int64, error)
+	CountSharesFn   func(ctx context.Context, ids []int64) (map[int64]int64, error)
+	ResolveSharedFn func(ctx context.Context, viewerID int64, contents []dbmysql.Content) (map[int64]*SharedOriginal, error)
+
+	SaveContentFn      func(ctx context.Context, userID, contentID int64, collectionName string) (*dbmysql.Collection, error)
+	UnsaveContentFn    func(ctx context.Context, userID, contentID, collectionID int64) error
+	ListCollectionsFn  func(ctx context.Context, userID int64) ([]CollectionSummary, error)
+	ListSavedContentFn func(ctx context.Context, userID, collectionID int64, cursor string, pageSize int) (*SavedPage, error)
+
+	CreateCarouselPostFn func(ctx context.Context, authorID int64, text string, media []MediaUpload, privacy string, audienceListID int64, publishAt *time.Time) (int64, error)
+	ListMediaFn          func(ctx context.Context, contents []dbmysql.Content) (map[int64][]MediaItem, error)
+
+	UploadMediaFn      func(ctx context.Context, uploaderID int64, fileName, mediaType string, r io.Reader) (*dbmysql.MediaRef, error)
+	CreateFromUploadFn func(ctx context.Context, upload UploadedContent) (int64, error)
+
+	GetVisibleContentFn         func(ctx context.Context, viewerID, id int64) (*dbmysql.Content, string, error)
+	CreateAudienceListFn        func(ctx context.Context, ownerID int64, name string, memberIDs []int64) (*dbmysql.AudienceList, error)
+	ListAudienceListsFn         func(ctx context.Context, ownerID int64) ([]AudienceListSummary, error)
+	AddAudienceListMembersFn    func(ctx context.Context, ownerID, listID int64, userIDs []int64) error
+	RemoveAudienceListMembersFn func(ctx context.Context, ownerID, listID int64, userIDs []int64) error
+	DeleteAudienceListFn        func(ctx context.Context, ownerID, listID int64) error
+	MarkReelViewedFn            func(ctx context.Context, viewerID, reelID int64) error
+	GetExploreReelsFn           func(ctx context.Context, viewerID int64, q TimelineQuery) (*TimelinePage, error)
+	ListScheduledContentFn      func(ctx context.Context, authorID int64, q TimelineQuery) (*TimelinePage, error)
+	RescheduleContentFn         func(ctx context.Context, authorID, contentID int64, publishAt time.Time) (*dbmysql.Content, error)
+	CancelScheduledContentFn    func(ctx context.Context, authorID, contentID int64) error
+	SaveDraftFn                 func(ctx context.Context, authorID int64, draft Draft) (*dbmysql.Content, error)
+	ListDraftsFn                func(ctx context.Context, authorID int64, q TimelineQuery) (*TimelinePage, error)
+	UpdateDraftFn               func(ctx context.Context, authorID, contentID int64, draft Draft) (*dbmysql.Content, error)
+	PublishDraftFn              func(ctx context.Context, authorID, contentID int64, publishAt *time.Time) (*dbmysql.Content, error)
+	DiscardDraftFn              func(ctx context.Context, authorID, contentID int64) error
 }
 
-func (f *fakeFeedSvc) CreatePost(ctx context.Context, a int64, t string, d []byte, n, mt, p string) (int64, error) {
-	return f.CreatePostFn(ctx, a, t, d, n, mt, p)
+func (f *fakeFeedSvc) CreatePost(ctx context.Context, a int64, t string, d []byte, n, mt, p string, l int64, at *time.Time) (int64, error) {
+	return f.CreatePostFn(ctx, a, t, d, n, mt, p, l, at)
 }
-func (f *fakeFeedSvc) CreateReel(ctx context.Context, a int64, c string, d []byte, n string, dur int, p string) (int64, error) {
-	return f.CreateReelFn(ctx, a, c, d, n, dur, p)
+func (f *fakeFeedSvc) CreateReel(ctx context.Context, a int64, c string, d []byte, n string, dur int, p string, at *time.Time) (int64, error) {
+	return f.CreateReelFn(ctx, a, c, d, n, dur, p, at)
 }
-func (f *fakeFeedSvc) CreateStory(ctx context.Context, a int64, d []byte, mt, mn string, dur int, p string) (int64, error) {
-	return f.CreateStoryFn(ctx, a, d, mt, mn, dur, p)
+func (f *fakeFeedSvc) CreateStory(ctx context.Context, a int64, d []byte, mt, mn string, dur int, p string, l int64) (int64, error) {
+	return f.CreateStoryFn(ctx, a, d, mt, mn, dur, p, l)
 }
 func (f *fakeFeedSvc) ReactToContent(ctx context.Context, u, c int64, r string) error {
 	return f.ReactToContentFn(ctx, u, c, r)
 }
-func (f *fakeFeedSvc) GetReactions(ctx context.Context, cid int64) ([]dbmysql.Reaction, error) {
-	return f.GetReactionsFn(ctx, cid)
+func (f *fakeFeedSvc) GetReactions(ctx context.Context, v, cid int64) ([]dbmysql.Reaction, error) {
+	return f.GetReactionsFn(ctx, v, cid)
 }
 func (f *fakeFeedSvc) DeleteReaction(ctx context.Context, u, c int64) error {
 	return f.DeleteReactionFn(ctx, u, c)
 }
-func (f *fakeFeedSvc) GetTimeline(ctx context.Context, u int64) ([]dbmysql.Content, []string, error) {
-	return f.GetTimelineFn(ctx, u)
+func (f *fakeFeedSvc) GetTimeline(ctx context.Context, u int64, q TimelineQuery) (*TimelinePage, error) {
+	return f.GetTimelineFn(ctx, u, q)
 }
 func (f *fakeFeedSvc) GetUserContent(ctx context.Context, r, t int64) ([]dbmysql.Content, []string, error) {
 	return f.GetUserContentFn(ctx, r, t)
 }
-func (f *fakeFeedSvc) GetMediaRef(ctx context.Context, id int64) (*dbmysql.MediaRef, []byte, error) {
-	return f.GetMediaRefFn(ctx, id)
+func (f *fakeFeedSvc) GetMediaRef(ctx context.Context, v, id int64) (*dbmysql.MediaRef, []byte, error) {
+	return f.GetMediaRefFn(ctx, v, id)
 }
 func (f *fakeFeedSvc) GetContent(ctx context.Context, id int64) (*dbmysql.Content, string, error) {
 	return f.GetContentFn(ctx, id)
 }
-func (f *fakeFeedSvc) DeleteContent(ctx context.Context, id int64) error {
-	return f.DeleteContentFn(ctx, id)
+func (f *fakeFeedSvc) DeleteContent(ctx context.Context, r, id int64) error {
+	return f.DeleteContentFn(ctx, r, id)
+}
+
+func (f *fakeFeedSvc) UpdateContentPrivacy(ctx context.Context, r, c int64, p string) error {
+	return f.UpdateContentPrivacyFn(ctx, r, c, p)
+}
+func (f *fakeFeedSvc) UpdateContent(ctx context.Context, e, c int64, u ContentUpdate) (*dbmysql.Content, error) {
+	return f.UpdateContentFn(ctx, e, c, u)
+}
+func (f *fakeFeedSvc) ListRevisions(ctx context.Context, v, c int64, cur string, n int) (*RevisionPage, error) {
+	return f.ListRevisionsFn(ctx, v, c, cur, n)
+}
+func (f *fakeFeedSvc) OnFriendshipAccepted(ctx context.Context, u, fr int64) error {
+	return f.OnFriendshipAcceptedFn(ctx, u, fr)
+}
+
+func (f *fakeFeedSvc) AddComment(ctx context.Context, a, c, p int64, t string) (*dbmysql.Comment, error) {
+	return f.AddCommentFn(ctx, a, c, p, t)
+}
+func (f *fakeFeedSvc) ListComments(ctx context.Context, v, c, p int64, cur string, n int) (*CommentPage, error) {
+	return f.ListCommentsFn(ctx, v, c, p, cur, n)
+}
+func (f *fakeFeedSvc) EditComment(ctx context.Context, e, c int64, t string) (*dbmysql.Comment, error) {
+	return f.EditCommentFn(ctx, e, c, t)
+}
+func (f *fakeFeedSvc) DeleteComment(ctx context.Context, r, c int64) error {
+	return f.DeleteCommentFn(ctx, r, c)
+}
+
+// CountComments defaults to no comments so timeline tests need not stub it
+func (f *fakeFeedSvc) CountComments(ctx context.Context, ids []int64) (map[int64]int64, error) {
+	if f.CountCommentsFn == nil {
+		return map[int64]int64{}, nil
+	}
+	return f.CountCommentsFn(ctx, ids)
+}
+
+// SummarizeReactions defaults to no reactions so timeline tests need not stub it
+func (f *fakeFeedSvc) SummarizeReactions(ctx context.Context, v int64, ids []int64) (map[int64]ReactionSummary, error) {
+	if f.SummarizeReactionsFn == nil {
+		return map[int64]ReactionSummary{}, nil
+	}
+	return f.SummarizeReactionsFn(ctx, v, ids)
+}
+func (f *fakeFeedSvc) ListReactors(ctx context.Context, v, c int64, t, cursor string, n int) (*ReactorPage, error) {
+	return f.ListReactorsFn(ctx, v, c, t, cursor, n)
+}
+func (f *fakeFeedSvc) MarkStoryViewed(ctx context.Context, v, sid int64) error {
+	return f.MarkStoryViewedFn(ctx, v, sid)
+}
+func (f *fakeFeedSvc) ListStoryViewers(ctx context.Context, r, sid int64, cursor string, n int) (*StoryViewerPage, error) {
+	return f.ListStoryViewersFn(ctx, r, sid, cursor, n)
+}
+
+// StoriesSeen defaults to nothing seen so timeline tests need not stub it
+func (f *fakeFeedSvc) StoriesSeen(ctx context.Context, v int64, contents []dbmysql.Content) (map[int64]bool, error) {
+	if f.StoriesSeenFn == nil {
+		return map[int64]bool{}, nil
+	}
+	return f.StoriesSeenFn(ctx, v, contents)
+}
+func (f *fakeFeedSvc) ListStoryArchive(ctx context.Context, a int64, q TimelineQuery) (*TimelinePage, error) {
+	return f.ListStoryArchiveFn(ctx, a, q)
+}
+func (f *fakeFeedSvc) CreateHighlight(ctx context.Context, o int64, title string, ids []int64) (*dbmysql.Highlight, error) {
+	return f.CreateHighlightFn(ctx, o, title, ids)
+}
+func (f *fakeFeedSvc) UpdateHighlight(ctx context.Context, r, hid int64, title string, ids []int64) (*dbmysql.Highlight, error) {
+	return f.UpdateHighlightFn(ctx, r, hid, title, ids)
+}
+func (f *fakeFeedSvc) DeleteHighlight(ctx context.Context, r, hid int64) error {
+	return f.DeleteHighlightFn(ctx, r, hid)
+}
+func (f *fakeFeedSvc) ReorderHighlights(ctx context.Context, o int64, ids []int64) error {
+	return f.ReorderHighlightsFn(ctx, o, ids)
+}
+
+// ListHighlights defaults to no highlights so profile tests need not stub it
+func (f *fakeFeedSvc) ListHighlights(ctx context.Context, v, o int64) ([]HighlightView, error) {
+	if f.ListHighlightsFn == nil {
+		return nil, nil
+	}
+	return f.ListHighlightsFn(ctx, v, o)
+}
+
+func (f *fakeFeedSvc) GetHashtagFeed(ctx context.Context, tag string, q TimelineQuery) (*TimelinePage, error) {
+	return f.GetHashtagFeedFn(ctx, tag, q)
+}
+func (f *fakeFeedSvc) GetTrendingHashtags(ctx context.Context, w time.Duration, l int) ([]TrendingHashtag, error) {
+	return f.GetTrendingHashtagsFn(ctx, w, l)
+}
+
+func (f *fakeFeedSvc) GetMentionsFeed(ctx context.Context, u int64, q TimelineQuery) (*TimelinePage, error) {
+	return f.GetMentionsFeedFn(ctx, u, q)
+}
+
+// ListMentions defaults to no mentions so timeline tests need not stub it
+func (f *fakeFeedSvc) ListMentions(ctx context.Context, ids []int64) (map[int64][]dbmysql.Mention, error) {
+	if f.ListMentionsFn == nil {
+		return map[int64][]dbmysql.Mention{}, nil
+	}
+	return f.ListMentionsFn(ctx, ids)
+}
+
+func (f *fakeFeedSvc) ShareContent(ctx context.Context, s, o int64, c, p string) (int64, error) {
+	return f.ShareContentFn(ctx, s, o, c, p)
+}
+
+// CountShares defaults to no shares so timeline tests need not stub it
+func (f *fakeFeedSvc) CountShares(ctx context.Context, ids []int64) (map[int64]int64, error) {
+	if f.CountSharesFn == nil {
+		return map[int64]int64{}, nil
+	}
+	return f.CountSharesFn(ctx, ids)
+}
+
+// ResolveShared defaults to every original being unavailable
+func (f *fakeFeedSvc) ResolveShared(ctx context.Context, v int64, contents []dbmysql.Content) (map[int64]*SharedOriginal, error) {
+	if f.ResolveSharedFn == nil {
+		return map[int64]*SharedOriginal{}, nil
+	}
+	return f.ResolveSharedFn(ctx, v, contents)
+}
+func (f *fakeFeedSvc) SaveContent(ctx context.Context, u, c int64, n string) (*dbmysql.Collection, error) {
+	return f.SaveContentFn(ctx, u, c, n)
+}
+func (f *fakeFeedSvc) UnsaveContent(ctx context.Context, u, c, col int64) error {
+	return f.UnsaveContentFn(ctx, u, c, col)
+}
+func (f *fakeFeedSvc) ListCollections(ctx context.Context, u int64) ([]CollectionSummary, error) {
+	return f.ListCollectionsFn(ctx, u)
+}
+func (f *fakeFeedSvc) ListSavedContent(ctx context.Context, u, col int64, cur string, n int) (*SavedPage, error) {
+	return f.ListSavedContentFn(ctx, u, col, cur, n)
+}
+
+func (f *fakeFeedSvc) CreateCarouselPost(ctx context.Context, a int64, t string, m []MediaUpload, p string, l int64, at *time.Time) (int64, error) {
+	return f.CreateCarouselPostFn(ctx, a, t, m, p, l, at)
+}
+func (f *fakeFeedSvc) ListMedia(ctx context.Context, contents []dbmysql.Content) (map[int64][]MediaItem, error) {
+	if f.ListMediaFn == nil {
+		return map[int64][]MediaItem{}, nil
+	}
+	return f.ListMediaFn(ctx, contents)
+}
+
+func (f *fakeFeedSvc) UploadMedia(ctx context.Context, u int64, n, t string, r io.Reader) (*dbmysql.MediaRef, error) {
+	return f.UploadMediaFn(ctx, u, n, t, r)
+}
+func (f *fakeFeedSvc) CreateFromUpload(ctx context.Context, upload UploadedContent) (int64, error) {
+	return f.CreateFromUploadFn(ctx, upload)
+}
+
+func (f *fakeFeedSvc) GetVisibleContent(ctx context.Context, v, id int64) (*dbmysql.Content, string, error) {
+	if f.GetVisibleContentFn == nil {
+		return f.GetContentFn(ctx, id)
+	}
+	return f.GetVisibleContentFn(ctx, v, id)
+}
+func (f *fakeFeedSvc) CreateAudienceList(ctx context.Context, o int64, n string, m []int64) (*dbmysql.AudienceList, error) {
+	return f.CreateAudienceListFn(ctx, o, n, m)
+}
+func (f *fakeFeedSvc) ListAudienceLists(ctx context.Context, o int64) ([]AudienceListSummary, error) {
+	return f.ListAudienceListsFn(ctx, o)
+}
+func (f *fakeFeedSvc) AddAudienceListMembers(ctx context.Context, o, l int64, u []int64) error {
+	return f.AddAudienceListMembersFn(ctx, o, l, u)
+}
+func (f *fakeFeedSvc) RemoveAudienceListMembers(ctx context.Context, o, l int64, u []int64) error {
+	return f.RemoveAudienceListMembersFn(ctx, o, l, u)
+}
+func (f *fakeFeedSvc) DeleteAudienceList(ctx context.Context, o, l int64) error {
+	return f.DeleteAudienceListFn(ctx, o, l)
+}
+func (f *fakeFeedSvc) MarkReelViewed(ctx context.Context, v, rid int64) error {
+	return f.MarkReelViewedFn(ctx, v, rid)
+}
+func (f *fakeFeedSvc) GetExploreReels(ctx context.Context, v int64, q TimelineQuery) (*TimelinePage, error) {
+	return f.GetExploreReelsFn(ctx, v, q)
+}
+func (f *fakeFeedSvc) ListScheduledContent(ctx context.Context, a int64, q TimelineQuery) (*TimelinePage, error) {
+	return f.ListScheduledContentFn(ctx, a, q)
+}
+func (f *fakeFeedSvc) RescheduleContent(ctx context.Context, a, id int64, at time.Time) (*dbmysql.Content, error) {
+	return f.RescheduleContentFn(ctx, a, id, at)
+}
+func (f *fakeFeedSvc) CancelScheduledContent(ctx context.Context, a, id int64) error {
+	return f.CancelScheduledContentFn(ctx, a, id)
+}
+func (f *fakeFeedSvc) SaveDraft(ctx context.Context, a int64, d Draft) (*dbmysql.Content, error) {
+	return f.SaveDraftFn(ctx, a, d)
+}
+func (f *fakeFeedSvc) ListDrafts(ctx context.Context, a int64, q TimelineQuery) (*TimelinePage, error) {
+	return f.ListDraftsFn(ctx, a, q)
+}
+func (f *fakeFeedSvc) UpdateDraft(ctx context.Context, a, id int64, d Draft) (*dbmysql.Content, error) {
+	return f.UpdateDraftFn(ctx, a, id, d)
+}
+func (f *fakeFeedSvc) PublishDraft(ctx context.Context, a, id int64, at *time.Time) (*dbmysql.Content, error) {
+	return f.PublishDraftFn(ctx, a, id, at)
+}
+func (f *fakeFeedSvc) DiscardDraft(ctx context.Context, a, id int64) error {
+	return f.DiscardDraftFn(ctx, a, id)
+}
+
+// asUser is the context the auth interceptor hands to handlers for an authenticated caller
+func asUser(userID int64) context.Context {
+	return common.ContextWithUser(context.Background(), uint64(userID), "")
 }
 
 func newHandlers(s *fakeFeedSvc) *FeedHandlers {
@@ -81,7 +360,7 @@ func TestHandlers_CreatePost_ValidationsAndSuccess(t *testing.T) {
 	})
 
 	// invalid author
-	_, err := h.CreatePost(context.Background(), &feedpb.CreatePostRequest{
+	_, err := h.CreatePost(asUser(1), &feedpb.CreatePostRequest{
 		AuthorId: 0, Text: "x", MediaType: "image", Privacy: "public", MediaName: "a.png",
 	})
 	if status.Code(err) != codes.InvalidArgument {
@@ -89,7 +368,7 @@ func TestHandlers_CreatePost_ValidationsAndSuccess(t *testing.T) {
 	}
 
 	// no text & no media
-	_, err = h.CreatePost(context.Background(), &feedpb.CreatePostRequest{
+	_, err = h.CreatePost(asUser(1), &feedpb.CreatePostRequest{
 		AuthorId: 1, Text: "", MediaType: "image", Privacy: "public", MediaName: "a.png",
 	})
 	if status.Code(err) != codes.InvalidArgument {
@@ -97,7 +376,7 @@ func TestHandlers_CreatePost_ValidationsAndSuccess(t *testing.T) {
 	}
 
 	// missing media type
-	_, err = h.CreatePost(context.Background(), &feedpb.CreatePostRequest{
+	_, err = h.CreatePost(asUser(1), &feedpb.CreatePostRequest{
 		AuthorId: 1, Text: "x", MediaType: "", Privacy: "public", MediaName: "a.png",
 	})
 	if status.Code(err) != codes.InvalidArgument {
@@ -105,7 +384,7 @@ func TestHandlers_CreatePost_ValidationsAndSuccess(t *testing.T) {
 	}
 
 	// missing privacy
-	_, err = h.CreatePost(context.Background(), &feedpb.CreatePostRequest{
+	_, err = h.CreatePost(asUser(1), &feedpb.CreatePostRequest{
 		AuthorId: 1, Text: "x", MediaType: "image", Privacy: "", MediaName: "a.png",
 	})
 	if status.Code(err) != codes.InvalidArgument {
@@ -113,7 +392,7 @@ func TestHandlers_CreatePost_ValidationsAndSuccess(t *testing.T) {
 	}
 
 	// missing media name with data
-	_, err = h.CreatePost(context.Background(), &feedpb.CreatePostRequest{
+	_, err = h.CreatePost(asUser(1), &feedpb.CreatePostRequest{
 		AuthorId: 1, Text: "", MediaData: []byte("d"), MediaType: "image", Privacy: "public", MediaName: "",
 	})
 	if status.Code(err) != codes.InvalidArgument {
@@ -122,7 +401,7 @@ func TestHandlers_CreatePost_ValidationsAndSuccess(t *testing.T) {
 
 	// success
 	ok := &fakeFeedSvc{
-		CreatePostFn: func(ctx context.Context, a int64, t string, d []byte, n, mt, p string) (int64, error) {
+		CreatePostFn: func(ctx context.Context, a int64, t string, d []byte, n, mt, p string, l int64, at *time.Time) (int64, error) {
 			return 101, nil
 		},
 		GetContentFn: func(ctx context.Context, id int64) (*dbmysql.Content, string, error) {
@@ -132,7 +411,7 @@ func TestHandlers_CreatePost_ValidationsAndSuccess(t *testing.T) {
 	}
 
 	h = newHandlers(ok)
-	resp, err := h.CreatePost(context.Background(), &feedpb.CreatePostRequest{
+	resp, err := h.CreatePost(asUser(1), &feedpb.CreatePostRequest{
 		AuthorId: 1, Text: "hello", MediaType: "image", Privacy: "public", MediaName: "a.png",
 	})
 	if err != nil || resp.ContentId != 101 {
@@ -142,7 +421,7 @@ func TestHandlers_CreatePost_ValidationsAndSuccess(t *testing.T) {
 
 func TestHandlers_CreateReel_ValidationsAndSuccess(t *testing.T) {
 	ok := &fakeFeedSvc{
-		CreateReelFn: func(ctx context.Context, a int64, c string, d []byte, n string, dur int, p string) (int64, error) {
+		CreateReelFn: func(ctx context.Context, a int64, c string, d []byte, n string, dur int, p string, at *time.Time) (int64, error) {
 			return 202, nil
 		},
 		// Add this to prevent nil pointer dereference
@@ -156,7 +435,7 @@ func TestHandlers_CreateReel_ValidationsAndSuccess(t *testing.T) {
 	// ... rest of your validation tests ...
 
 	// success
-	resp, err := h.CreateReel(context.Background(), &feedpb.CreateReelRequest{
+	resp, err := h.CreateReel(asUser(1), &feedpb.CreateReelRequest{
 		AuthorId: 1, Caption: "cap", MediaData: []byte("v"), MediaName: "v.mp4", DurationSecs: 6, Privacy: "public",
 	})
 	if err != nil || resp.ContentId != 202 {
@@ -166,7 +445,7 @@ func TestHandlers_CreateReel_ValidationsAndSuccess(t *testing.T) {
 
 func TestHandlers_CreateStory_ValidationsAndSuccess(t *testing.T) {
 	ok := &fakeFeedSvc{
-		CreateStoryFn: func(ctx context.Context, a int64, d []byte, mt, mn string, dur int, p string) (int64, error) {
+		CreateStoryFn: func(ctx context.Context, a int64, d []byte, mt, mn string, dur int, p string, l int64) (int64, error) {
 			return 303, nil
 		},
 		// ADD THIS to prevent nil pointer dereference
@@ -187,14 +466,14 @@ func TestHandlers_CreateStory_ValidationsAndSuccess(t *testing.T) {
 	}
 
 	for i := range cases {
-		_, err := h.CreateStory(context.Background(), &cases[i])
+		_, err := h.CreateStory(asUser(1), &cases[i])
 		if status.Code(err) != codes.InvalidArgument {
 			t.Fatalf("case %d: want InvalidArgument, got %v", i, err)
 		}
 	}
 
 	// success
-	resp, err := h.CreateStory(context.Background(), &feedpb.CreateStoryRequest{
+	resp, err := h.CreateStory(asUser(1), &feedpb.CreateStoryRequest{
 		AuthorId: 1, MediaData: []byte("x"), MediaType: "image", MediaName: "p.png", DurationSecs: 12, Privacy: "friends",
 	})
 	if err != nil || resp.ContentId != 303 {
@@ -205,7 +484,7 @@ func TestHandlers_CreateStory_ValidationsAndSuccess(t *testing.T) {
 func TestHandlers_Reactions_Flow(t *testing.T) {
 	ok := &fakeFeedSvc{
 		ReactToContentFn: func(ctx context.Context, u, c int64, r string) error { return nil },
-		GetReactionsFn: func(ctx context.Context, cid int64) ([]dbmysql.Reaction, error) {
+		GetReactionsFn: func(ctx context.Context, v, cid int64) ([]dbmysql.Reaction, error) {
 			return []dbmysql.Reaction{{UserID: 1, ContentID: 99, Type: "like"}}, nil
 		},
 		DeleteReactionFn: func(ctx context.Context, u, c int64) error { return nil },
@@ -213,35 +492,35 @@ func TestHandlers_Reactions_Flow(t *testing.T) {
 	h := newHandlers(ok)
 
 	// react – bad params
-	_, err := h.ReactToContent(context.Background(), &feedpb.ReactionRequest{UserId: 0, ContentId: 1, Type: "like"})
+	_, err := h.ReactToContent(asUser(1), &feedpb.ReactionRequest{UserId: 0, ContentId: 1, Type: "like"})
 	if status.Code(err) != codes.InvalidArgument {
 		t.Fatalf("expected InvalidArgument user")
 	}
 
 	// react – ok
-	if _, err := h.ReactToContent(context.Background(), &feedpb.ReactionRequest{UserId: 1, ContentId: 99, Type: "like"}); err != nil {
+	if _, err := h.ReactToContent(asUser(1), &feedpb.ReactionRequest{UserId: 1, ContentId: 99, Type: "like"}); err != nil {
 		t.Fatalf("ReactToContent err: %v", err)
 	}
 
 	// list reactions – invalid id
-	_, err = h.GetReactions(context.Background(), &feedpb.ContentID{ContentId: 0})
+	_, err = h.GetReactions(asUser(1), &feedpb.ContentID{ContentId: 0})
 	if status.Code(err) != codes.InvalidArgument {
 		t.Fatalf("expected InvalidArgument for content id")
 	}
 
 	// list reactions – ok
-	r, err := h.GetReactions(context.Background(), &feedpb.ContentID{ContentId: 99})
+	r, err := h.GetReactions(asUser(1), &feedpb.ContentID{ContentId: 99})
 	if err != nil || len(r.Reactions) != 1 || r.Reactions[0].Type != "like" {
 		t.Fatalf("GetReactions mismatch: %+v err=%v", r, err)
 	}
 
 	// delete reaction – invalid
-	_, err = h.DeleteReaction(context.Background(), &feedpb.DeleteReactionRequest{UserId: 0, ContentId: 1})
+	_, err = h.DeleteReaction(asUser(1), &feedpb.DeleteReactionRequest{UserId: 0, ContentId: 1})
 	if status.Code(err) != codes.InvalidArgument {
 		t.Fatalf("expected InvalidArgument on delete")
 	}
 	// delete reaction – ok
-	if _, err := h.DeleteReaction(context.Background(), &feedpb.DeleteReactionRequest{UserId: 1, ContentId: 99}); err != nil {
+	if _, err := h.DeleteReaction(asUser(1), &feedpb.DeleteReactionRequest{UserId: 1, ContentId: 99}); err != nil {
 		t.Fatalf("DeleteReaction err: %v", err)
 	}
 }
@@ -250,17 +529,20 @@ func TestHandlers_GetMediaRef_GetContent_DeleteContent_Timeline_UserContent(t *t
 	now := time.Now()
 	// service with various behaviors
 	ok := &fakeFeedSvc{
-		GetMediaRefFn: func(ctx context.Context, id int64) (*dbmysql.MediaRef, []byte, error) {
+		GetMediaRefFn: func(ctx context.Context, v, id int64) (*dbmysql.MediaRef, []byte, error) {
 			return &dbmysql.MediaRef{MediaRefID: uint(id), FileID: "deadbeef", URL: "deadbeef"}, []byte{}, nil
 		},
 		GetContentFn: func(ctx context.Context, id int64) (*dbmysql.Content, string, error) {
 			txt := "hello"
 			return &dbmysql.Content{ContentID: id, TextContent: &txt}, "url://x", nil
 		},
-		DeleteContentFn: func(ctx context.Context, id int64) error { return nil },
-		GetTimelineFn: func(ctx context.Context, uid int64) ([]dbmysql.Content, []string, error) {
+		DeleteContentFn: func(ctx context.Context, r, id int64) error { return nil },
+		GetTimelineFn: func(ctx context.Context, uid int64, q TimelineQuery) (*TimelinePage, error) {
 			// nil text to hit safeString(nil)
-			return []dbmysql.Content{{ContentID: 1, AuthorID: 2, Type: "POST", TextContent: nil, Privacy: "public", CreatedAt: now}}, []string{""}, nil
+			return &TimelinePage{
+				Contents:  []dbmysql.Content{{ContentID: 1, AuthorID: 2, Type: "POST", TextContent: nil, Privacy: "public", CreatedAt: now}},
+				MediaURLs: []string{""},
+			}, nil
 		},
 		GetUserContentFn: func(ctx context.Context, rid, tid int64) ([]dbmysql.Content, []string, error) {
 			txt := "ok"
@@ -271,17 +553,17 @@ func TestHandlers_GetMediaRef_GetContent_DeleteContent_Timeline_UserContent(t *t
 	h := newHandlers(ok)
 
 	// GetMediaRef invalid
-	if _, err := h.GetMediaRef(context.Background(), &feedpb.ContentID{ContentId: 0}); status.Code(err) != codes.InvalidArgument {
+	if _, err := h.GetMediaRef(asUser(1), &feedpb.ContentID{ContentId: 0}); status.Code(err) != codes.InvalidArgument {
 		t.Fatalf("expected InvalidArgument for GetMediaRef")
 	}
 	// GetMediaRef ok
-	mr, err := h.GetMediaRef(context.Background(), &feedpb.ContentID{ContentId: 7})
+	mr, err := h.GetMediaRef(asUser(1), &feedpb.ContentID{ContentId: 7})
 	if err != nil || mr.FilePath != "deadbeef" {
 		t.Fatalf("GetMediaRef mismatch: %+v err=%v", mr, err)
 	}
 
 	// GetContent invalid
-	if _, err := h.GetContent(context.Background(), &feedpb.ContentID{ContentId: 0}); status.Code(err) != codes.InvalidArgument {
+	if _, err := h.GetContent(asUser(1), &feedpb.ContentID{ContentId: 0}); status.Code(err) != codes.InvalidArgument {
 		t.Fatalf("expected InvalidArgument for GetContent")
 	}
 	// GetContent service error
@@ -290,40 +572,40 @@ func TestHandlers_GetMediaRef_GetContent_DeleteContent_Timeline_UserContent(t *t
 			return nil, "", errors.New("boom")
 		},
 	})
-	if _, err := bad.GetContent(context.Background(), &feedpb.ContentID{ContentId: 5}); status.Code(err) != codes.Internal {
+	if _, err := bad.GetContent(asUser(1), &feedpb.ContentID{ContentId: 5}); status.Code(err) != codes.Internal {
 		t.Fatalf("expected Internal on svc error")
 	}
 	// GetContent ok
-	resp, err := h.GetContent(context.Background(), &feedpb.ContentID{ContentId: 3})
+	resp, err := h.GetContent(asUser(1), &feedpb.ContentID{ContentId: 3})
 	if err != nil || resp.ContentId != 3 || resp.MediaUrl == "" || resp.Message != "hello" {
 		t.Fatalf("GetContent mismatch: %+v err=%v", resp, err)
 	}
 
 	// DeleteContent invalid
-	if _, err := h.DeleteContent(context.Background(), &feedpb.ContentID{ContentId: 0}); status.Code(err) != codes.InvalidArgument {
+	if _, err := h.DeleteContent(asUser(1), &feedpb.ContentID{ContentId: 0}); status.Code(err) != codes.InvalidArgument {
 		t.Fatalf("expected InvalidArgument for DeleteContent")
 	}
 	// DeleteContent ok
-	if _, err := h.DeleteContent(context.Background(), &feedpb.ContentID{ContentId: 3}); err != nil {
+	if _, err := h.DeleteContent(asUser(1), &feedpb.ContentID{ContentId: 3}); err != nil {
 		t.Fatalf("DeleteContent err: %v", err)
 	}
 
 	// GetTimeline invalid
-	if _, err := h.GetTimeline(context.Background(), &feedpb.UserID{UserId: 0}); status.Code(err) != codes.InvalidArgument {
+	if _, err := h.GetTimeline(asUser(1), &feedpb.GetTimelineRequest{UserId: 0}); status.Code(err) != codes.InvalidArgument {
 		t.Fatalf("expected InvalidArgument for GetTimeline")
 	}
 	// GetTimeline ok (covers safeString(nil))
-	tl, err := h.GetTimeline(context.Background(), &feedpb.UserID{UserId: 42})
+	tl, err := h.GetTimeline(asUser(42), &feedpb.GetTimelineRequest{UserId: 42})
 	if err != nil || len(tl.Contents) != 1 || tl.Contents[0].Text != "" {
 		t.Fatalf("timeline mismatch or safeString not applied: %+v err=%v", tl, err)
 	}
 
 	// GetUserContent invalid
-	if _, err := h.GetUserContent(context.Background(), &feedpb.GetUserContentRequest{RequesterId: 0, TargetUserId: 1}); status.Code(err) != codes.InvalidArgument {
+	if _, err := h.GetUserContent(asUser(1), &feedpb.GetUserContentRequest{RequesterId: 0, TargetUserId: 1}); status.Code(err) != codes.InvalidArgument {
 		t.Fatalf("expected InvalidArgument for GetUserContent")
 	}
 	// GetUserContent ok
-	uc, err := h.GetUserContent(context.Background(), &feedpb.GetUserContentRequest{RequesterId: 2, TargetUserId: 2})
+	uc, err := h.GetUserContent(asUser(2), &feedpb.GetUserContentRequest{RequesterId: 2, TargetUserId: 2})
 	if err != nil || len(uc.Contents) != 1 {
 		t.Fatalf("GetUserContent mismatch: %+v err=%v", uc, err)
 	}
@@ -339,7 +621,7 @@ func TestHandlers_ErrorBranches(t *testing.T) {
 		{
 			name: "CreatePost service error",
 			call: func(h *FeedHandlers) error {
-				_, err := h.CreatePost(context.Background(),
+				_, err := h.CreatePost(asUser(1),
 					&feedpb.CreatePostRequest{AuthorId: 1, Text: "t", MediaType: "image", Privacy: "pub", MediaName: "n"})
 				return err
 			},
@@ -347,7 +629,7 @@ func TestHandlers_ErrorBranches(t *testing.T) {
 		{
 			name: "CreateReel service error",
 			call: func(h *FeedHandlers) error {
-				_, err := h.CreateReel(context.Background(),
+				_, err := h.CreateReel(asUser(1),
 					&feedpb.CreateReelRequest{AuthorId: 1, Caption: "c", MediaName: "n", DurationSecs: 1, Privacy: "pub"})
 				return err
 			},
@@ -355,7 +637,7 @@ func TestHandlers_ErrorBranches(t *testing.T) {
 		{
 			name: "CreateStory service error",
 			call: func(h *FeedHandlers) error {
-				_, err := h.CreateStory(context.Background(),
+				_, err := h.CreateStory(asUser(1),
 					&feedpb.CreateStoryRequest{AuthorId: 1, MediaName: "n", MediaType: "image", DurationSecs: 5, Privacy: "pub"})
 				return err
 			},
@@ -363,7 +645,7 @@ func TestHandlers_ErrorBranches(t *testing.T) {
 		{
 			name: "ReactToContent service error",
 			call: func(h *FeedHandlers) error {
-				_, err := h.ReactToContent(context.Background(),
+				_, err := h.ReactToContent(asUser(1),
 					&feedpb.ReactionRequest{UserId: 1, ContentId: 2, Type: "like"})
 				return err
 			},
@@ -371,14 +653,14 @@ func TestHandlers_ErrorBranches(t *testing.T) {
 		{
 			name: "GetReactions service error",
 			call: func(h *FeedHandlers) error {
-				_, err := h.GetReactions(context.Background(), &feedpb.ContentID{ContentId: 1})
+				_, err := h.GetReactions(asUser(1), &feedpb.ContentID{ContentId: 1})
 				return err
 			},
 		},
 		{
 			name: "DeleteReaction service error",
 			call: func(h *FeedHandlers) error {
-				_, err := h.DeleteReaction(context.Background(),
+				_, err := h.DeleteReaction(asUser(1),
 					&feedpb.DeleteReactionRequest{UserId: 1, ContentId: 2})
 				return err
 			},
@@ -386,21 +668,21 @@ func TestHandlers_ErrorBranches(t *testing.T) {
 		{
 			name: "GetMediaRef service error",
 			call: func(h *FeedHandlers) error {
-				_, err := h.GetMediaRef(context.Background(), &feedpb.ContentID{ContentId: 9})
+				_, err := h.GetMediaRef(asUser(1), &feedpb.ContentID{ContentId: 9})
 				return err
 			},
 		},
 		{
 			name: "GetTimeline service error",
 			call: func(h *FeedHandlers) error {
-				_, err := h.GetTimeline(context.Background(), &feedpb.UserID{UserId: 5})
+				_, err := h.GetTimeline(asUser(5), &feedpb.GetTimelineRequest{UserId: 5})
 				return err
 			},
 		},
 		{
 			name: "GetUserContent service error",
 			call: func(h *FeedHandlers) error {
-				_, err := h.GetUserContent(context.Background(),
+				_, err := h.GetUserContent(asUser(1),
 					&feedpb.GetUserContentRequest{RequesterId: 1, TargetUserId: 2})
 				return err
 			},
@@ -408,20 +690,22 @@ func TestHandlers_ErrorBranches(t *testing.T) {
 	}
 
 	ff := &fakeFeedSvc{
-		CreatePostFn: func(context.Context, int64, string, []byte, string, string, string) (int64, error) {
+		CreatePostFn: func(context.Context, int64, string, []byte, string, string, string, int64, *time.Time) (int64, error) {
 			return 0, errors.New("fail")
 		},
-		CreateReelFn: func(context.Context, int64, string, []byte, string, int, string) (int64, error) {
+		CreateReelFn: func(context.Context, int64, string, []byte, string, int, string, *time.Time) (int64, error) {
 			return 0, errors.New("fail")
 		},
-		CreateStoryFn: func(context.Context, int64, []byte, string, string, int, string) (int64, error) {
+		CreateStoryFn: func(context.Context, int64, []byte, string, string, int, string, int64) (int64, error) {
 			return 0, errors.New("fail")
 		},
 		ReactToContentFn: func(context.Context, int64, int64, string) error { return errors.New("fail") },
-		GetReactionsFn:   func(context.Context, int64) ([]dbmysql.Reaction, error) { return nil, errors.New("fail") },
+		GetReactionsFn:   func(context.Context, int64, int64) ([]dbmysql.Reaction, error) { return nil, errors.New("fail") },
 		DeleteReactionFn: func(context.Context, int64, int64) error { return errors.New("fail") },
-		GetMediaRefFn:    func(context.Context, int64) (*dbmysql.MediaRef, []byte, error) { return nil, nil, errors.New("fail") },
-		GetTimelineFn:    func(context.Context, int64) ([]dbmysql.Content, []string, error) { return nil, nil, errors.New("fail") },
+		GetMediaRefFn: func(context.Context, int64, int64) (*dbmysql.MediaRef, []byte, error) {
+			return nil, nil, errors.New("fail")
+		},
+		GetTimelineFn: func(context.Context, int64, TimelineQuery) (*TimelinePage, error) { return nil, errors.New("fail") },
 		GetUserContentFn: func(context.Context, int64, int64) ([]dbmysql.Content, []string, error) {
 			return nil, nil, errors.New("fail")
 		},
@@ -439,11 +723,11 @@ func TestHandlers_ServiceInternalErrors(t *testing.T) {
 		GetContentFn: func(context.Context, int64) (*dbmysql.Content, string, error) {
 			return nil, "", errors.New("fail-content")
 		},
-		GetMediaRefFn: func(context.Context, int64) (*dbmysql.MediaRef, []byte, error) {
+		GetMediaRefFn: func(context.Context, int64, int64) (*dbmysql.MediaRef, []byte, error) {
 			return nil, nil, errors.New("fail-media")
 		},
-		GetTimelineFn: func(context.Context, int64) ([]dbmysql.Content, []string, error) {
-			return nil, nil, errors.New("fail-timeline")
+		GetTimelineFn: func(context.Context, int64, TimelineQuery) (*TimelinePage, error) {
+			return nil, errors.New("fail-timeline")
 		},
 		GetUserContentFn: func(context.Context, int64, int64) ([]dbmysql.Content, []string, error) {
 			return nil, nil, errors.New("fail-usercontent")
@@ -451,16 +735,16 @@ func TestHandlers_ServiceInternalErrors(t *testing.T) {
 	}
 	h := newHandlers(ff)
 
-	if _, err := h.GetContent(context.Background(), &feedpb.ContentID{ContentId: 1}); status.Code(err) != codes.Internal {
+	if _, err := h.GetContent(asUser(1), &feedpb.ContentID{ContentId: 1}); status.Code(err) != codes.Internal {
 		t.Errorf("GetContent: expected Internal, got %v", err)
 	}
-	if _, err := h.GetMediaRef(context.Background(), &feedpb.ContentID{ContentId: 2}); status.Code(err) != codes.Internal {
+	if _, err := h.GetMediaRef(asUser(1), &feedpb.ContentID{ContentId: 2}); status.Code(err) != codes.Internal {
 		t.Errorf("GetMediaRef: expected Internal, got %v", err)
 	}
-	if _, err := h.GetTimeline(context.Background(), &feedpb.UserID{UserId: 3}); status.Code(err) != codes.Internal {
+	if _, err := h.GetTimeline(asUser(3), &feedpb.GetTimelineRequest{UserId: 3}); status.Code(err) != codes.Internal {
 		t.Errorf("GetTimeline: expected Internal, got %v", err)
 	}
-	if _, err := h.GetUserContent(context.Background(),
+	if _, err := h.GetUserContent(asUser(1),
 		&feedpb.GetUserContentRequest{RequesterId: 1, TargetUserId: 2}); status.Code(err) != codes.Internal {
 		t.Errorf("GetUserContent: expected Internal, got %v", err)
 	}
@@ -470,30 +754,33 @@ func TestHandlers_ServiceInternalErrors(t *testing.T) {
 
 func TestHandlers_AllHappyPaths(t *testing.T) {
 	ff := &fakeFeedSvc{
-		CreatePostFn: func(context.Context, int64, string, []byte, string, string, string) (int64, error) {
+		CreatePostFn: func(context.Context, int64, string, []byte, string, string, string, int64, *time.Time) (int64, error) {
 			return 101, nil
 		},
-		CreateReelFn: func(context.Context, int64, string, []byte, string, int, string) (int64, error) {
+		CreateReelFn: func(context.Context, int64, string, []byte, string, int, string, *time.Time) (int64, error) {
 			return 202, nil
 		},
-		CreateStoryFn: func(context.Context, int64, []byte, string, string, int, string) (int64, error) {
+		CreateStoryFn: func(context.Context, int64, []byte, string, string, int, string, int64) (int64, error) {
 			return 303, nil
 		},
 		ReactToContentFn: func(context.Context, int64, int64, string) error { return nil },
-		GetReactionsFn: func(context.Context, int64) ([]dbmysql.Reaction, error) {
+		GetReactionsFn: func(context.Context, int64, int64) ([]dbmysql.Reaction, error) {
 			return []dbmysql.Reaction{{UserID: 1, ContentID: 2, Type: "like"}}, nil
 		},
 		DeleteReactionFn: func(context.Context, int64, int64) error { return nil },
-		GetMediaRefFn: func(ctx context.Context, id int64) (*dbmysql.MediaRef, []byte, error) {
+		GetMediaRefFn: func(ctx context.Context, v, id int64) (*dbmysql.MediaRef, []byte, error) {
 			return &dbmysql.MediaRef{MediaRefID: uint(id), FileID: "deadbeef"}, []byte{}, nil
 		},
 		GetContentFn: func(context.Context, int64) (*dbmysql.Content, string, error) {
 			txt := "sample"
 			return &dbmysql.Content{ContentID: 5, TextContent: &txt}, "url://content", nil
 		},
-		DeleteContentFn: func(context.Context, int64) error { return nil },
-		GetTimelineFn: func(context.Context, int64) ([]dbmysql.Content, []string, error) {
-			return []dbmysql.Content{{ContentID: 7, AuthorID: 1, Privacy: "public", CreatedAt: time.Now()}}, []string{"url://tl"}, nil
+		DeleteContentFn: func(context.Context, int64, int64) error { return nil },
+		GetTimelineFn: func(context.Context, int64, TimelineQuery) (*TimelinePage, error) {
+			return &TimelinePage{
+				Contents:  []dbmysql.Content{{ContentID: 7, AuthorID: 1, Privacy: "public", CreatedAt: time.Now()}},
+				MediaURLs: []string{"url://tl"},
+			}, nil
 		},
 		GetUserContentFn: func(context.Context, int64, int64) ([]dbmysql.Content, []string, error) {
 			return []dbmysql.Content{{ContentID: 9, AuthorID: 2, Privacy: "public", CreatedAt: time.Now()}}, []string{"url://uc"}, nil
@@ -506,32 +793,35 @@ func TestHandlers_AllHappyPaths(t *testing.T) {
 		run  func() error
 	}{
 		{"CreatePost", func() error {
-			_, e := h.CreatePost(context.Background(), &feedpb.CreatePostRequest{AuthorId: 1, Text: "t", MediaType: "image", Privacy: "public", MediaName: "m"})
+			_, e := h.CreatePost(asUser(1), &feedpb.CreatePostRequest{AuthorId: 1, Text: "t", MediaType: "image", Privacy: "public", MediaName: "m"})
 			return e
 		}},
 		{"CreateReel", func() error {
-			_, e := h.CreateReel(context.Background(), &feedpb.CreateReelRequest{AuthorId: 1, Caption: "c", MediaName: "m", DurationSecs: 5, Privacy: "public"})
+			_, e := h.CreateReel(asUser(1), &feedpb.CreateReelRequest{AuthorId: 1, Caption: "c", MediaName: "m", DurationSecs: 5, Privacy: "public"})
 			return e
 		}},
 		{"CreateStory", func() error {
-			_, e := h.CreateStory(context.Background(), &feedpb.CreateStoryRequest{AuthorId: 1, MediaType: "image", MediaName: "m", DurationSecs: 5, Privacy: "public"})
+			_, e := h.CreateStory(asUser(1), &feedpb.CreateStoryRequest{AuthorId: 1, MediaType: "image", MediaName: "m", DurationSecs: 5, Privacy: "public"})
 			return e
 		}},
 		{"ReactToContent", func() error {
-			_, e := h.ReactToContent(context.Background(), &feedpb.ReactionRequest{UserId: 1, ContentId: 2, Type: "like"})
+			_, e := h.ReactToContent(asUser(1), &feedpb.ReactionRequest{UserId: 1, ContentId: 2, Type: "like"})
 			return e
 		}},
-		{"GetReactions", func() error { _, e := h.GetReactions(context.Background(), &feedpb.ContentID{ContentId: 2}); return e }},
+		{"GetReactions", func() error { _, e := h.GetReactions(asUser(1), &feedpb.ContentID{ContentId: 2}); return e }},
 		{"DeleteReaction", func() error {
-			_, e := h.DeleteReaction(context.Background(), &feedpb.DeleteReactionRequest{UserId: 1, ContentId: 2})
+			_, e := h.DeleteReaction(asUser(1), &feedpb.DeleteReactionRequest{UserId: 1, ContentId: 2})
+			return e
+		}},
+		{"GetMediaRef", func() error { _, e := h.GetMediaRef(asUser(1), &feedpb.ContentID{ContentId: 1}); return e }},
+		{"GetContent", func() error { _, e := h.GetContent(asUser(1), &feedpb.ContentID{ContentId: 5}); return e }},
+		{"DeleteContent", func() error { _, e := h.DeleteContent(asUser(1), &feedpb.ContentID{ContentId: 5}); return e }},
+		{"GetTimeline", func() error {
+			_, e := h.GetTimeline(asUser(1), &feedpb.GetTimelineRequest{UserId: 1})
 			return e
 		}},
-		{"GetMediaRef", func() error { _, e := h.GetMediaRef(context.Background(), &feedpb.ContentID{ContentId: 1}); return e }},
-		{"GetContent", func() error { _, e := h.GetContent(context.Background(), &feedpb.ContentID{ContentId: 5}); return e }},
-		{"DeleteContent", func() error { _, e := h.DeleteContent(context.Background(), &feedpb.ContentID{ContentId: 5}); return e }},
-		{"GetTimeline", func() error { _, e := h.GetTimeline(context.Background(), &feedpb.UserID{UserId: 1}); return e }},
 		{"GetUserContent", func() error {
-			_, e := h.GetUserContent(context.Background(), &feedpb.GetUserContentRequest{RequesterId: 1, TargetUserId: 2})
+			_, e := h.GetUserContent(asUser(1), &feedpb.GetUserContentRequest{RequesterId: 1, TargetUserId: 2})
 			return e
 		}},
 	}
@@ -542,3 +832,1164 @@ func TestHandlers_AllHappyPaths(t *testing.T) {
 		}
 	}
 }
+
+func TestHandlers_GetTimeline_Pagination(t *testing.T) {
+	var gotQuery TimelineQuery
+	h := newHandlers(&fakeFeedSvc{
+		GetTimelineFn: func(ctx context.Context, uid int64, q TimelineQuery) (*TimelinePage, error) {
+			if q.Cursor == "bad" {
+				return nil, ErrInvalidCursor
+			}
+			if q.Ranking == "viral" {
+				return nil, ErrInvalidRanking
+			}
+			gotQuery = q
+			return &TimelinePage{
+				Contents:   []dbmysql.Content{{ContentID: 4, AuthorID: uid, Privacy: "public", CreatedAt: time.Now()}},
+				MediaURLs:  []string{""},
+				NextCursor: "next",
+			}, nil
+		},
+	})
+
+	if _, err := h.GetTimeline(asUser(1), &feedpb.GetTimelineRequest{UserId: 1, PageSize: -1}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for negative page size, got %v", err)
+	}
+	if _, err := h.GetTimeline(asUser(1), &feedpb.GetTimelineRequest{UserId: 1, Cursor: "bad"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for bad cursor, got %v", err)
+	}
+
+	if _, err := h.GetTimeline(asUser(1), &feedpb.GetTimelineRequest{UserId: 1, Ranking: "viral"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for unknown ranking, got %v", err)
+	}
+
+	resp, err := h.GetTimeline(asUser(1), &feedpb.GetTimelineRequest{UserId: 1, Cursor: "prev", PageSize: 10, Ranking: "ranked"})
+	if err != nil || resp.NextCursor != "next" || len(resp.Contents) != 1 {
+		t.Fatalf("unexpected response %+v err=%v", resp, err)
+	}
+	if gotQuery.Cursor != "prev" || gotQuery.PageSize != 10 || gotQuery.Ranking != RankingRanked {
+		t.Fatalf("query not passed through: %+v", gotQuery)
+	}
+}
+
+func TestHandlers_UpdateContentPrivacy_And_FriendshipAccepted(t *testing.T) {
+	h := newHandlers(&fakeFeedSvc{
+		UpdateContentPrivacyFn: func(ctx context.Context, r, c int64, p string) error {
+			switch {
+			case p == "secret":
+				return ErrInvalidPrivacy
+			case r != 1:
+				return ErrNotContentOwner
+			case c == 99:
+				return errors.New("db down")
+			}
+			return nil
+		},
+		OnFriendshipAcceptedFn: func(ctx context.Context, u, fr int64) error {
+			switch {
+			case u == 9:
+				return errors.New("db down")
+			case fr == 3:
+				return ErrNotFriends
+			}
+			return nil
+		},
+	})
+	ctx := asUser(1)
+
+	privacyCases := []struct {
+		req  *feedpb.UpdateContentPrivacyRequest
+		code codes.Code
+	}{
+		{&feedpb.UpdateContentPrivacyRequest{ContentId: 0, RequesterId: 1, Privacy: "public"}, codes.InvalidArgument},
+		{&feedpb.UpdateContentPrivacyRequest{ContentId: 3, RequesterId: 1, Privacy: "secret"}, codes.InvalidArgument},
+		{&feedpb.UpdateContentPrivacyRequest{ContentId: 3, RequesterId: 2, Privacy: "public"}, codes.PermissionDenied},
+		{&feedpb.UpdateContentPrivacyRequest{ContentId: 99, RequesterId: 1, Privacy: "public"}, codes.Internal},
+		{&feedpb.UpdateContentPrivacyRequest{ContentId: 3, RequesterId: 1, Privacy: "public"}, codes.OK},
+	}
+	for _, c := range privacyCases {
+		if _, err := h.UpdateContentPrivacy(asUser(c.req.RequesterId), c.req); status.Code(err) != c.code {
+			t.Errorf("UpdateContentPrivacy(%+v): want %v, got %v", c.req, c.code, err)
+		}
+	}
+
+	if _, err := h.FriendshipAccepted(ctx, &feedpb.FriendshipRequest{UserId: 1, FriendId: 1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("FriendshipAccepted with self: expected InvalidArgument, got %v", err)
+	}
+	if _, err := h.FriendshipAccepted(ctx, &feedpb.FriendshipRequest{UserId: 9, FriendId: 1}); status.Code(err) != codes.Internal {
+		t.Errorf("FriendshipAccepted svc error: expected Internal, got %v", err)
+	}
+	if _, err := h.FriendshipAccepted(ctx, &feedpb.FriendshipRequest{UserId: 1, FriendId: 3}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("FriendshipAccepted without a friendship: expected FailedPrecondition, got %v", err)
+	}
+	if _, err := h.FriendshipAccepted(ctx, &feedpb.FriendshipRequest{UserId: 1, FriendId: 2}); err != nil {
+		t.Errorf("FriendshipAccepted err: %v", err)
+	}
+}
+
+func TestHandlers_Comments(t *testing.T) {
+	now := time.Now()
+	parent := int64(4)
+	h := newHandlers(&fakeFeedSvc{
+		AddCommentFn: func(ctx context.Context, a, c, p int64, text string) (*dbmysql.Comment, error) {
+			switch text {
+			case "":
+				return nil, ErrInvalidComment
+			case "hidden":
+				return nil, ErrContentNotVisible
+			}
+			return &dbmysql.Comment{CommentID: 7, ContentID: c, AuthorID: a, ParentID: &parent, Text: text, CreatedAt: now}, nil
+		},
+		ListCommentsFn: func(ctx context.Context, v, c, p int64, cursor string, n int) (*CommentPage, error) {
+			if c == 404 {
+				return nil, gorm.ErrRecordNotFound
+			}
+			return &CommentPage{
+				Comments:    []dbmysql.Comment{{CommentID: 4, ContentID: c, AuthorID: 2, Text: "top", CreatedAt: now}},
+				ReplyCounts: map[int64]int64{4: 3},
+				NextCursor:  "more",
+			}, nil
+		},
+		EditCommentFn: func(ctx context.Context, e, c int64, text string) (*dbmysql.Comment, error) {
+			if e != 2 {
+				return nil, ErrNotCommentAuthor
+			}
+			return &dbmysql.Comment{CommentID: c, AuthorID: e, Text: text, CreatedAt: now, EditedAt: &now}, nil
+		},
+		DeleteCommentFn: func(ctx context.Context, r, c int64) error {
+			if r == 3 {
+				return ErrCannotDeleteComment
+			}
+			return nil
+		},
+		GetTimelineFn: func(ctx context.Context, uid int64, q TimelineQuery) (*TimelinePage, error) {
+			return &TimelinePage{Contents: []dbmysql.Content{{ContentID: 1, CreatedAt: now}}, MediaURLs: []string{""}}, nil
+		},
+		CountCommentsFn: func(ctx context.Context, ids []int64) (map[int64]int64, error) {
+			return map[int64]int64{1: 5}, nil
+		},
+	})
+	ctx := asUser(1)
+
+	if _, err := h.AddComment(ctx, &feedpb.AddCommentRequest{ContentId: 0, AuthorId: 1, Text: "x"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("AddComment bad ids: expected InvalidArgument, got %v", err)
+	}
+	if _, err := h.AddComment(ctx, &feedpb.AddCommentRequest{ContentId: 1, AuthorId: 1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("AddComment empty: expected InvalidArgument, got %v", err)
+	}
+	if _, err := h.AddComment(ctx, &feedpb.AddCommentRequest{ContentId: 1, AuthorId: 1, Text: "hidden"}); status.Code(err) != codes.NotFound {
+		t.Errorf("AddComment hidden: expected NotFound, got %v", err)
+	}
+	added, err := h.AddComment(ctx, &feedpb.AddCommentRequest{ContentId: 1, AuthorId: 1, ParentId: 4, Text: "hey"})
+	if err != nil || added.Comment.CommentId != 7 || added.Comment.ParentId != 4 || added.Comment.EditedAt != nil {
+		t.Fatalf("AddComment mismatch: %+v err=%v", added, err)
+	}
+
+	if _, err := h.ListComments(ctx, &feedpb.ListCommentsRequest{ContentId: 404, ViewerId: 1}); status.Code(err) != codes.NotFound {
+		t.Errorf("ListComments missing: expected NotFound, got %v", err)
+	}
+	list, err := h.ListComments(ctx, &feedpb.ListCommentsRequest{ContentId: 1, ViewerId: 1})
+	if err != nil || len(list.Comments) != 1 || list.Comments[0].ReplyCount != 3 || list.NextCursor != "more" {
+		t.Fatalf("ListComments mismatch: %+v err=%v", list, err)
+	}
+
+	if _, err := h.EditComment(ctx, &feedpb.EditCommentRequest{CommentId: 7, EditorId: 1, Text: "x"}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("EditComment other: expected PermissionDenied, got %v", err)
+	}
+	edited, err := h.EditComment(asUser(2), &feedpb.EditCommentRequest{CommentId: 7, EditorId: 2, Text: "x"})
+	if err != nil || edited.Comment.EditedAt == nil {
+		t.Fatalf("EditComment mismatch: %+v err=%v", edited, err)
+	}
+
+	if _, err := h.DeleteComment(asUser(3), &feedpb.DeleteCommentRequest{CommentId: 7, RequesterId: 3}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("DeleteComment other: expected PermissionDenied, got %v", err)
+	}
+	if _, err := h.DeleteComment(ctx, &feedpb.DeleteCommentRequest{CommentId: 7, RequesterId: 1}); err != nil {
+		t.Errorf("DeleteComment err: %v", err)
+	}
+
+	tl, err := h.GetTimeline(ctx, &feedpb.GetTimelineRequest{UserId: 1})
+	if err != nil || tl.Contents[0].CommentCount != 5 {
+		t.Fatalf("timeline should carry comment counts: %+v err=%v", tl, err)
+	}
+}
+
+func TestHandlers_Reactions(t *testing.T) {
+	now := time.Now()
+	text := "hello"
+	var summarizedFor int64
+	h := newHandlers(&fakeFeedSvc{
+		SummarizeReactionsFn: func(ctx context.Context, v int64, ids []int64) (map[int64]ReactionSummary, error) {
+			summarizedFor = v
+			return map[int64]ReactionSummary{1: {Counts: map[string]int64{"like": 2, "love": 1}, Total: 3, ViewerReaction: "like"}}, nil
+		},
+		ListReactorsFn: func(ctx context.Context, v, c int64, rt, cursor string, n int) (*ReactorPage, error) {
+			if c == 2 {
+				return nil, ErrContentNotVisible
+			}
+			return &ReactorPage{Reactors: []Reactor{{UserID: 9, Handle: "nine", Type: rt, ReactedAt: now}}, NextCursor: "next"}, nil
+		},
+		GetTimelineFn: func(ctx context.Context, uid int64, q TimelineQuery) (*TimelinePage, error) {
+			return &TimelinePage{Contents: []dbmysql.Content{{ContentID: 1, CreatedAt: now}, {ContentID: 5, CreatedAt: now}}, MediaURLs: []string{"", ""}}, nil
+		},
+		GetContentFn: func(ctx context.Context, id int64) (*dbmysql.Content, string, error) {
+			return &dbmysql.Content{ContentID: id, TextContent: &text}, "", nil
+		},
+	})
+	ctx := asUser(1)
+
+	tl, err := h.GetTimeline(asUser(4), &feedpb.GetTimelineRequest{UserId: 4})
+	if err != nil {
+		t.Fatalf("GetTimeline err: %v", err)
+	}
+	r := tl.Contents[0].Reactions
+	if summarizedFor != 4 || r.Total != 3 || r.Counts["love"] != 1 || r.ViewerReaction != "like" {
+		t.Fatalf("timeline reaction summary mismatch: %+v viewer=%d", r, summarizedFor)
+	}
+	if tl.Contents[1].Reactions == nil || tl.Contents[1].Reactions.Total != 0 {
+		t.Fatalf("contents without reactions should carry an empty summary: %+v", tl.Contents[1].Reactions)
+	}
+
+	content, err := h.GetContent(asUser(7), &feedpb.ContentID{ContentId: 1, ViewerId: 7})
+	if err != nil || summarizedFor != 7 || content.Reactions.Total != 3 {
+		t.Fatalf("GetContent reaction summary mismatch: %+v err=%v", content, err)
+	}
+
+	if _, err := h.ListReactors(ctx, &feedpb.ListReactorsRequest{ContentId: 1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ListReactors no viewer: expected InvalidArgument, got %v", err)
+	}
+	if _, err := h.ListReactors(ctx, &feedpb.ListReactorsRequest{ContentId: 2, ViewerId: 1}); status.Code(err) != codes.NotFound {
+		t.Errorf("ListReactors hidden: expected NotFound, got %v", err)
+	}
+	list, err := h.ListReactors(ctx, &feedpb.ListReactorsRequest{ContentId: 1, ViewerId: 1, Type: "love"})
+	if err != nil || len(list.Reactors) != 1 || list.Reactors[0].Handle != "nine" || list.Reactors[0].Type != "love" || list.NextCursor != "next" {
+		t.Fatalf("ListReactors mismatch: %+v err=%v", list, err)
+	}
+}
+
+func TestHandlers_StoryViews(t *testing.T) {
+	now := time.Now()
+	h := newHandlers(&fakeFeedSvc{
+		MarkStoryViewedFn: func(ctx context.Context, v, sid int64) error {
+			if sid == 2 {
+				return ErrNotAStory
+			}
+			return nil
+		},
+		ListStoryViewersFn: func(ctx context.Context, r, sid int64, cursor string, n int) (*StoryViewerPage, error) {
+			if r != 1 {
+				return nil, ErrNotContentOwner
+			}
+			return &StoryViewerPage{Viewers: []StoryViewer{{UserID: 2, Handle: "two", ViewedAt: now}}}, nil
+		},
+		GetTimelineFn: func(ctx context.Context, uid int64, q TimelineQuery) (*TimelinePage, error) {
+			return &TimelinePage{Contents: []dbmysql.Content{
+				{ContentID: 1, Type: "STORY", CreatedAt: now},
+				{ContentID: 2, Type: "STORY", CreatedAt: now},
+			}, MediaURLs: []string{"", ""}}, nil
+		},
+		StoriesSeenFn: func(ctx context.Context, v int64, contents []dbmysql.Content) (map[int64]bool, error) {
+			return map[int64]bool{1: true}, nil
+		},
+	})
+	ctx := asUser(1)
+
+	if _, err := h.MarkStoryViewed(ctx, &feedpb.StoryViewRequest{StoryId: 1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("MarkStoryViewed no viewer: expected InvalidArgument, got %v", err)
+	}
+	if _, err := h.MarkStoryViewed(asUser(3), &feedpb.StoryViewRequest{StoryId: 2, ViewerId: 3}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("MarkStoryViewed on a post: expected InvalidArgument, got %v", err)
+	}
+	if _, err := h.MarkStoryViewed(asUser(3), &feedpb.StoryViewRequest{StoryId: 1, ViewerId: 3}); err != nil {
+		t.Errorf("MarkStoryViewed err: %v", err)
+	}
+
+	if _, err := h.ListStoryViewers(asUser(3), &feedpb.ListStoryViewersRequest{StoryId: 1, RequesterId: 3}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ListStoryViewers non-author: expected PermissionDenied, got %v", err)
+	}
+	list, err := h.ListStoryViewers(ctx, &feedpb.ListStoryViewersRequest{StoryId: 1, RequesterId: 1})
+	if err != nil || len(list.Viewers) != 1 || list.Viewers[0].Handle != "two" {
+		t.Fatalf("ListStoryViewers mismatch: %+v err=%v", list, err)
+	}
+
+	tl, err := h.GetTimeline(asUser(3), &feedpb.GetTimelineRequest{UserId: 3})
+	if err != nil || !tl.Contents[0].Seen || tl.Contents[1].Seen {
+		t.Fatalf("timeline seen-state mismatch: %+v err=%v", tl, err)
+	}
+}
+
+func TestHandlers_Highlights(t *testing.T) {
+	now := time.Now()
+	h := newHandlers(&fakeFeedSvc{
+		CreateHighlightFn: func(ctx context.Context, o int64, title string, ids []int64) (*dbmysql.Highlight, error) {
+			if len(ids) == 0 {
+				return nil, ErrInvalidHighlight
+			}
+			return &dbmysql.Highlight{HighlightID: 1, OwnerID: o, Title: title, Position: 1, CreatedAt: now}, nil
+		},
+		UpdateHighlightFn: func(ctx context.Context, r, hid int64, title string, ids []int64) (*dbmysql.Highlight, error) {
+			return nil, ErrNotHighlightOwner
+		},
+		DeleteHighlightFn: func(ctx context.Context, r, hid int64) error {
+			return gorm.ErrRecordNotFound
+		},
+		ReorderHighlightsFn: func(ctx context.Context, o int64, ids []int64) error {
+			return ErrInvalidHighlightOrder
+		},
+		ListStoryArchiveFn: func(ctx context.Context, a int64, q TimelineQuery) (*TimelinePage, error) {
+			return &TimelinePage{Contents: []dbmysql.Content{{ContentID: 5, Type: "STORY", CreatedAt: now}}, MediaURLs: []string{"u"}, NextCursor: "c"}, nil
+		},
+		GetUserContentFn: func(ctx context.Context, r, tu int64) ([]dbmysql.Content, []string, error) {
+			return nil, nil, nil
+		},
+		ListHighlightsFn: func(ctx context.Context, v, o int64) ([]HighlightView, error) {
+			return []HighlightView{
+				{Highlight: dbmysql.Highlight{HighlightID: 1, Title: "a"}, Stories: []dbmysql.Content{{ContentID: 5}, {ContentID: 6}}, MediaURLs: []string{"x", "y"}},
+				{Highlight: dbmysql.Highlight{HighlightID: 2, Title: "b"}, Stories: []dbmysql.Content{{ContentID: 7}}, MediaURLs: []string{"z"}},
+			}, nil
+		},
+	})
+	ctx := asUser(1)
+
+	if _, err := h.CreateHighlight(ctx, &feedpb.CreateHighlightRequest{OwnerId: 1, Title: "a"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CreateHighlight empty: expected InvalidArgument, got %v", err)
+	}
+	created, err := h.CreateHighlight(ctx, &feedpb.CreateHighlightRequest{OwnerId: 1, Title: "a", StoryIds: []int64{5}})
+	if err != nil || created.Highlight.HighlightId != 1 || created.Highlight.Position != 1 {
+		t.Fatalf("CreateHighlight mismatch: %+v err=%v", created, err)
+	}
+	if _, err := h.UpdateHighlight(asUser(2), &feedpb.UpdateHighlightRequest{HighlightId: 1, RequesterId: 2}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("UpdateHighlight: expected PermissionDenied, got %v", err)
+	}
+	if _, err := h.DeleteHighlight(ctx, &feedpb.DeleteHighlightRequest{HighlightId: 9, RequesterId: 1}); status.Code(err) != codes.NotFound {
+		t.Errorf("DeleteHighlight: expected NotFound, got %v", err)
+	}
+	if _, err := h.ReorderHighlights(ctx, &feedpb.ReorderHighlightsRequest{OwnerId: 1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ReorderHighlights: expected InvalidArgument, got %v", err)
+	}
+
+	archive, err := h.ListStoryArchive(ctx, &feedpb.ListStoryArchiveRequest{UserId: 1})
+	if err != nil || len(archive.Contents) != 1 || archive.Contents[0].MediaUrl != "u" || archive.NextCursor != "c" {
+		t.Fatalf("ListStoryArchive mismatch: %+v err=%v", archive, err)
+	}
+
+	profile, err := h.GetUserContent(asUser(2), &feedpb.GetUserContentRequest{RequesterId: 2, TargetUserId: 1})
+	if err != nil || len(profile.Highlights) != 2 {
+		t.Fatalf("GetUserContent highlights mismatch: %+v err=%v", profile, err)
+	}
+	first, second := profile.Highlights[0], profile.Highlights[1]
+	if len(first.Stories) != 2 || first.Stories[1].MediaUrl != "y" || len(second.Stories) != 1 || second.Stories[0].ContentId != 7 {
+		t.Fatalf("highlight stories should be split back per highlight: %+v", profile.Highlights)
+	}
+}
+
+func TestHandlers_Hashtags(t *testing.T) {
+	var gotWindow time.Duration
+	h := newHandlers(&fakeFeedSvc{
+		GetHashtagFeedFn: func(ctx context.Context, tag string, q TimelineQuery) (*TimelinePage, error) {
+			if tag == "#" {
+				return nil, ErrInvalidHashtag
+			}
+			return &TimelinePage{Contents: []dbmysql.Content{{ContentID: 4, Type: "POST", Privacy: "public"}}, MediaURLs: []string{""}, NextCursor: "n"}, nil
+		},
+		GetTrendingHashtagsFn: func(ctx context.Context, w time.Duration, l int) ([]TrendingHashtag, error) {
+			gotWindow = w
+			return []TrendingHashtag{{Tag: "go", Uses: 6, PreviousUses: 1, Score: 2.5}}, nil
+		},
+	})
+	ctx := asUser(1)
+
+	if _, err := h.GetHashtagFeed(ctx, &feedpb.HashtagFeedRequest{ViewerId: 1, Tag: "#"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for a bad tag, got %v", err)
+	}
+	feed, err := h.GetHashtagFeed(ctx, &feedpb.HashtagFeedRequest{ViewerId: 1, Tag: "#Go"})
+	if err != nil || len(feed.Contents) != 1 || feed.Contents[0].ContentId != 4 || feed.NextCursor != "n" {
+		t.Fatalf("GetHashtagFeed mismatch: %+v err=%v", feed, err)
+	}
+
+	if _, err := h.GetTrendingHashtags(ctx, &feedpb.TrendingHashtagsRequest{WindowSeconds: -1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for a negative window, got %v", err)
+	}
+	trending, err := h.GetTrendingHashtags(ctx, &feedpb.TrendingHashtagsRequest{WindowSeconds: 1 << 62})
+	if err != nil || gotWindow != MaxTrendingWindow || trending.WindowSeconds != int64(MaxTrendingWindow/time.Second) {
+		t.Fatalf("huge windows should be capped, got %v / %+v err=%v", gotWindow, trending, err)
+	}
+	if len(trending.Hashtags) != 1 || trending.Hashtags[0].Tag != "go" || trending.Hashtags[0].PreviousUses != 1 || trending.Hashtags[0].Score != 2.5 {
+		t.Fatalf("unexpected hashtags %+v", trending.Hashtags)
+	}
+}
+
+func TestHandlers_Mentions(t *testing.T) {
+	h := newHandlers(&fakeFeedSvc{
+		GetMentionsFeedFn: func(ctx context.Context, u int64, q TimelineQuery) (*TimelinePage, error) {
+			if q.Cursor == "bad" {
+				return nil, ErrInvalidCursor
+			}
+			return &TimelinePage{Contents: []dbmysql.Content{{ContentID: 3, Type: "POST", TextContent: sptr("hi @bob")}}, MediaURLs: []string{""}}, nil
+		},
+		ListMentionsFn: func(ctx context.Context, ids []int64) (map[int64][]dbmysql.Mention, error) {
+			return map[int64][]dbmysql.Mention{3: {{ContentID: 3, MentionedUserID: 2, Handle: "bob", Offset: 3, Length: 4}}}, nil
+		},
+	})
+	ctx := asUser(2)
+
+	if _, err := h.GetMentionsFeed(ctx, &feedpb.MentionsFeedRequest{UserId: 2, Cursor: "bad"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for a bad cursor, got %v", err)
+	}
+	resp, err := h.GetMentionsFeed(ctx, &feedpb.MentionsFeedRequest{UserId: 2})
+	if err != nil || len(resp.Contents) != 1 {
+		t.Fatalf("GetMentionsFeed mismatch: %+v err=%v", resp, err)
+	}
+	m := resp.Contents[0].Mentions
+	if len(m) != 1 || m[0].UserId != 2 || m[0].Handle != "bob" || m[0].Offset != 3 || m[0].Length != 4 {
+		t.Fatalf("mentions should be returned with the content, got %+v", m)
+	}
+}
+
+func TestHandlers_Shares(t *testing.T) {
+	original, deleted := int64(1), int64(2)
+	h := newHandlers(&fakeFeedSvc{
+		ShareContentFn: func(ctx context.Context, s, o int64, c, p string) (int64, error) {
+			switch o {
+			case 5:
+				return 0, ErrSharePrivacy
+			case 6:
+				return 0, ErrContentNotVisible
+			}
+			return 10, nil
+		},
+		GetTimelineFn: func(ctx context.Context, u int64, q TimelineQuery) (*TimelinePage, error) {
+			return &TimelinePage{Contents: []dbmysql.Content{
+				{ContentID: 1, Type: "POST"},
+				{ContentID: 10, Type: "POST", SharedContentID: &original, TextContent: sptr("look")},
+				{ContentID: 11, Type: "POST", SharedContentID: &deleted},
+			}, MediaURLs: []string{"", "", ""}}, nil
+		},
+		CountSharesFn: func(ctx context.Context, ids []int64) (map[int64]int64, error) {
+			return map[int64]int64{1: 1}, nil
+		},
+		ResolveSharedFn: func(ctx context.Context, v int64, contents []dbmysql.Content) (map[int64]*SharedOriginal, error) {
+			return map[int64]*SharedOriginal{1: {Content: dbmysql.Content{ContentID: 1, AuthorID: 3, TextContent: sptr("hi")}, MediaURL: "m"}, 2: nil}, nil
+		},
+	})
+	ctx := asUser(1)
+
+	if _, err := h.ShareContent(ctx, &feedpb.ShareContentRequest{SharerId: 1, ContentId: 5, Privacy: "public"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for a wider privacy, got %v", err)
+	}
+	if _, err := h.ShareContent(ctx, &feedpb.ShareContentRequest{SharerId: 1, ContentId: 6}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound for an invisible original, got %v", err)
+	}
+	resp, err := h.ShareContent(ctx, &feedpb.ShareContentRequest{SharerId: 1, ContentId: 1, Commentary: "look"})
+	if err != nil || resp.ContentId != 10 {
+		t.Fatalf("ShareContent mismatch: %+v err=%v", resp, err)
+	}
+
+	timeline, err := h.GetTimeline(asUser(2), &feedpb.GetTimelineRequest{UserId: 2})
+	if err != nil || len(timeline.Contents) != 3 {
+		t.Fatalf("GetTimeline mismatch: %+v err=%v", timeline, err)
+	}
+	plain, quote, orphan := timeline.Contents[0], timeline.Contents[1], timeline.Contents[2]
+	if plain.ShareCount != 1 || plain.Shared != nil {
+		t.Fatalf("original should carry the share count, got %+v", plain)
+	}
+	if !quote.Shared.Available || quote.Shared.Content.Text != "hi" || quote.Shared.Content.MediaUrl != "m" || quote.Text != "look" {
+		t.Fatalf("quote post should embed the original, got %+v", quote.Shared)
+	}
+	if orphan.Shared.ContentId != 2 || orphan.Shared.Available || orphan.Shared.Content != nil {
+		t.Fatalf("share of a deleted original should be unavailable, got %+v", orphan.Shared)
+	}
+}
+
+func TestHandlers_Revisions(t *testing.T) {
+	edited := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	h := newHandlers(&fakeFeedSvc{
+		UpdateContentFn: func(ctx context.Context, e, c int64, u ContentUpdate) (*dbmysql.Content, error) {
+			switch {
+			case e != 1:
+				return nil, ErrNotContentOwner
+			case u.Text != nil && *u.Text == "":
+				return nil, ErrInvalidContentUpdate
+			case u.Privacy != nil:
+				return nil, errors.New("db down")
+			}
+			return &dbmysql.Content{ContentID: c, AuthorID: 1, Type: "POST", TextContent: u.Text, EditedAt: &edited}, nil
+		},
+		GetContentFn: func(ctx context.Context, id int64) (*dbmysql.Content, string, error) {
+			return &dbmysql.Content{ContentID: id}, "url", nil
+		},
+		ListRevisionsFn: func(ctx context.Context, v, c int64, cur string, n int) (*RevisionPage, error) {
+			if v != 1 {
+				return nil, ErrContentNotVisible
+			}
+			return &RevisionPage{Revisions: []dbmysql.ContentRevision{{RevisionID: 4, TextContent: sptr("old"), Privacy: "public", ReplacedAt: edited}}, NextCursor: "next"}, nil
+		},
+	})
+	ctx := asUser(1)
+
+	updateCases := []struct {
+		req  *feedpb.UpdateContentRequest
+		code codes.Code
+	}{
+		{&feedpb.UpdateContentRequest{ContentId: 0, EditorId: 1}, codes.InvalidArgument},
+		{&feedpb.UpdateContentRequest{ContentId: 3, EditorId: 2, Text: sptr("x")}, codes.PermissionDenied},
+		{&feedpb.UpdateContentRequest{ContentId: 3, EditorId: 1, Text: sptr("")}, codes.InvalidArgument},
+		{&feedpb.UpdateContentRequest{ContentId: 3, EditorId: 1, Privacy: sptr("public")}, codes.Internal},
+	}
+	for _, c := range updateCases {
+		if _, err := h.UpdateContent(asUser(c.req.EditorId), c.req); status.Code(err) != c.code {
+			t.Errorf("UpdateContent(%+v): want %v, got %v", c.req, c.code, err)
+		}
+	}
+	resp, err := h.UpdateContent(ctx, &feedpb.UpdateContentRequest{ContentId: 3, EditorId: 1, Text: sptr("new")})
+	if err != nil || resp.Content.Text != "new" || resp.Content.MediaUrl != "url" || !resp.Content.EditedAt.AsTime().Equal(edited) {
+		t.Fatalf("UpdateContent mismatch: %+v err=%v", resp, err)
+	}
+
+	if _, err := h.ListRevisions(asUser(2), &feedpb.ListRevisionsRequest{ContentId: 3, ViewerId: 2}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound, got %v", err)
+	}
+	list, err := h.ListRevisions(ctx, &feedpb.ListRevisionsRequest{ContentId: 3, ViewerId: 1})
+	if err != nil || len(list.Revisions) != 1 || list.Revisions[0].Text != "old" || list.NextCursor != "next" {
+		t.Fatalf("ListRevisions mismatch: %+v err=%v", list, err)
+	}
+}
+
+func TestHandlers_Collections(t *testing.T) {
+	saved := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	h := newHandlers(&fakeFeedSvc{
+		SaveContentFn: func(ctx context.Context, u, c int64, n string) (*dbmysql.Collection, error) {
+			switch c {
+			case 5:
+				return nil, ErrNotSaveable
+			case 6:
+				return nil, ErrContentNotVisible
+			}
+			return &dbmysql.Collection{CollectionID: 7, OwnerID: u, Name: n, CreatedAt: saved}, nil
+		},
+		UnsaveContentFn: func(ctx context.Context, u, c, col int64) error {
+			if col == 8 {
+				return ErrNotCollectionOwner
+			}
+			return nil
+		},
+		ListCollectionsFn: func(ctx context.Context, u int64) ([]CollectionSummary, error) {
+			return []CollectionSummary{{Collection: dbmysql.Collection{CollectionID: 7, Name: "Saved"}, ItemCount: 2}}, nil
+		},
+		ListSavedContentFn: func(ctx context.Context, u, col int64, cur string, n int) (*SavedPage, error) {
+			if col == 8 {
+				return nil, ErrNotCollectionOwner
+			}
+			return &SavedPage{Items: []SavedView{
+				{Item: dbmysql.SavedItem{ContentID: 1, SavedAt: saved}},
+				{Item: dbmysql.SavedItem{ContentID: 2, SavedAt: saved}, Content: &dbmysql.Content{ContentID: 2, Type: "POST", TextContent: sptr("hi")}, MediaURL: "m"},
+			}, NextCursor: "next"}, nil
+		},
+	})
+	ctx := asUser(1)
+
+	saveCases := []struct {
+		req  *feedpb.SaveContentRequest
+		code codes.Code
+	}{
+		{&feedpb.SaveContentRequest{UserId: 0, ContentId: 1}, codes.InvalidArgument},
+		{&feedpb.SaveContentRequest{UserId: 1, ContentId: 5}, codes.InvalidArgument},
+		{&feedpb.SaveContentRequest{UserId: 1, ContentId: 6}, codes.NotFound},
+	}
+	for _, c := range saveCases {
+		if _, err := h.SaveContent(ctx, c.req); status.Code(err) != c.code {
+			t.Errorf("SaveContent(%+v): want %v, got %v", c.req, c.code, err)
+		}
+	}
+	resp, err := h.SaveContent(ctx, &feedpb.SaveContentRequest{UserId: 1, ContentId: 1, Collection: "Recipes"})
+	if err != nil || resp.Collection.CollectionId != 7 || resp.Collection.Name != "Recipes" {
+		t.Fatalf("SaveContent mismatch: %+v err=%v", resp, err)
+	}
+
+	if _, err := h.UnsaveContent(ctx, &feedpb.UnsaveContentRequest{UserId: 1, ContentId: 1, CollectionId: 8}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected PermissionDenied, got %v", err)
+	}
+	if _, err := h.UnsaveContent(ctx, &feedpb.UnsaveContentRequest{UserId: 1, ContentId: 1}); err != nil {
+		t.Errorf("UnsaveContent err: %v", err)
+	}
+
+	list, err := h.ListCollections(ctx, &feedpb.UserID{UserId: 1})
+	if err != nil || len(list.Collections) != 1 || list.Collections[0].ItemCount != 2 {
+		t.Fatalf("ListCollections mismatch: %+v err=%v", list, err)
+	}
+
+	if _, err := h.ListSavedContent(ctx, &feedpb.ListSavedContentRequest{UserId: 1, CollectionId: 8}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected PermissionDenied, got %v", err)
+	}
+	items, err := h.ListSavedContent(ctx, &feedpb.ListSavedContentRequest{UserId: 1, CollectionId: 7})
+	if err != nil || len(items.Items) != 2 || items.NextCursor != "next" {
+		t.Fatalf("ListSavedContent mismatch: %+v err=%v", items, err)
+	}
+	if gone := items.Items[0]; gone.Available || gone.Content != nil || gone.ContentId != 1 {
+		t.Fatalf("unavailable item should be a placeholder, got %+v", gone)
+	}
+	if ok := items.Items[1]; !ok.Available || ok.Content.Text != "hi" || ok.Content.MediaUrl != "m" {
+		t.Fatalf("available item should carry its content, got %+v", ok)
+	}
+}
+
+func TestHandlers_CarouselPosts(t *testing.T) {
+	var got []MediaUpload
+	h := newHandlers(&fakeFeedSvc{
+		CreateCarouselPostFn: func(ctx context.Context, a int64, t string, m []MediaUpload, p string, l int64, at *time.Time) (int64, error) {
+			if len(m) > MaxPostMedia {
+				return 0, ErrInvalidMedia
+			}
+			got = m
+			return 4, nil
+		},
+		GetContentFn: func(ctx context.Context, id int64) (*dbmysql.Content, string, error) {
+			return &dbmysql.Content{ContentID: id}, "first", nil
+		},
+		GetTimelineFn: func(ctx context.Context, u int64, q TimelineQuery) (*TimelinePage, error) {
+			return &TimelinePage{Contents: []dbmysql.Content{{ContentID: 4, Type: "POST"}}, MediaURLs: []string{"first"}}, nil
+		},
+		ListMediaFn: func(ctx context.Context, contents []dbmysql.Content) (map[int64][]MediaItem, error) {
+			return map[int64][]MediaItem{4: {{URL: "first", Type: "image"}, {URL: "second", Type: "video"}}}, nil
+		},
+	})
+	ctx := asUser(1)
+
+	attachments := []*feedpb.MediaAttachment{{Data: []byte("a"), Type: "image", Name: "a.jpg"}, {Data: []byte("b"), Type: "video", Name: "b.mp4"}}
+	cases := []struct {
+		req  *feedpb.CreatePostRequest
+		code codes.Code
+	}{
+		{&feedpb.CreatePostRequest{AuthorId: 1, Privacy: "public", MediaData: []byte("x"), Attachments: attachments}, codes.InvalidArgument},
+		{&feedpb.CreatePostRequest{AuthorId: 1, Attachments: attachments}, codes.InvalidArgument},
+		{&feedpb.CreatePostRequest{AuthorId: 1, Privacy: "public", Attachments: make([]*feedpb.MediaAttachment, MaxPostMedia+1)}, codes.InvalidArgument},
+	}
+	for _, c := range cases {
+		if _, err := h.CreatePost(ctx, c.req); status.Code(err) != c.code {
+			t.Errorf("CreatePost(%d attachments): want %v, got %v", len(c.req.Attachments), c.code, err)
+		}
+	}
+
+	resp, err := h.CreatePost(ctx, &feedpb.CreatePostRequest{AuthorId: 1, Privacy: "public", Attachments: attachments})
+	if err != nil || resp.ContentId != 4 || resp.MediaUrl != "first" {
+		t.Fatalf("CreatePost mismatch: %+v err=%v", resp, err)
+	}
+	if len(got) != 2 || got[1].Name != "b.mp4" || got[1].Type != "video" {
+		t.Fatalf("attachments should reach the service in order, got %+v", got)
+	}
+
+	timeline, err := h.GetTimeline(ctx, &feedpb.GetTimelineRequest{UserId: 1})
+	if err != nil || len(timeline.Contents[0].Media) != 2 || timeline.Contents[0].Media[1].Url != "second" {
+		t.Fatalf("timeline should carry the ordered media, got %+v err=%v", timeline, err)
+	}
+}
+
+func TestHandlers_UploadMedia(t *testing.T) {
+	var received []byte
+	svc := &fakeFeedSvc{
+		UploadMediaFn: func(ctx context.Context, u int64, n, mt string, r io.Reader) (*dbmysql.MediaRef, error) {
+			if n == "" {
+				return nil, ErrInvalidUpload
+			}
+			data, err := io.ReadAll(r)
+			if err != nil {
+				return nil, err
+			}
+			received = data
+			return &dbmysql.MediaRef{MediaRefID: 7, FileID: "abc", Size: int64(len(data))}, nil
+		},
+		CreateFromUploadFn: func(ctx context.Context, upload UploadedContent) (int64, error) {
+			if upload.MediaRefID == 8 {
+				return 0, ErrNotMediaOwner
+			}
+			return 3, nil
+		},
+		GetContentFn: func(ctx context.Context, id int64) (*dbmysql.Content, string, error) {
+			return &dbmysql.Content{ContentID: id}, "url", nil
+		},
+	}
+
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.UnaryInterceptor(common.AuthInterceptor()), grpc.StreamInterceptor(common.AuthStreamInterceptor()))
+	feedpb.RegisterFeedServiceServer(server, newHandlers(svc))
+	go func() { _ = server.Serve(lis) }()
+	defer server.Stop()
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close()
+	client := feedpb.NewFeedServiceClient(conn)
+	token, err := common.GenerateToken(1, "user1")
+	if err != nil {
+		t.Fatalf("GenerateToken: %v", err)
+	}
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+
+	upload := func(chunks ...*feedpb.UploadChunk) (*feedpb.UploadMediaResponse, error) {
+		stream, err := client.UploadMedia(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range chunks {
+			if err := stream.Send(c); err != nil {
+				return nil, err
+			}
+		}
+		return stream.CloseAndRecv()
+	}
+
+	if _, err := upload(); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("empty upload: expected InvalidArgument, got %v", err)
+	}
+	if _, err := upload(&feedpb.UploadChunk{UploaderId: 1, MediaType: "image", Data: []byte("x")}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("upload without a name: expected InvalidArgument, got %v", err)
+	}
+	resp, err := upload(
+		&feedpb.UploadChunk{UploaderId: 1, FileName: "a.jpg", MediaType: "image", Data: []byte("hel")},
+		&feedpb.UploadChunk{},
+		&feedpb.UploadChunk{Data: []byte("lo")},
+	)
+	if err != nil || resp.MediaRefId != 7 || resp.SizeBytes != 5 || string(received) != "hello" {
+		t.Fatalf("UploadMedia mismatch: %+v received=%q err=%v", resp, received, err)
+	}
+
+	if _, err := upload(&feedpb.UploadChunk{UploaderId: 2, FileName: "b.jpg", MediaType: "image", Data: []byte("x")}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("upload for someone else: expected PermissionDenied, got %v", err)
+	}
+	if _, err := client.GetTimeline(context.Background(), &feedpb.GetTimelineRequest{UserId: 1}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("call without a token: expected Unauthenticated, got %v", err)
+	}
+
+	if _, err := client.CreatePost(ctx, &feedpb.CreatePostRequest{AuthorId: 1, Privacy: "public", MediaRefId: 7, MediaData: []byte("x")}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("combining data and an upload: expected InvalidArgument, got %v", err)
+	}
+	if _, err := client.CreateStory(ctx, &feedpb.CreateStoryRequest{AuthorId: 1, Privacy: "public", MediaRefId: 8, DurationSecs: 60}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("someone else's upload: expected PermissionDenied, got %v", err)
+	}
+	reel, err := client.CreateReel(ctx, &feedpb.CreateReelRequest{AuthorId: 1, Privacy: "public", MediaRefId: 7, DurationSecs: 15})
+	if err != nil || reel.ContentId != 3 || reel.MediaUrl != "url" {
+		t.Fatalf("CreateReel from upload mismatch: %+v err=%v", reel, err)
+	}
+}
+
+func TestHandlers_AudienceLists(t *testing.T) {
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	var posted int64
+	h := newHandlers(&fakeFeedSvc{
+		CreateAudienceListFn: func(ctx context.Context, o int64, n string, m []int64) (*dbmysql.AudienceList, error) {
+			switch n {
+			case "":
+				return nil, ErrInvalidAudienceList
+			case "taken":
+				return nil, ErrDuplicateAudienceList
+			}
+			return &dbmysql.AudienceList{ListID: 3, OwnerID: o, Name: n, CreatedAt: created}, nil
+		},
+		ListAudienceListsFn: func(ctx context.Context, o int64) ([]AudienceListSummary, error) {
+			return []AudienceListSummary{{List: dbmysql.AudienceList{ListID: 3, Name: "Close Friends"}, MemberIDs: []int64{2, 4}}}, nil
+		},
+		AddAudienceListMembersFn: func(ctx context.Context, o, l int64, u []int64) error {
+			if u[0] == 9 {
+				return ErrNotAFriend
+			}
+			return nil
+		},
+		RemoveAudienceListMembersFn: func(ctx context.Context, o, l int64, u []int64) error {
+			if o != 1 {
+				return ErrNotAudienceListOwner
+			}
+			return nil
+		},
+		DeleteAudienceListFn: func(ctx context.Context, o, l int64) error {
+			return gorm.ErrRecordNotFound
+		},
+		CreatePostFn: func(ctx context.Context, a int64, t string, d []byte, n, mt, p string, l int64, at *time.Time) (int64, error) {
+			if p == "list" && l == 0 {
+				return 0, ErrInvalidAudience
+			}
+			posted = l
+			return 10, nil
+		},
+		GetContentFn: func(ctx context.Context, id int64) (*dbmysql.Content, string, error) {
+			return &dbmysql.Content{ContentID: id}, "", nil
+		},
+		GetVisibleContentFn: func(ctx context.Context, v, id int64) (*dbmysql.Content, string, error) {
+			if v != 1 {
+				return nil, "", ErrContentNotVisible
+			}
+			return &dbmysql.Content{ContentID: id, TextContent: sptr("close friends only")}, "", nil
+		},
+	})
+	ctx := asUser(1)
+
+	createCases := []struct {
+		req  *feedpb.CreateAudienceListRequest
+		code codes.Code
+	}{
+		{&feedpb.CreateAudienceListRequest{OwnerId: 0, Name: "x"}, codes.InvalidArgument},
+		{&feedpb.CreateAudienceListRequest{OwnerId: 1, Name: ""}, codes.InvalidArgument},
+		{&feedpb.CreateAudienceListRequest{OwnerId: 1, Name: "taken"}, codes.AlreadyExists},
+	}
+	for _, c := range createCases {
+		if _, err := h.CreateAudienceList(ctx, c.req); status.Code(err) != c.code {
+			t.Errorf("CreateAudienceList(%+v): want %v, got %v", c.req, c.code, err)
+		}
+	}
+	resp, err := h.CreateAudienceList(ctx, &feedpb.CreateAudienceListRequest{OwnerId: 1, Name: "Close Friends", MemberIds: []int64{2}})
+	if err != nil || resp.List.ListId != 3 || len(resp.List.MemberIds) != 1 || !resp.List.CreatedAt.AsTime().Equal(created) {
+		t.Fatalf("CreateAudienceList mismatch: %+v err=%v", resp, err)
+	}
+
+	lists, err := h.ListAudienceLists(ctx, &feedpb.UserID{UserId: 1})
+	if err != nil || len(lists.Lists) != 1 || len(lists.Lists[0].MemberIds) != 2 {
+		t.Fatalf("ListAudienceLists mismatch: %+v err=%v", lists, err)
+	}
+
+	if _, err := h.AddAudienceListMembers(ctx, &feedpb.AudienceListMembersRequest{OwnerId: 1, ListId: 3}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument without users, got %v", err)
+	}
+	if _, err := h.AddAudienceListMembers(ctx, &feedpb.AudienceListMembersRequest{OwnerId: 1, ListId: 3, UserIds: []int64{9}}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for a non-friend, got %v", err)
+	}
+	if _, err := h.RemoveAudienceListMembers(asUser(2), &feedpb.AudienceListMembersRequest{OwnerId: 2, ListId: 3, UserIds: []int64{4}}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected PermissionDenied, got %v", err)
+	}
+	if _, err := h.DeleteAudienceList(ctx, &feedpb.DeleteAudienceListRequest{OwnerId: 1, ListId: 3}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound, got %v", err)
+	}
+
+	post := &feedpb.CreatePostRequest{AuthorId: 1, Text: "t", MediaType: "image", MediaName: "m", Privacy: "list"}
+	if _, err := h.CreatePost(ctx, post); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for list privacy without a list, got %v", err)
+	}
+	post.AudienceListId = 3
+	if _, err := h.CreatePost(ctx, post); err != nil || posted != 3 {
+		t.Fatalf("CreatePost should pass the audience list, got %d err=%v", posted, err)
+	}
+
+	if _, err := h.GetContent(asUser(4), &feedpb.ContentID{ContentId: 10, ViewerId: 4}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound for a viewer outside the list, got %v", err)
+	}
+	if got, err := h.GetContent(ctx, &feedpb.ContentID{ContentId: 10, ViewerId: 1}); err != nil || got.Message != "close friends only" {
+		t.Fatalf("GetContent mismatch: %+v err=%v", got, err)
+	}
+}
+
+func TestHandlers_Policy(t *testing.T) {
+	var viewers, requesters []int64
+	h := newHandlers(&fakeFeedSvc{
+		GetVisibleContentFn: func(ctx context.Context, v, id int64) (*dbmysql.Content, string, error) {
+			viewers = append(viewers, v)
+			if id == 2 {
+				return nil, "", ErrContentNotVisible
+			}
+			return &dbmysql.Content{ContentID: id}, "", nil
+		},
+		GetReactionsFn: func(ctx context.Context, v, id int64) ([]dbmysql.Reaction, error) {
+			return nil, ErrContentNotVisible
+		},
+		GetMediaRefFn: func(ctx context.Context, v, id int64) (*dbmysql.MediaRef, []byte, error) {
+			return nil, nil, ErrMediaNotFound
+		},
+		DeleteContentFn: func(ctx context.Context, r, id int64) error {
+			requesters = append(requesters, r)
+			if r != 1 {
+				return ErrNotContentOwner
+			}
+			return nil
+		},
+		GetTimelineFn: func(ctx context.Context, u int64, q TimelineQuery) (*TimelinePage, error) {
+			return &TimelinePage{}, nil
+		},
+		OnFriendshipAcceptedFn: func(ctx context.Context, u, fr int64) error { return nil },
+		GetTrendingHashtagsFn: func(ctx context.Context, w time.Duration, l int) ([]TrendingHashtag, error) {
+			return nil, nil
+		},
+	})
+
+	cases := []struct {
+		name string
+		call func() error
+		code codes.Code
+	}{
+		{"no caller", func() error {
+			_, err := h.GetContent(context.Background(), &feedpb.ContentID{ContentId: 1})
+			return err
+		}, codes.Unauthenticated},
+		{"trending without caller", func() error {
+			_, err := h.GetTrendingHashtags(context.Background(), &feedpb.TrendingHashtagsRequest{})
+			return err
+		}, codes.Unauthenticated},
+		{"acting for someone else", func() error {
+			_, err := h.GetTimeline(asUser(2), &feedpb.GetTimelineRequest{UserId: 1})
+			return err
+		}, codes.PermissionDenied},
+		{"viewing as someone else", func() error {
+			_, err := h.GetContent(asUser(1), &feedpb.ContentID{ContentId: 1, ViewerId: 3})
+			return err
+		}, codes.PermissionDenied},
+		{"invisible content", func() error {
+			_, err := h.GetContent(asUser(1), &feedpb.ContentID{ContentId: 2})
+			return err
+		}, codes.NotFound},
+		{"reactions of invisible content", func() error {
+			_, err := h.GetReactions(asUser(1), &feedpb.ContentID{ContentId: 2})
+			return err
+		}, codes.NotFound},
+		{"invisible media", func() error {
+			_, err := h.GetMediaRef(asUser(1), &feedpb.ContentID{ContentId: 2})
+			return err
+		}, codes.NotFound},
+		{"delete by another user", func() error {
+			_, err := h.DeleteContent(asUser(2), &feedpb.ContentID{ContentId: 1})
+			return err
+		}, codes.PermissionDenied},
+		{"friendship of others", func() error {
+			_, err := h.FriendshipAccepted(asUser(3), &feedpb.FriendshipRequest{UserId: 1, FriendId: 2})
+			return err
+		}, codes.PermissionDenied},
+		{"friendship by the friend", func() error {
+			_, err := h.FriendshipAccepted(asUser(2), &feedpb.FriendshipRequest{UserId: 1, FriendId: 2})
+			return err
+		}, codes.OK},
+		{"delete by the author", func() error {
+			_, err := h.DeleteContent(asUser(1), &feedpb.ContentID{ContentId: 1})
+			return err
+		}, codes.OK},
+	}
+	for _, c := range cases {
+		if err := c.call(); status.Code(err) != c.code {
+			t.Errorf("%s: want %v, got %v", c.name, c.code, err)
+		}
+	}
+
+	// content RPCs act for the authenticated caller when the request names no viewer
+	if len(viewers) != 1 || viewers[0] != 1 || len(requesters) != 2 || requesters[0] != 2 || requesters[1] != 1 {
+		t.Fatalf("expected the caller as viewer and requester, got viewers=%v requesters=%v", viewers, requesters)
+	}
+}
+
+func TestHandlers_Explore(t *testing.T) {
+	var gotQuery TimelineQuery
+	h := newHandlers(&fakeFeedSvc{
+		MarkReelViewedFn: func(ctx context.Context, v, rid int64) error {
+			if rid == 2 {
+				return ErrNotAReel
+			}
+			return nil
+		},
+		GetExploreReelsFn: func(ctx context.Context, v int64, q TimelineQuery) (*TimelinePage, error) {
+			if q.Cursor == "bad" {
+				return nil, ErrInvalidCursor
+			}
+			gotQuery = q
+			return &TimelinePage{Contents: []dbmysql.Content{{ContentID: 7, AuthorID: 5, Type: "REEL", Privacy: "public"}}, MediaURLs: []string{"/media/1"}, NextCursor: "n"}, nil
+		},
+	})
+	ctx := asUser(1)
+
+	if _, err := h.MarkReelViewed(ctx, &feedpb.ReelViewRequest{ReelId: 1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("MarkReelViewed no viewer: expected InvalidArgument, got %v", err)
+	}
+	if _, err := h.MarkReelViewed(ctx, &feedpb.ReelViewRequest{ReelId: 1, ViewerId: 3}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("MarkReelViewed for someone else: expected PermissionDenied, got %v", err)
+	}
+	if _, err := h.MarkReelViewed(ctx, &feedpb.ReelViewRequest{ReelId: 2, ViewerId: 1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("MarkReelViewed on a post: expected InvalidArgument, got %v", err)
+	}
+	if _, err := h.MarkReelViewed(ctx, &feedpb.ReelViewRequest{ReelId: 1, ViewerId: 1}); err != nil {
+		t.Errorf("MarkReelViewed err: %v", err)
+	}
+
+	if _, err := h.GetExploreReels(context.Background(), &feedpb.ExploreReelsRequest{ViewerId: 1}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("GetExploreReels without a token: expected Unauthenticated, got %v", err)
+	}
+	if _, err := h.GetExploreReels(ctx, &feedpb.ExploreReelsRequest{ViewerId: 1, PageSize: -1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetExploreReels negative page size: expected InvalidArgument, got %v", err)
+	}
+	if _, err := h.GetExploreReels(ctx, &feedpb.ExploreReelsRequest{ViewerId: 1, Cursor: "bad"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetExploreReels bad cursor: expected InvalidArgument, got %v", err)
+	}
+	resp, err := h.GetExploreReels(ctx, &feedpb.ExploreReelsRequest{ViewerId: 1, Cursor: "c", PageSize: 5})
+	if err != nil || len(resp.Contents) != 1 || resp.Contents[0].ContentId != 7 || resp.NextCursor != "n" {
+		t.Fatalf("GetExploreReels mismatch: %+v err=%v", resp, err)
+	}
+	if gotQuery.Cursor != "c" || gotQuery.PageSize != 5 {
+		t.Fatalf("query not passed through: %+v", gotQuery)
+	}
+}
+
+func TestHandlers_Scheduling(t *testing.T) {
+	var gotPublishAt *time.Time
+	publishAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	h := newHandlers(&fakeFeedSvc{
+		CreatePostFn: func(ctx context.Context, a int64, t string, d []byte, n, mt, p string, l int64, at *time.Time) (int64, error) {
+			if at != nil && !at.After(time.Now()) {
+				return 0, ErrInvalidPublishTime
+			}
+			gotPublishAt = at
+			return 8, nil
+		},
+		GetContentFn: func(ctx context.Context, id int64) (*dbmysql.Content, string, error) {
+			return &dbmysql.Content{ContentID: id, AuthorID: 1, Type: "REEL"}, "", nil
+		},
+		ListScheduledContentFn: func(ctx context.Context, a int64, q TimelineQuery) (*TimelinePage, error) {
+			return &TimelinePage{Contents: []dbmysql.Content{{ContentID: 8, AuthorID: 1, Type: "POST", CreatedAt: publishAt}}, MediaURLs: []string{""}}, nil
+		},
+		RescheduleContentFn: func(ctx context.Context, a, id int64, at time.Time) (*dbmysql.Content, error) {
+			if id == 2 {
+				return nil, ErrNotScheduled
+			}
+			return &dbmysql.Content{ContentID: id, AuthorID: a, Type: "REEL", CreatedAt: at, PublishAt: &at}, nil
+		},
+		CancelScheduledContentFn: func(ctx context.Context, a, id int64) error {
+			if id == 2 {
+				return ErrContentNotVisible
+			}
+			return nil
+		},
+	})
+	ctx := asUser(1)
+
+	post := &feedpb.CreatePostRequest{AuthorId: 1, Text: "soon", MediaType: "text", Privacy: "public", MediaName: "n", PublishAt: timestamppb.New(time.Now().Add(-time.Hour))}
+	if _, err := h.CreatePost(ctx, post); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CreatePost in the past: expected InvalidArgument, got %v", err)
+	}
+	post.PublishAt = &timestamppb.Timestamp{Seconds: 1, Nanos: -1}
+	if _, err := h.CreatePost(ctx, post); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CreatePost invalid timestamp: expected InvalidArgument, got %v", err)
+	}
+	post.PublishAt = timestamppb.New(publishAt)
+	if _, err := h.CreatePost(ctx, post); err != nil || gotPublishAt == nil || !gotPublishAt.Equal(publishAt) {
+		t.Fatalf("CreatePost scheduled: publishAt=%v err=%v", gotPublishAt, err)
+	}
+
+	if _, err := h.ListScheduledContent(ctx, &feedpb.ListScheduledContentRequest{AuthorId: 3}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ListScheduledContent for someone else: expected PermissionDenied, got %v", err)
+	}
+	list, err := h.ListScheduledContent(ctx, &feedpb.ListScheduledContentRequest{AuthorId: 1})
+	if err != nil || len(list.Contents) != 1 || !list.Contents[0].CreatedAt.AsTime().Equal(publishAt) {
+		t.Fatalf("ListScheduledContent mismatch: %+v err=%v", list, err)
+	}
+
+	if _, err := h.RescheduleContent(ctx, &feedpb.RescheduleContentRequest{ContentId: 1, AuthorId: 1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("RescheduleContent without a time: expected InvalidArgument, got %v", err)
+	}
+	if _, err := h.RescheduleContent(ctx, &feedpb.RescheduleContentRequest{ContentId: 2, AuthorId: 1, PublishAt: timestamppb.New(publishAt)}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("RescheduleContent published content: expected FailedPrecondition, got %v", err)
+	}
+	resp, err := h.RescheduleContent(ctx, &feedpb.RescheduleContentRequest{ContentId: 1, AuthorId: 1, PublishAt: timestamppb.New(publishAt)})
+	if err != nil || !resp.Content.CreatedAt.AsTime().Equal(publishAt) {
+		t.Fatalf("RescheduleContent mismatch: %+v err=%v", resp, err)
+	}
+
+	if _, err := h.CancelScheduledContent(ctx, &feedpb.CancelScheduledContentRequest{ContentId: 2, AuthorId: 1}); status.Code(err) != codes.NotFound {
+		t.Errorf("CancelScheduledContent unknown content: expected NotFound, got %v", err)
+	}
+	if _, err := h.CancelScheduledContent(ctx, &feedpb.CancelScheduledContentRequest{ContentId: 1, AuthorId: 1}); err != nil {
+		t.Errorf("CancelScheduledContent err: %v", err)
+	}
+}
+
+func TestHandlers_Drafts(t *testing.T) {
+	var saved Draft
+	var gotPublishAt *time.Time
+	h := newHandlers(&fakeFeedSvc{
+		SaveDraftFn: func(ctx context.Context, a int64, d Draft) (*dbmysql.Content, error) {
+			if d.Type == "STORY" {
+				return nil, ErrInvalidDraft
+			}
+			saved = d
+			return &dbmysql.Content{ContentID: 5, AuthorID: a, Type: d.Type, Privacy: d.Privacy, Draft: true}, nil
+		},
+		GetContentFn: func(ctx context.Context, id int64) (*dbmysql.Content, string, error) {
+			return &dbmysql.Content{ContentID: id, AuthorID: 1, Type: "POST"}, "/media/1", nil
+		},
+		ListDraftsFn: func(ctx context.Context, a int64, q TimelineQuery) (*TimelinePage, error) {
+			if q.Cursor == "bad" {
+				return nil, ErrInvalidCursor
+			}
+			return &TimelinePage{Contents: []dbmysql.Content{{ContentID: 5, AuthorID: a, Type: "POST", Draft: true}}, MediaURLs: []string{""}, NextCursor: "n"}, nil
+		},
+		UpdateDraftFn: func(ctx context.Context, a, id int64, d Draft) (*dbmysql.Content, error) {
+			switch id {
+			case 2:
+				return nil, ErrNotADraft
+			case 3:
+				return nil, ErrMediaNotFound
+			}
+			return &dbmysql.Content{ContentID: id, AuthorID: a, Type: "POST", Privacy: d.Privacy, Draft: true}, nil
+		},
+		PublishDraftFn: func(ctx context.Context, a, id int64, at *time.Time) (*dbmysql.Content, error) {
+			if id == 2 {
+				return nil, ErrIncompleteDraft
+			}
+			gotPublishAt = at
+			return &dbmysql.Content{ContentID: id, AuthorID: a, Type: "POST"}, nil
+		},
+		DiscardDraftFn: func(ctx context.Context, a, id int64) error {
+			if id == 2 {
+				return ErrContentNotVisible
+			}
+			return nil
+		},
+	})
+	ctx := asUser(1)
+
+	if _, err := h.SaveDraft(ctx, &feedpb.SaveDraftRequest{AuthorId: 3, Type: "POST", Privacy: "public"}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("SaveDraft for someone else: expected PermissionDenied, got %v", err)
+	}
+	if _, err := h.SaveDraft(ctx, &feedpb.SaveDraftRequest{AuthorId: 1, Type: "POST"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("SaveDraft without privacy: expected InvalidArgument, got %v", err)
+	}
+	if _, err := h.SaveDraft(ctx, &feedpb.SaveDraftRequest{AuthorId: 1, Type: "STORY", Privacy: "public"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("SaveDraft story: expected InvalidArgument, got %v", err)
+	}
+	resp, err := h.SaveDraft(ctx, &feedpb.SaveDraftRequest{AuthorId: 1, Type: "REEL", Text: "wip", MediaRefIds: []int64{7}, DurationSecs: 9, Privacy: "public"})
+	if err != nil || resp.Content.ContentId != 5 || resp.Content.MediaUrl != "/media/1" {
+		t.Fatalf("SaveDraft mismatch: %+v err=%v", resp, err)
+	}
+	if saved.Type != "REEL" || saved.Text != "wip" || len(saved.MediaRefIDs) != 1 || saved.DurationSec != 9 {
+		t.Fatalf("draft not passed through: %+v", saved)
+	}
+
+	if _, err := h.ListDrafts(ctx, &feedpb.ListDraftsRequest{AuthorId: 1, Cursor: "bad"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ListDrafts bad cursor: expected InvalidArgument, got %v", err)
+	}
+	list, err := h.ListDrafts(ctx, &feedpb.ListDraftsRequest{AuthorId: 1})
+	if err != nil || len(list.Contents) != 1 || list.NextCursor != "n" {
+		t.Fatalf("ListDrafts mismatch: %+v err=%v", list, err)
+	}
+
+	if _, err := h.UpdateDraft(ctx, &feedpb.UpdateDraftRequest{ContentId: 2, AuthorId: 1, Privacy: "public"}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("UpdateDraft published content: expected FailedPrecondition, got %v", err)
+	}
+	if _, err := h.UpdateDraft(ctx, &feedpb.UpdateDraftRequest{ContentId: 3, AuthorId: 1, Privacy: "public"}); status.Code(err) != codes.NotFound {
+		t.Errorf("UpdateDraft unknown media: expected NotFound, got %v", err)
+	}
+	if updated, err := h.UpdateDraft(ctx, &feedpb.UpdateDraftRequest{ContentId: 1, AuthorId: 1, Privacy: "friends"}); err != nil || updated.Content.Privacy != "friends" {
+		t.Fatalf("UpdateDraft mismatch: %+v err=%v", updated, err)
+	}
+
+	if _, err := h.PublishDraft(ctx, &feedpb.PublishDraftRequest{ContentId: 2, AuthorId: 1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("PublishDraft incomplete: expected InvalidArgument, got %v", err)
+	}
+	published, err := h.PublishDraft(ctx, &feedpb.PublishDraftRequest{ContentId: 1, AuthorId: 1})
+	if err != nil || published.ContentId != 1 || gotPublishAt != nil {
+		t.Fatalf("PublishDraft mismatch: %+v at=%v err=%v", published, gotPublishAt, err)
+	}
+	publishAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	if _, err := h.PublishDraft(ctx, &feedpb.PublishDraftRequest{ContentId: 1, AuthorId: 1, PublishAt: timestamppb.New(publishAt)}); err != nil || gotPublishAt == nil || !gotPublishAt.Equal(publishAt) {
+		t.Fatalf("PublishDraft scheduled: at=%v err=%v", gotPublishAt, err)
+	}
+
+	if _, err := h.DiscardDraft(ctx, &feedpb.DiscardDraftRequest{ContentId: 2, AuthorId: 1}); status.Code(err) != codes.NotFound {
+		t.Errorf("DiscardDraft unknown draft: expected NotFound, got %v", err)
+	}
+	if _, err := h.DiscardDraft(ctx, &feedpb.DiscardDraftRequest{ContentId: 1, AuthorId: 1}); err != nil {
+		t.Errorf("DiscardDraft err: %v", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"sort"
 	"strconv"
 	"testing"
@@ -13,136 +14,1173 @@ import (
 	"gosocial/internal/dbmysql"
 
 	"google.golang.org/grpc"
+	"gorm.io/gorm"
 )
 
 // ---------- Fakes for Content/Media/Reaction repos ----------
 
 type fakeContentRepo struct {
-	m    map[int64]dbmysql.Content
-	next int64
+	m         map[int64]dbmysql.Content
+	next      int64
+	revisions []dbmysql.ContentRevision
+
+	// decides who sees list content in timelines, nil hides it from everyone but its author
+	audience *fakeAudienceRepo
 }
 
 func newFakeContentRepo() *fakeContentRepo {
 	return &fakeContentRepo{m: map[int64]dbmysql.Content{}, next: 1}
 }
-func (r *fakeContentRepo) CreateContent(ctx context.Context, c *dbmysql.Content) error {
-	if c.ContentID == 0 {
-		c.ContentID = r.next
-		r.next++
+func (r *fakeContentRepo) CreateContent(ctx context.Context, c *dbmysql.Content) error {
+	if c.ContentID == 0 {
+		c.ContentID = r.next
+		r.next++
+	}
+	if c.CreatedAt.IsZero() {
+		c.CreatedAt = time.Now()
+	}
+	cp := *c
+	r.m[c.ContentID] = cp
+	return nil
+}
+func (r *fakeContentRepo) GetContentByID(ctx context.Context, id int64) (*dbmysql.Content, error) {
+	c, ok := r.m[id]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	cp := c
+	return &cp, nil
+}
+func (r *fakeContentRepo) ListUserContent(ctx context.Context, userID int64) ([]dbmysql.Content, error) {
+	var out []dbmysql.Content
+	for _, v := range r.m {
+		if v.AuthorID == userID && v.ArchivedAt == nil && v.PublishAt == nil && !v.Draft {
+			x := v
+			out = append(out, x)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].CreatedAt.Before(out[j].CreatedAt) })
+	return out, nil
+}
+func (r *fakeContentRepo) ListContentsByIDs(ctx context.Context, ids []int64) ([]dbmysql.Content, error) {
+	var out []dbmysql.Content
+	for _, id := range ids {
+		if c, ok := r.m[id]; ok {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+func (r *fakeContentRepo) CountShares(ctx context.Context, ids []int64) (map[int64]int64, error) {
+	wanted := map[int64]bool{}
+	for _, id := range ids {
+		wanted[id] = true
+	}
+	counts := map[int64]int64{}
+	for _, c := range r.m {
+		if c.SharedContentID != nil && wanted[*c.SharedContentID] {
+			counts[*c.SharedContentID]++
+		}
+	}
+	return counts, nil
+}
+func (r *fakeContentRepo) ListTimeline(ctx context.Context, viewerID int64, authorIDs []int64, cursor *TimelineCursor, limit int) ([]dbmysql.Content, error) {
+	authors := map[int64]bool{}
+	for _, id := range authorIDs {
+		authors[id] = true
+	}
+	var out []dbmysql.Content
+	for _, v := range r.m {
+		if !authors[v.AuthorID] || v.ArchivedAt != nil || v.PublishAt != nil || v.Draft || (v.AuthorID != viewerID && v.Privacy == "private") {
+			continue
+		}
+		if v.AuthorID != viewerID && v.Privacy == "list" && !r.audience.isMember(v.AudienceListID, viewerID) {
+			continue
+		}
+		if cursor != nil && !v.CreatedAt.Before(cursor.CreatedAt) &&
+			!(v.CreatedAt.Equal(cursor.CreatedAt) && v.ContentID < cursor.ContentID) {
+			continue
+		}
+		out = append(out, v)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].CreatedAt.Equal(out[j].CreatedAt) {
+			return out[i].ContentID > out[j].ContentID
+		}
+		return out[i].CreatedAt.After(out[j].CreatedAt)
+	})
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+func (r *fakeContentRepo) UpdateContent(ctx context.Context, c *dbmysql.Content, rev *dbmysql.ContentRevision) error {
+	if _, ok := r.m[c.ContentID]; !ok {
+		return errors.New("not found")
+	}
+	rev.RevisionID = int64(len(r.revisions) + 1)
+	r.revisions = append(r.revisions, *rev)
+	r.m[c.ContentID] = *c
+	return nil
+}
+func (r *fakeContentRepo) ListRevisions(ctx context.Context, contentID, afterID int64, limit int) ([]dbmysql.ContentRevision, error) {
+	var out []dbmysql.ContentRevision
+	for _, rev := range r.revisions {
+		if rev.ContentID == contentID && rev.RevisionID > afterID && len(out) < limit {
+			out = append(out, rev)
+		}
+	}
+	return out, nil
+}
+func (r *fakeContentRepo) ListContentMedia(ctx context.Context, ids []int64) (map[int64][]dbmysql.ContentMedia, error) {
+	out := map[int64][]dbmysql.ContentMedia{}
+	for _, id := range ids {
+		if c, ok := r.m[id]; ok && len(c.Media) > 0 {
+			out[id] = c.Media
+		}
+	}
+	return out, nil
+}
+func (r *fakeContentRepo) IsMediaAttached(ctx context.Context, mediaRefID int64) (bool, error) {
+	for _, c := range r.m {
+		if c.MediaRefID != nil && *c.MediaRefID == mediaRefID {
+			return true, nil
+		}
+		for _, m := range c.Media {
+			if m.MediaRefID == mediaRefID {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+func (r *fakeContentRepo) ListContentByMedia(ctx context.Context, mediaRefID int64) ([]dbmysql.Content, error) {
+	var out []dbmysql.Content
+	for _, c := range r.m {
+		uses := c.MediaRefID != nil && *c.MediaRefID == mediaRefID
+		for _, m := range c.Media {
+			uses = uses || m.MediaRefID == mediaRefID
+		}
+		if uses {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+func (r *fakeContentRepo) ListExploreReels(ctx context.Context, excludeAuthorIDs, excludeIDs []int64, since time.Time, limit int) ([]dbmysql.Content, error) {
+	excluded := func(ids []int64, id int64) bool {
+		for _, x := range ids {
+			if x == id {
+				return true
+			}
+		}
+		return false
+	}
+	var out []dbmysql.Content
+	for _, c := range r.m {
+		if c.Type == "REEL" && c.Privacy == "public" && c.ArchivedAt == nil && c.PublishAt == nil && !c.Draft && !c.CreatedAt.Before(since) &&
+			!excluded(excludeAuthorIDs, c.AuthorID) && !excluded(excludeIDs, c.ContentID) {
+			out = append(out, c)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].CreatedAt.Equal(out[j].CreatedAt) {
+			return out[i].CreatedAt.After(out[j].CreatedAt)
+		}
+		return out[i].ContentID > out[j].ContentID
+	})
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+func (r *fakeContentRepo) DeleteContent(ctx context.Context, id int64) error {
+	delete(r.m, id)
+	return nil
+}
+func (r *fakeContentRepo) ListExpiredStories(ctx context.Context, now time.Time) ([]dbmysql.Content, error) {
+	var out []dbmysql.Content
+	for _, v := range r.m {
+		if v.Type == "STORY" && v.Expiration != nil && !v.Expiration.After(now) && v.ArchivedAt == nil {
+			out = append(out, v)
+		}
+	}
+	return out, nil
+}
+func (r *fakeContentRepo) ArchiveContent(ctx context.Context, id int64, at time.Time) error {
+	c, ok := r.m[id]
+	if !ok {
+		return errors.New("not found")
+	}
+	c.ArchivedAt = &at
+	r.m[id] = c
+	return nil
+}
+func (r *fakeContentRepo) ListArchivedStories(ctx context.Context, authorID int64, cursor *TimelineCursor, limit int) ([]dbmysql.Content, error) {
+	var out []dbmysql.Content
+	for _, v := range r.m {
+		if v.AuthorID != authorID || v.Type != "STORY" || v.ArchivedAt == nil {
+			continue
+		}
+		if cursor != nil && !v.CreatedAt.Before(cursor.CreatedAt) &&
+			!(v.CreatedAt.Equal(cursor.CreatedAt) && v.ContentID < cursor.ContentID) {
+			continue
+		}
+		out = append(out, v)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].CreatedAt.Equal(out[j].CreatedAt) {
+			return out[i].ContentID > out[j].ContentID
+		}
+		return out[i].CreatedAt.After(out[j].CreatedAt)
+	})
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+func (r *fakeContentRepo) ListScheduledContent(ctx context.Context, authorID int64, cursor *TimelineCursor, limit int) ([]dbmysql.Content, error) {
+	var out []dbmysql.Content
+	for _, v := range r.m {
+		if v.AuthorID != authorID || v.PublishAt == nil {
+			continue
+		}
+		if cursor != nil && !v.CreatedAt.After(cursor.CreatedAt) &&
+			!(v.CreatedAt.Equal(cursor.CreatedAt) && v.ContentID > cursor.ContentID) {
+			continue
+		}
+		out = append(out, v)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].CreatedAt.Equal(out[j].CreatedAt) {
+			return out[i].ContentID < out[j].ContentID
+		}
+		return out[i].CreatedAt.Before(out[j].CreatedAt)
+	})
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+func (r *fakeContentRepo) ListDueContent(ctx context.Context, now time.Time) ([]dbmysql.Content, error) {
+	var out []dbmysql.Content
+	for _, v := range r.m {
+		if v.PublishAt != nil && !v.PublishAt.After(now) {
+			out = append(out, v)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].PublishAt.Before(*out[j].PublishAt) })
+	return out, nil
+}
+func (r *fakeContentRepo) RescheduleContent(ctx context.Context, id int64, publishAt time.Time) (bool, error) {
+	c, ok := r.m[id]
+	if !ok || c.PublishAt == nil {
+		return false, nil
+	}
+	c.PublishAt = &publishAt
+	c.CreatedAt = publishAt
+	r.m[id] = c
+	return true, nil
+}
+func (r *fakeContentRepo) PublishContent(ctx context.Context, id int64) (bool, error) {
+	c, ok := r.m[id]
+	if !ok || c.PublishAt == nil {
+		return false, nil
+	}
+	c.PublishAt = nil
+	r.m[id] = c
+	return true, nil
+}
+func (r *fakeContentRepo) ListDrafts(ctx context.Context, authorID int64, cursor *TimelineCursor, limit int) ([]dbmysql.Content, error) {
+	var out []dbmysql.Content
+	for _, v := range r.m {
+		if v.AuthorID != authorID || !v.Draft {
+			continue
+		}
+		if cursor != nil && !v.CreatedAt.Before(cursor.CreatedAt) &&
+			!(v.CreatedAt.Equal(cursor.CreatedAt) && v.ContentID < cursor.ContentID) {
+			continue
+		}
+		out = append(out, v)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].CreatedAt.Equal(out[j].CreatedAt) {
+			return out[i].ContentID > out[j].ContentID
+		}
+		return out[i].CreatedAt.After(out[j].CreatedAt)
+	})
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+func (r *fakeContentRepo) ListAbandonedDrafts(ctx context.Context, before time.Time) ([]dbmysql.Content, error) {
+	var out []dbmysql.Content
+	for _, v := range r.m {
+		if v.Draft && v.UpdatedAt.Before(before) {
+			out = append(out, v)
+		}
+	}
+	return out, nil
+}
+func (r *fakeContentRepo) UpdateDraft(ctx context.Context, c *dbmysql.Content) (bool, error) {
+	if old, ok := r.m[c.ContentID]; !ok || !old.Draft {
+		return false, nil
+	}
+	r.m[c.ContentID] = *c
+	return true, nil
+}
+func (r *fakeContentRepo) PublishDraft(ctx context.Context, id int64, createdAt time.Time, publishAt *time.Time) (bool, error) {
+	c, ok := r.m[id]
+	if !ok || !c.Draft {
+		return false, nil
+	}
+	c.Draft = false
+	c.CreatedAt = createdAt
+	c.PublishAt = publishAt
+	r.m[id] = c
+	return true, nil
+}
+
+type fakeMediaRepo struct {
+	meta       map[int64]dbmysql.MediaRef
+	data       map[int64][]byte
+	next       int64
+	deleteErr  error
+	batchCalls int
+	failName   string // CreateMediaRef fails for files with this name
+}
+
+func newFakeMediaRepo() *fakeMediaRepo {
+	return &fakeMediaRepo{meta: map[int64]dbmysql.MediaRef{}, data: map[int64][]byte{}, next: 1}
+}
+func (m *fakeMediaRepo) CreateMediaRef(ctx context.Context, media *dbmysql.MediaRef, fileData []byte) error {
+	if m.failName != "" && media.FileName == m.failName {
+		return errors.New("upload failed")
+	}
+	media.MediaRefID = uint(m.next)
+	m.next++
+	if media.FileID == "" {
+		media.FileID = "deadbeef"
+	}
+	m.meta[int64(media.MediaRefID)] = *media
+	m.data[int64(media.MediaRefID)] = append([]byte{}, fileData...)
+	return nil
+}
+func (m *fakeMediaRepo) UploadMediaRef(ctx context.Context, media *dbmysql.MediaRef, content io.Reader) error {
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return err
+	}
+	if err := m.CreateMediaRef(ctx, media, data); err != nil {
+		return err
+	}
+	media.Size = int64(len(data))
+	m.meta[int64(media.MediaRefID)] = *media
+	return nil
+}
+func (m *fakeMediaRepo) GetMediaRefByID(ctx context.Context, id int64) (*dbmysql.MediaRef, []byte, error) {
+	meta, ok := m.meta[id]
+	if !ok {
+		return nil, nil, errors.New("not found")
+	}
+	cp := meta
+	return &cp, append([]byte{}, m.data[id]...), nil
+}
+func (m *fakeMediaRepo) ListMediaRefsByIDs(ctx context.Context, ids []int64) ([]dbmysql.MediaRef, error) {
+	m.batchCalls++
+	var out []dbmysql.MediaRef
+	for _, id := range ids {
+		if meta, ok := m.meta[id]; ok {
+			out = append(out, meta)
+		}
+	}
+	return out, nil
+}
+func (m *fakeMediaRepo) DeleteMedia(ctx context.Context, id int64) error {
+	if m.deleteErr != nil {
+		return m.deleteErr
+	}
+	delete(m.meta, id)
+	delete(m.data, id)
+	return nil
+}
+
+type fakeReactionRepo struct {
+	m        map[string]dbmysql.Reaction
+	next     int64
+	contents *fakeContentRepo // resolves authors for CountInteractions when set
+}
+
+func newFakeReactionRepo() *fakeReactionRepo {
+	return &fakeReactionRepo{m: map[string]dbmysql.Reaction{}}
+}
+func key(u, c int64) string { return fmt.Sprintf("%d|%d", u, c) }
+
+func (r *fakeReactionRepo) AddReaction(ctx context.Context, rx *dbmysql.Reaction) error {
+	r.next++
+	rx.ID = r.next
+	r.m[key(rx.UserID, rx.ContentID)] = *rx
+	return nil
+}
+func (r *fakeReactionRepo) GetReactionsForContent(ctx context.Context, contentID int64) ([]dbmysql.Reaction, error) {
+	var out []dbmysql.Reaction
+	for _, v := range r.m {
+		if v.ContentID == contentID {
+			out = append(out, v)
+		}
+	}
+	return out, nil
+}
+func (r *fakeReactionRepo) DeleteReaction(ctx context.Context, u, c int64) error {
+	delete(r.m, key(u, c))
+	return nil
+}
+func (r *fakeReactionRepo) CountReactions(ctx context.Context, contentIDs []int64) (map[int64]int64, error) {
+	counts := map[int64]int64{}
+	for _, id := range contentIDs {
+		for _, v := range r.m {
+			if v.ContentID == id {
+				counts[id]++
+			}
+		}
+	}
+	return counts, nil
+}
+func (r *fakeReactionRepo) CountInteractions(ctx context.Context, viewerID int64, authorIDs []int64) (map[int64]int64, error) {
+	counts := map[int64]int64{}
+	if r.contents == nil {
+		return counts, nil
+	}
+	for _, v := range r.m {
+		c, ok := r.contents.m[v.ContentID]
+		if !ok || v.UserID != viewerID {
+			continue
+		}
+		for _, a := range authorIDs {
+			if c.AuthorID == a {
+				counts[a]++
+			}
+		}
+	}
+	return counts, nil
+}
+func (r *fakeReactionRepo) CountReactionsByType(ctx context.Context, contentIDs []int64) (map[int64]map[string]int64, error) {
+	counts := map[int64]map[string]int64{}
+	for _, id := range contentIDs {
+		for _, v := range r.m {
+			if v.ContentID != id {
+				continue
+			}
+			if counts[id] == nil {
+				counts[id] = map[string]int64{}
+			}
+			counts[id][v.Type]++
+		}
+	}
+	return counts, nil
+}
+func (r *fakeReactionRepo) GetViewerReactions(ctx context.Context, viewerID int64, contentIDs []int64) (map[int64]string, error) {
+	types := map[int64]string{}
+	for _, id := range contentIDs {
+		if v, ok := r.m[key(viewerID, id)]; ok {
+			types[id] = v.Type
+		}
+	}
+	return types, nil
+}
+func (r *fakeReactionRepo) ListReactors(ctx context.Context, contentID int64, reactionType string, afterID int64, limit int) ([]dbmysql.Reaction, error) {
+	var out []dbmysql.Reaction
+	for _, v := range r.m {
+		if v.ContentID == contentID && v.ID > afterID && (reactionType == "" || v.Type == reactionType) {
+			out = append(out, v)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+type fakeStoryViewRepo struct {
+	views []dbmysql.StoryView
+}
+
+func (r *fakeStoryViewRepo) RecordStoryView(ctx context.Context, v *dbmysql.StoryView) error {
+	for _, existing := range r.views {
+		if existing.StoryID == v.StoryID && existing.ViewerID == v.ViewerID {
+			return nil
+		}
+	}
+	v.ID = int64(len(r.views) + 1)
+	r.views = append(r.views, *v)
+	return nil
+}
+func (r *fakeStoryViewRepo) ListStoryViews(ctx context.Context, storyID, afterID int64, limit int) ([]dbmysql.StoryView, error) {
+	var out []dbmysql.StoryView
+	for _, v := range r.views {
+		if v.StoryID == storyID && v.ID > afterID && len(out) < limit {
+			out = append(out, v)
+		}
+	}
+	return out, nil
+}
+func (r *fakeStoryViewRepo) ListViewedStories(ctx context.Context, viewerID int64, storyIDs []int64) (map[int64]bool, error) {
+	seen := map[int64]bool{}
+	for _, v := range r.views {
+		for _, id := range storyIDs {
+			if v.StoryID == id && v.ViewerID == viewerID {
+				seen[id] = true
+			}
+		}
+	}
+	return seen, nil
+}
+func (r *fakeStoryViewRepo) DeleteStoryViews(ctx context.Context, storyID int64) error {
+	kept := r.views[:0]
+	for _, v := range r.views {
+		if v.StoryID != storyID {
+			kept = append(kept, v)
+		}
+	}
+	r.views = kept
+	return nil
+}
+
+type fakeReelViewRepo struct {
+	views []dbmysql.ReelView
+}
+
+func (r *fakeReelViewRepo) RecordReelView(ctx context.Context, v *dbmysql.ReelView) error {
+	for _, existing := range r.views {
+		if existing.ReelID == v.ReelID && existing.ViewerID == v.ViewerID {
+			return nil
+		}
+	}
+	v.ID = int64(len(r.views) + 1)
+	r.views = append(r.views, *v)
+	return nil
+}
+func (r *fakeReelViewRepo) CountReelViews(ctx context.Context, reelIDs []int64) (map[int64]int64, error) {
+	counts := map[int64]int64{}
+	for _, v := range r.views {
+		for _, id := range reelIDs {
+			if v.ReelID == id {
+				counts[id]++
+			}
+		}
+	}
+	return counts, nil
+}
+func (r *fakeReelViewRepo) DeleteReelViews(ctx context.Context, reelID int64) error {
+	kept := r.views[:0]
+	for _, v := range r.views {
+		if v.ReelID != reelID {
+			kept = append(kept, v)
+		}
+	}
+	r.views = kept
+	return nil
+}
+
+type fakeHighlightRepo struct {
+	highlights map[int64]dbmysql.Highlight
+	stories    map[int64][]int64 // highlight -> story ids in order
+	contents   *fakeContentRepo
+	next       int64
+}
+
+func newFakeHighlightRepo(contents *fakeContentRepo) *fakeHighlightRepo {
+	return &fakeHighlightRepo{highlights: map[int64]dbmysql.Highlight{}, stories: map[int64][]int64{}, contents: contents, next: 1}
+}
+func (r *fakeHighlightRepo) CreateHighlight(ctx context.Context, h *dbmysql.Highlight, storyIDs []int64) error {
+	h.HighlightID = r.next
+	r.next++
+	for _, existing := range r.highlights {
+		if existing.OwnerID == h.OwnerID && existing.Position >= h.Position {
+			h.Position = existing.Position + 1
+		}
+	}
+	if h.Position == 0 {
+		h.Position = 1
+	}
+	r.highlights[h.HighlightID] = *h
+	r.stories[h.HighlightID] = append([]int64(nil), storyIDs...)
+	return nil
+}
+func (r *fakeHighlightRepo) GetHighlightByID(ctx context.Context, id int64) (*dbmysql.Highlight, error) {
+	h, ok := r.highlights[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &h, nil
+}
+func (r *fakeHighlightRepo) UpdateHighlight(ctx context.Context, id int64, title string, storyIDs []int64) error {
+	h := r.highlights[id]
+	h.Title = title
+	r.highlights[id] = h
+	r.stories[id] = append([]int64(nil), storyIDs...)
+	return nil
+}
+func (r *fakeHighlightRepo) DeleteHighlight(ctx context.Context, id int64) error {
+	delete(r.highlights, id)
+	delete(r.stories, id)
+	return nil
+}
+func (r *fakeHighlightRepo) ListHighlights(ctx context.Context, ownerID int64) ([]dbmysql.Highlight, error) {
+	var out []dbmysql.Highlight
+	for _, h := range r.highlights {
+		if h.OwnerID == ownerID {
+			out = append(out, h)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Position < out[j].Position })
+	return out, nil
+}
+func (r *fakeHighlightRepo) ReorderHighlights(ctx context.Context, ownerID int64, ids []int64) error {
+	for i, id := range ids {
+		h := r.highlights[id]
+		h.Position = i + 1
+		r.highlights[id] = h
+	}
+	return nil
+}
+func (r *fakeHighlightRepo) ListHighlightStories(ctx context.Context, ids []int64) (map[int64][]dbmysql.Content, error) {
+	out := map[int64][]dbmysql.Content{}
+	for _, id := range ids {
+		for _, sid := range r.stories[id] {
+			if c, ok := r.contents.m[sid]; ok {
+				out[id] = append(out[id], c)
+			}
+		}
+	}
+	return out, nil
+}
+func (r *fakeHighlightRepo) HighlightedStories(ctx context.Context, storyIDs []int64) (map[int64]bool, error) {
+	highlighted := map[int64]bool{}
+	for _, sids := range r.stories {
+		for _, sid := range sids {
+			for _, id := range storyIDs {
+				if sid == id {
+					highlighted[id] = true
+				}
+			}
+		}
+	}
+	return highlighted, nil
+}
+func (r *fakeHighlightRepo) RemoveStoryFromHighlights(ctx context.Context, storyID int64) error {
+	for id, sids := range r.stories {
+		var kept []int64
+		for _, sid := range sids {
+			if sid != storyID {
+				kept = append(kept, sid)
+			}
+		}
+		r.stories[id] = kept
+	}
+	return nil
+}
+
+// fakeHashtagRepo keeps the tags of each content and reads privacy and dates from the content fake
+type fakeHashtagRepo struct {
+	tags     map[int64][]string
+	contents *fakeContentRepo
+}
+
+func newFakeHashtagRepo(contents *fakeContentRepo) *fakeHashtagRepo {
+	return &fakeHashtagRepo{tags: map[int64][]string{}, contents: contents}
+}
+func (r *fakeHashtagRepo) TagContent(ctx context.Context, contentID int64, tags []string, at time.Time) error {
+	r.tags[contentID] = append(r.tags[contentID], tags...)
+	return nil
+}
+func (r *fakeHashtagRepo) tagged(c dbmysql.Content, tag string) bool {
+	for _, t := range r.tags[c.ContentID] {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+func (r *fakeHashtagRepo) ListHashtagContent(ctx context.Context, tag string, cursor *TimelineCursor, limit int) ([]dbmysql.Content, error) {
+	var out []dbmysql.Content
+	for _, v := range r.contents.m {
+		if !r.tagged(v, tag) || v.Privacy != "public" || v.ArchivedAt != nil {
+			continue
+		}
+		if cursor != nil && !v.CreatedAt.Before(cursor.CreatedAt) &&
+			!(v.CreatedAt.Equal(cursor.CreatedAt) && v.ContentID < cursor.ContentID) {
+			continue
+		}
+		out = append(out, v)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].CreatedAt.Equal(out[j].CreatedAt) {
+			return out[i].ContentID > out[j].ContentID
+		}
+		return out[i].CreatedAt.After(out[j].CreatedAt)
+	})
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+func (r *fakeHashtagRepo) CountHashtagUses(ctx context.Context, since, until time.Time, tags []string) (map[string]int64, error) {
+	wanted := map[string]bool{}
+	for _, t := range tags {
+		wanted[t] = true
+	}
+	uses := map[string]int64{}
+	for id, list := range r.tags {
+		c, ok := r.contents.m[id]
+		if !ok || c.Privacy != "public" || c.ArchivedAt != nil || c.CreatedAt.Before(since) || !c.CreatedAt.Before(until) {
+			continue
+		}
+		for _, t := range list {
+			if tags == nil || wanted[t] {
+				uses[t]++
+			}
+		}
+	}
+	return uses, nil
+}
+func (r *fakeHashtagRepo) DeleteContentHashtags(ctx context.Context, contentID int64) error {
+	delete(r.tags, contentID)
+	return nil
+}
+
+// fakeMentionRepo keeps mentions in insertion order and reads privacy and dates from the content fake
+type fakeMentionRepo struct {
+	mentions []dbmysql.Mention
+	contents *fakeContentRepo
+}
+
+func newFakeMentionRepo(contents *fakeContentRepo) *fakeMentionRepo {
+	return &fakeMentionRepo{contents: contents}
+}
+func (r *fakeMentionRepo) CreateMentions(ctx context.Context, mentions []dbmysql.Mention) error {
+	for _, m := range mentions {
+		m.MentionID = int64(len(r.mentions) + 1)
+		r.mentions = append(r.mentions, m)
+	}
+	return nil
+}
+func (r *fakeMentionRepo) ListMentions(ctx context.Context, ids []int64) (map[int64][]dbmysql.Mention, error) {
+	wanted := map[int64]bool{}
+	for _, id := range ids {
+		wanted[id] = true
+	}
+	out := map[int64][]dbmysql.Mention{}
+	for _, m := range r.mentions {
+		if wanted[m.ContentID] {
+			out[m.ContentID] = append(out[m.ContentID], m)
+		}
+	}
+	return out, nil
+}
+func (r *fakeMentionRepo) ListMentionedContent(ctx context.Context, userID int64, friendIDs []int64, cursor *TimelineCursor, limit int) ([]dbmysql.Content, error) {
+	friends := map[int64]bool{}
+	for _, id := range friendIDs {
+		friends[id] = true
+	}
+	mentioned := map[int64]bool{}
+	for _, m := range r.mentions {
+		if m.MentionedUserID == userID {
+			mentioned[m.ContentID] = true
+		}
+	}
+	var out []dbmysql.Content
+	for _, v := range r.contents.m {
+		visible := v.AuthorID == userID || v.Privacy == "public" || (v.Privacy == "friends" && friends[v.AuthorID])
+		if !mentioned[v.ContentID] || !visible || v.ArchivedAt != nil {
+			continue
+		}
+		if cursor != nil && !v.CreatedAt.Before(cursor.CreatedAt) &&
+			!(v.CreatedAt.Equal(cursor.CreatedAt) && v.ContentID < cursor.ContentID) {
+			continue
+		}
+		out = append(out, v)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].CreatedAt.Equal(out[j].CreatedAt) {
+			return out[i].ContentID > out[j].ContentID
+		}
+		return out[i].CreatedAt.After(out[j].CreatedAt)
+	})
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+func (r *fakeMentionRepo) DeleteMentions(ctx context.Context, contentID int64) error {
+	var kept []dbmysql.Mention
+	for _, m := range r.mentions {
+		if m.ContentID != contentID {
+			kept = append(kept, m)
+		}
+	}
+	r.mentions = kept
+	return nil
+}
+
+// fakeCollectionRepo keeps saved items in save order
+type fakeCollectionRepo struct {
+	collections []dbmysql.Collection
+	items       []dbmysql.SavedItem
+}
+
+func (r *fakeCollectionRepo) GetOrCreateCollection(ctx context.Context, ownerID int64, name string) (*dbmysql.Collection, error) {
+	for _, c := range r.collections {
+		if c.OwnerID == ownerID && c.Name == name {
+			return &c, nil
+		}
+	}
+	c := dbmysql.Collection{CollectionID: int64(len(r.collections) + 1), OwnerID: ownerID, Name: name, CreatedAt: time.Now()}
+	r.collections = append(r.collections, c)
+	return &c, nil
+}
+func (r *fakeCollectionRepo) GetCollectionByID(ctx context.Context, id int64) (*dbmysql.Collection, error) {
+	for _, c := range r.collections {
+		if c.CollectionID == id {
+			return &c, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+func (r *fakeCollectionRepo) ListCollections(ctx context.Context, ownerID int64) ([]dbmysql.Collection, error) {
+	var out []dbmysql.Collection
+	for _, c := range r.collections {
+		if c.OwnerID == ownerID {
+			out = append(out, c)
+		}
 	}
-	if c.CreatedAt.IsZero() {
-		c.CreatedAt = time.Now()
+	return out, nil
+}
+func (r *fakeCollectionRepo) CountSavedItems(ctx context.Context, ids []int64) (map[int64]int64, error) {
+	counts := map[int64]int64{}
+	for _, item := range r.items {
+		for _, id := range ids {
+			if item.CollectionID == id {
+				counts[id]++
+			}
+		}
 	}
-	cp := *c
-	r.m[c.ContentID] = cp
+	return counts, nil
+}
+func (r *fakeCollectionRepo) SaveItem(ctx context.Context, item *dbmysql.SavedItem) error {
+	for _, existing := range r.items {
+		if existing.CollectionID == item.CollectionID && existing.ContentID == item.ContentID {
+			return nil
+		}
+	}
+	item.SaveID = int64(len(r.items) + 1)
+	r.items = append(r.items, *item)
 	return nil
 }
-func (r *fakeContentRepo) GetContentByID(ctx context.Context, id int64) (*dbmysql.Content, error) {
-	c, ok := r.m[id]
-	if !ok {
-		return nil, errors.New("not found")
+func (r *fakeCollectionRepo) UnsaveItem(ctx context.Context, ids []int64, contentID int64) error {
+	in := map[int64]bool{}
+	for _, id := range ids {
+		in[id] = true
 	}
-	cp := c
-	return &cp, nil
+	r.remove(func(item dbmysql.SavedItem) bool { return in[item.CollectionID] && item.ContentID == contentID })
+	return nil
 }
-func (r *fakeContentRepo) ListUserContent(ctx context.Context, userID int64) ([]dbmysql.Content, error) {
-	var out []dbmysql.Content
-	for _, v := range r.m {
-		if v.AuthorID == userID {
-			x := v
-			out = append(out, x)
+func (r *fakeCollectionRepo) ListSavedItems(ctx context.Context, collectionID, beforeID int64, limit int) ([]dbmysql.SavedItem, error) {
+	var out []dbmysql.SavedItem
+	for i := len(r.items) - 1; i >= 0 && len(out) < limit; i-- {
+		item := r.items[i]
+		if item.CollectionID == collectionID && (beforeID == 0 || item.SaveID < beforeID) {
+			out = append(out, item)
 		}
 	}
-	sort.SliceStable(out, func(i, j int) bool { return out[i].CreatedAt.Before(out[j].CreatedAt) })
 	return out, nil
 }
-func (r *fakeContentRepo) DeleteContent(ctx context.Context, id int64) error {
-	delete(r.m, id)
+func (r *fakeCollectionRepo) DeleteSavedContent(ctx context.Context, contentID int64) error {
+	r.remove(func(item dbmysql.SavedItem) bool { return item.ContentID == contentID })
 	return nil
 }
-func (r *fakeContentRepo) ListExpiredStories(ctx context.Context, now time.Time) ([]dbmysql.Content, error) {
-	var out []dbmysql.Content
-	for _, v := range r.m {
-		if v.Type == "STORY" && v.Expiration != nil && !v.Expiration.After(now) {
-			out = append(out, v)
+func (r *fakeCollectionRepo) remove(match func(dbmysql.SavedItem) bool) {
+	var kept []dbmysql.SavedItem
+	for _, item := range r.items {
+		if !match(item) {
+			kept = append(kept, item)
 		}
 	}
-	return out, nil
+	r.items = kept
 }
 
-type fakeMediaRepo struct {
-	meta      map[int64]dbmysql.MediaRef
-	data      map[int64][]byte
-	next      int64
-	deleteErr error
+// fakeAudienceRepo keeps lists in creation order and members in the order they were added
+type fakeAudienceRepo struct {
+	lists   []dbmysql.AudienceList
+	members map[int64][]int64
 }
 
-func newFakeMediaRepo() *fakeMediaRepo {
-	return &fakeMediaRepo{meta: map[int64]dbmysql.MediaRef{}, data: map[int64][]byte{}, next: 1}
+func newFakeAudienceRepo() *fakeAudienceRepo {
+	return &fakeAudienceRepo{members: map[int64][]int64{}}
 }
-func (m *fakeMediaRepo) CreateMediaRef(ctx context.Context, media *dbmysql.MediaRef, fileData []byte) error {
-	media.MediaRefID = uint(m.next)
-	m.next++
-	if media.FileID == "" {
-		media.FileID = "deadbeef"
+func (r *fakeAudienceRepo) CreateAudienceList(ctx context.Context, list *dbmysql.AudienceList) error {
+	list.ListID = int64(len(r.lists) + 1)
+	r.lists = append(r.lists, *list)
+	return nil
+}
+func (r *fakeAudienceRepo) GetAudienceList(ctx context.Context, id int64) (*dbmysql.AudienceList, error) {
+	for _, l := range r.lists {
+		if l.ListID == id {
+			return &l, nil
+		}
 	}
-	m.meta[int64(media.MediaRefID)] = *media
-	m.data[int64(media.MediaRefID)] = append([]byte{}, fileData...)
+	return nil, gorm.ErrRecordNotFound
+}
+func (r *fakeAudienceRepo) ListAudienceLists(ctx context.Context, ownerID int64) ([]dbmysql.AudienceList, error) {
+	var out []dbmysql.AudienceList
+	for _, l := range r.lists {
+		if l.OwnerID == ownerID {
+			out = append(out, l)
+		}
+	}
+	return out, nil
+}
+func (r *fakeAudienceRepo) DeleteAudienceList(ctx context.Context, id int64) error {
+	var kept []dbmysql.AudienceList
+	for _, l := range r.lists {
+		if l.ListID != id {
+			kept = append(kept, l)
+		}
+	}
+	r.lists = kept
+	delete(r.members, id)
 	return nil
 }
-func (m *fakeMediaRepo) GetMediaRefByID(ctx context.Context, id int64) (*dbmysql.MediaRef, []byte, error) {
-	meta, ok := m.meta[id]
-	if !ok {
-		return nil, nil, errors.New("not found")
+func (r *fakeAudienceRepo) AddAudienceListMembers(ctx context.Context, listID int64, userIDs []int64) error {
+	for _, id := range userIDs {
+		if !r.isMember(&listID, id) {
+			r.members[listID] = append(r.members[listID], id)
+		}
 	}
-	cp := meta
-	return &cp, append([]byte{}, m.data[id]...), nil
+	return nil
 }
-func (m *fakeMediaRepo) DeleteMedia(ctx context.Context, id int64) error {
-	if m.deleteErr != nil {
-		return m.deleteErr
+func (r *fakeAudienceRepo) RemoveAudienceListMembers(ctx context.Context, listID int64, userIDs []int64) error {
+	removed := map[int64]bool{}
+	for _, id := range userIDs {
+		removed[id] = true
 	}
-	delete(m.meta, id)
-	delete(m.data, id)
+	var kept []int64
+	for _, id := range r.members[listID] {
+		if !removed[id] {
+			kept = append(kept, id)
+		}
+	}
+	r.members[listID] = kept
 	return nil
 }
+func (r *fakeAudienceRepo) ListAudienceListMembers(ctx context.Context, listID int64) ([]int64, error) {
+	return r.members[listID], nil
+}
+func (r *fakeAudienceRepo) IsAudienceListMember(ctx context.Context, listID, userID int64) (bool, error) {
+	return r.isMember(&listID, userID), nil
+}
+func (r *fakeAudienceRepo) isMember(listID *int64, userID int64) bool {
+	if r == nil || listID == nil {
+		return false
+	}
+	for _, id := range r.members[*listID] {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
 
-type fakeReactionRepo struct{ m map[string]dbmysql.Reaction }
-
-func newFakeReactionRepo() *fakeReactionRepo {
-	return &fakeReactionRepo{m: map[string]dbmysql.Reaction{}}
+type fakeCommentRepo struct {
+	m    map[int64]dbmysql.Comment
+	next int64
 }
-func key(u, c int64) string { return fmt.Sprintf("%d|%d", u, c) }
 
-func (r *fakeReactionRepo) AddReaction(ctx context.Context, rx *dbmysql.Reaction) error {
-	r.m[key(rx.UserID, rx.ContentID)] = *rx
+func newFakeCommentRepo() *fakeCommentRepo {
+	return &fakeCommentRepo{m: map[int64]dbmysql.Comment{}, next: 1}
+}
+func (r *fakeCommentRepo) CreateComment(ctx context.Context, c *dbmysql.Comment) error {
+	c.CommentID = r.next
+	r.next++
+	r.m[c.CommentID] = *c
 	return nil
 }
-func (r *fakeReactionRepo) GetReactionsForContent(ctx context.Context, contentID int64) ([]dbmysql.Reaction, error) {
-	var out []dbmysql.Reaction
-	for _, v := range r.m {
-		if v.ContentID == contentID {
-			out = append(out, v)
+func (r *fakeCommentRepo) GetCommentByID(ctx context.Context, id int64) (*dbmysql.Comment, error) {
+	c, ok := r.m[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &c, nil
+}
+func (r *fakeCommentRepo) ListComments(ctx context.Context, contentID int64, parentID *int64, afterID int64, limit int) ([]dbmysql.Comment, error) {
+	var out []dbmysql.Comment
+	for id := afterID + 1; id < r.next && len(out) < limit; id++ {
+		c, ok := r.m[id]
+		if !ok || c.ContentID != contentID || (parentID == nil) != (c.ParentID == nil) {
+			continue
 		}
+		if parentID != nil && *c.ParentID != *parentID {
+			continue
+		}
+		out = append(out, c)
 	}
 	return out, nil
 }
-func (r *fakeReactionRepo) DeleteReaction(ctx context.Context, u, c int64) error {
-	delete(r.m, key(u, c))
+func (r *fakeCommentRepo) UpdateCommentText(ctx context.Context, id int64, text string, editedAt time.Time) error {
+	c := r.m[id]
+	c.Text = text
+	c.EditedAt = &editedAt
+	r.m[id] = c
+	return nil
+}
+func (r *fakeCommentRepo) DeleteComment(ctx context.Context, id int64) error {
+	for cid, c := range r.m {
+		if cid == id || (c.ParentID != nil && *c.ParentID == id) {
+			delete(r.m, cid)
+		}
+	}
+	return nil
+}
+func (r *fakeCommentRepo) DeleteCommentsForContent(ctx context.Context, contentID int64) error {
+	for cid, c := range r.m {
+		if c.ContentID == contentID {
+			delete(r.m, cid)
+		}
+	}
+	return nil
+}
+func (r *fakeCommentRepo) CountComments(ctx context.Context, contentIDs []int64) (map[int64]int64, error) {
+	counts := map[int64]int64{}
+	for _, id := range contentIDs {
+		for _, c := range r.m {
+			if c.ContentID == id {
+				counts[id]++
+			}
+		}
+	}
+	return counts, nil
+}
+func (r *fakeCommentRepo) CountReplies(ctx context.Context, commentIDs []int64) (map[int64]int64, error) {
+	counts := map[int64]int64{}
+	for _, id := range commentIDs {
+		for _, c := range r.m {
+			if c.ParentID != nil && *c.ParentID == id {
+				counts[id]++
+			}
+		}
+	}
+	return counts, nil
+}
+
+// fakeTimelineStore keeps owner -> content IDs and reads pages back through the content repo
+type fakeTimelineStore struct {
+	contents *fakeContentRepo
+	entries  map[int64]map[int64]bool
+}
+
+func newFakeTimelineStore(contents *fakeContentRepo) *fakeTimelineStore {
+	return &fakeTimelineStore{contents: contents, entries: map[int64]map[int64]bool{}}
+}
+func (f *fakeTimelineStore) has(ownerID, contentID int64) bool { return f.entries[ownerID][contentID] }
+func (f *fakeTimelineStore) PushToTimelines(ctx context.Context, ownerIDs []int64, c *dbmysql.Content) error {
+	for _, id := range ownerIDs {
+		if f.entries[id] == nil {
+			f.entries[id] = map[int64]bool{}
+		}
+		f.entries[id][c.ContentID] = true
+	}
+	return nil
+}
+func (f *fakeTimelineStore) RemoveFromTimelines(ctx context.Context, contentID int64) error {
+	for _, owned := range f.entries {
+		delete(owned, contentID)
+	}
+	return nil
+}
+func (f *fakeTimelineStore) BackfillTimeline(ctx context.Context, ownerID, authorID int64, limit int) error {
+	recent, _ := f.contents.ListUserContent(ctx, authorID)
+	for i := len(recent) - 1; i >= 0 && limit > 0; i-- {
+		if recent[i].Privacy != "private" {
+			_ = f.PushToTimelines(ctx, []int64{ownerID}, &recent[i])
+			limit--
+		}
+	}
 	return nil
 }
+func (f *fakeTimelineStore) ListMaterializedTimeline(ctx context.Context, ownerID int64, cursor *TimelineCursor, limit int) ([]dbmysql.Content, error) {
+	var authors []int64
+	seen := map[int64]bool{}
+	for id := range f.entries[ownerID] {
+		c := f.contents.m[id]
+		if !seen[c.AuthorID] {
+			seen[c.AuthorID] = true
+			authors = append(authors, c.AuthorID)
+		}
+	}
+	page, err := f.contents.ListTimeline(ctx, ownerID, authors, cursor, len(f.contents.m))
+	var out []dbmysql.Content
+	for _, c := range page {
+		if f.has(ownerID, c.ContentID) && len(out) < limit {
+			out = append(out, c)
+		}
+	}
+	return out, err
+}
 
 // ---------- Fake user client (ListFriends only) ----------
 
 type fakeUserClient struct {
 	userpb.UserServiceClient
-	ListFn func(ctx context.Context, in *userpb.UserID, opts ...grpc.CallOption) (*userpb.FriendList, error)
+	ListFn    func(ctx context.Context, in *userpb.UserID, opts ...grpc.CallOption) (*userpb.FriendList, error)
+	ResolveFn func(ctx context.Context, in *userpb.ResolveHandlesRequest, opts ...grpc.CallOption) (*userpb.ResolveHandlesResponse, error)
+	HandlesFn func(ctx context.Context, in *userpb.GetHandlesRequest, opts ...grpc.CallOption) (*userpb.GetHandlesResponse, error)
+
+	// nil means nobody blocked anyone
+	BlockersFn func(ctx context.Context, in *userpb.UserID, opts ...grpc.CallOption) (*userpb.UserIDList, error)
 }
 
 func (f *fakeUserClient) ListFriends(ctx context.Context, in *userpb.UserID, opts ...grpc.CallOption) (*userpb.FriendList, error) {
 	return f.ListFn(ctx, in, opts...)
 }
 
+func (f *fakeUserClient) ResolveHandles(ctx context.Context, in *userpb.ResolveHandlesRequest, opts ...grpc.CallOption) (*userpb.ResolveHandlesResponse, error) {
+	return f.ResolveFn(ctx, in, opts...)
+}
+
+func (f *fakeUserClient) GetHandles(ctx context.Context, in *userpb.GetHandlesRequest, opts ...grpc.CallOption) (*userpb.GetHandlesResponse, error) {
+	return f.HandlesFn(ctx, in, opts...)
+}
+
+func (f *fakeUserClient) ListBlockers(ctx context.Context, in *userpb.UserID, opts ...grpc.CallOption) (*userpb.UserIDList, error) {
+	if f.BlockersFn == nil {
+		return &userpb.UserIDList{}, nil
+	}
+	return f.BlockersFn(ctx, in, opts...)
+}
+
+// newTestFeedService wires a FeedService with every repository faked
+func newTestFeedService(uc userpb.UserServiceClient) *FeedService {
+	cRepo := newFakeContentRepo()
+	aRepo := newFakeAudienceRepo()
+	cRepo.audience = aRepo
+	return &FeedService{
+		contentRepo:    cRepo,
+		mediaRepo:      newFakeMediaRepo(),
+		reactionRepo:   newFakeReactionRepo(),
+		commentRepo:    newFakeCommentRepo(),
+		storyViewRepo:  &fakeStoryViewRepo{},
+		reelViewRepo:   &fakeReelViewRepo{},
+		highlightRepo:  newFakeHighlightRepo(cRepo),
+		hashtagRepo:    newFakeHashtagRepo(cRepo),
+		mentionRepo:    newFakeMentionRepo(cRepo),
+		collectionRepo: &fakeCollectionRepo{},
+		audienceRepo:   aRepo,
+		UserClient:     uc,
+	}
+}
+
 // ---------- Tests ----------
 
 func TestService_CreateContent_NoMedia_And_WithMedia(t *testing.T) {
@@ -175,10 +1213,9 @@ func TestService_CreateContent_NoMedia_And_WithMedia(t *testing.T) {
 }
 
 func TestService_DeleteContent_MediaDeleteErrorIsIgnored(t *testing.T) {
-	cRepo := newFakeContentRepo()
-	mRepo := newFakeMediaRepo()
-	rRepo := newFakeReactionRepo()
-	svc := &FeedService{contentRepo: cRepo, mediaRepo: mRepo, reactionRepo: rRepo}
+	svc := newTestFeedService(nil)
+	cRepo := svc.contentRepo.(*fakeContentRepo)
+	mRepo := svc.mediaRepo.(*fakeMediaRepo)
 
 	// create content with media
 	txt := "x"
@@ -190,7 +1227,7 @@ func TestService_DeleteContent_MediaDeleteErrorIsIgnored(t *testing.T) {
 	}
 	mRepo.deleteErr = errors.New("gridfs down")
 
-	if err := svc.DeleteContent(context.Background(), id); err != nil {
+	if err := svc.DeleteContent(context.Background(), 9, id); err != nil {
 		t.Fatalf("DeleteContent returned err: %v", err)
 	}
 	if _, ok := cRepo.m[id]; ok {
@@ -205,13 +1242,13 @@ func TestService_CreateReel_And_CreateStory(t *testing.T) {
 	svc := &FeedService{contentRepo: cRepo, mediaRepo: mRepo, reactionRepo: rRepo}
 
 	// reel
-	idr, err := svc.CreateReel(context.Background(), 1, "cap", []byte("v"), "r.mp4", 12, "public")
+	idr, err := svc.CreateReel(context.Background(), 1, "cap", []byte("v"), "r.mp4", 12, "public", nil)
 	if err != nil || idr == 0 {
 		t.Fatalf("CreateReel err=%v id=%d", err, idr)
 	}
 
 	// story
-	ids, err := svc.CreateStory(context.Background(), 2, []byte("p"), "image", "s.png", 60, "friends")
+	ids, err := svc.CreateStory(context.Background(), 2, []byte("p"), "image", "s.png", 60, "friends", 0)
 	if err != nil || ids == 0 {
 		t.Fatalf("CreateStory err=%v id=%d", err, ids)
 	}
@@ -219,6 +1256,13 @@ func TestService_CreateReel_And_CreateStory(t *testing.T) {
 	if st.Type != "STORY" || st.Duration == nil || *st.Duration != 60 || st.Expiration == nil {
 		t.Fatalf("story fields wrong: %+v", st)
 	}
+	if !st.Expiration.Equal(st.CreatedAt.Add(time.Minute)) {
+		t.Fatalf("story should expire a minute after it was created, got %v", st.Expiration)
+	}
+	// a fresh story is not swept up by the cleaner
+	if expired, _ := cRepo.ListExpiredStories(context.Background(), time.Now()); len(expired) != 0 {
+		t.Fatalf("fresh story listed as expired: %+v", expired)
+	}
 }
 
 func TestService_CreateMediaRef_And_GetMediaRef(t *testing.T) {
@@ -232,7 +1276,7 @@ func TestService_CreateMediaRef_And_GetMediaRef(t *testing.T) {
 	if err != nil || id == 0 {
 		t.Fatalf("CreateMediaRef err=%v id=%d", err, id)
 	}
-	meta, data, err := svc.GetMediaRef(context.Background(), id)
+	meta, data, err := svc.GetMediaRef(context.Background(), 1, id)
 	if err != nil || meta.FileID != "deadbeef" || string(data) != "xx" {
 		t.Fatalf("GetMediaRef mismatch: meta=%+v data=%s err=%v", meta, string(data), err)
 	}
@@ -243,6 +1287,7 @@ func TestService_Reactions_SetSemantics(t *testing.T) {
 	mRepo := newFakeMediaRepo()
 	rRepo := newFakeReactionRepo()
 	svc := &FeedService{contentRepo: cRepo, mediaRepo: mRepo, reactionRepo: rRepo}
+	cRepo.m[10] = dbmysql.Content{ContentID: 10, AuthorID: 2, Type: "POST", Privacy: "public"}
 
 	// like then change to love (idempotent "set")
 	if err := svc.ReactToContent(context.Background(), 1, 10, "like"); err != nil {
@@ -251,7 +1296,7 @@ func TestService_Reactions_SetSemantics(t *testing.T) {
 	if err := svc.ReactToContent(context.Background(), 1, 10, "love"); err != nil {
 		t.Fatal(err)
 	}
-	rxs, _ := svc.GetReactions(context.Background(), 10)
+	rxs, _ := svc.GetReactions(context.Background(), 1, 10)
 	if len(rxs) != 1 || rxs[0].Type != "love" {
 		t.Fatalf("want single love, got %+v", rxs)
 	}
@@ -289,10 +1334,11 @@ func TestService_GetTimeline_SortsAndBuildsMediaURLs(t *testing.T) {
 
 	svc := &FeedService{contentRepo: cRepo, mediaRepo: mRepo, reactionRepo: rRepo, UserClient: uc}
 
-	cs, urls, err := svc.GetTimeline(context.Background(), 1)
+	page, err := svc.GetTimeline(context.Background(), 1, TimelineQuery{})
 	if err != nil {
 		t.Fatalf("GetTimeline err: %v", err)
 	}
+	cs, urls := page.Contents, page.MediaURLs
 	// newest first -> self first
 	if len(cs) != 2 || cs[0].AuthorID != 1 {
 		t.Fatalf("unexpected order: %+v", cs)
@@ -395,7 +1441,7 @@ func TestService_GetTimeline_ListFriendsError(t *testing.T) {
 		},
 	}
 	svc := &FeedService{contentRepo: cRepo, mediaRepo: mRepo, reactionRepo: rRepo, UserClient: uc}
-	_, _, err := svc.GetTimeline(context.Background(), 1)
+	_, err := svc.GetTimeline(context.Background(), 1, TimelineQuery{})
 	if err == nil {
 		t.Fatal("expected error")
 	}
@@ -421,72 +1467,143 @@ func TestService_GetUserContent_ListFriendsError(t *testing.T) {
 }
 
 func TestService_ExpiredStoryCleaner(t *testing.T) {
-	cRepo := newFakeContentRepo()
-	mRepo := newFakeMediaRepo()
-	rRepo := newFakeReactionRepo()
+	svc := newTestFeedService(nil)
+	cRepo := svc.contentRepo.(*fakeContentRepo)
+	mRepo := svc.mediaRepo.(*fakeMediaRepo)
 	expiration := time.Now().Add(-1 * time.Minute)
-	_ = cRepo.CreateContent(context.Background(),
-		&dbmysql.Content{AuthorID: 1, Type: "STORY", Privacy: "public", Expiration: &expiration})
-	svc := &FeedService{contentRepo: cRepo, mediaRepo: mRepo, reactionRepo: rRepo}
+	_, _ = svc.CreateContent(context.Background(),
+		&dbmysql.Content{AuthorID: 1, Type: "STORY", Privacy: "public", Expiration: &expiration}, []byte("s"), "image", "s.png")
+	views := svc.storyViewRepo.(*fakeStoryViewRepo)
+	views.views = []dbmysql.StoryView{{ID: 1, StoryID: 1, ViewerID: 2}}
 	// run once manually
 	expired, _ := svc.contentRepo.ListExpiredStories(context.Background(), time.Now())
 	if len(expired) == 0 {
 		t.Fatal("expected expired story present")
 	}
-	for _, story := range expired {
-		_ = svc.DeleteContent(context.Background(), story.ContentID)
+	svc.cleanupExpiredStories(context.Background(), time.Now())
+	story, err := cRepo.GetContentByID(context.Background(), 1)
+	if err != nil || story.ArchivedAt == nil {
+		t.Fatalf("expired story should be archived, got %+v err=%v", story, err)
+	}
+	if len(mRepo.meta) != 1 {
+		t.Fatal("archiving must keep the story media")
+	}
+	if len(views.views) != 0 {
+		t.Fatalf("story views should be purged on archive, got %+v", views.views)
+	}
+	if again, _ := cRepo.ListExpiredStories(context.Background(), time.Now()); len(again) != 0 {
+		t.Fatalf("archived stories should not be picked up again: %+v", again)
 	}
 }
-func TestService_GetTimeline_PartialFriendContentError(t *testing.T) {
+func TestService_GetTimeline_PrivacyAndPagination(t *testing.T) {
 	cRepo := newFakeContentRepo()
 	mRepo := newFakeMediaRepo()
 	rRepo := newFakeReactionRepo()
-	// friend(2) with error listing
 	uc := &fakeUserClient{
 		ListFn: func(context.Context, *userpb.UserID, ...grpc.CallOption) (*userpb.FriendList, error) {
-			return &userpb.FriendList{Friends: []*userpb.Friend{{UserId: 2}, {UserId: 3}}}, nil
+			return &userpb.FriendList{Friends: []*userpb.Friend{{UserId: 2}}}, nil
 		},
 	}
-	// Only userID=2 will fail, userID=3 will succeed
-	goodPost := dbmysql.Content{AuthorID: 3, Type: "POST", Privacy: "public", TextContent: &[]string{"ok"}[0]}
-	_ = cRepo.CreateContent(context.Background(), &goodPost)
+	svc := &FeedService{contentRepo: cRepo, mediaRepo: mRepo, reactionRepo: rRepo, UserClient: uc}
+
+	base := time.Now().Add(-time.Hour)
+	seed := []struct {
+		author  int64
+		privacy string
+	}{
+		{1, "private"}, // own private content is visible
+		{2, "friends"},
+		{2, "private"}, // friend's private content is hidden
+		{3, "public"},  // not a friend
+		{2, "public"},
+		{1, "public"},
+	}
+	for i, c := range seed {
+		txt := strconv.Itoa(i)
+		_ = cRepo.CreateContent(context.Background(), &dbmysql.Content{
+			AuthorID: c.author, Type: "POST", TextContent: &txt, Privacy: c.privacy, CreatedAt: base,
+		})
+	}
+	// newest post carries media
+	_, _ = svc.CreateContent(context.Background(), &dbmysql.Content{
+		AuthorID: 2, Type: "POST", Privacy: "public", CreatedAt: base.Add(time.Minute),
+	}, []byte("m"), "image", "p.png")
+
+	var got []int64
+	cursor := ""
+	for pages := 0; pages < 5; pages++ {
+		page, err := svc.GetTimeline(context.Background(), 1, TimelineQuery{Cursor: cursor, PageSize: 2})
+		if err != nil {
+			t.Fatalf("GetTimeline err: %v", err)
+		}
+		if len(page.Contents) != len(page.MediaURLs) {
+			t.Fatalf("contents and urls out of step: %d vs %d", len(page.Contents), len(page.MediaURLs))
+		}
+		for _, c := range page.Contents {
+			got = append(got, c.ContentID)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+
+	// same created_at is ordered by content_id desc, the media post is newest
+	want := []int64{7, 6, 5, 2, 1}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("timeline = %v, want %v", got, want)
+	}
+	if mRepo.batchCalls != 3 {
+		t.Fatalf("expected one media batch per page, got %d", mRepo.batchCalls)
+	}
+
+	first, _ := svc.GetTimeline(context.Background(), 1, TimelineQuery{PageSize: 1})
+	if first.MediaURLs[0] != GetMediaURL("deadbeef") {
+		t.Fatalf("unexpected media url %q", first.MediaURLs[0])
+	}
+}
+
+func TestService_GetTimeline_InvalidCursor(t *testing.T) {
+	svc := &FeedService{contentRepo: newFakeContentRepo(), mediaRepo: newFakeMediaRepo(), reactionRepo: newFakeReactionRepo()}
+	for _, cursor := range []string{"%%%", "bm90LWEtY3Vyc29y"} {
+		if _, err := svc.GetTimeline(context.Background(), 1, TimelineQuery{Cursor: cursor}); !errors.Is(err, ErrInvalidCursor) {
+			t.Fatalf("cursor %q: expected ErrInvalidCursor, got %v", cursor, err)
+		}
+	}
+}
 
+func TestService_GetTimeline_ContentError(t *testing.T) {
+	cRepo := newFakeContentRepo()
+	uc := &fakeUserClient{
+		ListFn: func(context.Context, *userpb.UserID, ...grpc.CallOption) (*userpb.FriendList, error) {
+			return &userpb.FriendList{Friends: []*userpb.Friend{{UserId: 2}}}, nil
+		},
+	}
 	svc := &FeedService{
-		contentRepo:  contentRepoWithError{fakeContentRepo: cRepo, badUser: 2},
-		mediaRepo:    mRepo,
-		reactionRepo: rRepo,
+		contentRepo:  contentRepoWithError{fakeContentRepo: cRepo},
+		mediaRepo:    newFakeMediaRepo(),
+		reactionRepo: newFakeReactionRepo(),
 		UserClient:   uc,
 	}
-	cs, _, err := svc.GetTimeline(context.Background(), 1)
-	if err != nil {
-		t.Fatalf("unexpected err: %v", err)
-	}
-	if len(cs) == 0 {
-		t.Fatal("expected some content despite one friend failing")
+	if _, err := svc.GetTimeline(context.Background(), 1, TimelineQuery{}); err == nil {
+		t.Fatal("expected timeline query error")
 	}
 }
 
 type contentRepoWithError struct {
 	*fakeContentRepo
-	badUser int64
 }
 
-func (r contentRepoWithError) ListUserContent(ctx context.Context, userID int64) ([]dbmysql.Content, error) {
-	if userID == r.badUser {
-		return nil, errors.New("boom")
-	}
-	return r.fakeContentRepo.ListUserContent(ctx, userID)
+func (r contentRepoWithError) ListTimeline(ctx context.Context, viewerID int64, authorIDs []int64, cursor *TimelineCursor, limit int) ([]dbmysql.Content, error) {
+	return nil, errors.New("boom")
 }
 
 func TestService_StartExpiredStoryCleaner_Tick(t *testing.T) {
-	cRepo := newFakeContentRepo()
-	mRepo := newFakeMediaRepo()
-	rRepo := newFakeReactionRepo()
+	svc := newTestFeedService(nil)
 	expiration := time.Now().Add(-2 * time.Second)
-	_ = cRepo.CreateContent(context.Background(),
+	_ = svc.contentRepo.CreateContent(context.Background(),
 		&dbmysql.Content{AuthorID: 1, Type: "STORY", Privacy: "public", Expiration: &expiration})
 
-	svc := &FeedService{contentRepo: cRepo, mediaRepo: mRepo, reactionRepo: rRepo}
 	ctx, cancel := context.WithCancel(context.Background())
 	go func() {
 		svc.cleanupStarted = false
@@ -494,10 +1611,7 @@ func TestService_StartExpiredStoryCleaner_Tick(t *testing.T) {
 		for {
 			select {
 			case <-ticker.C:
-				expired, _ := svc.contentRepo.ListExpiredStories(context.Background(), time.Now())
-				for _, st := range expired {
-					_ = svc.DeleteContent(context.Background(), st.ContentID)
-				}
+				svc.cleanupExpiredStories(context.Background(), time.Now())
 				return
 			case <-ctx.Done():
 				return
@@ -509,11 +1623,11 @@ func TestService_StartExpiredStoryCleaner_Tick(t *testing.T) {
 }
 func TestService_CreateReel_And_Story(t *testing.T) {
 	svc := &FeedService{contentRepo: newFakeContentRepo(), mediaRepo: newFakeMediaRepo(), reactionRepo: newFakeReactionRepo()}
-	_, err := svc.CreateReel(context.Background(), 1, "cap", []byte("data"), "mp4", 10, "public")
+	_, err := svc.CreateReel(context.Background(), 1, "cap", []byte("data"), "mp4", 10, "public", nil)
 	if err != nil {
 		t.Fatalf("CreateReel failed: %v", err)
 	}
-	_, err = svc.CreateStory(context.Background(), 1, []byte("data"), "story", "jpg", 5, "public")
+	_, err = svc.CreateStory(context.Background(), 1, []byte("data"), "story", "jpg", 5, "public", 0)
 	if err != nil {
 		t.Fatalf("CreateStory failed: %v", err)
 	}
@@ -531,10 +1645,12 @@ func TestService_ListUserContent(t *testing.T) {
 }
 
 func TestService_GetReactions_HappyPath(t *testing.T) {
+	cRepo := newFakeContentRepo()
+	_ = cRepo.CreateContent(context.Background(), &dbmysql.Content{AuthorID: 2, Type: "POST", Privacy: "public"})
 	rRepo := newFakeReactionRepo()
-	_ = rRepo.AddReaction(context.Background(), &dbmysql.Reaction{UserID: 1, ContentID: 2, Type: "like"})
-	svc := &FeedService{contentRepo: newFakeContentRepo(), mediaRepo: newFakeMediaRepo(), reactionRepo: rRepo}
-	_, err := svc.GetReactions(context.Background(), 2)
+	_ = rRepo.AddReaction(context.Background(), &dbmysql.Reaction{UserID: 1, ContentID: 1, Type: "like"})
+	svc := &FeedService{contentRepo: cRepo, mediaRepo: newFakeMediaRepo(), reactionRepo: rRepo}
+	_, err := svc.GetReactions(context.Background(), 1, 1)
 	if err != nil {
 		t.Fatalf("GetReactions failed: %v", err)
 	}
@@ -563,7 +1679,7 @@ func TestService_GetTimeline_And_UserContent_HappyPath(t *testing.T) {
 		},
 	}
 	svc := &FeedService{contentRepo: cRepo, mediaRepo: newFakeMediaRepo(), reactionRepo: newFakeReactionRepo(), UserClient: uc}
-	_, _, err := svc.GetTimeline(context.Background(), 1)
+	_, err := svc.GetTimeline(context.Background(), 1, TimelineQuery{})
 	if err != nil {
 		t.Fatalf("GetTimeline failed: %v", err)
 	}
@@ -594,29 +1710,169 @@ func TestService_AllMissingHappyPaths(t *testing.T) {
 	if _, err := svc.ListUserContent(context.Background(), 1); err != nil {
 		t.Fatal(err)
 	}
-	if _, err := svc.CreateMediaRef(context.Background(), &dbmysql.MediaRef{FileName: "n", Type: "image"}, []byte("d")); err != nil {
+	if _, err := svc.CreateMediaRef(context.Background(), &dbmysql.MediaRef{FileName: "n", Type: "image", UploadedBy: "1"}, []byte("d")); err != nil {
 		t.Fatal(err)
 	}
-	if _, _, err := svc.GetMediaRef(context.Background(), 1); err != nil {
+	if _, _, err := svc.GetMediaRef(context.Background(), 1, 1); err != nil {
 		t.Fatal(err)
 	}
 	_ = rRepo.AddReaction(context.Background(), &dbmysql.Reaction{UserID: 1, ContentID: 1, Type: "like"})
-	if _, err := svc.GetReactions(context.Background(), 1); err != nil {
+	if _, err := svc.GetReactions(context.Background(), 1, 1); err != nil {
 		t.Fatal(err)
 	}
 	if _, err := svc.GetUserFriendIDs(context.Background(), 1); err != nil {
 		t.Fatal(err)
 	}
-	if _, _, err := svc.GetTimeline(context.Background(), 1); err != nil {
+	if _, err := svc.GetTimeline(context.Background(), 1, TimelineQuery{}); err != nil {
 		t.Fatal(err)
 	}
 	if _, _, err := svc.GetUserContent(context.Background(), 1, 1); err != nil {
 		t.Fatal(err)
 	}
-	if _, err := svc.CreateReel(context.Background(), 1, "cap", []byte("d"), "f", 5, "public"); err != nil {
+	if _, err := svc.CreateReel(context.Background(), 1, "cap", []byte("d"), "f", 5, "public", nil); err != nil {
 		t.Fatal(err)
 	}
-	if _, err := svc.CreateStory(context.Background(), 1, []byte("d"), "image", "name", 5, "public"); err != nil {
+	if _, err := svc.CreateStory(context.Background(), 1, []byte("d"), "image", "name", 5, "public", 0); err != nil {
 		t.Fatal(err)
 	}
 }
+
+func newMaterializedService(friends map[int64][]int64) (*FeedService, *fakeContentRepo, *fakeTimelineStore) {
+	uc := &fakeUserClient{
+		ListFn: func(ctx context.Context, in *userpb.UserID, _ ...grpc.CallOption) (*userpb.FriendList, error) {
+			list := &userpb.FriendList{}
+			for _, id := range friends[in.UserId] {
+				list.Friends = append(list.Friends, &userpb.Friend{UserId: id})
+			}
+			return list, nil
+		},
+	}
+	svc := newTestFeedService(uc)
+	cRepo := svc.contentRepo.(*fakeContentRepo)
+	store := newFakeTimelineStore(cRepo)
+	svc.SetTimelineStore(store, 2)
+	return svc, cRepo, store
+}
+
+func TestService_MaterializedTimeline_FanOutOnWrite(t *testing.T) {
+	svc, _, store := newMaterializedService(map[int64][]int64{1: {2, 3}})
+	ctx := context.Background()
+
+	pub, _ := svc.CreatePost(ctx, 1, "hi", nil, "", "", "friends", 0, nil)
+	priv, _ := svc.CreatePost(ctx, 1, "me only", nil, "", "", "private", 0, nil)
+
+	for _, owner := range []int64{1, 2, 3} {
+		if !store.has(owner, pub) {
+			t.Fatalf("content %d missing from timeline of %d", pub, owner)
+		}
+	}
+	if !store.has(1, priv) || store.has(2, priv) {
+		t.Fatalf("private content must only reach its author")
+	}
+
+	page, err := svc.GetTimeline(ctx, 2, TimelineQuery{})
+	if err != nil || len(page.Contents) != 1 || page.Contents[0].ContentID != pub {
+		t.Fatalf("unexpected materialized timeline %+v err=%v", page, err)
+	}
+}
+
+func TestService_MaterializedTimeline_DeleteAndPrivacyChange(t *testing.T) {
+	svc, cRepo, store := newMaterializedService(map[int64][]int64{1: {2}})
+	ctx := context.Background()
+
+	id, _ := svc.CreatePost(ctx, 1, "hi", nil, "", "", "public", 0, nil)
+
+	if err := svc.UpdateContentPrivacy(ctx, 2, id, "private"); !errors.Is(err, ErrNotContentOwner) {
+		t.Fatalf("expected ErrNotContentOwner, got %v", err)
+	}
+	if err := svc.UpdateContentPrivacy(ctx, 1, id, "secret"); !errors.Is(err, ErrInvalidPrivacy) {
+		t.Fatalf("expected ErrInvalidPrivacy, got %v", err)
+	}
+
+	if err := svc.UpdateContentPrivacy(ctx, 1, id, "private"); err != nil {
+		t.Fatalf("UpdateContentPrivacy err: %v", err)
+	}
+	if cRepo.m[id].Privacy != "private" || store.has(2, id) || !store.has(1, id) {
+		t.Fatalf("private content should leave friends' timelines only")
+	}
+
+	if err := svc.UpdateContentPrivacy(ctx, 1, id, "friends"); err != nil {
+		t.Fatalf("UpdateContentPrivacy err: %v", err)
+	}
+	if !store.has(2, id) {
+		t.Fatalf("content should be pushed again once visible to friends")
+	}
+
+	if err := svc.DeleteContent(ctx, 1, id); err != nil {
+		t.Fatalf("DeleteContent err: %v", err)
+	}
+	if store.has(1, id) || store.has(2, id) {
+		t.Fatalf("deleted content should leave every timeline")
+	}
+}
+
+func TestService_MaterializedTimeline_BackfillOnFriendship(t *testing.T) {
+	friends := map[int64][]int64{}
+	svc, _, store := newMaterializedService(friends)
+	ctx := context.Background()
+
+	old, _ := svc.CreatePost(ctx, 5, "old", nil, "", "", "public", 0, nil)
+	time.Sleep(time.Millisecond)
+	mid, _ := svc.CreatePost(ctx, 5, "mid", nil, "", "", "friends", 0, nil)
+	time.Sleep(time.Millisecond)
+	hidden, _ := svc.CreatePost(ctx, 5, "hidden", nil, "", "", "private", 0, nil)
+	time.Sleep(time.Millisecond)
+	latest, _ := svc.CreatePost(ctx, 5, "latest", nil, "", "", "public", 0, nil)
+	other, _ := svc.CreatePost(ctx, 6, "other", nil, "", "", "public", 0, nil)
+
+	if err := svc.OnFriendshipAccepted(ctx, 6, 5); !errors.Is(err, ErrNotFriends) {
+		t.Fatalf("expected ErrNotFriends before the friendship exists, got %v", err)
+	}
+	if len(store.entries[6]) != 1 || len(store.entries[5]) != 4 {
+		t.Fatalf("nothing should be backfilled between strangers: %v", store.entries)
+	}
+
+	friends[5], friends[6] = []int64{6}, []int64{5}
+	if err := svc.OnFriendshipAccepted(ctx, 6, 5); err != nil {
+		t.Fatalf("OnFriendshipAccepted err: %v", err)
+	}
+	// backfill limit is 2 recent non-private posts per side
+	if !store.has(6, latest) || !store.has(6, mid) || store.has(6, old) || store.has(6, hidden) {
+		t.Fatalf("unexpected backfill for 6: %v", store.entries[6])
+	}
+	if !store.has(5, other) {
+		t.Fatalf("backfill should run both ways")
+	}
+}
+
+func TestService_MaterializedTimeline_EndedFriendship(t *testing.T) {
+	friends := map[int64][]int64{1: {2}, 2: {1}}
+	svc, _, store := newMaterializedService(friends)
+	ctx := context.Background()
+
+	shared, _ := svc.CreatePost(ctx, 2, "friends only", nil, "", "", "friends", 0, nil)
+	time.Sleep(time.Millisecond)
+	public, _ := svc.CreatePost(ctx, 2, "everyone", nil, "", "", "public", 0, nil)
+	time.Sleep(time.Millisecond)
+	own, _ := svc.CreatePost(ctx, 1, "mine", nil, "", "", "public", 0, nil)
+	if !store.has(1, shared) {
+		t.Fatalf("friends content should be fanned out to friends")
+	}
+
+	delete(friends, 1)
+	delete(friends, 2)
+	page, err := svc.GetTimeline(ctx, 1, TimelineQuery{PageSize: 2})
+	if err != nil {
+		t.Fatalf("GetTimeline err: %v", err)
+	}
+	if len(page.Contents) != 2 || page.Contents[0].ContentID != own || page.Contents[1].ContentID != public || page.NextCursor != "" {
+		t.Fatalf("friends content of an ended friendship should be skipped and the page refilled, got %+v next=%q", page.Contents, page.NextCursor)
+	}
+}
+
+func TestService_OnFriendshipAccepted_NoStore(t *testing.T) {
+	svc := &FeedService{contentRepo: newFakeContentRepo(), mediaRepo: newFakeMediaRepo(), reactionRepo: newFakeReactionRepo()}
+	if err := svc.OnFriendshipAccepted(context.Background(), 1, 2); err != nil {
+		t.Fatalf("expected no-op without a timeline store, got %v", err)
+	}
+}
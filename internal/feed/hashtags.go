@@ -0,0 +1,168 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	MaxHashtagLength      = 100
+	MaxHashtagsPerContent = 30
+
+	DefaultTrendingWindow = 24 * time.Hour
+	MinTrendingWindow     = time.Hour
+	MaxTrendingWindow     = 7 * 24 * time.Hour
+	DefaultTrendingLimit  = 10
+	MaxTrendingLimit      = 50
+
+	// a tag needs this many uses in the current window to trend
+	minTrendingUses = 3
+)
+
+var ErrInvalidHashtag = errors.New("a hashtag is 1 to 100 letters, digits or underscores with at least one letter")
+
+var (
+	// a '#' only starts a tag at the beginning of the text or after a character that cannot be part of a tag,
+	// so URL fragments like page#top and runs like #a#b are not split into tags
+	hashtagPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_&/#])#([\p{L}\p{N}_]+)`)
+	hashtagBody    = regexp.MustCompile(`^[\p{L}\p{N}_]+$`)
+)
+
+// TrendingHashtag is a tag rising over the window, Score is its growth against the previous window
+type TrendingHashtag struct {
+	Tag          string
+	Uses         int64
+	PreviousUses int64
+	Score        float64
+}
+
+// NormalizeHashtag lowercases a tag and strips its leading '#'
+func NormalizeHashtag(tag string) (string, error) {
+	tag = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+	if !hashtagBody.MatchString(tag) || utf8.RuneCountInString(tag) > MaxHashtagLength || strings.IndexFunc(tag, unicode.IsLetter) < 0 {
+		return "", ErrInvalidHashtag
+	}
+	return tag, nil
+}
+
+// ExtractHashtags returns the distinct normalized tags of a text in order of appearance, invalid ones are skipped
+func ExtractHashtags(text string) []string {
+	var tags []string
+	seen := map[string]bool{}
+	for _, match := range hashtagPattern.FindAllStringSubmatch(text, -1) {
+		tag, err := NormalizeHashtag(match[1])
+		if err != nil || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+		if len(tags) == MaxHashtagsPerContent {
+			break
+		}
+	}
+	return tags
+}
+
+// GetHashtagFeed pages through the public content with the tag, newest first
+func (s *FeedService) GetHashtagFeed(ctx context.Context, tag string, query TimelineQuery) (*TimelinePage, error) {
+	tag, err := NormalizeHashtag(tag)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := DecodeCursor(query.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := clampPageSize(query.PageSize)
+
+	contents, err := s.hashtagRepo.ListHashtagContent(ctx, tag, cursor, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &TimelinePage{}
+	if len(contents) > pageSize {
+		contents = contents[:pageSize]
+		page.NextCursor = cursorOf(contents[pageSize-1]).Encode()
+	}
+	page.Contents = contents
+	page.MediaURLs, err = s.mediaURLs(ctx, contents)
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// GetTrendingHashtags ranks the tags whose use grew the most over the last window compared to the window before.
+// Only public content counts, a zero window or limit picks the default
+func (s *FeedService) GetTrendingHashtags(ctx context.Context, window time.Duration, limit int) ([]TrendingHashtag, error) {
+	window = clampTrendingWindow(window)
+	if limit <= 0 {
+		limit = DefaultTrendingLimit
+	}
+	if limit > MaxTrendingLimit {
+		limit = MaxTrendingLimit
+	}
+
+	now := time.Now()
+	current, err := s.hashtagRepo.CountHashtagUses(ctx, now.Add(-window), now, nil)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]string, 0, len(current))
+	for tag, uses := range current {
+		if uses >= minTrendingUses {
+			candidates = append(candidates, tag)
+		}
+	}
+	previous, err := s.hashtagRepo.CountHashtagUses(ctx, now.Add(-2*window), now.Add(-window), candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	var trending []TrendingHashtag
+	for _, tag := range candidates {
+		uses, before := current[tag], previous[tag]
+		if uses <= before {
+			continue
+		}
+		trending = append(trending, TrendingHashtag{
+			Tag:          tag,
+			Uses:         uses,
+			PreviousUses: before,
+			Score:        float64(uses-before) / float64(before+1),
+		})
+	}
+	sort.Slice(trending, func(i, j int) bool {
+		if trending[i].Score != trending[j].Score {
+			return trending[i].Score > trending[j].Score
+		}
+		if trending[i].Uses != trending[j].Uses {
+			return trending[i].Uses > trending[j].Uses
+		}
+		return trending[i].Tag < trending[j].Tag
+	})
+	if len(trending) > limit {
+		trending = trending[:limit]
+	}
+	return trending, nil
+}
+
+func clampTrendingWindow(window time.Duration) time.Duration {
+	if window <= 0 {
+		return DefaultTrendingWindow
+	}
+	if window < MinTrendingWindow {
+		return MinTrendingWindow
+	}
+	if window > MaxTrendingWindow {
+		return MaxTrendingWindow
+	}
+	return window
+}
@@ -0,0 +1,136 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"gosocial/internal/dbmysql"
+)
+
+func TestHashtags_Extract(t *testing.T) {
+	cases := []struct {
+		text string
+		want []string
+	}{
+		{"#Go is fun, #golang!", []string{"go", "golang"}},
+		{"#GO #go #Go", []string{"go"}},
+		{"Ünïcode #Café and #日本", []string{"café", "日本"}},
+		{"see example.com/page#top or a#b", nil},
+		{"#2024 is not a tag but #y2024 is", []string{"y2024"}},
+		{"(#wrapped) #snake_case", []string{"wrapped", "snake_case"}},
+	}
+	for _, c := range cases {
+		if got := ExtractHashtags(c.text); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("ExtractHashtags(%q) = %v, want %v", c.text, got, c.want)
+		}
+	}
+
+	if _, err := NormalizeHashtag("#no spaces"); !errors.Is(err, ErrInvalidHashtag) {
+		t.Errorf("expected ErrInvalidHashtag, got %v", err)
+	}
+}
+
+func TestHashtags_FeedListsPublicTaggedContent(t *testing.T) {
+	svc, cRepo, _ := newCommentService()
+	ctx := context.Background()
+
+	posts := []struct {
+		text, privacy string
+	}{
+		{"first #Travel", "public"},
+		{"friends only #travel", "friends"},
+		{"second #travel #food", "public"},
+		{"untagged travel", "public"},
+	}
+	for _, p := range posts {
+		if _, err := svc.CreatePost(ctx, 1, p.text, nil, "", "", p.privacy, 0, nil); err != nil {
+			t.Fatalf("CreatePost err: %v", err)
+		}
+	}
+	if _, err := svc.CreateReel(ctx, 2, "reel #TRAVEL", []byte("v"), "r.mp4", 10, "public", nil); err != nil {
+		t.Fatalf("CreateReel err: %v", err)
+	}
+	// space out creation times so the feed order is deterministic
+	for id := int64(1); id <= 5; id++ {
+		c := cRepo.m[id]
+		c.CreatedAt = time.Now().Add(time.Duration(id) * time.Minute)
+		cRepo.m[id] = c
+	}
+
+	page, err := svc.GetHashtagFeed(ctx, "#Travel", TimelineQuery{PageSize: 2})
+	if err != nil || len(page.Contents) != 2 || page.Contents[0].ContentID != 5 || page.Contents[1].ContentID != 3 || page.NextCursor == "" {
+		t.Fatalf("unexpected first page %+v err=%v", page, err)
+	}
+	page, err = svc.GetHashtagFeed(ctx, "travel", TimelineQuery{Cursor: page.NextCursor, PageSize: 2})
+	if err != nil || len(page.Contents) != 1 || page.Contents[0].ContentID != 1 || page.NextCursor != "" {
+		t.Fatalf("unexpected last page %+v err=%v", page, err)
+	}
+
+	if _, err := svc.GetHashtagFeed(ctx, "", TimelineQuery{}); !errors.Is(err, ErrInvalidHashtag) {
+		t.Fatalf("expected ErrInvalidHashtag, got %v", err)
+	}
+
+	if err := svc.DeleteContent(ctx, 2, 5); err != nil {
+		t.Fatalf("DeleteContent err: %v", err)
+	}
+	page, _ = svc.GetHashtagFeed(ctx, "travel", TimelineQuery{})
+	if len(page.Contents) != 2 {
+		t.Fatalf("deleted content should leave the hashtag feed, got %+v", page.Contents)
+	}
+}
+
+func TestHashtags_TrendingRanksGrowth(t *testing.T) {
+	svc := newTestFeedService(nil)
+	cRepo := svc.contentRepo.(*fakeContentRepo)
+	hRepo := svc.hashtagRepo.(*fakeHashtagRepo)
+	ctx := context.Background()
+
+	now := time.Now()
+	tag := func(at time.Time, privacy string, tags ...string) {
+		c := &dbmysql.Content{AuthorID: 1, Type: "POST", Privacy: privacy, CreatedAt: at}
+		_ = cRepo.CreateContent(ctx, c)
+		_ = hRepo.TagContent(ctx, c.ContentID, tags, at)
+	}
+	recent, earlier := now.Add(-time.Hour), now.Add(-30*time.Hour)
+
+	// "new" appears from nowhere, "steady" is as popular as before, "grow" doubles
+	for i := 0; i < 3; i++ {
+		tag(recent, "public", "new")
+	}
+	for i := 0; i < 5; i++ {
+		tag(recent, "public", "steady", "grow")
+		tag(earlier, "public", "steady")
+	}
+	for i := 0; i < 5; i++ {
+		tag(recent, "public", "grow")
+	}
+	tag(earlier, "public", "grow")
+	// private content and tags below the minimum never trend
+	for i := 0; i < 5; i++ {
+		tag(recent, "private", "secret")
+	}
+	tag(recent, "public", "rare")
+
+	trending, err := svc.GetTrendingHashtags(ctx, 0, 0)
+	if err != nil {
+		t.Fatalf("GetTrendingHashtags err: %v", err)
+	}
+	var got []string
+	for _, h := range trending {
+		got = append(got, h.Tag)
+	}
+	if !reflect.DeepEqual(got, []string{"grow", "new"}) {
+		t.Fatalf("unexpected trending order %v (%+v)", got, trending)
+	}
+	if trending[0].Uses != 10 || trending[0].PreviousUses != 1 || trending[0].Score != 4.5 {
+		t.Fatalf("unexpected score %+v", trending[0])
+	}
+
+	trending, _ = svc.GetTrendingHashtags(ctx, 0, 1)
+	if len(trending) != 1 {
+		t.Fatalf("limit should cap the result, got %+v", trending)
+	}
+}
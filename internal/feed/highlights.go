@@ -0,0 +1,205 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gosocial/internal/dbmysql"
+)
+
+const (
+	MaxHighlightTitleLength = 50
+	MaxHighlightStories     = 100
+)
+
+var (
+	ErrInvalidHighlight      = errors.New("a highlight needs a title of 1 to 50 characters and 1 to 100 distinct stories")
+	ErrNotArchivedStory      = errors.New("highlights can only contain the owner's archived stories")
+	ErrNotHighlightOwner     = errors.New("only the owner can change this highlight")
+	ErrInvalidHighlightOrder = errors.New("reordering must list every highlight of the owner exactly once")
+)
+
+// HighlightView is a highlight with the stories the viewer may see and their media URLs at the same index
+type HighlightView struct {
+	Highlight dbmysql.Highlight
+	Stories   []dbmysql.Content
+	MediaURLs []string
+}
+
+// ListStoryArchive pages through the author's own archived stories, newest first
+func (s *FeedService) ListStoryArchive(ctx context.Context, authorID int64, query TimelineQuery) (*TimelinePage, error) {
+	cursor, err := DecodeCursor(query.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := clampPageSize(query.PageSize)
+
+	stories, err := s.contentRepo.ListArchivedStories(ctx, authorID, cursor, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &TimelinePage{}
+	if len(stories) > pageSize {
+		stories = stories[:pageSize]
+		page.NextCursor = cursorOf(stories[pageSize-1]).Encode()
+	}
+	page.Contents = stories
+	page.MediaURLs, err = s.mediaURLs(ctx, stories)
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// CreateHighlight adds a highlight after the owner's existing ones
+func (s *FeedService) CreateHighlight(ctx context.Context, ownerID int64, title string, storyIDs []int64) (*dbmysql.Highlight, error) {
+	title, err := s.validateHighlight(ctx, ownerID, title, storyIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	highlight := &dbmysql.Highlight{
+		OwnerID:   ownerID,
+		Title:     title,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	if err := s.highlightRepo.CreateHighlight(ctx, highlight, storyIDs); err != nil {
+		return nil, err
+	}
+	return highlight, nil
+}
+
+// UpdateHighlight renames a highlight and replaces its stories, storyIDs gives the new order
+func (s *FeedService) UpdateHighlight(ctx context.Context, requesterID, highlightID int64, title string, storyIDs []int64) (*dbmysql.Highlight, error) {
+	highlight, err := s.ownHighlight(ctx, requesterID, highlightID)
+	if err != nil {
+		return nil, err
+	}
+	title, err = s.validateHighlight(ctx, requesterID, title, storyIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.highlightRepo.UpdateHighlight(ctx, highlightID, title, storyIDs); err != nil {
+		return nil, err
+	}
+	highlight.Title = title
+	highlight.UpdatedAt = time.Now()
+	return highlight, nil
+}
+
+func (s *FeedService) DeleteHighlight(ctx context.Context, requesterID, highlightID int64) error {
+	if _, err := s.ownHighlight(ctx, requesterID, highlightID); err != nil {
+		return err
+	}
+	return s.highlightRepo.DeleteHighlight(ctx, highlightID)
+}
+
+// ReorderHighlights puts the owner's highlights in the given order
+func (s *FeedService) ReorderHighlights(ctx context.Context, ownerID int64, highlightIDs []int64) error {
+	existing, err := s.highlightRepo.ListHighlights(ctx, ownerID)
+	if err != nil {
+		return err
+	}
+	if len(existing) != len(highlightIDs) {
+		return ErrInvalidHighlightOrder
+	}
+	owned := make(map[int64]bool, len(existing))
+	for _, h := range existing {
+		owned[h.HighlightID] = true
+	}
+	for _, id := range highlightIDs {
+		if !owned[id] {
+			return ErrInvalidHighlightOrder
+		}
+		delete(owned, id)
+	}
+	return s.highlightRepo.ReorderHighlights(ctx, ownerID, highlightIDs)
+}
+
+// ListHighlights returns the owner's highlights in order. Other viewers only get the stories
+// the story privacy allows them to see, and highlights left empty are dropped
+func (s *FeedService) ListHighlights(ctx context.Context, viewerID, ownerID int64) ([]HighlightView, error) {
+	highlights, err := s.highlightRepo.ListHighlights(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, 0, len(highlights))
+	for _, h := range highlights {
+		ids = append(ids, h.HighlightID)
+	}
+	stories, err := s.highlightRepo.ListHighlightStories(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	// Highlighted stories are archived, only their privacy decides who sees them
+	var views []HighlightView
+	policy := s.newViewPolicy(viewerID)
+	for _, h := range highlights {
+		var visible []dbmysql.Content
+		for _, story := range stories[h.HighlightID] {
+			ok, err := policy.allows(ctx, &story)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				visible = append(visible, story)
+			}
+		}
+		if len(visible) == 0 && viewerID != ownerID {
+			continue
+		}
+
+		urls, err := s.mediaURLs(ctx, visible)
+		if err != nil {
+			return nil, err
+		}
+		views = append(views, HighlightView{Highlight: h, Stories: visible, MediaURLs: urls})
+	}
+	return views, nil
+}
+
+func (s *FeedService) ownHighlight(ctx context.Context, requesterID, highlightID int64) (*dbmysql.Highlight, error) {
+	highlight, err := s.highlightRepo.GetHighlightByID(ctx, highlightID)
+	if err != nil {
+		return nil, err
+	}
+	if highlight.OwnerID != requesterID {
+		return nil, ErrNotHighlightOwner
+	}
+	return highlight, nil
+}
+
+// validateHighlight checks the title and that every story is a distinct archived story of the owner
+func (s *FeedService) validateHighlight(ctx context.Context, ownerID int64, title string, storyIDs []int64) (string, error) {
+	title = strings.TrimSpace(title)
+	if title == "" || utf8.RuneCountInString(title) > MaxHighlightTitleLength {
+		return "", ErrInvalidHighlight
+	}
+	if len(storyIDs) == 0 || len(storyIDs) > MaxHighlightStories {
+		return "", ErrInvalidHighlight
+	}
+
+	seen := make(map[int64]bool, len(storyIDs))
+	for _, id := range storyIDs {
+		if seen[id] {
+			return "", ErrInvalidHighlight
+		}
+		seen[id] = true
+
+		story, err := s.contentRepo.GetContentByID(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		if story.Type != "STORY" || story.AuthorID != ownerID || story.ArchivedAt == nil {
+			return "", ErrNotArchivedStory
+		}
+	}
+	return title, nil
+}
@@ -0,0 +1,159 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gosocial/internal/dbmysql"
+)
+
+// newHighlightService seeds archived stories 1 (public) and 2 (friends) and live story 3 of user 1,
+// and archived story 4 of user 2. User 2 is a friend of user 1, user 3 is not
+func newHighlightService(t *testing.T) (*FeedService, *fakeContentRepo, *fakeHighlightRepo) {
+	svc, cRepo, _ := newStoryService()
+	hRepo := svc.highlightRepo.(*fakeHighlightRepo)
+	ctx := context.Background()
+
+	past := time.Now().Add(-48 * time.Hour)
+	future := time.Now().Add(time.Hour)
+	seed := []struct {
+		author     int64
+		privacy    string
+		expiration time.Time
+	}{
+		{1, "public", past},
+		{1, "friends", past},
+		{1, "public", future},
+		{2, "public", past},
+	}
+	for i, st := range seed {
+		exp := st.expiration
+		_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: st.author, Type: "STORY", Privacy: st.privacy, Expiration: &exp, CreatedAt: past.Add(time.Duration(i) * time.Minute)})
+	}
+	svc.cleanupExpiredStories(ctx, time.Now())
+	return svc, cRepo, hRepo
+}
+
+func TestHighlights_ArchiveIsPrivateToTheAuthor(t *testing.T) {
+	svc, _, _ := newHighlightService(t)
+	ctx := context.Background()
+
+	page, err := svc.ListStoryArchive(ctx, 1, TimelineQuery{})
+	if err != nil || len(page.Contents) != 2 || page.Contents[0].ContentID != 2 {
+		t.Fatalf("unexpected archive %+v err=%v", page, err)
+	}
+
+	// archived stories disappear from the profile and reject interaction from others
+	contents, _, _ := svc.GetUserContent(ctx, 2, 1)
+	if len(contents) != 1 || contents[0].ContentID != 3 {
+		t.Fatalf("only the live story should be on the profile, got %+v", contents)
+	}
+	if err := svc.MarkStoryViewed(ctx, 3, 1); !errors.Is(err, ErrContentNotVisible) {
+		t.Fatalf("expected ErrContentNotVisible for an archived story, got %v", err)
+	}
+}
+
+func TestHighlights_Validation(t *testing.T) {
+	svc, _, _ := newHighlightService(t)
+	ctx := context.Background()
+
+	cases := []struct {
+		name    string
+		title   string
+		stories []int64
+		want    error
+	}{
+		{"blank title", "  ", []int64{1}, ErrInvalidHighlight},
+		{"no stories", "Trip", nil, ErrInvalidHighlight},
+		{"duplicate story", "Trip", []int64{1, 1}, ErrInvalidHighlight},
+		{"live story", "Trip", []int64{3}, ErrNotArchivedStory},
+		{"someone else's story", "Trip", []int64{4}, ErrNotArchivedStory},
+	}
+	for _, c := range cases {
+		if _, err := svc.CreateHighlight(ctx, 1, c.title, c.stories); !errors.Is(err, c.want) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.want, err)
+		}
+	}
+}
+
+func TestHighlights_CurateAndShowOnProfile(t *testing.T) {
+	svc, _, hRepo := newHighlightService(t)
+	ctx := context.Background()
+
+	trip, err := svc.CreateHighlight(ctx, 1, " Trip ", []int64{2, 1})
+	if err != nil || trip.Title != "Trip" {
+		t.Fatalf("CreateHighlight mismatch: %+v err=%v", trip, err)
+	}
+	friendsOnly, _ := svc.CreateHighlight(ctx, 1, "Friends", []int64{2})
+
+	if _, err := svc.UpdateHighlight(ctx, 2, trip.HighlightID, "Mine", []int64{1}); !errors.Is(err, ErrNotHighlightOwner) {
+		t.Fatalf("expected ErrNotHighlightOwner, got %v", err)
+	}
+	if err := svc.ReorderHighlights(ctx, 1, []int64{friendsOnly.HighlightID}); !errors.Is(err, ErrInvalidHighlightOrder) {
+		t.Fatalf("expected ErrInvalidHighlightOrder for a partial order, got %v", err)
+	}
+	if err := svc.ReorderHighlights(ctx, 1, []int64{friendsOnly.HighlightID, trip.HighlightID}); err != nil {
+		t.Fatalf("ReorderHighlights err: %v", err)
+	}
+
+	// the owner and friends see everything in order
+	own, _ := svc.ListHighlights(ctx, 2, 1)
+	if len(own) != 2 || own[0].Highlight.HighlightID != friendsOnly.HighlightID || own[1].Stories[0].ContentID != 2 || own[1].Stories[1].ContentID != 1 {
+		t.Fatalf("unexpected friend view %+v", own)
+	}
+	// strangers lose friends-only stories and the highlight left empty
+	public, _ := svc.ListHighlights(ctx, 3, 1)
+	if len(public) != 1 || public[0].Highlight.HighlightID != trip.HighlightID || len(public[0].Stories) != 1 || public[0].Stories[0].ContentID != 1 {
+		t.Fatalf("unexpected stranger view %+v", public)
+	}
+
+	// explicitly deleting a story hard deletes it and takes it out of highlights
+	if err := svc.DeleteContent(ctx, 1, 2); err != nil {
+		t.Fatalf("DeleteContent err: %v", err)
+	}
+	if len(hRepo.stories[trip.HighlightID]) != 1 || len(hRepo.stories[friendsOnly.HighlightID]) != 0 {
+		t.Fatalf("deleted story should leave every highlight: %v", hRepo.stories)
+	}
+
+	if err := svc.DeleteHighlight(ctx, 3, trip.HighlightID); !errors.Is(err, ErrNotHighlightOwner) {
+		t.Fatalf("expected ErrNotHighlightOwner, got %v", err)
+	}
+	if err := svc.DeleteHighlight(ctx, 1, trip.HighlightID); err != nil {
+		t.Fatalf("DeleteHighlight err: %v", err)
+	}
+}
+
+func TestHighlights_MediaFollowsStoryPrivacy(t *testing.T) {
+	svc, cRepo, _ := newHighlightService(t)
+	ctx := context.Background()
+
+	public, _ := svc.CreateStory(ctx, 1, []byte("a"), "image", "a.jpg", 60, "public", 0)
+	friends, _ := svc.CreateStory(ctx, 1, []byte("b"), "image", "b.jpg", 60, "friends", 0)
+	svc.cleanupExpiredStories(ctx, time.Now().Add(2*time.Hour))
+	publicMedia, friendsMedia := *cRepo.m[public].MediaRefID, *cRepo.m[friends].MediaRefID
+
+	// archived stories outside highlights stay with their author
+	if _, _, err := svc.GetMediaRef(ctx, 3, publicMedia); !errors.Is(err, ErrMediaNotFound) {
+		t.Fatalf("expected ErrMediaNotFound before the story is highlighted, got %v", err)
+	}
+
+	if _, err := svc.CreateHighlight(ctx, 1, "Trip", []int64{public, friends}); err != nil {
+		t.Fatalf("CreateHighlight err: %v", err)
+	}
+	cases := []struct {
+		viewer, media int64
+		visible       bool
+	}{
+		{3, publicMedia, true},
+		{3, friendsMedia, false},
+		{2, friendsMedia, true},
+	}
+	for _, c := range cases {
+		_, _, err := svc.GetMediaRef(ctx, c.viewer, c.media)
+		if c.visible != (err == nil) || (!c.visible && !errors.Is(err, ErrMediaNotFound)) {
+			t.Errorf("viewer %d, media %d: want visible=%v, got %v", c.viewer, c.media, c.visible, err)
+		}
+	}
+}
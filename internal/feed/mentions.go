@@ -0,0 +1,181 @@
+package feed
+
+import (
+	"context"
+	"regexp"
+	"unicode/utf8"
+
+	userpb "gosocial/api/v1/user"
+	"gosocial/internal/dbmysql"
+)
+
+const (
+	MaxMentionsPerContent = 20
+
+	// handles are 3 to 50 letters, digits or underscores, see common.ValidateHandle
+	minHandleLength = 3
+	maxHandleLength = 50
+)
+
+// an '@' only starts a mention at the beginning of the text or after a character that cannot be part of
+// a handle or an email address, so a@b.com is not a mention
+var mentionPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_@.])@([A-Za-z0-9_]+)`)
+
+// ExtractMentions returns the @handles of a text in order, with rune offsets covering the '@'.
+// The result is not resolved yet, MentionedUserID and ContentID are left empty
+func ExtractMentions(text string) []dbmysql.Mention {
+	var mentions []dbmysql.Mention
+	for _, loc := range mentionPattern.FindAllStringSubmatchIndex(text, -1) {
+		handle := text[loc[2]:loc[3]]
+		if len(handle) < minHandleLength || len(handle) > maxHandleLength {
+			continue
+		}
+		at := loc[2] - 1
+		mentions = append(mentions, dbmysql.Mention{
+			Handle: handle,
+			Offset: utf8.RuneCountInString(text[:at]),
+			Length: utf8.RuneCountInString(text[at:loc[3]]),
+		})
+		if len(mentions) == MaxMentionsPerContent {
+			break
+		}
+	}
+	return mentions
+}
+
+// GetMentionsFeed pages through the content mentioning the user, newest first, keeping only what the user may see
+func (s *FeedService) GetMentionsFeed(ctx context.Context, userID int64, query TimelineQuery) (*TimelinePage, error) {
+	cursor, err := DecodeCursor(query.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := clampPageSize(query.PageSize)
+
+	friendIDs, err := s.GetUserFriendIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	contents, err := s.mentionRepo.ListMentionedContent(ctx, userID, friendIDs, cursor, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &TimelinePage{}
+	if len(contents) > pageSize {
+		contents = contents[:pageSize]
+		page.NextCursor = cursorOf(contents[pageSize-1]).Encode()
+	}
+	page.Contents = contents
+	page.MediaURLs, err = s.mediaURLs(ctx, contents)
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// ListMentions returns the mentions of each content in text order
+func (s *FeedService) ListMentions(ctx context.Context, contentIDs []int64) (map[int64][]dbmysql.Mention, error) {
+	return s.mentionRepo.ListMentions(ctx, contentIDs)
+}
+
+// recordMentions resolves the @handles in the content's text, stores them and notifies the mentioned users
+// who may see the content, except those in notified. Unknown handles are plain text
+func (s *FeedService) recordMentions(ctx context.Context, content *dbmysql.Content, notified map[int64]bool) error {
+	if content.TextContent == nil {
+		return nil
+	}
+	mentions := ExtractMentions(*content.TextContent)
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	var handles []string
+	asked := map[string]bool{}
+	for _, m := range mentions {
+		if !asked[m.Handle] {
+			asked[m.Handle] = true
+			handles = append(handles, m.Handle)
+		}
+	}
+	resolved, err := s.UserClient.ResolveHandles(ctx, &userpb.ResolveHandlesRequest{Handles: handles})
+	if err != nil {
+		return err
+	}
+
+	var stored []dbmysql.Mention
+	var userIDs []int64
+	if notified == nil {
+		notified = map[int64]bool{}
+	}
+	for _, m := range mentions {
+		userID, ok := resolved.UserIds[m.Handle]
+		if !ok {
+			continue
+		}
+		m.ContentID = content.ContentID
+		m.MentionedUserID = userID
+		m.CreatedAt = content.CreatedAt
+		stored = append(stored, m)
+
+		if userID != content.AuthorID && !notified[userID] {
+			notified[userID] = true
+			userIDs = append(userIDs, userID)
+		}
+	}
+	if err := s.mentionRepo.CreateMentions(ctx, stored); err != nil {
+		return err
+	}
+
+	if s.notifier == nil || len(userIDs) == 0 {
+		return nil
+	}
+	recipients, err := s.mentionRecipients(ctx, content, userIDs)
+	if err != nil {
+		return err
+	}
+	if len(recipients) > 0 {
+		s.notifier.Mentioned(content, recipients)
+	}
+	return nil
+}
+
+// mentionRecipients keeps the mentioned users the content's privacy lets see it
+func (s *FeedService) mentionRecipients(ctx context.Context, content *dbmysql.Content, userIDs []int64) ([]int64, error) {
+	switch content.Privacy {
+	case "public":
+		return userIDs, nil
+	case "friends":
+		friendIDs, err := s.GetUserFriendIDs(ctx, content.AuthorID)
+		if err != nil {
+			return nil, err
+		}
+		friends := make(map[int64]bool, len(friendIDs))
+		for _, id := range friendIDs {
+			friends[id] = true
+		}
+		var recipients []int64
+		for _, id := range userIDs {
+			if friends[id] {
+				recipients = append(recipients, id)
+			}
+		}
+		return recipients, nil
+	case "list":
+		memberIDs, err := s.audienceMembers(ctx, content)
+		if err != nil {
+			return nil, err
+		}
+		members := make(map[int64]bool, len(memberIDs))
+		for _, id := range memberIDs {
+			members[id] = true
+		}
+		var recipients []int64
+		for _, id := range userIDs {
+			if members[id] {
+				recipients = append(recipients, id)
+			}
+		}
+		return recipients, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,131 @@
+package feed
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	userpb "gosocial/api/v1/user"
+	"gosocial/internal/dbmysql"
+
+	"google.golang.org/grpc"
+)
+
+func TestMentions_Extract(t *testing.T) {
+	got := ExtractMentions("héllo @Bob, mail a@b.com or @al and @carol_1!")
+	want := []dbmysql.Mention{
+		{Handle: "Bob", Offset: 6, Length: 4},
+		{Handle: "carol_1", Offset: 36, Length: 8},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ExtractMentions = %+v, want %+v", got, want)
+	}
+}
+
+// newMentionService resolves user2, user3 and user4 to their IDs, the author 1 is friends with 2 only
+func newMentionService() (*FeedService, *fakeContentRepo, *fakeMentionRepo, *recordingNotifier) {
+	svc, cRepo, _ := newCommentService()
+	mRepo := svc.mentionRepo.(*fakeMentionRepo)
+	notifier := &recordingNotifier{}
+	svc.SetNotifier(notifier)
+
+	known := map[string]int64{"user1": 1, "user2": 2, "user3": 3, "user4": 4}
+	svc.UserClient.(*fakeUserClient).ResolveFn = func(ctx context.Context, in *userpb.ResolveHandlesRequest, _ ...grpc.CallOption) (*userpb.ResolveHandlesResponse, error) {
+		resp := &userpb.ResolveHandlesResponse{UserIds: map[string]int64{}}
+		for _, h := range in.Handles {
+			if id, ok := known[h]; ok {
+				resp.UserIds[h] = id
+			}
+		}
+		return resp, nil
+	}
+	return svc, cRepo, mRepo, notifier
+}
+
+func TestMentions_ResolvedAndNotifiedByPrivacy(t *testing.T) {
+	svc, _, mRepo, notifier := newMentionService()
+	ctx := context.Background()
+
+	id, err := svc.CreatePost(ctx, 1, "@user2 @nobody @user3 @user1 @user2", nil, "", "", "public", 0, nil)
+	if err != nil {
+		t.Fatalf("CreatePost err: %v", err)
+	}
+	mentions, _ := svc.ListMentions(ctx, []int64{id})
+	var users []int64
+	for _, m := range mentions[id] {
+		users = append(users, m.MentionedUserID)
+	}
+	if !reflect.DeepEqual(users, []int64{2, 3, 1, 2}) {
+		t.Fatalf("unknown handles should be skipped, got %v", users)
+	}
+	// the author is not notified and user 2 only once
+	if !reflect.DeepEqual(notifier.mentioned, []int64{2, 3}) {
+		t.Fatalf("unexpected notifications %v", notifier.mentioned)
+	}
+
+	notifier.mentioned = nil
+	_, _ = svc.CreateReel(ctx, 1, "with @user2 and @user3", []byte("v"), "r.mp4", 5, "friends", nil)
+	if !reflect.DeepEqual(notifier.mentioned, []int64{2}) {
+		t.Fatalf("friends-only content should only notify friends, got %v", notifier.mentioned)
+	}
+
+	notifier.mentioned = nil
+	_, _ = svc.CreatePost(ctx, 1, "note to self about @user2", nil, "", "", "private", 0, nil)
+	if len(notifier.mentioned) != 0 {
+		t.Fatalf("private content should notify nobody, got %v", notifier.mentioned)
+	}
+	if len(mRepo.mentions) != 7 {
+		t.Fatalf("mentions are stored whatever the privacy, got %d", len(mRepo.mentions))
+	}
+}
+
+func TestMentions_FeedHonorsPrivacy(t *testing.T) {
+	svc, cRepo, _, _ := newMentionService()
+	ctx := context.Background()
+
+	for _, privacy := range []string{"public", "friends", "private"} {
+		_, _ = svc.CreatePost(ctx, 1, "hey @user2 @user3", nil, "", "", privacy, 0, nil)
+	}
+	for id := int64(1); id <= 3; id++ {
+		c := cRepo.m[id]
+		c.CreatedAt = time.Now().Add(time.Duration(id) * time.Minute)
+		cRepo.m[id] = c
+	}
+
+	ids := func(userID int64) []int64 {
+		page, err := svc.GetMentionsFeed(ctx, userID, TimelineQuery{})
+		if err != nil {
+			t.Fatalf("GetMentionsFeed err: %v", err)
+		}
+		var out []int64
+		for _, c := range page.Contents {
+			out = append(out, c.ContentID)
+		}
+		return out
+	}
+
+	// user 2 is a friend of the author, user 3 is not and user 4 was not mentioned
+	svc.UserClient.(*fakeUserClient).ListFn = func(ctx context.Context, in *userpb.UserID, _ ...grpc.CallOption) (*userpb.FriendList, error) {
+		if in.UserId == 2 {
+			return &userpb.FriendList{Friends: []*userpb.Friend{{UserId: 1}}}, nil
+		}
+		return &userpb.FriendList{}, nil
+	}
+	if got := ids(2); !reflect.DeepEqual(got, []int64{2, 1}) {
+		t.Fatalf("friend should see public and friends-only mentions, got %v", got)
+	}
+	if got := ids(3); !reflect.DeepEqual(got, []int64{1}) {
+		t.Fatalf("stranger should only see public mentions, got %v", got)
+	}
+	if got := ids(4); len(got) != 0 {
+		t.Fatalf("unmentioned user should see nothing, got %v", got)
+	}
+
+	if err := svc.DeleteContent(ctx, 1, 1); err != nil {
+		t.Fatalf("DeleteContent err: %v", err)
+	}
+	if got := ids(3); len(got) != 0 {
+		t.Fatalf("deleted content should leave the mentions feed, got %v", got)
+	}
+}
@@ -0,0 +1,239 @@
+package feed
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	notifpb "gosocial/api/v1"
+	userpb "gosocial/api/v1/user"
+	"gosocial/internal/common"
+	"gosocial/internal/dbmysql"
+)
+
+const (
+	deepLinkPrefix        = "gosocial://content/"
+	commentPreviewLength  = 100
+	notificationSendLimit = 10 * time.Second
+)
+
+// Notifier tells content authors about engagement and scheduled publishing, self-engagement is filtered by the caller
+type Notifier interface {
+	ReactionAdded(content *dbmysql.Content, reaction *dbmysql.Reaction)
+	CommentAdded(content *dbmysql.Content, comment *dbmysql.Comment)
+	Mentioned(content *dbmysql.Content, userIDs []int64)
+	Published(content *dbmysql.Content)
+}
+
+// EngagementNotifier sends engagement events to notifs-svc. Comments are sent right away,
+// reactions on one content are collected for a window and sent as one "X and N others" event
+type EngagementNotifier struct {
+	client notifpb.NotificationServiceClient
+	users  userpb.UserServiceClient
+	window time.Duration
+
+	mu      sync.Mutex
+	pending map[int64]*reactionBatch
+	// schedule runs flush after the window, replaced in tests
+	schedule func(d time.Duration, flush func())
+}
+
+// reactionBatch holds the reactions on one content received during the current window
+type reactionBatch struct {
+	authorID    int64
+	contentID   int64
+	contentType string
+	actorIDs    []int64
+	types       map[string]bool
+}
+
+func NewEngagementNotifier(client notifpb.NotificationServiceClient, users userpb.UserServiceClient, window time.Duration) *EngagementNotifier {
+	return &EngagementNotifier{
+		client:  client,
+		users:   users,
+		window:  window,
+		pending: map[int64]*reactionBatch{},
+		schedule: func(d time.Duration, flush func()) {
+			time.AfterFunc(d, flush)
+		},
+	}
+}
+
+func (n *EngagementNotifier) ReactionAdded(content *dbmysql.Content, reaction *dbmysql.Reaction) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	batch, ok := n.pending[content.ContentID]
+	if !ok {
+		batch = &reactionBatch{
+			authorID:    content.AuthorID,
+			contentID:   content.ContentID,
+			contentType: content.Type,
+			types:       map[string]bool{},
+		}
+		n.pending[content.ContentID] = batch
+		n.schedule(n.window, func() { n.flushReactions(content.ContentID) })
+	}
+
+	// a user reacting again in the same window moves to the front of the batch
+	for i, id := range batch.actorIDs {
+		if id == reaction.UserID {
+			batch.actorIDs = append(batch.actorIDs[:i], batch.actorIDs[i+1:]...)
+			break
+		}
+	}
+	batch.actorIDs = append(batch.actorIDs, reaction.UserID)
+	batch.types[reaction.Type] = true
+}
+
+func (n *EngagementNotifier) flushReactions(contentID int64) {
+	n.mu.Lock()
+	batch := n.pending[contentID]
+	delete(n.pending, contentID)
+	n.mu.Unlock()
+	if batch == nil || len(batch.actorIDs) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), notificationSendLimit)
+	defer cancel()
+
+	latest := batch.actorIDs[len(batch.actorIDs)-1]
+	verb := "reacted to"
+	if len(batch.types) == 1 && batch.types["like"] {
+		verb = "liked"
+	}
+
+	notifType := common.PostReactionType
+	if batch.contentType == "STORY" {
+		notifType = common.StoryReactionType
+	}
+
+	data := deepLinkData(batch.contentID, batch.contentType)
+	data["actor_id"] = strconv.FormatInt(latest, 10)
+	data["actor_count"] = strconv.Itoa(len(batch.actorIDs))
+
+	n.send(ctx, &notifpb.SendNotificationRequest{
+		UserId:  strconv.FormatInt(batch.authorID, 10),
+		Title:   "New reactions",
+		Message: fmt.Sprintf("%s %s your %s", n.actors(ctx, latest, len(batch.actorIDs)-1), verb, contentNoun(batch.contentType)),
+		Type:    string(notifType),
+		Data:    data,
+	})
+}
+
+func (n *EngagementNotifier) CommentAdded(content *dbmysql.Content, comment *dbmysql.Comment) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), notificationSendLimit)
+		defer cancel()
+
+		data := deepLinkData(content.ContentID, content.Type)
+		data["comment_id"] = strconv.FormatInt(comment.CommentID, 10)
+		data["actor_id"] = strconv.FormatInt(comment.AuthorID, 10)
+		data["deep_link"] = fmt.Sprintf("%s%d?comment=%d", deepLinkPrefix, content.ContentID, comment.CommentID)
+
+		verb := "commented on"
+		if comment.ParentID != nil {
+			verb = "replied to a comment on"
+		}
+
+		n.send(ctx, &notifpb.SendNotificationRequest{
+			UserId:  strconv.FormatInt(content.AuthorID, 10),
+			Title:   "New comment",
+			Message: fmt.Sprintf("%s %s your %s: %s", n.actors(ctx, comment.AuthorID, 0), verb, contentNoun(content.Type), commentPreview(comment.Text)),
+			Type:    string(common.CommentType),
+			Data:    data,
+		})
+	}()
+}
+
+// Mentioned tells each user they were mentioned, the caller only passes users allowed to see the content
+func (n *EngagementNotifier) Mentioned(content *dbmysql.Content, userIDs []int64) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), notificationSendLimit)
+		defer cancel()
+
+		data := deepLinkData(content.ContentID, content.Type)
+		data["actor_id"] = strconv.FormatInt(content.AuthorID, 10)
+
+		message := fmt.Sprintf("%s mentioned you in a %s", n.actors(ctx, content.AuthorID, 0), contentNoun(content.Type))
+		if content.TextContent != nil {
+			message += ": " + commentPreview(*content.TextContent)
+		}
+
+		for _, userID := range userIDs {
+			n.send(ctx, &notifpb.SendNotificationRequest{
+				UserId:  strconv.FormatInt(userID, 10),
+				Title:   "New mention",
+				Message: message,
+				Type:    string(common.MentionType),
+				Data:    data,
+			})
+		}
+	}()
+}
+
+// Published tells the author their scheduled content went live
+func (n *EngagementNotifier) Published(content *dbmysql.Content) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), notificationSendLimit)
+		defer cancel()
+
+		n.send(ctx, &notifpb.SendNotificationRequest{
+			UserId:  strconv.FormatInt(content.AuthorID, 10),
+			Title:   "Scheduled " + contentNoun(content.Type) + " published",
+			Message: fmt.Sprintf("Your scheduled %s is now live", contentNoun(content.Type)),
+			Type:    string(common.PublishedType),
+			Data:    deepLinkData(content.ContentID, content.Type),
+		})
+	}()
+}
+
+func (n *EngagementNotifier) send(ctx context.Context, req *notifpb.SendNotificationRequest) {
+	if _, err := n.client.SendNotification(ctx, req); err != nil {
+		log.Printf("failed to notify user %s about content %s: %v", req.UserId, req.Data["content_id"], err)
+	}
+}
+
+// actors names the latest actor and counts the rest, "Someone" stands in when the handle lookup fails
+func (n *EngagementNotifier) actors(ctx context.Context, latestID int64, others int) string {
+	name := "Someone"
+	if resp, err := n.users.GetHandles(ctx, &userpb.GetHandlesRequest{UserIds: []int64{latestID}}); err == nil && resp.Handles[latestID] != "" {
+		name = resp.Handles[latestID]
+	}
+
+	switch others {
+	case 0:
+		return name
+	case 1:
+		return name + " and 1 other"
+	}
+	return fmt.Sprintf("%s and %d others", name, others)
+}
+
+func deepLinkData(contentID int64, contentType string) map[string]string {
+	return map[string]string{
+		"content_id":   strconv.FormatInt(contentID, 10),
+		"content_type": contentType,
+		"deep_link":    fmt.Sprintf("%s%d", deepLinkPrefix, contentID),
+	}
+}
+
+func contentNoun(contentType string) string {
+	if contentType == "" {
+		return "post"
+	}
+	return strings.ToLower(contentType)
+}
+
+func commentPreview(text string) string {
+	if utf8.RuneCountInString(text) <= commentPreviewLength {
+		return text
+	}
+	return string([]rune(text)[:commentPreviewLength]) + "…"
+}
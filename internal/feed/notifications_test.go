@@ -0,0 +1,203 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	notifpb "gosocial/api/v1"
+	userpb "gosocial/api/v1/user"
+	"gosocial/internal/common"
+	"gosocial/internal/dbmysql"
+
+	"google.golang.org/grpc"
+)
+
+// fakeNotifClient captures sends, any other notification RPC panics through the nil embedded client
+type fakeNotifClient struct {
+	notifpb.NotificationServiceClient
+	sent chan *notifpb.SendNotificationRequest
+}
+
+func (f *fakeNotifClient) SendNotification(ctx context.Context, in *notifpb.SendNotificationRequest, opts ...grpc.CallOption) (*notifpb.SendNotificationResponse, error) {
+	f.sent <- in
+	return &notifpb.SendNotificationResponse{Success: true}, nil
+}
+
+// recordingNotifier records what the service asks to notify about
+type recordingNotifier struct {
+	reactions []dbmysql.Reaction
+	comments  []dbmysql.Comment
+	mentioned []int64
+	published []int64
+}
+
+func (r *recordingNotifier) ReactionAdded(content *dbmysql.Content, reaction *dbmysql.Reaction) {
+	r.reactions = append(r.reactions, *reaction)
+}
+func (r *recordingNotifier) CommentAdded(content *dbmysql.Content, comment *dbmysql.Comment) {
+	r.comments = append(r.comments, *comment)
+}
+
+func (r *recordingNotifier) Mentioned(content *dbmysql.Content, userIDs []int64) {
+	r.mentioned = append(r.mentioned, userIDs...)
+}
+
+func (r *recordingNotifier) Published(content *dbmysql.Content) {
+	r.published = append(r.published, content.ContentID)
+}
+
+func handleUsers() *fakeUserClient {
+	return &fakeUserClient{
+		HandlesFn: func(ctx context.Context, in *userpb.GetHandlesRequest, _ ...grpc.CallOption) (*userpb.GetHandlesResponse, error) {
+			resp := &userpb.GetHandlesResponse{Handles: map[int64]string{}}
+			for _, id := range in.UserIds {
+				if id == 99 {
+					return nil, errors.New("user service down")
+				}
+				resp.Handles[id] = fmt.Sprintf("user%d", id)
+			}
+			return resp, nil
+		},
+	}
+}
+
+// newTestNotifier returns a notifier whose batches only flush when the returned func is called
+func newTestNotifier(client *fakeNotifClient) (*EngagementNotifier, func()) {
+	n := NewEngagementNotifier(client, handleUsers(), time.Minute)
+	var flushes []func()
+	n.schedule = func(d time.Duration, flush func()) { flushes = append(flushes, flush) }
+	return n, func() {
+		for _, flush := range flushes {
+			flush()
+		}
+		flushes = nil
+	}
+}
+
+func expectSent(t *testing.T, client *fakeNotifClient) *notifpb.SendNotificationRequest {
+	t.Helper()
+	select {
+	case req := <-client.sent:
+		return req
+	case <-time.After(2 * time.Second):
+		t.Fatal("notification was not sent")
+	}
+	return nil
+}
+
+func TestEngagementNotifier_AggregatesReactions(t *testing.T) {
+	client := &fakeNotifClient{sent: make(chan *notifpb.SendNotificationRequest, 4)}
+	n, flush := newTestNotifier(client)
+
+	post := &dbmysql.Content{ContentID: 7, AuthorID: 1, Type: "POST"}
+	for _, uid := range []int64{2, 3, 4, 3} {
+		n.ReactionAdded(post, &dbmysql.Reaction{UserID: uid, ContentID: 7, Type: "like"})
+	}
+	if len(client.sent) != 0 {
+		t.Fatalf("reactions should wait for the window to close")
+	}
+	flush()
+
+	req := expectSent(t, client)
+	if req.UserId != "1" || req.Type != string(common.PostReactionType) {
+		t.Fatalf("unexpected recipient or type: %+v", req)
+	}
+	// user 3 reacted last, each user is counted once
+	if req.Message != "user3 and 2 others liked your post" {
+		t.Fatalf("unexpected message %q", req.Message)
+	}
+	if req.Data["content_id"] != "7" || req.Data["deep_link"] != "gosocial://content/7" || req.Data["actor_count"] != "3" {
+		t.Fatalf("unexpected deep link data: %v", req.Data)
+	}
+
+	// the next window starts a new batch
+	story := &dbmysql.Content{ContentID: 8, AuthorID: 1, Type: "STORY"}
+	n.ReactionAdded(story, &dbmysql.Reaction{UserID: 2, ContentID: 8, Type: "love"})
+	n.ReactionAdded(story, &dbmysql.Reaction{UserID: 99, ContentID: 8, Type: "like"})
+	flush()
+
+	req = expectSent(t, client)
+	if req.Type != string(common.StoryReactionType) || req.Message != "Someone and 1 other reacted to your story" {
+		t.Fatalf("unexpected story notification: %+v", req)
+	}
+}
+
+func TestEngagementNotifier_Comment(t *testing.T) {
+	client := &fakeNotifClient{sent: make(chan *notifpb.SendNotificationRequest, 1)}
+	n, _ := newTestNotifier(client)
+
+	parent := int64(3)
+	n.CommentAdded(&dbmysql.Content{ContentID: 7, AuthorID: 1, Type: "REEL"}, &dbmysql.Comment{CommentID: 5, ContentID: 7, AuthorID: 2, ParentID: &parent, Text: "great"})
+
+	req := expectSent(t, client)
+	if req.UserId != "1" || req.Type != string(common.CommentType) || req.Message != "user2 replied to a comment on your reel: great" {
+		t.Fatalf("unexpected comment notification: %+v", req)
+	}
+	if req.Data["comment_id"] != "5" || req.Data["deep_link"] != "gosocial://content/7?comment=5" {
+		t.Fatalf("unexpected deep link data: %v", req.Data)
+	}
+}
+
+func TestEngagementNotifier_Mention(t *testing.T) {
+	client := &fakeNotifClient{sent: make(chan *notifpb.SendNotificationRequest, 2)}
+	n, _ := newTestNotifier(client)
+
+	text := "lunch with @user2 and @user3"
+	n.Mentioned(&dbmysql.Content{ContentID: 9, AuthorID: 1, Type: "POST", TextContent: &text}, []int64{2, 3})
+
+	recipients := map[string]bool{}
+	for i := 0; i < 2; i++ {
+		req := expectSent(t, client)
+		recipients[req.UserId] = true
+		if req.Type != string(common.MentionType) || req.Message != "user1 mentioned you in a post: lunch with @user2 and @user3" {
+			t.Fatalf("unexpected mention notification: %+v", req)
+		}
+		if req.Data["deep_link"] != "gosocial://content/9" || req.Data["actor_id"] != "1" {
+			t.Fatalf("unexpected deep link data: %v", req.Data)
+		}
+	}
+	if !recipients["2"] || !recipients["3"] {
+		t.Fatalf("expected users 2 and 3 to be notified, got %v", recipients)
+	}
+}
+
+func TestEngagementNotifier_Published(t *testing.T) {
+	client := &fakeNotifClient{sent: make(chan *notifpb.SendNotificationRequest, 1)}
+	n, _ := newTestNotifier(client)
+
+	n.Published(&dbmysql.Content{ContentID: 12, AuthorID: 1, Type: "REEL"})
+
+	req := expectSent(t, client)
+	if req.UserId != "1" || req.Type != string(common.PublishedType) || req.Message != "Your scheduled reel is now live" {
+		t.Fatalf("unexpected published notification: %+v", req)
+	}
+	if req.Data["deep_link"] != "gosocial://content/12" {
+		t.Fatalf("unexpected deep link data: %v", req.Data)
+	}
+}
+
+func TestService_NotifiesAuthorsButNotThemselves(t *testing.T) {
+	svc, cRepo, _ := newCommentService()
+	notifier := &recordingNotifier{}
+	svc.SetNotifier(notifier)
+	ctx := context.Background()
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 1, Type: "POST", Privacy: "public"})
+
+	_ = svc.ReactToContent(ctx, 1, 1, "like")
+	_ = svc.ReactToContent(ctx, 2, 1, "like")
+	// changing a reaction is not a new reaction
+	_ = svc.ReactToContent(ctx, 2, 1, "love")
+
+	if len(notifier.reactions) != 1 || notifier.reactions[0].UserID != 2 {
+		t.Fatalf("expected one notification for user 2, got %+v", notifier.reactions)
+	}
+
+	_, _ = svc.AddComment(ctx, 1, 1, 0, "my own post")
+	_, _ = svc.AddComment(ctx, 3, 1, 0, "nice")
+	if len(notifier.comments) != 1 || notifier.comments[0].AuthorID != 3 {
+		t.Fatalf("expected one comment notification for user 3, got %+v", notifier.comments)
+	}
+}
@@ -0,0 +1,202 @@
+package feed
+
+import (
+	"context"
+	"strconv"
+
+	"gosocial/internal/common"
+	"gosocial/internal/dbmysql"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The feed acts for the user authenticated from the request's JWT, never for the user IDs a request carries.
+// Handlers check those IDs against the caller with authorize, then the service decides what the caller may
+// see with canView and what they may change with ownContent. Content the caller may not see is reported as
+// missing, changes by anyone but the author are refused.
+
+// CallerID returns the user the auth interceptor authenticated for this call
+func CallerID(ctx context.Context) (int64, bool) {
+	userID, ok := common.UserIDFromContext(ctx)
+	if !ok || userID == 0 {
+		return 0, false
+	}
+	return int64(userID), true
+}
+
+// authorize checks that the user a request acts for is the authenticated caller, as a gRPC status error
+func authorize(ctx context.Context, userID int64) error {
+	callerID, ok := CallerID(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	if userID != callerID {
+		return status.Error(codes.PermissionDenied, "requests can only act for the authenticated user")
+	}
+	return nil
+}
+
+// callerFor returns the caller for requests where the acting user is optional, userID 0 means the request names none
+func callerFor(ctx context.Context, userID int64) (int64, error) {
+	callerID, ok := CallerID(ctx)
+	if !ok {
+		return 0, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	if userID != 0 && userID != callerID {
+		return 0, status.Error(codes.PermissionDenied, "requests can only act for the authenticated user")
+	}
+	return callerID, nil
+}
+
+// viewPolicy decides what one viewer may see. Friendships and list memberships are looked up once per
+// author and list, so it can filter a batch of content
+type viewPolicy struct {
+	s        *FeedService
+	viewerID int64
+	friendOf map[int64]bool
+	lists    *listMembership
+}
+
+func (s *FeedService) newViewPolicy(viewerID int64) *viewPolicy {
+	return &viewPolicy{s: s, viewerID: viewerID, friendOf: map[int64]bool{}, lists: s.newListMembership(viewerID)}
+}
+
+// canView applies the content privacy, archived stories, scheduled content and drafts are only visible to their author
+func (p *viewPolicy) canView(ctx context.Context, content *dbmysql.Content) (bool, error) {
+	if (content.ArchivedAt != nil || content.PublishAt != nil || content.Draft) && content.AuthorID != p.viewerID {
+		return false, nil
+	}
+	return p.allows(ctx, content)
+}
+
+// allows applies the privacy alone: public for everyone, friends for the author's friends, list for the members
+// of its audience list, private for the author
+func (p *viewPolicy) allows(ctx context.Context, content *dbmysql.Content) (bool, error) {
+	switch {
+	case content.AuthorID == p.viewerID || content.Privacy == "public":
+		return true, nil
+	case content.Privacy == "friends":
+		return p.isFriendOf(ctx, content.AuthorID)
+	case content.Privacy == "list":
+		return p.lists.canSee(ctx, content)
+	}
+	return false, nil
+}
+
+func (p *viewPolicy) isFriendOf(ctx context.Context, authorID int64) (bool, error) {
+	if friend, ok := p.friendOf[authorID]; ok {
+		return friend, nil
+	}
+	friendIDs, err := p.s.GetUserFriendIDs(ctx, authorID)
+	if err != nil {
+		return false, err
+	}
+	friend := false
+	for _, id := range friendIDs {
+		if id == p.viewerID {
+			friend = true
+			break
+		}
+	}
+	p.friendOf[authorID] = friend
+	return friend, nil
+}
+
+// canView tells whether the viewer may see a single content
+func (s *FeedService) canView(ctx context.Context, viewerID int64, content *dbmysql.Content) (bool, error) {
+	return s.newViewPolicy(viewerID).canView(ctx, content)
+}
+
+// filterVisible keeps the contents the viewer may see, in order
+func (s *FeedService) filterVisible(ctx context.Context, viewerID int64, contents []dbmysql.Content) ([]dbmysql.Content, error) {
+	policy := s.newViewPolicy(viewerID)
+	var visible []dbmysql.Content
+	for i := range contents {
+		ok, err := policy.canView(ctx, &contents[i])
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			visible = append(visible, contents[i])
+		}
+	}
+	return visible, nil
+}
+
+// visibleContent loads a content and checks that the viewer may see it
+func (s *FeedService) visibleContent(ctx context.Context, viewerID, contentID int64) (*dbmysql.Content, error) {
+	content, err := s.contentRepo.GetContentByID(ctx, contentID)
+	if err != nil {
+		return nil, err
+	}
+	visible, err := s.canView(ctx, viewerID, content)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return nil, ErrContentNotVisible
+	}
+	return content, nil
+}
+
+// ownContent loads a content and checks that the user is its author. Other users who may see it get
+// ErrNotContentOwner, the rest ErrContentNotVisible
+func (s *FeedService) ownContent(ctx context.Context, userID, contentID int64) (*dbmysql.Content, error) {
+	content, err := s.contentRepo.GetContentByID(ctx, contentID)
+	if err != nil {
+		return nil, err
+	}
+	if content.AuthorID == userID {
+		return content, nil
+	}
+	visible, err := s.canView(ctx, userID, content)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return nil, ErrContentNotVisible
+	}
+	return nil, ErrNotContentOwner
+}
+
+// canViewMedia tells whether the viewer uploaded the media or may see a content using it. Like ListHighlights,
+// archived stories kept in a highlight are seen by whoever their privacy allows
+func (s *FeedService) canViewMedia(ctx context.Context, viewerID int64, media *dbmysql.MediaRef) (bool, error) {
+	if media.UploadedBy == strconv.FormatInt(viewerID, 10) {
+		return true, nil
+	}
+	contents, err := s.contentRepo.ListContentByMedia(ctx, int64(media.MediaRefID))
+	if err != nil {
+		return false, err
+	}
+
+	var storyIDs []int64
+	for _, c := range contents {
+		if c.Type == "STORY" && c.ArchivedAt != nil {
+			storyIDs = append(storyIDs, c.ContentID)
+		}
+	}
+	highlighted := map[int64]bool{}
+	if len(storyIDs) > 0 {
+		if highlighted, err = s.highlightRepo.HighlightedStories(ctx, storyIDs); err != nil {
+			return false, err
+		}
+	}
+
+	policy := s.newViewPolicy(viewerID)
+	for i := range contents {
+		check := policy.canView
+		if highlighted[contents[i].ContentID] {
+			check = policy.allows
+		}
+		visible, err := check(ctx, &contents[i])
+		if err != nil {
+			return false, err
+		}
+		if visible {
+			return true, nil
+		}
+	}
+	return false, nil
+}
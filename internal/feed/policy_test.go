@@ -0,0 +1,140 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"gosocial/internal/dbmysql"
+)
+
+func TestPolicy_ContentVisibility(t *testing.T) {
+	svc, cRepo, _ := newAudienceService()
+	ctx := context.Background()
+
+	list, _ := svc.CreateAudienceList(ctx, 1, "Close Friends", []int64{2})
+	ids := map[string]int64{}
+	for _, privacy := range []string{"public", "friends", "private", "list"} {
+		listID := int64(0)
+		if privacy == "list" {
+			listID = list.ListID
+		}
+		id, err := svc.CreatePost(ctx, 1, privacy, nil, "", "", privacy, listID, nil)
+		if err != nil {
+			t.Fatalf("CreatePost %s err: %v", privacy, err)
+		}
+		ids[privacy] = id
+	}
+	archived := time.Now()
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 1, Type: "STORY", Privacy: "public", ArchivedAt: &archived})
+	ids["archived"] = cRepo.next - 1
+
+	// author 1, list member and friend 2, friend outside the list 4, stranger 3
+	want := map[int64]string{1: "public friends private list archived", 2: "public friends list", 4: "public friends", 3: "public"}
+	for viewer, visible := range want {
+		for name, id := range ids {
+			shown := strings.Contains(visible, name)
+			_, _, err := svc.GetVisibleContent(ctx, viewer, id)
+			if shown != (err == nil) || (!shown && !errors.Is(err, ErrContentNotVisible)) {
+				t.Errorf("viewer %d, %s content: want visible=%v, got %v", viewer, name, shown, err)
+			}
+			if _, err := svc.GetReactions(ctx, viewer, id); shown != (err == nil) {
+				t.Errorf("viewer %d, %s reactions: want visible=%v, got %v", viewer, name, shown, err)
+			}
+			if err := svc.ReactToContent(ctx, viewer, id, "like"); shown != (err == nil) {
+				t.Errorf("viewer %d, %s react: want allowed=%v, got %v", viewer, name, shown, err)
+			}
+		}
+
+		profile, _, err := svc.GetUserContent(ctx, viewer, 1)
+		if err != nil {
+			t.Fatalf("GetUserContent err: %v", err)
+		}
+		var names []string
+		for _, c := range profile {
+			names = append(names, *c.TextContent)
+		}
+		sort.Strings(names)
+		// archived stories stay out of profiles, even the author's
+		expected := strings.Fields(strings.TrimSuffix(visible, " archived"))
+		sort.Strings(expected)
+		if strings.Join(names, " ") != strings.Join(expected, " ") {
+			t.Errorf("viewer %d profile: want %v, got %v", viewer, expected, names)
+		}
+	}
+}
+
+func TestPolicy_OnlyAuthorsChangeContent(t *testing.T) {
+	svc, cRepo, _ := newAudienceService()
+	ctx := context.Background()
+
+	id, _ := svc.CreatePost(ctx, 1, "mine", nil, "", "", "friends", 0, nil)
+	text := "edited"
+
+	// a friend sees the post but may not change it, a stranger does not even see it
+	if err := svc.DeleteContent(ctx, 2, id); !errors.Is(err, ErrNotContentOwner) {
+		t.Fatalf("friend delete: expected ErrNotContentOwner, got %v", err)
+	}
+	if err := svc.DeleteContent(ctx, 3, id); !errors.Is(err, ErrContentNotVisible) {
+		t.Fatalf("stranger delete: expected ErrContentNotVisible, got %v", err)
+	}
+	if _, err := svc.UpdateContent(ctx, 2, id, ContentUpdate{Text: &text}); !errors.Is(err, ErrNotContentOwner) {
+		t.Fatalf("friend update: expected ErrNotContentOwner, got %v", err)
+	}
+	if _, err := svc.UpdateContent(ctx, 3, id, ContentUpdate{Text: &text}); !errors.Is(err, ErrContentNotVisible) {
+		t.Fatalf("stranger update: expected ErrContentNotVisible, got %v", err)
+	}
+	if _, ok := cRepo.m[id]; !ok || *cRepo.m[id].TextContent != "mine" {
+		t.Fatalf("refused changes should leave the content alone, got %+v", cRepo.m[id])
+	}
+
+	story, _ := svc.CreateStory(ctx, 1, []byte("img"), "image", "s.png", 60, "public", 0)
+	if _, err := svc.ListStoryViewers(ctx, 2, story, "", 0); !errors.Is(err, ErrNotContentOwner) {
+		t.Fatalf("friend listing story viewers: expected ErrNotContentOwner, got %v", err)
+	}
+
+	if err := svc.DeleteContent(ctx, 1, id); err != nil {
+		t.Fatalf("author delete err: %v", err)
+	}
+	if _, ok := cRepo.m[id]; ok {
+		t.Fatalf("author delete should remove the content")
+	}
+}
+
+func TestPolicy_MediaFollowsContent(t *testing.T) {
+	svc, _, _ := newAudienceService()
+	ctx := context.Background()
+
+	publicID, _ := svc.CreatePost(ctx, 1, "", []byte("a"), "a.jpg", "image", "public", 0, nil)
+	privateID, _ := svc.CreatePost(ctx, 1, "", []byte("b"), "b.jpg", "image", "private", 0, nil)
+	publicMedia, _, _ := svc.GetContent(ctx, publicID)
+	privateMedia, _, _ := svc.GetContent(ctx, privateID)
+	unattached, err := svc.UploadMedia(ctx, 1, "c.jpg", "image", strings.NewReader("c"))
+	if err != nil {
+		t.Fatalf("UploadMedia err: %v", err)
+	}
+
+	cases := []struct {
+		viewer, media int64
+		visible       bool
+	}{
+		{3, *publicMedia.MediaRefID, true},
+		{1, *privateMedia.MediaRefID, true},
+		{2, *privateMedia.MediaRefID, false},
+		{1, int64(unattached.MediaRefID), true},
+		{2, int64(unattached.MediaRefID), false},
+		{1, 404, false},
+	}
+	for _, c := range cases {
+		_, data, err := svc.GetMediaRef(ctx, c.viewer, c.media)
+		if c.visible && (err != nil || len(data) == 0) {
+			t.Errorf("viewer %d, media %d: expected the file, got err=%v", c.viewer, c.media, err)
+		}
+		if !c.visible && !errors.Is(err, ErrMediaNotFound) {
+			t.Errorf("viewer %d, media %d: expected ErrMediaNotFound, got %v", c.viewer, c.media, err)
+		}
+	}
+}
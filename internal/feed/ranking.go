@@ -0,0 +1,214 @@
+package feed
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gosocial/internal/config"
+	"gosocial/internal/dbmysql"
+)
+
+const (
+	RankingChronological = "chronological"
+	RankingRanked        = "ranked"
+)
+
+// a ranked timeline ranks the newest contents of the window, older contents are left to the chronological timeline
+const (
+	rankedTimelineWindow         = 3 * 24 * time.Hour
+	rankedTimelineCandidateLimit = 500
+)
+
+var ErrInvalidRanking = errors.New("ranking must be chronological or ranked")
+
+// Ranker orders timeline contents for a viewer
+type Ranker interface {
+	Rank(ctx context.Context, viewerID int64, contents []dbmysql.Content) ([]dbmysql.Content, error)
+}
+
+// counts at which the reaction and affinity signals reach one half
+const (
+	reactionHalfSaturation = 10
+	affinityHalfSaturation = 5
+)
+
+// ScoringRanker is the default Ranker, it sums weighted recency decay, reaction counts,
+// author affinity and a per content type boost
+type ScoringRanker struct {
+	signals Reactions
+	weights config.RankingConfig
+	now     func() time.Time
+}
+
+func NewScoringRanker(signals Reactions, weights config.RankingConfig) *ScoringRanker {
+	return &ScoringRanker{signals: signals, weights: weights, now: time.Now}
+}
+
+func (r *ScoringRanker) Rank(ctx context.Context, viewerID int64, contents []dbmysql.Content) ([]dbmysql.Content, error) {
+	if len(contents) < 2 {
+		return contents, nil
+	}
+
+	contentIDs := make([]int64, 0, len(contents))
+	var authorIDs []int64
+	seen := map[int64]bool{}
+	for _, c := range contents {
+		contentIDs = append(contentIDs, c.ContentID)
+		if c.AuthorID != viewerID && !seen[c.AuthorID] {
+			seen[c.AuthorID] = true
+			authorIDs = append(authorIDs, c.AuthorID)
+		}
+	}
+
+	reactions, err := r.signals.CountReactions(ctx, contentIDs)
+	if err != nil {
+		return nil, err
+	}
+	interactions, err := r.signals.CountInteractions(ctx, viewerID, authorIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	now := r.now()
+	scores := make(map[int64]float64, len(contents))
+	for _, c := range contents {
+		scores[c.ContentID] = r.Score(c, reactions[c.ContentID], interactions[c.AuthorID], now)
+	}
+
+	ranked := append([]dbmysql.Content(nil), contents...)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return scores[ranked[i].ContentID] > scores[ranked[j].ContentID]
+	})
+	return ranked, nil
+}
+
+// Score rates a single content, interactions is how often the viewer reacted to its author
+func (r *ScoringRanker) Score(c dbmysql.Content, reactions, interactions int64, now time.Time) float64 {
+	var recency float64
+	if r.weights.RecencyHalfLifeHrs > 0 {
+		ageHrs := math.Max(now.Sub(c.CreatedAt).Hours(), 0)
+		recency = math.Exp2(-ageHrs / r.weights.RecencyHalfLifeHrs)
+	}
+
+	return r.weights.RecencyWeight*recency +
+		r.weights.ReactionWeight*saturate(reactions, reactionHalfSaturation) +
+		r.weights.AffinityWeight*saturate(interactions, affinityHalfSaturation) +
+		r.weights.TypeBoosts[c.Type]
+}
+
+// saturate maps a count onto 0..1, reaching one half at half
+func saturate(count int64, half float64) float64 {
+	n := float64(count)
+	return n / (n + half)
+}
+
+// rankedSession is what a ranked timeline cursor carries: when the session started and the contents served so far
+type rankedSession struct {
+	startedAt time.Time
+	served    []int64
+}
+
+// getRankedTimeline ranks the candidate window of the user's timeline as a whole and pages through the result.
+// The window is fixed when the session starts so newer contents do not reshuffle it, and like explore the cursor
+// carries the contents already served instead of a position, since scores shift between pages
+func (s *FeedService) getRankedTimeline(ctx context.Context, userID int64, query TimelineQuery) (*TimelinePage, error) {
+	session, err := decodeRankedCursor(query.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	if session.startedAt.IsZero() {
+		session.startedAt = time.Now()
+	}
+	pageSize := clampPageSize(query.PageSize)
+
+	// Step 1: The newest contents up to the start of the session, within the window
+	start := &TimelineCursor{CreatedAt: session.startedAt, ContentID: math.MaxInt64}
+	candidates, err := s.listTimeline(ctx, userID, start, rankedTimelineCandidateLimit)
+	if err != nil {
+		return nil, err
+	}
+	served := make(map[int64]bool, len(session.served))
+	for _, id := range session.served {
+		served[id] = true
+	}
+	since := session.startedAt.Add(-rankedTimelineWindow)
+	var window []dbmysql.Content
+	for _, c := range candidates {
+		if c.CreatedAt.Before(since) {
+			break
+		}
+		if !served[c.ContentID] {
+			window = append(window, c)
+		}
+	}
+
+	// Step 2: Rank what the session has not served yet and cut the page
+	contents, err := s.ranker.Rank(ctx, userID, window)
+	if err != nil {
+		return nil, err
+	}
+	page := &TimelinePage{}
+	if len(contents) > pageSize {
+		contents = contents[:pageSize]
+		for _, c := range contents {
+			session.served = append(session.served, c.ContentID)
+		}
+		page.NextCursor = session.encode()
+	}
+	page.Contents = contents
+
+	// Step 3: Media URLs in one batch
+	page.MediaURLs, err = s.mediaURLs(ctx, contents)
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+func (r rankedSession) encode() string {
+	ids := make([]string, 0, len(r.served))
+	for _, id := range r.served {
+		ids = append(ids, strconv.FormatInt(id, 10))
+	}
+	raw := fmt.Sprintf("%d|%s", r.startedAt.UnixNano(), strings.Join(ids, ","))
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeRankedCursor parses a ranked timeline cursor, an empty cursor starts a new session
+func decodeRankedCursor(cursor string) (rankedSession, error) {
+	if cursor == "" {
+		return rankedSession{}, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return rankedSession{}, ErrInvalidCursor
+	}
+	started, ids, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return rankedSession{}, ErrInvalidCursor
+	}
+	nanos, err := strconv.ParseInt(started, 10, 64)
+	if err != nil || nanos <= 0 {
+		return rankedSession{}, ErrInvalidCursor
+	}
+	fields := strings.Split(ids, ",")
+	if len(fields) > rankedTimelineCandidateLimit {
+		return rankedSession{}, ErrInvalidCursor
+	}
+	session := rankedSession{startedAt: time.Unix(0, nanos), served: make([]int64, 0, len(fields))}
+	for _, f := range fields {
+		id, err := strconv.ParseInt(f, 10, 64)
+		if err != nil || id <= 0 {
+			return rankedSession{}, ErrInvalidCursor
+		}
+		session.served = append(session.served, id)
+	}
+	return session, nil
+}
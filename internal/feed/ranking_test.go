@@ -0,0 +1,162 @@
+package feed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	userpb "gosocial/api/v1/user"
+	"gosocial/internal/config"
+	"gosocial/internal/dbmysql"
+
+	"google.golang.org/grpc"
+)
+
+var testWeights = config.RankingConfig{
+	RecencyWeight:      1,
+	RecencyHalfLifeHrs: 6,
+	ReactionWeight:     1,
+	AffinityWeight:     1,
+	TypeBoosts:         map[string]float64{"REEL": 0.5},
+}
+
+func TestScoringRanker_Score(t *testing.T) {
+	now := time.Now()
+	r := NewScoringRanker(newFakeReactionRepo(), testWeights)
+
+	fresh := r.Score(dbmysql.Content{Type: "POST", CreatedAt: now}, 0, 0, now)
+	halfLife := r.Score(dbmysql.Content{Type: "POST", CreatedAt: now.Add(-6 * time.Hour)}, 0, 0, now)
+	if fresh != 1 || halfLife < 0.49 || halfLife > 0.51 {
+		t.Fatalf("recency decay off: fresh=%v halfLife=%v", fresh, halfLife)
+	}
+
+	popular := r.Score(dbmysql.Content{Type: "POST", CreatedAt: now}, 10, 0, now)
+	if popular != 1.5 {
+		t.Fatalf("10 reactions should add half the reaction weight, got %v", popular)
+	}
+	affine := r.Score(dbmysql.Content{Type: "POST", CreatedAt: now}, 0, 5, now)
+	if affine != 1.5 {
+		t.Fatalf("5 interactions should add half the affinity weight, got %v", affine)
+	}
+	reel := r.Score(dbmysql.Content{Type: "REEL", CreatedAt: now}, 0, 0, now)
+	if reel != 1.5 {
+		t.Fatalf("reel boost not applied, got %v", reel)
+	}
+
+	noDecay := NewScoringRanker(newFakeReactionRepo(), config.RankingConfig{RecencyWeight: 1})
+	if s := noDecay.Score(dbmysql.Content{CreatedAt: now}, 0, 0, now); s != 0 {
+		t.Fatalf("recency without half-life should be ignored, got %v", s)
+	}
+}
+
+func TestService_GetTimeline_Ranked(t *testing.T) {
+	ctx := context.Background()
+	cRepo := newFakeContentRepo()
+	rRepo := newFakeReactionRepo()
+	rRepo.contents = cRepo
+	uc := &fakeUserClient{
+		ListFn: func(context.Context, *userpb.UserID, ...grpc.CallOption) (*userpb.FriendList, error) {
+			return &userpb.FriendList{Friends: []*userpb.Friend{{UserId: 2}, {UserId: 3}}}, nil
+		},
+	}
+	svc := &FeedService{contentRepo: cRepo, mediaRepo: newFakeMediaRepo(), reactionRepo: rRepo, UserClient: uc}
+	svc.SetRanker(NewScoringRanker(rRepo, testWeights))
+
+	now := time.Now()
+	seed := []dbmysql.Content{
+		{AuthorID: 2, Type: "POST", Privacy: "public", CreatedAt: now.Add(-3 * time.Hour)}, // older post by a close friend
+		{AuthorID: 3, Type: "POST", Privacy: "public", CreatedAt: now.Add(-2 * time.Hour)},
+		{AuthorID: 3, Type: "POST", Privacy: "public", CreatedAt: now.Add(-time.Hour)},
+		{AuthorID: 3, Type: "POST", Privacy: "public", CreatedAt: now.Add(-10 * 24 * time.Hour)}, // next page
+	}
+	for i := range seed {
+		_ = cRepo.CreateContent(ctx, &seed[i])
+	}
+	// the viewer often reacts to author 2, and others reacted to the close friend's post
+	for u := int64(10); u < 20; u++ {
+		_ = rRepo.AddReaction(ctx, &dbmysql.Reaction{UserID: u, ContentID: seed[0].ContentID, Type: "like"})
+	}
+	_ = rRepo.AddReaction(ctx, &dbmysql.Reaction{UserID: 1, ContentID: seed[0].ContentID, Type: "like"})
+
+	chrono, err := svc.GetTimeline(ctx, 1, TimelineQuery{PageSize: 3})
+	if err != nil || chrono.Contents[0].ContentID != seed[2].ContentID {
+		t.Fatalf("chronological page unexpected: %+v err=%v", chrono, err)
+	}
+
+	ranked, err := svc.GetTimeline(ctx, 1, TimelineQuery{PageSize: 3, Ranking: RankingRanked})
+	if err != nil {
+		t.Fatalf("ranked GetTimeline err: %v", err)
+	}
+	if ranked.Contents[0].ContentID != seed[0].ContentID {
+		t.Fatalf("close friend's popular post should rank first, got %+v", ranked.Contents)
+	}
+	if len(ranked.Contents) != 3 || ranked.NextCursor != "" {
+		t.Fatalf("ranked timeline should end at the edge of its window, got %+v next=%q", ranked.Contents, ranked.NextCursor)
+	}
+
+	if _, err := svc.GetTimeline(ctx, 1, TimelineQuery{Ranking: "viral"}); err != ErrInvalidRanking {
+		t.Fatalf("expected ErrInvalidRanking, got %v", err)
+	}
+}
+
+func TestService_GetTimeline_RankedPages(t *testing.T) {
+	ctx := context.Background()
+	uc := &fakeUserClient{
+		ListFn: func(context.Context, *userpb.UserID, ...grpc.CallOption) (*userpb.FriendList, error) {
+			return &userpb.FriendList{Friends: []*userpb.Friend{{UserId: 2}}}, nil
+		},
+	}
+	svc := newTestFeedService(uc)
+	cRepo := svc.contentRepo.(*fakeContentRepo)
+	rRepo := svc.reactionRepo.(*fakeReactionRepo)
+	rRepo.contents = cRepo
+	svc.SetRanker(NewScoringRanker(rRepo, testWeights))
+
+	now := time.Now()
+	seed := []dbmysql.Content{
+		{AuthorID: 2, Type: "POST", Privacy: "public", CreatedAt: now.Add(-2 * time.Hour)}, // popular, third chronologically
+		{AuthorID: 2, Type: "POST", Privacy: "public", CreatedAt: now.Add(-time.Hour)},
+		{AuthorID: 2, Type: "POST", Privacy: "public", CreatedAt: now.Add(-30 * time.Minute)},
+		{AuthorID: 2, Type: "POST", Privacy: "public", CreatedAt: now.Add(-10 * time.Minute)},
+	}
+	for i := range seed {
+		_ = cRepo.CreateContent(ctx, &seed[i])
+	}
+	for u := int64(10); u < 20; u++ {
+		_ = rRepo.AddReaction(ctx, &dbmysql.Reaction{UserID: u, ContentID: seed[0].ContentID, Type: "like"})
+	}
+
+	first, err := svc.GetTimeline(ctx, 1, TimelineQuery{PageSize: 2, Ranking: RankingRanked})
+	if err != nil {
+		t.Fatalf("ranked GetTimeline err: %v", err)
+	}
+	if len(first.Contents) != 2 || first.Contents[0].ContentID != seed[0].ContentID || first.NextCursor == "" {
+		t.Fatalf("popular post beyond the first chronological page should rank first, got %+v", first.Contents)
+	}
+
+	// contents posted during the session wait for the next one
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 2, Type: "POST", Privacy: "public", CreatedAt: time.Now().Add(time.Minute)})
+
+	second, err := svc.GetTimeline(ctx, 1, TimelineQuery{PageSize: 2, Ranking: RankingRanked, Cursor: first.NextCursor})
+	if err != nil {
+		t.Fatalf("ranked GetTimeline page 2 err: %v", err)
+	}
+	served := map[int64]bool{}
+	for _, c := range append(first.Contents, second.Contents...) {
+		if served[c.ContentID] {
+			t.Fatalf("content %d served twice", c.ContentID)
+		}
+		served[c.ContentID] = true
+	}
+	if len(second.Contents) != 2 || second.NextCursor != "" || len(served) != len(seed) {
+		t.Fatalf("second page should serve the rest of the window, got %+v next=%q", second.Contents, second.NextCursor)
+	}
+
+	chrono, _ := svc.GetTimeline(ctx, 1, TimelineQuery{PageSize: 2})
+	if _, err := svc.GetTimeline(ctx, 1, TimelineQuery{Ranking: RankingRanked, Cursor: chrono.NextCursor}); err != ErrInvalidCursor {
+		t.Fatalf("chronological cursor should not continue a ranked timeline, got %v", err)
+	}
+	if _, err := svc.GetTimeline(ctx, 1, TimelineQuery{Cursor: first.NextCursor}); err != ErrInvalidCursor {
+		t.Fatalf("ranked cursor should not continue a chronological timeline, got %v", err)
+	}
+}
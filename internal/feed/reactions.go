@@ -0,0 +1,103 @@
+package feed
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	DefaultReactorPageSize = 20
+	MaxReactorPageSize     = 100
+)
+
+// ReactionSummary aggregates the reactions of one content, ViewerReaction is empty when the viewer has not reacted
+type ReactionSummary struct {
+	Counts         map[string]int64
+	Total          int64
+	ViewerReaction string
+}
+
+// Reactor is a user who reacted to a content
+type Reactor struct {
+	UserID    int64
+	Handle    string
+	Type      string
+	ReactedAt time.Time
+}
+
+type ReactorPage struct {
+	Reactors   []Reactor
+	NextCursor string
+}
+
+// SummarizeReactions builds the reaction summary of each content in one pass, viewerID 0 skips the viewer's reaction
+func (s *FeedService) SummarizeReactions(ctx context.Context, viewerID int64, contentIDs []int64) (map[int64]ReactionSummary, error) {
+	counts, err := s.reactionRepo.CountReactionsByType(ctx, contentIDs)
+	if err != nil {
+		return nil, err
+	}
+	viewerReactions := map[int64]string{}
+	if viewerID > 0 {
+		viewerReactions, err = s.reactionRepo.GetViewerReactions(ctx, viewerID, contentIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	summaries := make(map[int64]ReactionSummary, len(contentIDs))
+	for _, id := range contentIDs {
+		summary := ReactionSummary{Counts: counts[id], ViewerReaction: viewerReactions[id]}
+		for _, n := range summary.Counts {
+			summary.Total += n
+		}
+		summaries[id] = summary
+	}
+	return summaries, nil
+}
+
+// ListReactors pages through the users who reacted to a content, oldest first, reactionType filters when set
+func (s *FeedService) ListReactors(ctx context.Context, viewerID, contentID int64, reactionType, cursor string, pageSize int) (*ReactorPage, error) {
+	afterID, err := decodeIDCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	if pageSize <= 0 {
+		pageSize = DefaultReactorPageSize
+	}
+	if pageSize > MaxReactorPageSize {
+		pageSize = MaxReactorPageSize
+	}
+
+	if _, err := s.visibleContent(ctx, viewerID, contentID); err != nil {
+		return nil, err
+	}
+
+	reactions, err := s.reactionRepo.ListReactors(ctx, contentID, reactionType, afterID, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &ReactorPage{}
+	if len(reactions) > pageSize {
+		reactions = reactions[:pageSize]
+		page.NextCursor = encodeIDCursor(reactions[pageSize-1].ID)
+	}
+
+	userIDs := make([]int64, 0, len(reactions))
+	for _, reaction := range reactions {
+		userIDs = append(userIDs, reaction.UserID)
+	}
+	handles, err := s.GetUserHandles(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, reaction := range reactions {
+		page.Reactors = append(page.Reactors, Reactor{
+			UserID:    reaction.UserID,
+			Handle:    handles[reaction.UserID],
+			Type:      reaction.Type,
+			ReactedAt: reaction.CreatedAt,
+		})
+	}
+	return page, nil
+}
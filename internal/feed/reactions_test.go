@@ -0,0 +1,102 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	userpb "gosocial/api/v1/user"
+	"gosocial/internal/dbmysql"
+
+	"google.golang.org/grpc"
+)
+
+func TestReactions_Summaries(t *testing.T) {
+	svc, cRepo, _ := newCommentService()
+	ctx := context.Background()
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 1, Type: "POST", Privacy: "public"})
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 1, Type: "POST", Privacy: "public"})
+
+	_ = svc.ReactToContent(ctx, 2, 1, "like")
+	_ = svc.ReactToContent(ctx, 3, 1, "like")
+	_ = svc.ReactToContent(ctx, 4, 1, "love")
+	// changing a reaction replaces it
+	_ = svc.ReactToContent(ctx, 2, 1, "laugh")
+
+	summaries, err := svc.SummarizeReactions(ctx, 2, []int64{1, 2})
+	if err != nil {
+		t.Fatalf("SummarizeReactions err: %v", err)
+	}
+	got := summaries[1]
+	if got.Total != 3 || got.Counts["like"] != 1 || got.Counts["love"] != 1 || got.Counts["laugh"] != 1 {
+		t.Fatalf("unexpected counts: %+v", got)
+	}
+	if got.ViewerReaction != "laugh" {
+		t.Fatalf("expected viewer reaction laugh, got %q", got.ViewerReaction)
+	}
+	if empty := summaries[2]; empty.Total != 0 || len(empty.Counts) != 0 || empty.ViewerReaction != "" {
+		t.Fatalf("content without reactions should have an empty summary: %+v", empty)
+	}
+
+	anonymous, _ := svc.SummarizeReactions(ctx, 0, []int64{1})
+	if anonymous[1].Total != 3 || anonymous[1].ViewerReaction != "" {
+		t.Fatalf("viewer 0 should only get counts: %+v", anonymous[1])
+	}
+}
+
+func TestReactions_ListReactors(t *testing.T) {
+	svc, cRepo, _ := newCommentService()
+	lookups := 0
+	svc.UserClient.(*fakeUserClient).HandlesFn = func(ctx context.Context, in *userpb.GetHandlesRequest, _ ...grpc.CallOption) (*userpb.GetHandlesResponse, error) {
+		lookups++
+		resp := &userpb.GetHandlesResponse{Handles: map[int64]string{}}
+		for _, id := range in.UserIds {
+			resp.Handles[id] = fmt.Sprintf("user%d", id)
+		}
+		return resp, nil
+	}
+	ctx := context.Background()
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 1, Type: "POST", Privacy: "public"})
+	for uid, rt := range map[int64]string{2: "like", 3: "love", 4: "like", 5: "like"} {
+		_ = svc.ReactToContent(ctx, uid, 1, rt)
+	}
+	// the post becomes friends-only after strangers reacted
+	post := cRepo.m[1]
+	post.Privacy = "friends"
+	cRepo.m[1] = post
+
+	// only the author and friends can see who reacted to a friends-only post
+	if _, err := svc.ListReactors(ctx, 3, 1, "", "", 0); !errors.Is(err, ErrContentNotVisible) {
+		t.Fatalf("expected ErrContentNotVisible, got %v", err)
+	}
+
+	first, err := svc.ListReactors(ctx, 2, 1, "like", "", 2)
+	if err != nil || len(first.Reactors) != 2 || first.NextCursor == "" {
+		t.Fatalf("unexpected first page %+v err=%v", first, err)
+	}
+	if lookups != 1 {
+		t.Fatalf("expected one batched handle lookup per page, got %d", lookups)
+	}
+	second, err := svc.ListReactors(ctx, 2, 1, "like", first.NextCursor, 2)
+	if err != nil || len(second.Reactors) != 1 || second.NextCursor != "" {
+		t.Fatalf("unexpected second page %+v err=%v", second, err)
+	}
+
+	seen := map[int64]bool{}
+	for _, r := range append(first.Reactors, second.Reactors...) {
+		if r.Type != "like" || r.Handle != fmt.Sprintf("user%d", r.UserID) || seen[r.UserID] {
+			t.Fatalf("unexpected reactor %+v", r)
+		}
+		seen[r.UserID] = true
+	}
+
+	all, _ := svc.ListReactors(ctx, 1, 1, "", "", 0)
+	if len(all.Reactors) != 4 {
+		t.Fatalf("expected every reactor without a type filter, got %d", len(all.Reactors))
+	}
+
+	if _, err := svc.ListReactors(ctx, 1, 1, "", "bogus!", 0); !errors.Is(err, ErrInvalidCursor) {
+		t.Fatalf("expected ErrInvalidCursor, got %v", err)
+	}
+}
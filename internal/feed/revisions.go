@@ -0,0 +1,189 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"gosocial/internal/dbmysql"
+)
+
+const (
+	DefaultRevisionPageSize = 20
+	MaxRevisionPageSize     = 100
+)
+
+var ErrInvalidContentUpdate = errors.New("stories have no text and a post without media needs text")
+
+// ContentUpdate lists the fields to change, nil leaves a field as it is. Text is a post's text or a reel's caption,
+// AudienceListID picks the list of list privacy
+type ContentUpdate struct {
+	Text           *string
+	Privacy        *string
+	AudienceListID *int64
+}
+
+type RevisionPage struct {
+	Revisions  []dbmysql.ContentRevision
+	NextCursor string
+}
+
+// UpdateContent lets the author change the text, privacy and audience list of a content. The replaced version
+// is kept as a revision and EditedAt is set, an update that changes nothing stores nothing
+func (s *FeedService) UpdateContent(ctx context.Context, editorID, contentID int64, update ContentUpdate) (*dbmysql.Content, error) {
+	if update.Privacy != nil {
+		if _, ok := privacyRank[*update.Privacy]; !ok {
+			return nil, ErrInvalidPrivacy
+		}
+	}
+
+	content, err := s.ownContent(ctx, editorID, contentID)
+	if err != nil {
+		return nil, err
+	}
+	if content.Draft {
+		return nil, ErrUnpublishedDraft
+	}
+	revision := &dbmysql.ContentRevision{
+		ContentID:      content.ContentID,
+		TextContent:    content.TextContent,
+		Privacy:        content.Privacy,
+		AudienceListID: content.AudienceListID,
+	}
+
+	textChanged := update.Text != nil && *update.Text != safeString(content.TextContent)
+	if textChanged {
+		text := *update.Text
+		if content.Type == "STORY" || (text == "" && content.Type == "POST" && content.MediaRefID == nil && content.SharedContentID == nil) {
+			return nil, ErrInvalidContentUpdate
+		}
+		content.TextContent = &text
+		if text == "" {
+			content.TextContent = nil
+		}
+	}
+	privacy, audienceListID := content.Privacy, content.AudienceListID
+	if update.Privacy != nil {
+		privacy = *update.Privacy
+	}
+	if update.AudienceListID != nil {
+		audienceListID = audienceListRef(*update.AudienceListID)
+	}
+	privacyChanged := privacy != content.Privacy || (privacy == "list" && !sameAudienceList(audienceListID, content.AudienceListID))
+	if privacyChanged {
+		content.Privacy, content.AudienceListID = privacy, audienceListID
+		if err := s.checkAudience(ctx, content); err != nil {
+			return nil, err
+		}
+		if err := s.checkSharePrivacy(ctx, content, privacy); err != nil {
+			return nil, err
+		}
+	}
+	if !textChanged && !privacyChanged {
+		return content, nil
+	}
+
+	now := time.Now()
+	content.EditedAt = &now
+	content.UpdatedAt = now
+	revision.ReplacedAt = now
+	if err := s.contentRepo.UpdateContent(ctx, content, revision); err != nil {
+		return nil, err
+	}
+
+	// scheduled content is indexed and fanned out once it is published
+	if content.PublishAt != nil {
+		return content, nil
+	}
+	if textChanged {
+		s.reindexText(ctx, content)
+	}
+	// privacy decides whose timelines hold the content
+	if privacyChanged && s.timelines != nil {
+		if err := s.timelines.RemoveFromTimelines(ctx, contentID); err != nil {
+			return nil, err
+		}
+		s.fanOut(ctx, content)
+	}
+	return content, nil
+}
+
+// ListRevisions pages through the earlier versions of a content oldest first, for whoever may see the content.
+// Each version keeps its own privacy, so versions the viewer could not see are left out of the page
+func (s *FeedService) ListRevisions(ctx context.Context, viewerID, contentID int64, cursor string, pageSize int) (*RevisionPage, error) {
+	afterID, err := decodeIDCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	if pageSize <= 0 {
+		pageSize = DefaultRevisionPageSize
+	}
+	if pageSize > MaxRevisionPageSize {
+		pageSize = MaxRevisionPageSize
+	}
+
+	content, err := s.visibleContent(ctx, viewerID, contentID)
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := s.contentRepo.ListRevisions(ctx, contentID, afterID, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &RevisionPage{}
+	if len(revisions) > pageSize {
+		revisions = revisions[:pageSize]
+		page.NextCursor = encodeIDCursor(revisions[pageSize-1].RevisionID)
+	}
+	policy := s.newViewPolicy(viewerID)
+	for _, r := range revisions {
+		visible, err := policy.allows(ctx, &dbmysql.Content{AuthorID: content.AuthorID, Privacy: r.Privacy, AudienceListID: r.AudienceListID})
+		if err != nil {
+			return nil, err
+		}
+		if visible {
+			page.Revisions = append(page.Revisions, r)
+		}
+	}
+	return page, nil
+}
+
+// reindexText rebuilds the hashtags and mentions of edited text, users mentioned before are not notified again.
+// Like on creation, the edit is saved even if this fails
+func (s *FeedService) reindexText(ctx context.Context, content *dbmysql.Content) {
+	if err := s.hashtagRepo.DeleteContentHashtags(ctx, content.ContentID); err != nil {
+		log.Printf("failed to clear hashtags of content %d: %v", content.ContentID, err)
+	} else if content.TextContent != nil {
+		if tags := ExtractHashtags(*content.TextContent); len(tags) > 0 {
+			if err := s.hashtagRepo.TagContent(ctx, content.ContentID, tags, content.CreatedAt); err != nil {
+				log.Printf("failed to tag content %d: %v", content.ContentID, err)
+			}
+		}
+	}
+
+	previous, err := s.mentionRepo.ListMentions(ctx, []int64{content.ContentID})
+	if err != nil {
+		log.Printf("failed to load mentions of content %d: %v", content.ContentID, err)
+		return
+	}
+	notified := map[int64]bool{}
+	for _, m := range previous[content.ContentID] {
+		notified[m.MentionedUserID] = true
+	}
+	if err := s.mentionRepo.DeleteMentions(ctx, content.ContentID); err != nil {
+		log.Printf("failed to clear mentions of content %d: %v", content.ContentID, err)
+		return
+	}
+	if err := s.recordMentions(ctx, content, notified); err != nil {
+		log.Printf("failed to record mentions of content %d: %v", content.ContentID, err)
+	}
+}
+
+func sameAudienceList(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,115 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"gosocial/internal/dbmysql"
+)
+
+func TestRevisions_UpdateKeepsHistory(t *testing.T) {
+	svc, cRepo, mRepo, notifier := newMentionService()
+	hRepo := svc.hashtagRepo.(*fakeHashtagRepo)
+	ctx := context.Background()
+
+	id, err := svc.CreatePost(ctx, 1, "hello @user2 #go", nil, "", "", "public", 0, nil)
+	if err != nil {
+		t.Fatalf("CreatePost err: %v", err)
+	}
+	created := cRepo.m[id].UpdatedAt
+	notifier.mentioned = nil
+
+	text, privacy := "hello @user2 @user3 #golang", "friends"
+	if _, err := svc.UpdateContent(ctx, 2, id, ContentUpdate{Text: &text}); !errors.Is(err, ErrNotContentOwner) {
+		t.Fatalf("expected ErrNotContentOwner, got %v", err)
+	}
+	updated, err := svc.UpdateContent(ctx, 1, id, ContentUpdate{Text: &text, Privacy: &privacy})
+	if err != nil {
+		t.Fatalf("UpdateContent err: %v", err)
+	}
+	if safeString(updated.TextContent) != text || updated.Privacy != "friends" || updated.EditedAt == nil || !updated.UpdatedAt.After(created) {
+		t.Fatalf("unexpected updated content %+v", updated)
+	}
+	if !reflect.DeepEqual(hRepo.tags[id], []string{"golang"}) {
+		t.Fatalf("hashtags should follow the new text, got %v", hRepo.tags[id])
+	}
+	if len(mRepo.mentions) != 2 {
+		t.Fatalf("mentions should follow the new text, got %+v", mRepo.mentions)
+	}
+	// user 2 was already notified and user 3 is not a friend of the author
+	if len(notifier.mentioned) != 0 {
+		t.Fatalf("no one should be notified again, got %v", notifier.mentioned)
+	}
+
+	// an update that changes nothing stores no revision
+	same, err := svc.UpdateContent(ctx, 1, id, ContentUpdate{Text: &text})
+	if err != nil || len(cRepo.revisions) != 1 || *same.EditedAt != *updated.EditedAt {
+		t.Fatalf("no-op update should not add a revision, got %d err=%v", len(cRepo.revisions), err)
+	}
+
+	page, err := svc.ListRevisions(ctx, 2, id, "", 0)
+	if err != nil || len(page.Revisions) != 1 {
+		t.Fatalf("ListRevisions mismatch: %+v err=%v", page, err)
+	}
+	if rev := page.Revisions[0]; safeString(rev.TextContent) != "hello @user2 #go" || rev.Privacy != "public" {
+		t.Fatalf("revision should hold the replaced version, got %+v", rev)
+	}
+	if _, err := svc.ListRevisions(ctx, 3, id, "", 0); !errors.Is(err, ErrContentNotVisible) {
+		t.Fatalf("expected ErrContentNotVisible for a stranger, got %v", err)
+	}
+}
+
+func TestRevisions_KeepTheirOwnPrivacy(t *testing.T) {
+	svc, _, _ := newCommentService()
+	ctx := context.Background()
+
+	id, err := svc.CreatePost(ctx, 1, "secret plan", nil, "", "", "private", 0, nil)
+	if err != nil {
+		t.Fatalf("CreatePost err: %v", err)
+	}
+	text, privacy := "announcement", "public"
+	if _, err := svc.UpdateContent(ctx, 1, id, ContentUpdate{Text: &text, Privacy: &privacy}); err != nil {
+		t.Fatalf("UpdateContent err: %v", err)
+	}
+
+	for _, viewerID := range []int64{2, 3} {
+		page, err := svc.ListRevisions(ctx, viewerID, id, "", 0)
+		if err != nil || len(page.Revisions) != 0 {
+			t.Fatalf("the private version should stay hidden from user %d, got %+v err=%v", viewerID, page, err)
+		}
+	}
+	page, err := svc.ListRevisions(ctx, 1, id, "", 0)
+	if err != nil || len(page.Revisions) != 1 || safeString(page.Revisions[0].TextContent) != "secret plan" {
+		t.Fatalf("the author should see every version, got %+v err=%v", page, err)
+	}
+}
+
+func TestRevisions_InvalidUpdates(t *testing.T) {
+	svc, cRepo, _ := newCommentService()
+	ctx := context.Background()
+
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 1, Type: "POST", Privacy: "public", TextContent: sptr("hi")})
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 1, Type: "STORY", Privacy: "public"})
+
+	empty, caption, secret := "", "caption", "secret"
+	cases := []struct {
+		name    string
+		content int64
+		update  ContentUpdate
+		want    error
+	}{
+		{"emptying a text-only post", 1, ContentUpdate{Text: &empty}, ErrInvalidContentUpdate},
+		{"captioning a story", 2, ContentUpdate{Text: &caption}, ErrInvalidContentUpdate},
+		{"unknown privacy", 1, ContentUpdate{Privacy: &secret}, ErrInvalidPrivacy},
+	}
+	for _, c := range cases {
+		if _, err := svc.UpdateContent(ctx, 1, c.content, c.update); !errors.Is(err, c.want) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.want, err)
+		}
+	}
+	if len(cRepo.revisions) != 0 {
+		t.Fatalf("refused updates should store no revision, got %+v", cRepo.revisions)
+	}
+}
@@ -0,0 +1,138 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"gosocial/internal/dbmysql"
+)
+
+// MaxScheduleAhead is how far in the future posts and reels can be scheduled
+const MaxScheduleAhead = 75 * 24 * time.Hour
+
+var (
+	ErrInvalidPublishTime = errors.New("only posts and reels can be scheduled, for a time in the future at most 75 days ahead")
+	ErrNotScheduled       = errors.New("content is not waiting to be published")
+)
+
+// Scheduled content is saved right away with PublishAt set and CreatedAt equal to it. Until the publisher clears
+// PublishAt only the author sees it, and its hashtags, mentions and timeline entries wait for publishing
+
+// checkPublishAt validates the publish time of content about to be scheduled
+func checkPublishAt(content *dbmysql.Content, now time.Time) error {
+	if content.Type != "POST" && content.Type != "REEL" {
+		return ErrInvalidPublishTime
+	}
+	if !content.PublishAt.After(now) || content.PublishAt.After(now.Add(MaxScheduleAhead)) {
+		return ErrInvalidPublishTime
+	}
+	return nil
+}
+
+// ListScheduledContent pages through the author's content waiting to be published, soonest first
+func (s *FeedService) ListScheduledContent(ctx context.Context, authorID int64, query TimelineQuery) (*TimelinePage, error) {
+	cursor, err := DecodeCursor(query.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := clampPageSize(query.PageSize)
+
+	contents, err := s.contentRepo.ListScheduledContent(ctx, authorID, cursor, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &TimelinePage{}
+	if len(contents) > pageSize {
+		contents = contents[:pageSize]
+		page.NextCursor = cursorOf(contents[pageSize-1]).Encode()
+	}
+	page.Contents = contents
+	page.MediaURLs, err = s.mediaURLs(ctx, contents)
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// RescheduleContent moves the author's scheduled content to another publish time
+func (s *FeedService) RescheduleContent(ctx context.Context, authorID, contentID int64, publishAt time.Time) (*dbmysql.Content, error) {
+	content, err := s.scheduledContent(ctx, authorID, contentID)
+	if err != nil {
+		return nil, err
+	}
+	content.PublishAt = &publishAt
+	if err := checkPublishAt(content, time.Now()); err != nil {
+		return nil, err
+	}
+
+	// the publisher may have got to it in the meantime
+	rescheduled, err := s.contentRepo.RescheduleContent(ctx, contentID, publishAt)
+	if err != nil {
+		return nil, err
+	}
+	if !rescheduled {
+		return nil, ErrNotScheduled
+	}
+	content.CreatedAt = publishAt
+	return content, nil
+}
+
+// CancelScheduledContent deletes the author's scheduled content before anyone saw it
+func (s *FeedService) CancelScheduledContent(ctx context.Context, authorID, contentID int64) error {
+	if _, err := s.scheduledContent(ctx, authorID, contentID); err != nil {
+		return err
+	}
+	return s.DeleteContent(ctx, authorID, contentID)
+}
+
+// scheduledContent loads the author's content and checks that it still waits to be published
+func (s *FeedService) scheduledContent(ctx context.Context, authorID, contentID int64) (*dbmysql.Content, error) {
+	content, err := s.ownContent(ctx, authorID, contentID)
+	if err != nil {
+		return nil, err
+	}
+	if content.PublishAt == nil {
+		return nil, ErrNotScheduled
+	}
+	return content, nil
+}
+
+func (s *FeedService) startScheduledPublisher() {
+	ticker := time.NewTicker(time.Minute)
+	for {
+		<-ticker.C
+
+		s.publishDueContent(context.Background(), time.Now())
+	}
+}
+
+// publishDueContent publishes the scheduled content whose time has come, then spreads it like new content
+// and tells its author
+func (s *FeedService) publishDueContent(ctx context.Context, now time.Time) {
+	due, err := s.contentRepo.ListDueContent(ctx, now)
+	if err != nil {
+		log.Printf("failed to fetch due scheduled content: %v", err)
+		return
+	}
+
+	for i := range due {
+		content := &due[i]
+		// only one publisher wins when several instances run
+		published, err := s.contentRepo.PublishContent(ctx, content.ContentID)
+		if err != nil {
+			log.Printf("failed to publish content %d: %v", content.ContentID, err)
+			continue
+		}
+		if !published {
+			continue
+		}
+		content.PublishAt = nil
+		s.distribute(ctx, content)
+		if s.notifier != nil {
+			s.notifier.Published(content)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"gosocial/internal/dbmysql"
+)
+
+func TestScheduling_HiddenUntilPublished(t *testing.T) {
+	svc, cRepo, _ := newCommentService()
+	notifier := &recordingNotifier{}
+	svc.SetNotifier(notifier)
+	ctx := context.Background()
+
+	publishAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	id, err := svc.CreatePost(ctx, 1, "soon #launch", nil, "", "", "public", 0, &publishAt)
+	if err != nil {
+		t.Fatalf("CreatePost err: %v", err)
+	}
+	if got := cRepo.m[id].CreatedAt; !got.Equal(publishAt) {
+		t.Fatalf("scheduled content should be created at its publish time, got %v", got)
+	}
+
+	if _, _, err := svc.GetVisibleContent(ctx, 2, id); !errors.Is(err, ErrContentNotVisible) {
+		t.Fatalf("friend should not see scheduled content, got %v", err)
+	}
+	if _, _, err := svc.GetVisibleContent(ctx, 1, id); err != nil {
+		t.Fatalf("author should see their scheduled content, got %v", err)
+	}
+	if contents, _, _ := svc.GetUserContent(ctx, 2, 1); len(contents) != 0 {
+		t.Fatalf("scheduled content should stay off the author's profile, got %v", contentIDs(contents))
+	}
+	if page, _ := svc.GetHashtagFeed(ctx, "launch", TimelineQuery{}); len(page.Contents) != 0 {
+		t.Fatalf("scheduled content should not be tagged yet, got %v", contentIDs(page.Contents))
+	}
+
+	// nothing is due before the publish time
+	svc.publishDueContent(ctx, time.Now())
+	if len(notifier.published) != 0 {
+		t.Fatalf("nothing should publish early, got %v", notifier.published)
+	}
+
+	svc.publishDueContent(ctx, publishAt)
+	svc.publishDueContent(ctx, publishAt.Add(time.Minute))
+	if fmt.Sprint(notifier.published) != fmt.Sprint([]int64{id}) {
+		t.Fatalf("author should be notified once, got %v", notifier.published)
+	}
+	if cRepo.m[id].PublishAt != nil {
+		t.Fatal("published content should no longer be scheduled")
+	}
+	contents, _, _ := svc.GetUserContent(ctx, 2, 1)
+	if got := contentIDs(contents); fmt.Sprint(got) != fmt.Sprint([]int64{id}) || !contents[0].CreatedAt.Equal(publishAt) {
+		t.Fatalf("published content should appear at its publish time, got %v", contents)
+	}
+	if page, _ := svc.GetHashtagFeed(ctx, "launch", TimelineQuery{}); len(page.Contents) != 1 {
+		t.Fatalf("published content should be tagged, got %v", contentIDs(page.Contents))
+	}
+}
+
+func TestScheduling_InvalidPublishTime(t *testing.T) {
+	svc, _, _ := newCommentService()
+	ctx := context.Background()
+
+	past := time.Now().Add(-time.Minute)
+	tooFar := time.Now().Add(MaxScheduleAhead + time.Hour)
+	soon := time.Now().Add(time.Hour)
+	if _, err := svc.CreatePost(ctx, 1, "late", nil, "", "", "public", 0, &past); !errors.Is(err, ErrInvalidPublishTime) {
+		t.Fatalf("expected ErrInvalidPublishTime for a past time, got %v", err)
+	}
+	if _, err := svc.CreateReel(ctx, 1, "far", nil, "", 10, "public", &tooFar); !errors.Is(err, ErrInvalidPublishTime) {
+		t.Fatalf("expected ErrInvalidPublishTime too far ahead, got %v", err)
+	}
+	story := &dbmysql.Content{AuthorID: 1, Type: "STORY", Privacy: "public", PublishAt: &soon}
+	if _, err := svc.CreateContent(ctx, story, nil, "", ""); !errors.Is(err, ErrInvalidPublishTime) {
+		t.Fatalf("stories cannot be scheduled, got %v", err)
+	}
+}
+
+func TestScheduling_ListRescheduleCancel(t *testing.T) {
+	svc, cRepo, _ := newCommentService()
+	ctx := context.Background()
+
+	now := time.Now()
+	var ids []int64
+	for _, in := range []time.Duration{3 * time.Hour, time.Hour, 2 * time.Hour} {
+		at := now.Add(in)
+		id, err := svc.CreateReel(ctx, 1, "reel", nil, "", 10, "public", &at)
+		if err != nil {
+			t.Fatalf("CreateReel err: %v", err)
+		}
+		ids = append(ids, id)
+	}
+	published, _ := svc.CreatePost(ctx, 1, "now", nil, "", "", "public", 0, nil)
+
+	first, err := svc.ListScheduledContent(ctx, 1, TimelineQuery{PageSize: 2})
+	if err != nil {
+		t.Fatalf("ListScheduledContent err: %v", err)
+	}
+	if got := contentIDs(first.Contents); fmt.Sprint(got) != fmt.Sprint([]int64{ids[1], ids[2]}) || first.NextCursor == "" {
+		t.Fatalf("first page: want soonest first, got %v cursor=%q", got, first.NextCursor)
+	}
+	second, _ := svc.ListScheduledContent(ctx, 1, TimelineQuery{Cursor: first.NextCursor, PageSize: 2})
+	if got := contentIDs(second.Contents); fmt.Sprint(got) != fmt.Sprint([]int64{ids[0]}) || second.NextCursor != "" {
+		t.Fatalf("second page: want [%d], got %v cursor=%q", ids[0], got, second.NextCursor)
+	}
+	if page, _ := svc.ListScheduledContent(ctx, 2, TimelineQuery{}); len(page.Contents) != 0 {
+		t.Fatalf("others have nothing scheduled, got %v", contentIDs(page.Contents))
+	}
+
+	later := now.Add(5 * time.Hour).Truncate(time.Second)
+	// others cannot tell scheduled content exists
+	if _, err := svc.RescheduleContent(ctx, 2, ids[1], later); !errors.Is(err, ErrContentNotVisible) {
+		t.Fatalf("expected ErrContentNotVisible, got %v", err)
+	}
+	if _, err := svc.RescheduleContent(ctx, 1, published, later); !errors.Is(err, ErrNotScheduled) {
+		t.Fatalf("expected ErrNotScheduled, got %v", err)
+	}
+	if _, err := svc.RescheduleContent(ctx, 1, ids[1], now.Add(-time.Hour)); !errors.Is(err, ErrInvalidPublishTime) {
+		t.Fatalf("expected ErrInvalidPublishTime, got %v", err)
+	}
+	moved, err := svc.RescheduleContent(ctx, 1, ids[1], later)
+	if err != nil {
+		t.Fatalf("RescheduleContent err: %v", err)
+	}
+	if !moved.CreatedAt.Equal(later) || !cRepo.m[ids[1]].PublishAt.Equal(later) || !cRepo.m[ids[1]].CreatedAt.Equal(later) {
+		t.Fatalf("rescheduled content should move to %v, got %+v", later, cRepo.m[ids[1]])
+	}
+
+	if err := svc.CancelScheduledContent(ctx, 2, ids[2]); !errors.Is(err, ErrContentNotVisible) {
+		t.Fatalf("expected ErrContentNotVisible, got %v", err)
+	}
+	if err := svc.CancelScheduledContent(ctx, 1, published); !errors.Is(err, ErrNotScheduled) {
+		t.Fatalf("published content cannot be cancelled, got %v", err)
+	}
+	if err := svc.CancelScheduledContent(ctx, 1, ids[2]); err != nil {
+		t.Fatalf("CancelScheduledContent err: %v", err)
+	}
+	if _, ok := cRepo.m[ids[2]]; ok {
+		t.Fatal("cancelled content should be deleted")
+	}
+	page, _ := svc.ListScheduledContent(ctx, 1, TimelineQuery{})
+	if got := contentIDs(page.Contents); fmt.Sprint(got) != fmt.Sprint([]int64{ids[0], ids[1]}) {
+		t.Fatalf("want the remaining schedule in order, got %v", got)
+	}
+}
@@ -0,0 +1,132 @@
+package feed
+
+import (
+	"context"
+	"errors"
+
+	"gosocial/internal/dbmysql"
+)
+
+var (
+	ErrNotShareable = errors.New("only posts and reels can be shared")
+	ErrSharePrivacy = errors.New("a share cannot be visible to more people than the original")
+)
+
+// privacyRank orders privacies from the widest to the narrowest audience, audience lists hold some of the friends
+var privacyRank = map[string]int{"public": 0, "friends": 1, "list": 2, "private": 3}
+
+// SharedOriginal is the original of a share as the viewer sees it, nil in a map means it is unavailable
+type SharedOriginal struct {
+	Content  dbmysql.Content
+	MediaURL string
+}
+
+// ShareContent creates a POST referencing the original, commentary turns the repost into a quote post.
+// An empty privacy takes the original's, a wider one than the original's is refused.
+// Reposting a repost without commentary shares the original it points to
+func (s *FeedService) ShareContent(ctx context.Context, sharerID, originalID int64, commentary, privacy string) (int64, error) {
+	original, err := s.visibleContent(ctx, sharerID, originalID)
+	if err != nil {
+		return 0, err
+	}
+	if original.SharedContentID != nil && safeString(original.TextContent) == "" {
+		if original, err = s.visibleContent(ctx, sharerID, *original.SharedContentID); err != nil {
+			return 0, err
+		}
+	}
+	if original.Type != "POST" && original.Type != "REEL" {
+		return 0, ErrNotShareable
+	}
+
+	if privacy == "" {
+		privacy = original.Privacy
+		// the original's audience list belongs to its author, the share stays with the sharer
+		if privacy == "list" {
+			privacy = "private"
+		}
+	}
+	if _, ok := privacyRank[privacy]; !ok {
+		return 0, ErrInvalidPrivacy
+	}
+	if widerThan(privacy, nil, original) {
+		return 0, ErrSharePrivacy
+	}
+
+	share := &dbmysql.Content{
+		AuthorID:        sharerID,
+		Type:            "POST",
+		Privacy:         privacy,
+		SharedContentID: &original.ContentID,
+	}
+	if commentary != "" {
+		share.TextContent = &commentary
+	}
+	return s.CreateContent(ctx, share, nil, "", "")
+}
+
+func (s *FeedService) CountShares(ctx context.Context, contentIDs []int64) (map[int64]int64, error) {
+	return s.contentRepo.CountShares(ctx, contentIDs)
+}
+
+// ResolveShared loads the originals of the shares among contents, keyed by original ID.
+// Originals that were deleted or that the viewer may not see map to nil
+func (s *FeedService) ResolveShared(ctx context.Context, viewerID int64, contents []dbmysql.Content) (map[int64]*SharedOriginal, error) {
+	var ids []int64
+	for _, c := range contents {
+		if c.SharedContentID != nil {
+			ids = append(ids, *c.SharedContentID)
+		}
+	}
+	shared := make(map[int64]*SharedOriginal, len(ids))
+	if len(ids) == 0 {
+		return shared, nil
+	}
+	for _, id := range ids {
+		shared[id] = nil
+	}
+
+	originals, err := s.contentRepo.ListContentsByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	visible, err := s.filterVisible(ctx, viewerID, originals)
+	if err != nil {
+		return nil, err
+	}
+
+	urls, err := s.mediaURLs(ctx, visible)
+	if err != nil {
+		return nil, err
+	}
+	for i, original := range visible {
+		shared[original.ContentID] = &SharedOriginal{Content: original, MediaURL: urls[i]}
+	}
+	return shared, nil
+}
+
+// checkSharePrivacy keeps a share at most as visible as its original, a deleted original no longer limits it
+func (s *FeedService) checkSharePrivacy(ctx context.Context, share *dbmysql.Content, privacy string) error {
+	if share.SharedContentID == nil {
+		return nil
+	}
+	originals, err := s.contentRepo.ListContentsByIDs(ctx, []int64{*share.SharedContentID})
+	if err != nil {
+		return err
+	}
+	if len(originals) > 0 && widerThan(privacy, share.AudienceListID, &originals[0]) {
+		return ErrSharePrivacy
+	}
+	return nil
+}
+
+// widerThan tells whether privacy, with audienceListID for list privacy, reaches people the original does not.
+// Two lists are only comparable when they are the same list
+func widerThan(privacy string, audienceListID *int64, original *dbmysql.Content) bool {
+	if privacyRank[privacy] < privacyRank[original.Privacy] {
+		return true
+	}
+	if privacy == "list" && original.Privacy == "list" {
+		return audienceListID == nil || original.AudienceListID == nil || *audienceListID != *original.AudienceListID
+	}
+	return false
+}
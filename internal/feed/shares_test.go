@@ -0,0 +1,101 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gosocial/internal/dbmysql"
+)
+
+func TestShares_PrivacyAndCounts(t *testing.T) {
+	svc, cRepo, _ := newCommentService()
+	ctx := context.Background()
+
+	for _, privacy := range []string{"public", "friends", "private"} {
+		_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 1, Type: "POST", Privacy: privacy})
+	}
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 1, Type: "STORY", Privacy: "public"})
+
+	cases := []struct {
+		name             string
+		sharer, original int64
+		privacy          string
+		want             error
+	}{
+		{"friends-only post shared publicly", 2, 2, "public", ErrSharePrivacy},
+		{"stranger sharing a friends-only post", 3, 2, "friends", ErrContentNotVisible},
+		{"private post shared by someone else", 2, 3, "", ErrContentNotVisible},
+		{"story", 2, 4, "", ErrNotShareable},
+		{"unknown privacy", 2, 1, "everyone", ErrInvalidPrivacy},
+	}
+	for _, c := range cases {
+		if _, err := svc.ShareContent(ctx, c.sharer, c.original, "", c.privacy); !errors.Is(err, c.want) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.want, err)
+		}
+	}
+
+	// a friend may reshare to their friends, the privacy defaults to the original's
+	repost, err := svc.ShareContent(ctx, 2, 2, "", "")
+	if err != nil || cRepo.m[repost].Privacy != "friends" || *cRepo.m[repost].SharedContentID != 2 || cRepo.m[repost].TextContent != nil {
+		t.Fatalf("unexpected repost %+v err=%v", cRepo.m[repost], err)
+	}
+	quote, err := svc.ShareContent(ctx, 3, 1, "so true", "friends")
+	if err != nil || safeString(cRepo.m[quote].TextContent) != "so true" || cRepo.m[quote].Type != "POST" {
+		t.Fatalf("unexpected quote post %+v err=%v", cRepo.m[quote], err)
+	}
+	// reposting a repost shares its original
+	again, err := svc.ShareContent(ctx, 2, repost, "", "")
+	if err != nil || *cRepo.m[again].SharedContentID != 2 {
+		t.Fatalf("repost of a repost should point to the original, got %+v err=%v", cRepo.m[again], err)
+	}
+
+	counts, _ := svc.CountShares(ctx, []int64{1, 2, 3})
+	if counts[1] != 1 || counts[2] != 2 || counts[3] != 0 {
+		t.Fatalf("unexpected share counts %v", counts)
+	}
+
+	// a share cannot later be widened beyond its original
+	if err := svc.UpdateContentPrivacy(ctx, 2, repost, "public"); !errors.Is(err, ErrSharePrivacy) {
+		t.Fatalf("expected ErrSharePrivacy, got %v", err)
+	}
+	if err := svc.UpdateContentPrivacy(ctx, 2, repost, "private"); err != nil {
+		t.Fatalf("narrowing a share should be allowed, got %v", err)
+	}
+}
+
+func TestShares_OriginalAvailability(t *testing.T) {
+	svc, cRepo, _ := newCommentService()
+	ctx := context.Background()
+
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 1, Type: "POST", Privacy: "public"})
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 1, Type: "POST", Privacy: "public"})
+	first, _ := svc.ShareContent(ctx, 2, 1, "", "")
+	second, _ := svc.ShareContent(ctx, 2, 2, "", "")
+
+	// the original later becomes friends-only, then the other one is deleted
+	if err := svc.UpdateContentPrivacy(ctx, 1, 1, "friends"); err != nil {
+		t.Fatalf("UpdateContentPrivacy err: %v", err)
+	}
+	if err := svc.DeleteContent(ctx, 1, 2); err != nil {
+		t.Fatalf("DeleteContent err: %v", err)
+	}
+	shares := []dbmysql.Content{cRepo.m[first], cRepo.m[second]}
+
+	forFriend, err := svc.ResolveShared(ctx, 2, shares)
+	if err != nil || forFriend[1] == nil || forFriend[1].Content.ContentID != 1 {
+		t.Fatalf("friend should still see the original, got %+v err=%v", forFriend, err)
+	}
+	if original, ok := forFriend[2]; !ok || original != nil {
+		t.Fatalf("deleted original should be unavailable, got %+v", forFriend[2])
+	}
+
+	forStranger, _ := svc.ResolveShared(ctx, 3, shares)
+	if forStranger[1] != nil {
+		t.Fatalf("stranger should no longer see the friends-only original, got %+v", forStranger[1])
+	}
+	// the shares themselves survive the original
+	if _, ok := cRepo.m[second]; !ok {
+		t.Fatalf("share should not be deleted with its original")
+	}
+}
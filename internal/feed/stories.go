@@ -0,0 +1,145 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"gosocial/internal/dbmysql"
+)
+
+const (
+	DefaultStoryViewerPageSize = 50
+	MaxStoryViewerPageSize     = 200
+)
+
+var ErrNotAStory = errors.New("content is not a story")
+
+// StoryViewer is a user who watched a story
+type StoryViewer struct {
+	UserID   int64
+	Handle   string
+	ViewedAt time.Time
+}
+
+type StoryViewerPage struct {
+	Viewers    []StoryViewer
+	NextCursor string
+}
+
+// MarkStoryViewed records that the viewer watched a story, only the first view counts and the author's own views are not recorded
+func (s *FeedService) MarkStoryViewed(ctx context.Context, viewerID, storyID int64) error {
+	story, err := s.visibleContent(ctx, viewerID, storyID)
+	if err != nil {
+		return err
+	}
+	if story.Type != "STORY" {
+		return ErrNotAStory
+	}
+	if story.AuthorID == viewerID {
+		return nil
+	}
+
+	return s.storyViewRepo.RecordStoryView(ctx, &dbmysql.StoryView{
+		StoryID:   storyID,
+		ViewerID:  viewerID,
+		CreatedAt: time.Now(),
+	})
+}
+
+// ListStoryViewers pages through the viewers of a story in the order they watched it, only for its author
+func (s *FeedService) ListStoryViewers(ctx context.Context, requesterID, storyID int64, cursor string, pageSize int) (*StoryViewerPage, error) {
+	afterID, err := decodeIDCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	if pageSize <= 0 {
+		pageSize = DefaultStoryViewerPageSize
+	}
+	if pageSize > MaxStoryViewerPageSize {
+		pageSize = MaxStoryViewerPageSize
+	}
+
+	story, err := s.ownContent(ctx, requesterID, storyID)
+	if err != nil {
+		return nil, err
+	}
+	if story.Type != "STORY" {
+		return nil, ErrNotAStory
+	}
+
+	views, err := s.storyViewRepo.ListStoryViews(ctx, storyID, afterID, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &StoryViewerPage{}
+	if len(views) > pageSize {
+		views = views[:pageSize]
+		page.NextCursor = encodeIDCursor(views[pageSize-1].ID)
+	}
+
+	viewerIDs := make([]int64, 0, len(views))
+	for _, view := range views {
+		viewerIDs = append(viewerIDs, view.ViewerID)
+	}
+	handles, err := s.GetUserHandles(ctx, viewerIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, view := range views {
+		page.Viewers = append(page.Viewers, StoryViewer{
+			UserID:   view.ViewerID,
+			Handle:   handles[view.ViewerID],
+			ViewedAt: view.CreatedAt,
+		})
+	}
+	return page, nil
+}
+
+// StoriesSeen reports for every story among contents whether the viewer has watched it, the viewer's own stories count as seen
+func (s *FeedService) StoriesSeen(ctx context.Context, viewerID int64, contents []dbmysql.Content) (map[int64]bool, error) {
+	var storyIDs []int64
+	for _, c := range contents {
+		if c.Type == "STORY" && c.AuthorID != viewerID {
+			storyIDs = append(storyIDs, c.ContentID)
+		}
+	}
+
+	seen, err := s.storyViewRepo.ListViewedStories(ctx, viewerID, storyIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range contents {
+		if c.Type == "STORY" && c.AuthorID == viewerID {
+			seen[c.ContentID] = true
+		}
+	}
+	return seen, nil
+}
+
+// cleanupExpiredStories moves every story that expired before now to its author's archive.
+// Views are purged and the story leaves the timelines, its media is kept for highlights
+func (s *FeedService) cleanupExpiredStories(ctx context.Context, now time.Time) {
+	expired, err := s.contentRepo.ListExpiredStories(ctx, now)
+	if err != nil {
+		log.Printf("failed to fetch expired stories: %v", err)
+		return
+	}
+
+	for _, story := range expired {
+		if err := s.contentRepo.ArchiveContent(ctx, story.ContentID, now); err != nil {
+			log.Printf("failed to archive expired story %d: %v", story.ContentID, err)
+			continue
+		}
+		if err := s.storyViewRepo.DeleteStoryViews(ctx, story.ContentID); err != nil {
+			log.Printf("failed to purge views of story %d: %v", story.ContentID, err)
+		}
+		if s.timelines != nil {
+			if err := s.timelines.RemoveFromTimelines(ctx, story.ContentID); err != nil {
+				log.Printf("failed to remove story %d from timelines: %v", story.ContentID, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	userpb "gosocial/api/v1/user"
+	"gosocial/internal/dbmysql"
+
+	"google.golang.org/grpc"
+)
+
+func newStoryService() (*FeedService, *fakeContentRepo, *fakeStoryViewRepo) {
+	svc, cRepo, _ := newCommentService()
+	svc.UserClient.(*fakeUserClient).HandlesFn = func(ctx context.Context, in *userpb.GetHandlesRequest, _ ...grpc.CallOption) (*userpb.GetHandlesResponse, error) {
+		resp := &userpb.GetHandlesResponse{Handles: map[int64]string{}}
+		for _, id := range in.UserIds {
+			resp.Handles[id] = fmt.Sprintf("user%d", id)
+		}
+		return resp, nil
+	}
+	return svc, cRepo, svc.storyViewRepo.(*fakeStoryViewRepo)
+}
+
+func TestStories_MarkViewedAndListViewers(t *testing.T) {
+	svc, cRepo, views := newStoryService()
+	ctx := context.Background()
+	expiration := time.Now().Add(time.Hour)
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 1, Type: "STORY", Privacy: "friends", Expiration: &expiration})
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 1, Type: "POST", Privacy: "public"})
+
+	if err := svc.MarkStoryViewed(ctx, 2, 2); !errors.Is(err, ErrNotAStory) {
+		t.Fatalf("expected ErrNotAStory, got %v", err)
+	}
+	// user 3 is not a friend of the author
+	if err := svc.MarkStoryViewed(ctx, 3, 1); !errors.Is(err, ErrContentNotVisible) {
+		t.Fatalf("expected ErrContentNotVisible, got %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if err := svc.MarkStoryViewed(ctx, 2, 1); err != nil {
+			t.Fatalf("MarkStoryViewed err: %v", err)
+		}
+	}
+	// the author watching their own story is not a view
+	_ = svc.MarkStoryViewed(ctx, 1, 1)
+	if len(views.views) != 1 {
+		t.Fatalf("expected exactly one recorded view, got %+v", views.views)
+	}
+
+	if _, err := svc.ListStoryViewers(ctx, 2, 1, "", 0); !errors.Is(err, ErrNotContentOwner) {
+		t.Fatalf("only the author may list viewers, got %v", err)
+	}
+	page, err := svc.ListStoryViewers(ctx, 1, 1, "", 0)
+	if err != nil || len(page.Viewers) != 1 || page.Viewers[0].UserID != 2 || page.Viewers[0].Handle != "user2" || page.NextCursor != "" {
+		t.Fatalf("unexpected viewers %+v err=%v", page, err)
+	}
+}
+
+func TestStories_ViewerPagination(t *testing.T) {
+	svc, cRepo, _ := newStoryService()
+	ctx := context.Background()
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 1, Type: "STORY", Privacy: "public"})
+	for uid := int64(2); uid <= 6; uid++ {
+		_ = svc.MarkStoryViewed(ctx, uid, 1)
+	}
+
+	var got []int64
+	cursor := ""
+	for {
+		page, err := svc.ListStoryViewers(ctx, 1, 1, cursor, 2)
+		if err != nil {
+			t.Fatalf("ListStoryViewers err: %v", err)
+		}
+		for _, v := range page.Viewers {
+			got = append(got, v.UserID)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+	if fmt.Sprint(got) != "[2 3 4 5 6]" {
+		t.Fatalf("viewers should be listed once each in view order, got %v", got)
+	}
+}
+
+func TestStories_Seen(t *testing.T) {
+	svc, cRepo, _ := newStoryService()
+	ctx := context.Background()
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 1, Type: "STORY", Privacy: "public"})
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 1, Type: "STORY", Privacy: "public"})
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 2, Type: "STORY", Privacy: "public"})
+	_ = cRepo.CreateContent(ctx, &dbmysql.Content{AuthorID: 1, Type: "POST", Privacy: "public"})
+	_ = svc.MarkStoryViewed(ctx, 2, 1)
+
+	var contents []dbmysql.Content
+	for id := int64(1); id <= 4; id++ {
+		c, _ := cRepo.GetContentByID(ctx, id)
+		contents = append(contents, *c)
+	}
+	seen, err := svc.StoriesSeen(ctx, 2, contents)
+	if err != nil {
+		t.Fatalf("StoriesSeen err: %v", err)
+	}
+	// story 1 was watched, story 2 was not, story 3 is the viewer's own
+	if !seen[1] || seen[2] || !seen[3] || seen[4] {
+		t.Fatalf("unexpected seen state %v", seen)
+	}
+}
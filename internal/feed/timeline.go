@@ -0,0 +1,73 @@
+package feed
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"gosocial/internal/dbmysql"
+)
+
+const (
+	DefaultTimelinePageSize = 20
+	MaxTimelinePageSize     = 100
+)
+
+var ErrInvalidCursor = errors.New("invalid timeline cursor")
+
+// TimelineCursor marks the last item of a page, timelines are ordered by (created_at, content_id) descending
+type TimelineCursor struct {
+	CreatedAt time.Time
+	ContentID int64
+}
+
+// TimelineQuery selects one page of a timeline, Ranking is chronological when empty
+type TimelineQuery struct {
+	Cursor   string
+	PageSize int
+	Ranking  string
+}
+
+// TimelinePage holds the contents of a page and their media URLs at the same index
+type TimelinePage struct {
+	Contents   []dbmysql.Content
+	MediaURLs  []string
+	NextCursor string
+}
+
+func (c TimelineCursor) Encode() string {
+	raw := fmt.Sprintf("%d:%d", c.CreatedAt.UnixNano(), c.ContentID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeCursor parses an opaque cursor, an empty cursor means the first page
+func DecodeCursor(cursor string) (*TimelineCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var nanos, contentID int64
+	if _, err := fmt.Sscanf(string(raw), "%d:%d", &nanos, &contentID); err != nil || contentID <= 0 {
+		return nil, ErrInvalidCursor
+	}
+	return &TimelineCursor{CreatedAt: time.Unix(0, nanos), ContentID: contentID}, nil
+}
+
+func cursorOf(c dbmysql.Content) TimelineCursor {
+	return TimelineCursor{CreatedAt: c.CreatedAt, ContentID: c.ContentID}
+}
+
+func clampPageSize(size int) int {
+	if size <= 0 {
+		return DefaultTimelinePageSize
+	}
+	if size > MaxTimelinePageSize {
+		return MaxTimelinePageSize
+	}
+	return size
+}
@@ -0,0 +1,117 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"time"
+
+	"gosocial/internal/common"
+	"gosocial/internal/dbmysql"
+)
+
+// MaxUploadBytes caps a single streamed upload
+const MaxUploadBytes = 200 << 20
+
+var (
+	ErrInvalidUpload  = errors.New("an upload needs an uploader, a file name and an image or video type")
+	ErrUploadTooLarge = errors.New("uploads are limited to 200MB")
+	ErrMediaNotFound  = errors.New("uploaded media not found")
+	ErrNotMediaOwner  = errors.New("only the uploader can use this media")
+	ErrMediaInUse     = errors.New("the media is already used by another content")
+)
+
+// UploadedContent is a post, reel or story whose media was sent earlier through UploadMedia
+type UploadedContent struct {
+	AuthorID    int64
+	Type        string // POST, REEL or STORY
+	Text        string // a post's text or a reel's caption
+	MediaRefID  int64
+	DurationSec int
+	Privacy     string
+
+	// the audience list of list privacy content, 0 for other privacies
+	AudienceListID int64
+
+	// when set, a post or reel waits until then to be published
+	PublishAt *time.Time
+}
+
+// UploadMedia stores a file as it is read from r, without holding all of it in memory.
+// The media belongs to the uploader until a content uses it
+func (s *FeedService) UploadMedia(ctx context.Context, uploaderID int64, fileName, mediaType string, r io.Reader) (*dbmysql.MediaRef, error) {
+	if uploaderID <= 0 || fileName == "" || !common.MediaFileType(mediaType).IsValid() {
+		return nil, ErrInvalidUpload
+	}
+
+	media := &dbmysql.MediaRef{
+		Type:       mediaType,
+		FileName:   fileName,
+		UploadedBy: strconv.FormatInt(uploaderID, 10),
+	}
+	if err := s.mediaRepo.UploadMediaRef(ctx, media, &limitedReader{r: r, left: MaxUploadBytes}); err != nil {
+		return nil, err
+	}
+	return media, nil
+}
+
+// CreateFromUpload creates a post, reel or story around media the author uploaded and no content uses yet
+func (s *FeedService) CreateFromUpload(ctx context.Context, upload UploadedContent) (int64, error) {
+	refs, err := s.mediaRepo.ListMediaRefsByIDs(ctx, []int64{upload.MediaRefID})
+	if err != nil {
+		return 0, err
+	}
+	if len(refs) == 0 {
+		return 0, ErrMediaNotFound
+	}
+	if refs[0].UploadedBy != strconv.FormatInt(upload.AuthorID, 10) {
+		return 0, ErrNotMediaOwner
+	}
+	attached, err := s.contentRepo.IsMediaAttached(ctx, upload.MediaRefID)
+	if err != nil {
+		return 0, err
+	}
+	if attached {
+		return 0, ErrMediaInUse
+	}
+
+	content := &dbmysql.Content{
+		AuthorID:       upload.AuthorID,
+		Type:           upload.Type,
+		Privacy:        upload.Privacy,
+		AudienceListID: audienceListRef(upload.AudienceListID),
+		MediaRefID:     &upload.MediaRefID,
+		PublishAt:      upload.PublishAt,
+	}
+	switch upload.Type {
+	case "POST":
+		content.TextContent = &upload.Text
+	case "REEL":
+		if refs[0].Type != string(common.MediaFileTypeVideo) {
+			return 0, ErrInvalidMedia
+		}
+		content.TextContent = &upload.Text
+		content.Duration = &upload.DurationSec
+	case "STORY":
+		content.Duration = &upload.DurationSec
+	default:
+		return 0, ErrInvalidMedia
+	}
+	return s.CreateContent(ctx, content, nil, "", "")
+}
+
+// limitedReader fails with ErrUploadTooLarge once more than left bytes were read
+type limitedReader struct {
+	r    io.Reader
+	left int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.left -= int64(n)
+	if l.left < 0 {
+		return n, ErrUploadTooLarge
+	}
+	return n, err
+}
@@ -0,0 +1,62 @@
+package feed
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestUploads_StreamAndCreate(t *testing.T) {
+	svc, cRepo, _ := newCommentService()
+	ctx := context.Background()
+
+	if _, err := svc.UploadMedia(ctx, 1, "", "image", bytes.NewReader([]byte("x"))); !errors.Is(err, ErrInvalidUpload) {
+		t.Fatalf("expected ErrInvalidUpload, got %v", err)
+	}
+	image, err := svc.UploadMedia(ctx, 1, "a.jpg", "image", bytes.NewReader([]byte("image")))
+	if err != nil || image.Size != 5 || image.UploadedBy != "1" {
+		t.Fatalf("UploadMedia mismatch: %+v err=%v", image, err)
+	}
+	video, _ := svc.UploadMedia(ctx, 1, "b.mp4", "video", bytes.NewReader([]byte("video")))
+
+	imageID, videoID := int64(image.MediaRefID), int64(video.MediaRefID)
+	cases := []struct {
+		name   string
+		upload UploadedContent
+		want   error
+	}{
+		{"unknown media", UploadedContent{AuthorID: 1, Type: "POST", MediaRefID: 99, Privacy: "public"}, ErrMediaNotFound},
+		{"someone else's upload", UploadedContent{AuthorID: 2, Type: "POST", MediaRefID: imageID, Privacy: "public"}, ErrNotMediaOwner},
+		{"reel from an image", UploadedContent{AuthorID: 1, Type: "REEL", MediaRefID: imageID, DurationSec: 5, Privacy: "public"}, ErrInvalidMedia},
+	}
+	for _, c := range cases {
+		if _, err := svc.CreateFromUpload(ctx, c.upload); !errors.Is(err, c.want) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.want, err)
+		}
+	}
+
+	postID, err := svc.CreateFromUpload(ctx, UploadedContent{AuthorID: 1, Type: "POST", Text: "hi", MediaRefID: imageID, Privacy: "public"})
+	if err != nil || *cRepo.m[postID].MediaRefID != imageID {
+		t.Fatalf("post should use the upload, got %+v err=%v", cRepo.m[postID], err)
+	}
+	if _, err := svc.CreateFromUpload(ctx, UploadedContent{AuthorID: 1, Type: "STORY", MediaRefID: imageID, DurationSec: 60, Privacy: "public"}); !errors.Is(err, ErrMediaInUse) {
+		t.Fatalf("expected ErrMediaInUse, got %v", err)
+	}
+	reelID, err := svc.CreateFromUpload(ctx, UploadedContent{AuthorID: 1, Type: "REEL", Text: "clip", MediaRefID: videoID, DurationSec: 15, Privacy: "friends"})
+	if err != nil || *cRepo.m[reelID].Duration != 15 || safeString(cRepo.m[reelID].TextContent) != "clip" {
+		t.Fatalf("reel should use the upload, got %+v err=%v", cRepo.m[reelID], err)
+	}
+}
+
+func TestUploads_LimitedReader(t *testing.T) {
+	r := &limitedReader{r: bytes.NewReader(make([]byte, 10)), left: 8}
+	if _, err := io.ReadAll(r); !errors.Is(err, ErrUploadTooLarge) {
+		t.Fatalf("expected ErrUploadTooLarge, got %v", err)
+	}
+	r = &limitedReader{r: bytes.NewReader(make([]byte, 8)), left: 8}
+	if data, err := io.ReadAll(r); err != nil || len(data) != 8 {
+		t.Fatalf("reading up to the limit should succeed, got %d bytes err=%v", len(data), err)
+	}
+}
@@ -14,6 +14,7 @@ type FriendRepository interface {
 	ListFriends(ctx context.Context, userID uint64) ([]*dbmysql.User, error)
 	ListPendingRequests(ctx context.Context, userID uint64)([]*dbmysql.Friend, error)
 	CheckFriendshipExists(ctx context.Context, userID, friendUserID uint64)(bool, error)
+	ListBlockers(ctx context.Context, userID uint64) ([]uint64, error)
 }
 
 
@@ -104,3 +105,13 @@ func (r *friendRepository) CheckFriendshipExists(ctx context.Context, userID, fr
 			Count(&count).Error
 	return count > 0, err
 }
+
+// ListBlockers returns the users who blocked userID, a block is a friendship row of the blocker with status blocked
+func (r *friendRepository) ListBlockers(ctx context.Context, userID uint64) ([]uint64, error) {
+	var blockerIDs []uint64
+	err := r.db.WithContext(ctx).
+		Model(&dbmysql.Friend{}).
+		Where("friend_user_id = ? AND status = ?", userID, "blocked").
+		Pluck("user_id", &blockerIDs).Error
+	return blockerIDs, err
+}
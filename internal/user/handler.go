@@ -67,6 +67,36 @@ func(h *Handler) GetProfile(ctx context.Context, req *pb.GetProfileRequest)(*pb.
 	}, nil
 }
 
+// ResolveHandles only serves other services, users cannot probe which handles exist
+func (h *Handler) ResolveHandles(ctx context.Context, req *pb.ResolveHandlesRequest) (*pb.ResolveHandlesResponse, error) {
+	ids, err := h.userService.ResolveHandles(ctx, req.Handles)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	resp := &pb.ResolveHandlesResponse{UserIds: make(map[string]int64, len(ids))}
+	for handle, id := range ids {
+		resp.UserIds[handle] = int64(id)
+	}
+	return resp, nil
+}
+
+// GetHandles only serves other services, they name users in lists and notifications with it
+func (h *Handler) GetHandles(ctx context.Context, req *pb.GetHandlesRequest) (*pb.GetHandlesResponse, error) {
+	userIDs := make([]uint64, 0, len(req.UserIds))
+	for _, id := range req.UserIds {
+		userIDs = append(userIDs, uint64(id))
+	}
+	handles, err := h.userService.GetHandles(ctx, userIDs)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	resp := &pb.GetHandlesResponse{Handles: make(map[int64]string, len(handles))}
+	for id, handle := range handles {
+		resp.Handles[int64(id)] = handle
+	}
+	return resp, nil
+}
+
 func (h *Handler) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRequest) (*pb.StatusResponse, error) {
 	userID, ok := ctx.Value("user_id").(uint64)
     if !ok {
@@ -103,11 +133,15 @@ func(h *Handler) AcceptFriendRequest(ctx context.Context, req *pb.FriendAcceptRe
 	return &pb.StatusResponse{Message: "Friend Request Accepted!!", Success: true}, nil
 }
 
+// ListFriends answers for the caller, other services authenticate with the service token and ask for req.UserId
 func (h *Handler) ListFriends(ctx context.Context, req *pb.UserID) (*pb.FriendList, error){
 	userID, ok := ctx.Value("user_id").(uint64)
-    if !ok {
-        return nil, status.Error(codes.Unauthenticated, "user not authenticated")
-    }
+	if !ok {
+		if req.UserId <= 0 {
+			return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+		}
+		userID = uint64(req.UserId)
+	}
 	friends, err := h.userService.ListFriends(ctx, userID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -125,6 +159,19 @@ func (h *Handler) ListFriends(ctx context.Context, req *pb.UserID) (*pb.FriendLi
 
 }
 
+// ListBlockers only serves other services, the auth interceptor refuses it to users so no one is told who blocked them
+func (h *Handler) ListBlockers(ctx context.Context, req *pb.UserID) (*pb.UserIDList, error) {
+	blockerIDs, err := h.userService.ListBlockers(ctx, uint64(req.UserId))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	out := make([]int64, 0, len(blockerIDs))
+	for _, id := range blockerIDs {
+		out = append(out, int64(id))
+	}
+	return &pb.UserIDList{UserIds: out}, nil
+}
+
 
 func (h *Handler) RegisterDevice(ctx context.Context, req *pb.DeviceTokenRequest) (*pb.StatusResponse, error) {
 	userID, ok := ctx.Value("user_id").(uint64)
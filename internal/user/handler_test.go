@@ -3,14 +3,20 @@ package user
 import (
 	"context"
 	"errors"
+	"net"
 	"testing"
 
+	"gosocial/internal/common"
 	"gosocial/internal/dbmysql"
 	pb "gosocial/api/v1/user"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
 )
 
 // Helper: context with user_id
@@ -446,6 +452,117 @@ func TestHandler_ListFriends(t *testing.T) {
 	}
 }
 
+// ---- ListBlockers ----
+// serveWithAuth runs the handler behind the real auth interceptor and returns a dialer for it
+func serveWithAuth(t *testing.T, svc UserService) func(opts ...grpc.DialOption) pb.UserServiceClient {
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.UnaryInterceptor(common.AuthInterceptor()))
+	pb.RegisterUserServiceServer(server, NewHandler(svc))
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+	return func(opts ...grpc.DialOption) pb.UserServiceClient {
+		opts = append(opts,
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+			grpc.WithTransportCredentials(insecure.NewCredentials()))
+		conn, err := grpc.NewClient("passthrough:///bufnet", opts...)
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+		return pb.NewUserServiceClient(conn)
+	}
+}
+
+func TestHandler_ListBlockers_ServiceOnly(t *testing.T) {
+	t.Setenv("SERVICE_TOKEN", "internal-secret")
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockSvc := NewMockUserService(ctrl)
+	mockSvc.EXPECT().ListBlockers(gomock.Any(), uint64(1)).Return([]uint64{4, 7}, nil)
+	dial := serveWithAuth(t, mockSvc)
+
+	// a user's JWT does not open service-only methods
+	token, err := common.GenerateToken(1, "alice")
+	require.NoError(t, err)
+	userCtx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+	_, err = dial().ListBlockers(userCtx, &pb.UserID{UserId: 1})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	wrongCtx := metadata.AppendToOutgoingContext(context.Background(), "x-service-token", "guess")
+	_, err = dial().ListBlockers(wrongCtx, &pb.UserID{UserId: 1})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	resp, err := dial(grpc.WithUnaryInterceptor(common.ServiceTokenInterceptor())).ListBlockers(context.Background(), &pb.UserID{UserId: 1})
+	require.NoError(t, err)
+	require.Equal(t, []int64{4, 7}, resp.UserIds)
+}
+
+func TestHandler_ResolveHandles_ServiceOnly(t *testing.T) {
+	t.Setenv("SERVICE_TOKEN", "internal-secret")
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockSvc := NewMockUserService(ctrl)
+	dial := serveWithAuth(t, mockSvc)
+
+	token, err := common.GenerateToken(1, "alice")
+	require.NoError(t, err)
+	userCtx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+	_, err = dial().ResolveHandles(userCtx, &pb.ResolveHandlesRequest{Handles: []string{"bob"}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	mockSvc.EXPECT().ResolveHandles(gomock.Any(), []string{"bob"}).Return(map[string]uint64{"bob": 9}, nil)
+	resp, err := dial(grpc.WithUnaryInterceptor(common.ServiceTokenInterceptor())).ResolveHandles(context.Background(), &pb.ResolveHandlesRequest{Handles: []string{"bob"}})
+	require.NoError(t, err)
+	require.Equal(t, int64(9), resp.UserIds["bob"])
+}
+
+func TestHandler_GetHandles_ServiceOnly(t *testing.T) {
+	t.Setenv("SERVICE_TOKEN", "internal-secret")
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockSvc := NewMockUserService(ctrl)
+	dial := serveWithAuth(t, mockSvc)
+
+	token, err := common.GenerateToken(1, "alice")
+	require.NoError(t, err)
+	userCtx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+	_, err = dial().GetHandles(userCtx, &pb.GetHandlesRequest{UserIds: []int64{9}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	mockSvc.EXPECT().GetHandles(gomock.Any(), []uint64{9, 12}).Return(map[uint64]string{9: "bob"}, nil)
+	resp, err := dial(grpc.WithUnaryInterceptor(common.ServiceTokenInterceptor())).GetHandles(context.Background(), &pb.GetHandlesRequest{UserIds: []int64{9, 12}})
+	require.NoError(t, err)
+	require.Equal(t, map[int64]string{9: "bob"}, resp.Handles)
+}
+
+func TestHandler_ListFriends_ServiceAsksForAnyUser(t *testing.T) {
+	t.Setenv("SERVICE_TOKEN", "internal-secret")
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockSvc := NewMockUserService(ctrl)
+	dial := serveWithAuth(t, mockSvc)
+
+	// services get the friends of the user they ask for
+	mockSvc.EXPECT().ListFriends(gomock.Any(), uint64(5)).Return([]*dbmysql.User{{UserID: 9, Handle: "bob"}}, nil)
+	resp, err := dial(grpc.WithUnaryInterceptor(common.ServiceTokenInterceptor())).ListFriends(context.Background(), &pb.UserID{UserId: 5})
+	require.NoError(t, err)
+	require.Len(t, resp.Friends, 1)
+	require.Equal(t, int64(9), resp.Friends[0].UserId)
+
+	// users only ever get their own
+	token, err := common.GenerateToken(1, "alice")
+	require.NoError(t, err)
+	mockSvc.EXPECT().ListFriends(gomock.Any(), uint64(1)).Return(nil, nil)
+	userCtx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+	_, err = dial().ListFriends(userCtx, &pb.UserID{UserId: 5})
+	require.NoError(t, err)
+
+	wrongCtx := metadata.AppendToOutgoingContext(context.Background(), "x-service-token", "guess")
+	_, err = dial().ListFriends(wrongCtx, &pb.UserID{UserId: 5})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = dial().ListFriends(context.Background(), &pb.UserID{UserId: 5})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
 // ---- RegisterDevice ----
 func TestHandler_RegisterDevice(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -79,6 +79,21 @@ func (mr *MockFriendRepositoryMockRecorder) GetFriendRequest(ctx, userID, friend
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFriendRequest", reflect.TypeOf((*MockFriendRepository)(nil).GetFriendRequest), ctx, userID, friendUserID)
 }
 
+// ListBlockers mocks base method.
+func (m *MockFriendRepository) ListBlockers(ctx context.Context, userID uint64) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBlockers", ctx, userID)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBlockers indicates an expected call of ListBlockers.
+func (mr *MockFriendRepositoryMockRecorder) ListBlockers(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlockers", reflect.TypeOf((*MockFriendRepository)(nil).ListBlockers), ctx, userID)
+}
+
 // ListFriends mocks base method.
 func (m *MockFriendRepository) ListFriends(ctx context.Context, userID uint64) ([]*dbmysql.User, error) {
 	m.ctrl.T.Helper()
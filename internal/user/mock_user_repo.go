@@ -109,6 +109,36 @@ func (mr *MockUserRepositoryMockRecorder) GetUserByID(ctx, userID interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUserRepository)(nil).GetUserByID), ctx, userID)
 }
 
+// GetUsersByHandles mocks base method.
+func (m *MockUserRepository) GetUsersByHandles(ctx context.Context, handles []string) ([]*dbmysql.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersByHandles", ctx, handles)
+	ret0, _ := ret[0].([]*dbmysql.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersByHandles indicates an expected call of GetUsersByHandles.
+func (mr *MockUserRepositoryMockRecorder) GetUsersByHandles(ctx, handles interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByHandles", reflect.TypeOf((*MockUserRepository)(nil).GetUsersByHandles), ctx, handles)
+}
+
+// GetUsersByIDs mocks base method.
+func (m *MockUserRepository) GetUsersByIDs(ctx context.Context, userIDs []uint64) ([]*dbmysql.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersByIDs", ctx, userIDs)
+	ret0, _ := ret[0].([]*dbmysql.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersByIDs indicates an expected call of GetUsersByIDs.
+func (mr *MockUserRepositoryMockRecorder) GetUsersByIDs(ctx, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByIDs", reflect.TypeOf((*MockUserRepository)(nil).GetUsersByIDs), ctx, userIDs)
+}
+
 // UpdateUser mocks base method.
 func (m *MockUserRepository) UpdateUser(ctx context.Context, user *dbmysql.User) error {
 	m.ctrl.T.Helper()
@@ -49,6 +49,21 @@ func (mr *MockUserServiceMockRecorder) AcceptFriendRequest(ctx, userID, requeste
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptFriendRequest", reflect.TypeOf((*MockUserService)(nil).AcceptFriendRequest), ctx, userID, requesterID)
 }
 
+// GetHandles mocks base method.
+func (m *MockUserService) GetHandles(ctx context.Context, userIDs []uint64) (map[uint64]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHandles", ctx, userIDs)
+	ret0, _ := ret[0].(map[uint64]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHandles indicates an expected call of GetHandles.
+func (mr *MockUserServiceMockRecorder) GetHandles(ctx, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHandles", reflect.TypeOf((*MockUserService)(nil).GetHandles), ctx, userIDs)
+}
+
 // GetProfile mocks base method.
 func (m *MockUserService) GetProfile(ctx context.Context, userID uint64) (*dbmysql.User, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +94,21 @@ func (mr *MockUserServiceMockRecorder) GetUserDevices(ctx, userID interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDevices", reflect.TypeOf((*MockUserService)(nil).GetUserDevices), ctx, userID)
 }
 
+// ListBlockers mocks base method.
+func (m *MockUserService) ListBlockers(ctx context.Context, userID uint64) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBlockers", ctx, userID)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBlockers indicates an expected call of ListBlockers.
+func (mr *MockUserServiceMockRecorder) ListBlockers(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlockers", reflect.TypeOf((*MockUserService)(nil).ListBlockers), ctx, userID)
+}
+
 // ListFriends mocks base method.
 func (m *MockUserService) ListFriends(ctx context.Context, userID uint64) ([]*dbmysql.User, error) {
 	m.ctrl.T.Helper()
@@ -154,6 +184,21 @@ func (mr *MockUserServiceMockRecorder) RemoveDevice(ctx, token interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDevice", reflect.TypeOf((*MockUserService)(nil).RemoveDevice), ctx, token)
 }
 
+// ResolveHandles mocks base method.
+func (m *MockUserService) ResolveHandles(ctx context.Context, handles []string) (map[string]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveHandles", ctx, handles)
+	ret0, _ := ret[0].(map[string]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveHandles indicates an expected call of ResolveHandles.
+func (mr *MockUserServiceMockRecorder) ResolveHandles(ctx, handles interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveHandles", reflect.TypeOf((*MockUserService)(nil).ResolveHandles), ctx, handles)
+}
+
 // SendFriendRequest mocks base method.
 func (m *MockUserService) SendFriendRequest(ctx context.Context, userID, targetUserID uint64) error {
 	m.ctrl.T.Helper()
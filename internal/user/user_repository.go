@@ -12,6 +12,8 @@ type UserRepository interface {
 	CreateUser (ctx context.Context, user *dbmysql.User) error
 	GetUserByID(ctx context.Context, userID uint64) (*dbmysql.User, error)
 	GetUserByHandle(ctx context.Context, handle string)(*dbmysql.User, error)
+	GetUsersByHandles(ctx context.Context, handles []string) ([]*dbmysql.User, error)
+	GetUsersByIDs(ctx context.Context, userIDs []uint64) ([]*dbmysql.User, error)
 	UpdateUser (ctx context.Context, user *dbmysql.User) error
 
 	GetUserByEmail(ctx context.Context, email string) (*dbmysql.User, error)
@@ -51,6 +53,26 @@ func (r *userRepository) GetUserByHandle(ctx context.Context, handle string)(*db
 	return &user, nil
 }
 
+// GetUsersByHandles returns the active users among the handles, unknown handles are skipped
+func (r *userRepository) GetUsersByHandles(ctx context.Context, handles []string) ([]*dbmysql.User, error) {
+	var users []*dbmysql.User
+	if len(handles) == 0 {
+		return users, nil
+	}
+	err := r.db.WithContext(ctx).Where("handle IN ? AND status = ?", handles, "active").Find(&users).Error
+	return users, err
+}
+
+// GetUsersByIDs returns the active users among the IDs, unknown IDs are skipped
+func (r *userRepository) GetUsersByIDs(ctx context.Context, userIDs []uint64) ([]*dbmysql.User, error) {
+	var users []*dbmysql.User
+	if len(userIDs) == 0 {
+		return users, nil
+	}
+	err := r.db.WithContext(ctx).Where("user_id IN ? AND status = ?", userIDs, "active").Find(&users).Error
+	return users, err
+}
+
 func (r *userRepository) UpdateUser(ctx context.Context, user *dbmysql.User) error {
 	return r.db.WithContext(ctx).Save(user).Error
 }
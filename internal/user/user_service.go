@@ -5,6 +5,7 @@ import (
 	"errors"
 	"gosocial/internal/common"
 	"gosocial/internal/dbmysql"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
@@ -14,10 +15,13 @@ type UserService interface {
 	RegisterUser(ctx context.Context, handle, email, password string) (*dbmysql.User, string, error)
 	LoginUser(ctx context.Context, handle, password string) (*dbmysql.User, string, error)
 	GetProfile(ctx context.Context, userID uint64) (*dbmysql.User, error)
+	ResolveHandles(ctx context.Context, handles []string) (map[string]uint64, error)
+	GetHandles(ctx context.Context, userIDs []uint64) (map[uint64]string, error)
 	UpdateProfile(ctx context.Context, userID uint64, email, phone, profileDetails string) error
 	SendFriendRequest(ctx context.Context, userID, targetUserID uint64) error
 	AcceptFriendRequest(ctx context.Context, userID, requesterID uint64) error
 	ListFriends(ctx context.Context, userID uint64) ([]*dbmysql.User, error)
+	ListBlockers(ctx context.Context, userID uint64) ([]uint64, error)
 	RegisterDevice(ctx context.Context, userID uint64, token, platform string) error
 	RemoveDevice(ctx context.Context, token string) error
 	GetUserDevices(ctx context.Context, userID uint64) ([]*dbmysql.Device, error)
@@ -116,6 +120,48 @@ func (s *userService) GetProfile(ctx context.Context, userID uint64) (*dbmysql.U
 	return s.userRepo.GetUserByID(ctx, userID)
 }
 
+// ResolveHandles maps each handle of an active user to its ID, keyed by the handle as it was asked for
+func (s *userService) ResolveHandles(ctx context.Context, handles []string) (map[string]uint64, error) {
+	if len(handles) > 100 {
+		return nil, errors.New("at most 100 handles can be resolved at once")
+	}
+
+	users, err := s.userRepo.GetUsersByHandles(ctx, handles)
+	if err != nil {
+		return nil, err
+	}
+
+	// handles are compared case-insensitively, like the database collation does
+	byHandle := make(map[string]uint64, len(users))
+	for _, u := range users {
+		byHandle[strings.ToLower(u.Handle)] = u.UserID
+	}
+	ids := make(map[string]uint64, len(handles))
+	for _, h := range handles {
+		if id, ok := byHandle[strings.ToLower(h)]; ok {
+			ids[h] = id
+		}
+	}
+	return ids, nil
+}
+
+// GetHandles maps each active user among userIDs to their handle
+func (s *userService) GetHandles(ctx context.Context, userIDs []uint64) (map[uint64]string, error) {
+	if len(userIDs) > 100 {
+		return nil, errors.New("at most 100 handles can be looked up at once")
+	}
+
+	users, err := s.userRepo.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	handles := make(map[uint64]string, len(users))
+	for _, u := range users {
+		handles[u.UserID] = u.Handle
+	}
+	return handles, nil
+}
+
 func (s *userService) UpdateProfile(ctx context.Context, userID uint64, email, phone, profileDetails string) error {
 	user, err := s.userRepo.GetUserByID(ctx, userID)
 	if err != nil {
@@ -199,6 +245,11 @@ func (s *userService) ListFriends(ctx context.Context, userID uint64) ([]*dbmysq
 	return s.friendRepo.ListFriends(ctx, userID)
 }
 
+// ListBlockers returns the users who blocked userID, so other services can hide their content from them
+func (s *userService) ListBlockers(ctx context.Context, userID uint64) ([]uint64, error) {
+	return s.friendRepo.ListBlockers(ctx, userID)
+}
+
 func (s *userService) RegisterDevice(ctx context.Context, userID uint64, token, platform string) error {
 	if token == "" {
 		return errors.New("device token required")
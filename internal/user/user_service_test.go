@@ -278,6 +278,47 @@ func TestUserService_GetProfile(t *testing.T) {
 	}
 }
 
+func TestUserService_ResolveHandles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockUserRepo := NewMockUserRepository(ctrl)
+	svc := NewUserService(mockUserRepo, NewMockFriendRepository(ctrl), NewMockDeviceRepository(ctrl))
+	ctx := context.Background()
+
+	handles := []string{"Alice", "bob", "ghost"}
+	mockUserRepo.EXPECT().GetUsersByHandles(ctx, handles).
+		Return([]*dbmysql.User{{UserID: 1, Handle: "alice"}, {UserID: 2, Handle: "bob"}}, nil)
+
+	ids, err := svc.ResolveHandles(ctx, handles)
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{"Alice": 1, "bob": 2}, ids)
+
+	mockUserRepo.EXPECT().GetUsersByHandles(ctx, []string{"x"}).Return(nil, errors.New("db down"))
+	_, err = svc.ResolveHandles(ctx, []string{"x"})
+	require.Error(t, err)
+
+	_, err = svc.ResolveHandles(ctx, make([]string, 101))
+	require.Error(t, err)
+}
+
+func TestUserService_GetHandles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockUserRepo := NewMockUserRepository(ctrl)
+	svc := NewUserService(mockUserRepo, NewMockFriendRepository(ctrl), NewMockDeviceRepository(ctrl))
+	ctx := context.Background()
+
+	mockUserRepo.EXPECT().GetUsersByIDs(ctx, []uint64{1, 2, 3}).
+		Return([]*dbmysql.User{{UserID: 1, Handle: "alice"}, {UserID: 2, Handle: "bob"}}, nil)
+
+	handles, err := svc.GetHandles(ctx, []uint64{1, 2, 3})
+	require.NoError(t, err)
+	require.Equal(t, map[uint64]string{1: "alice", 2: "bob"}, handles)
+
+	_, err = svc.GetHandles(ctx, make([]uint64, 101))
+	require.Error(t, err)
+}
+
 func TestUserService_UpdateProfile(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -639,6 +680,23 @@ func TestUserService_ListFriends(t *testing.T) {
 	}
 }
 
+func TestUserService_ListBlockers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockFriendRepo := NewMockFriendRepository(ctrl)
+	svc := NewUserService(NewMockUserRepository(ctrl), mockFriendRepo, NewMockDeviceRepository(ctrl))
+	ctx := context.Background()
+
+	mockFriendRepo.EXPECT().ListBlockers(ctx, uint64(1)).Return([]uint64{4, 7}, nil)
+	blockers, err := svc.ListBlockers(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{4, 7}, blockers)
+
+	mockFriendRepo.EXPECT().ListBlockers(ctx, uint64(2)).Return(nil, errors.New("db error"))
+	_, err = svc.ListBlockers(ctx, 2)
+	require.Error(t, err)
+}
+
 func TestUserService_RegisterDevice(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
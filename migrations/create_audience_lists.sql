@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS audience_lists (
+    list_id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    owner_id BIGINT NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    UNIQUE INDEX idx_audience_lists_owner_name (owner_id, name),
+    FOREIGN KEY (owner_id) REFERENCES users(user_id)
+    );
+
+CREATE TABLE IF NOT EXISTS audience_list_members (
+    list_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    added_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (list_id, user_id),
+    INDEX idx_audience_list_members_user_id (user_id),
+    FOREIGN KEY (list_id) REFERENCES audience_lists(list_id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(user_id)
+    );
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS bots (
+                                    bot_id BIGINT AUTO_INCREMENT PRIMARY KEY,
+                                    owner_id VARCHAR(36) NOT NULL,
+                                    name VARCHAR(100) NOT NULL,
+                                    token_hash VARCHAR(64) NOT NULL UNIQUE,
+                                    webhook_url VARCHAR(500),
+                                    webhook_secret VARCHAR(64),
+                                    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+                                    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+                                    INDEX idx_owner_id (owner_id)
+);
+
+CREATE TABLE IF NOT EXISTS bot_conversations (
+                                                 bot_id BIGINT NOT NULL,
+                                                 conversation_id VARCHAR(36) NOT NULL,
+                                                 added_by VARCHAR(36),
+                                                 added_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+
+                                                 PRIMARY KEY (bot_id, conversation_id),
+                                                 FOREIGN KEY (bot_id) REFERENCES bots(bot_id)
+);
@@ -0,0 +1,38 @@
+CREATE TABLE IF NOT EXISTS chat_events (
+                                           event_id BIGINT AUTO_INCREMENT PRIMARY KEY,
+                                           conversation_id VARCHAR(36) NOT NULL,
+                                           kind VARCHAR(32) NOT NULL,
+                                           message_id BIGINT,
+                                           actor_id VARCHAR(36),
+                                           subject_id VARCHAR(36),
+                                           created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+
+                                           INDEX idx_conversation_id (conversation_id),
+                                           INDEX idx_subject_id (subject_id)
+);
+
+CREATE TABLE IF NOT EXISTS conversation_members (
+                                                    conversation_id VARCHAR(36) NOT NULL,
+                                                    user_id VARCHAR(36) NOT NULL,
+                                                    joined_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+
+                                                    PRIMARY KEY (conversation_id, user_id),
+                                                    INDEX idx_user_id (user_id)
+);
+
+CREATE TABLE IF NOT EXISTS device_sync_cursors (
+                                                   device_token VARCHAR(255) PRIMARY KEY,
+                                                   user_id VARCHAR(36) NOT NULL,
+                                                   last_event_id BIGINT NOT NULL DEFAULT 0,
+                                                   updated_at DATETIME DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+                                                   INDEX idx_user_id (user_id),
+                                                   FOREIGN KEY (device_token) REFERENCES devices(device_token) ON DELETE CASCADE
+);
+
+-- Conversations from before membership keep the people who already wrote in them
+INSERT IGNORE INTO conversation_members (conversation_id, user_id, joined_at)
+SELECT conversation_id, sender_id, MIN(sent_at)
+FROM messages
+WHERE sender_id NOT LIKE 'bot:%'
+GROUP BY conversation_id, sender_id;
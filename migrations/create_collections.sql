@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS collections (
+    collection_id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    owner_id BIGINT NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    UNIQUE INDEX idx_collections_owner_name (owner_id, name),
+    FOREIGN KEY (owner_id) REFERENCES users(user_id)
+    );
+
+CREATE TABLE IF NOT EXISTS saved_items (
+    save_id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    collection_id BIGINT NOT NULL,
+    content_id BIGINT NOT NULL,
+    saved_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE INDEX idx_saved_items_collection_content (collection_id, content_id),
+    INDEX idx_saved_items_content_id (content_id),
+    FOREIGN KEY (collection_id) REFERENCES collections(collection_id) ON DELETE CASCADE,
+    FOREIGN KEY (content_id) REFERENCES contents(content_id) ON DELETE CASCADE
+    );
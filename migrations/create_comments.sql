@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS comments (
+    comment_id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    content_id BIGINT NOT NULL,
+    author_id BIGINT NOT NULL,
+    parent_id BIGINT,
+    text TEXT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    edited_at DATETIME,
+
+    INDEX idx_comments_content_id (content_id),
+    INDEX idx_comments_parent_id (parent_id),
+    FOREIGN KEY (content_id) REFERENCES contents(content_id) ON DELETE CASCADE,
+    FOREIGN KEY (author_id) REFERENCES users(user_id),
+    FOREIGN KEY (parent_id) REFERENCES comments(comment_id) ON DELETE CASCADE
+    );
@@ -4,12 +4,23 @@ CREATE TABLE IF NOT EXISTS contents (
                                         type ENUM('POST', 'STORY', 'REEL') NOT NULL,
     text_content TEXT,
     media_ref_id BIGINT,
-    privacy ENUM('public', 'friends', 'private') NOT NULL,
+    privacy ENUM('public', 'friends', 'list', 'private') NOT NULL,
+    audience_list_id BIGINT,
     expiration DATETIME,
     duration INT,
+    archived_at DATETIME,
+    shared_content_id BIGINT,
+    edited_at DATETIME,
+    publish_at DATETIME,
+    draft BOOLEAN NOT NULL DEFAULT FALSE,
     created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
     updated_at DATETIME DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
 
+    INDEX idx_contents_timeline (author_id, created_at),
+    INDEX idx_contents_shared_content_id (shared_content_id),
+    INDEX idx_contents_audience_list_id (audience_list_id),
+    INDEX idx_contents_publish_at (publish_at),
+    INDEX idx_contents_draft (draft, updated_at),
     FOREIGN KEY (author_id) REFERENCES users(user_id),
     FOREIGN KEY (media_ref_id) REFERENCES media_refs(media_ref_id)
     );
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS content_media (
+    content_id BIGINT NOT NULL,
+    position INT NOT NULL,
+    media_ref_id BIGINT NOT NULL,
+
+    PRIMARY KEY (content_id, position),
+    FOREIGN KEY (content_id) REFERENCES contents(content_id) ON DELETE CASCADE,
+    FOREIGN KEY (media_ref_id) REFERENCES media_refs(media_ref_id)
+    );
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS content_revisions (
+    revision_id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    content_id BIGINT NOT NULL,
+    text_content TEXT,
+    privacy ENUM('public', 'friends', 'list', 'private') NOT NULL,
+    audience_list_id BIGINT,
+    replaced_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+
+    INDEX idx_content_revisions_content_id (content_id),
+    FOREIGN KEY (content_id) REFERENCES contents(content_id) ON DELETE CASCADE
+    );
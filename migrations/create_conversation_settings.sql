@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS conversation_settings (
+                                                     conversation_id VARCHAR(36) NOT NULL,
+                                                     user_id VARCHAR(36) NOT NULL,
+                                                     muted_until DATETIME NULL,
+                                                     archived BOOLEAN NOT NULL DEFAULT FALSE,
+                                                     pinned BOOLEAN NOT NULL DEFAULT FALSE,
+                                                     pinned_at DATETIME NULL,
+                                                     updated_at DATETIME DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+                                                     PRIMARY KEY (conversation_id, user_id),
+                                                     INDEX idx_user_id (user_id)
+);
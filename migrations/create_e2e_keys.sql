@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS device_key_bundles (
+                                                  device_token VARCHAR(255) PRIMARY KEY,
+                                                  device_id VARCHAR(32) NOT NULL UNIQUE,
+                                                  user_id VARCHAR(36) NOT NULL,
+                                                  identity_key BLOB NOT NULL,
+                                                  signed_prekey_id INT UNSIGNED NOT NULL,
+                                                  signed_prekey BLOB NOT NULL,
+                                                  signed_prekey_signature BLOB NOT NULL,
+                                                  updated_at DATETIME DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+                                                  INDEX idx_user_id (user_id),
+                                                  FOREIGN KEY (device_token) REFERENCES devices(device_token) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS one_time_prekeys (
+                                                id BIGINT AUTO_INCREMENT PRIMARY KEY,
+                                                device_token VARCHAR(255) NOT NULL,
+                                                key_id INT UNSIGNED NOT NULL,
+                                                public_key BLOB NOT NULL,
+                                                created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+
+                                                INDEX idx_device_token (device_token),
+                                                FOREIGN KEY (device_token) REFERENCES devices(device_token) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS e2e_conversations (
+                                                 conversation_id VARCHAR(36) PRIMARY KEY,
+                                                 enabled_by VARCHAR(36) NOT NULL,
+                                                 enabled_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
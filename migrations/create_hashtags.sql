@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS hashtags (
+    hashtag_id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    tag VARCHAR(100) NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE INDEX idx_hashtags_tag (tag)
+    );
+
+CREATE TABLE IF NOT EXISTS content_hashtags (
+    content_id BIGINT NOT NULL,
+    hashtag_id BIGINT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (content_id, hashtag_id),
+    INDEX idx_content_hashtags_usage (hashtag_id, created_at),
+    FOREIGN KEY (content_id) REFERENCES contents(content_id) ON DELETE CASCADE,
+    FOREIGN KEY (hashtag_id) REFERENCES hashtags(hashtag_id)
+    );
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS highlights (
+    highlight_id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    owner_id BIGINT NOT NULL,
+    title VARCHAR(50) NOT NULL,
+    position INT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    INDEX idx_highlights_owner_id (owner_id),
+    FOREIGN KEY (owner_id) REFERENCES users(user_id)
+    );
+
+CREATE TABLE IF NOT EXISTS highlight_stories (
+    highlight_id BIGINT NOT NULL,
+    story_id BIGINT NOT NULL,
+    position INT NOT NULL,
+
+    PRIMARY KEY (highlight_id, story_id),
+    INDEX idx_highlight_stories_story_id (story_id),
+    FOREIGN KEY (highlight_id) REFERENCES highlights(highlight_id) ON DELETE CASCADE,
+    FOREIGN KEY (story_id) REFERENCES contents(content_id) ON DELETE CASCADE
+    );
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS mentions (
+    mention_id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    content_id BIGINT NOT NULL,
+    mentioned_user_id BIGINT NOT NULL,
+    handle VARCHAR(50) NOT NULL,
+    `offset` INT NOT NULL,
+    length INT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+
+    INDEX idx_mentions_content_id (content_id),
+    INDEX idx_mentions_user (mentioned_user_id, created_at),
+    FOREIGN KEY (content_id) REFERENCES contents(content_id) ON DELETE CASCADE,
+    FOREIGN KEY (mentioned_user_id) REFERENCES users(user_id)
+    );
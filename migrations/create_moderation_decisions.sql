@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS moderation_decisions (
+                                                    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+                                                    message_id BIGINT NULL,
+                                                    conversation_id VARCHAR(36) NOT NULL,
+                                                    sender_id VARCHAR(36) NOT NULL,
+                                                    conversation_type VARCHAR(16) NOT NULL,
+                                                    filter VARCHAR(50) NOT NULL,
+                                                    action ENUM('redact', 'reject', 'flag') NOT NULL,
+                                                    reason VARCHAR(255),
+                                                    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+
+                                                    INDEX idx_message_id (message_id),
+                                                    INDEX idx_conversation_id (conversation_id),
+                                                    INDEX idx_action (action)
+);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS reel_views (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    reel_id BIGINT NOT NULL,
+    viewer_id BIGINT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE INDEX idx_reel_views_viewer (reel_id, viewer_id),
+    FOREIGN KEY (reel_id) REFERENCES contents(content_id) ON DELETE CASCADE,
+    FOREIGN KEY (viewer_id) REFERENCES users(user_id)
+    );
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS story_views (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    story_id BIGINT NOT NULL,
+    viewer_id BIGINT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE INDEX idx_story_views_viewer (story_id, viewer_id),
+    FOREIGN KEY (story_id) REFERENCES contents(content_id) ON DELETE CASCADE,
+    FOREIGN KEY (viewer_id) REFERENCES users(user_id)
+    );
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS timeline_entries (
+    owner_id BIGINT NOT NULL,
+    content_id BIGINT NOT NULL,
+    author_id BIGINT NOT NULL,
+    created_at DATETIME NOT NULL,
+
+    PRIMARY KEY (owner_id, content_id),
+    INDEX idx_timeline_entries_page (owner_id, created_at),
+    INDEX idx_timeline_entries_content_id (content_id),
+    FOREIGN KEY (content_id) REFERENCES contents(content_id) ON DELETE CASCADE
+    );